@@ -40,6 +40,22 @@ func (c *Client) GetTranscript(ctx context.Context, transcriptID string) (*Trans
 	return &transcript, nil
 }
 
+// GetRedactedAudio retrieves the URL of the PII-redacted audio of a transcript created with RedactPIIAudio
+func (c *Client) GetRedactedAudio(ctx context.Context, transcriptID string) (*RedactedAudioResponse, error) {
+	endpoint := fmt.Sprintf("/transcript/%s/redacted-audio", transcriptID)
+	resp, err := c.makeRequest(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var redactedAudio RedactedAudioResponse
+	if err := c.handleResponse(resp, &redactedAudio); err != nil {
+		return nil, err
+	}
+
+	return &redactedAudio, nil
+}
+
 // ListTranscripts lists transcripts with optional pagination
 func (c *Client) ListTranscripts(ctx context.Context, limit *int, beforeID, afterID *string) (*ListTranscriptsResponse, error) {
 	endpoint := "/transcript"
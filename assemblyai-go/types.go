@@ -291,6 +291,12 @@ type UploadResponse struct {
 	UploadURL string `json:"upload_url"`
 }
 
+// RedactedAudioResponse represents the response from fetching a transcript's redacted audio
+type RedactedAudioResponse struct {
+	Status           string `json:"status"`
+	RedactedAudioURL string `json:"redacted_audio_url"`
+}
+
 // ListTranscriptsResponse represents the response from listing transcripts
 type ListTranscriptsResponse struct {
 	PageDetails PageDetails  `json:"page_details"`
@@ -16,11 +16,13 @@ require (
 require (
 	cloud.google.com/go/storage v1.30.1
 	firebase.google.com/go/v4 v4.11.0
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/golang-migrate/migrate/v4 v4.16.2
 	github.com/stretchr/testify v1.10.0
 	github.com/swaggo/files v1.0.1
 	github.com/swaggo/gin-swagger v1.6.0
 	github.com/swaggo/swag v1.16.4
+	golang.org/x/image v0.18.0
 	google.golang.org/api v0.126.0
 )
 
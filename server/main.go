@@ -1,23 +1,50 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
+	userServices "teammate/server/modules/user/application/services"
+	userRepos "teammate/server/modules/user/infrastructure/repositories"
 	"teammate/server/modules/user/interfaces/http/handlers"
 	"teammate/server/modules/user/interfaces/http/routes"
 	"teammate/server/seedwork/application/middleware"
+	"teammate/server/seedwork/infrastructure/config"
 	"teammate/server/seedwork/infrastructure/container"
 	"teammate/server/seedwork/infrastructure/database"
+	seedworkRepos "teammate/server/seedwork/infrastructure/repositories"
 
 	// Add imports for enhanced transcription
 	transcriptionServices "teammate/server/modules/transcription/application/services"
+	transcriptionDomainServices "teammate/server/modules/transcription/domain/services"
+	transcriptionAnswerers "teammate/server/modules/transcription/infrastructure/answerers"
+	transcriptionEmbedders "teammate/server/modules/transcription/infrastructure/embedders"
+	transcriptionLLM "teammate/server/modules/transcription/infrastructure/llm"
+	transcriptionProviders "teammate/server/modules/transcription/infrastructure/providers"
 	transcriptionRepos "teammate/server/modules/transcription/infrastructure/repositories"
+	transcriptionSummarizers "teammate/server/modules/transcription/infrastructure/summarizers"
+	transcriptionHandlers "teammate/server/modules/transcription/interfaces/http/handlers"
 	persistentHandlers "teammate/server/modules/transcription/interfaces/http/handlers/persistent"
+	transcriptionRoutes "teammate/server/modules/transcription/interfaces/http/routes"
 
-	// Add import for meeting repository
+	// Add imports for meetings
+	meetingServices "teammate/server/modules/meeting/application/services"
 	meetingRepos "teammate/server/modules/meeting/infrastructure/repositories"
+	meetingHandlers "teammate/server/modules/meeting/interfaces/http/handlers"
+	meetingRoutes "teammate/server/modules/meeting/interfaces/http/routes"
+
+	// Add imports for action items
+	actionItemServices "teammate/server/modules/actionitem/application/services"
+	actionItemDomainServices "teammate/server/modules/actionitem/domain/services"
+	actionItemExtractors "teammate/server/modules/actionitem/infrastructure/extractors"
+	actionItemRepos "teammate/server/modules/actionitem/infrastructure/repositories"
+	actionItemTicketing "teammate/server/modules/actionitem/infrastructure/ticketing"
+	actionItemHandlers "teammate/server/modules/actionitem/interfaces/http/handlers"
+	actionItemRoutes "teammate/server/modules/actionitem/interfaces/http/routes"
 
 	// Add import for shared EventBus
 	"teammate/server/seedwork/infrastructure/events"
@@ -28,6 +55,7 @@ import (
 	"github.com/joho/godotenv"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
 )
 
 // @title           Teammate API
@@ -85,23 +113,269 @@ func main() {
 	// Create handlers
 	userHandlers := handlers.NewUserHandlers(container.GetUserService(), container.GetFirebaseAuthService())
 
+	// Create team handlers; team members see trends across each other's meetings
+	teamRepo := userRepos.NewGormTeamRepository()
+	teamHandlers := handlers.NewTeamHandlers(userServices.NewTeamService(teamRepo, container.GetUserRepository()))
+
 	// Create enhanced audio handlers with database persistence
 	transcriptionRepo := transcriptionRepos.NewGormTranscriptionRepository()
 	meetingRepo := meetingRepos.NewGormMeetingRepository()
 	audioFactory := transcriptionServices.NewAudioProcessorFactory()
 	eventBus := events.NewMemoryEventBus()
 
+	vocabularyRepo := transcriptionRepos.NewGormVocabularyRepository()
+	redactionSettingsRepo := transcriptionRepos.NewGormRedactionSettingsRepository()
+
 	transcriptionService := transcriptionServices.NewEnhancedTranscriptionService(
 		transcriptionRepo,
 		meetingRepo,
+		vocabularyRepo,
+		redactionSettingsRepo,
 		audioFactory,
 		eventBus,
 	)
 
 	audioHandlers := persistentHandlers.NewPersistentAudioHandler(transcriptionService, eventBus)
 
+	// Create transcript editing handlers with revision history
+	revisionRepo := transcriptionRepos.NewGormTranscriptRevisionRepository()
+	transcriptEditService := transcriptionServices.NewTranscriptEditService(
+		transcriptionRepo,
+		revisionRepo,
+		meetingRepo,
+		database.NewGormTransactor(),
+		eventBus,
+	)
+	transcriptEditHandlers := transcriptionHandlers.NewTranscriptEditHandlers(transcriptEditService)
+
+	// Create transcript search handlers
+	transcriptSearchService := transcriptionServices.NewTranscriptSearchService(transcriptionRepos.NewGormTranscriptSearchRepository())
+
+	// Index completed transcriptions into passages for top-k retrieval
+	passageIndexService := transcriptionServices.NewPassageIndexService(
+		transcriptionRepo,
+		transcriptionRepos.NewGormTranscriptChunkRepository(),
+		transcriptionEmbedders.NewBM25Embedder(),
+	)
+	passageIndexService.SubscribeToEvents(eventBus)
+	go func() {
+		if err := passageIndexService.IndexMissing(context.Background()); err != nil {
+			log.Printf("Failed to backfill passage index: %v", err)
+		}
+	}()
+
+	transcriptSearchHandlers := transcriptionHandlers.NewTranscriptSearchHandlers(transcriptSearchService, passageIndexService)
+
+	// Create custom vocabulary handlers
+	vocabularyService := transcriptionServices.NewVocabularyService(vocabularyRepo)
+	vocabularyHandlers := transcriptionHandlers.NewVocabularyHandlers(vocabularyService)
+
+	// Create PII redaction settings handlers
+	redactionSettingsService := transcriptionServices.NewRedactionSettingsService(redactionSettingsRepo)
+	redactionSettingsHandlers := transcriptionHandlers.NewRedactionSettingsHandlers(redactionSettingsService)
+
+	// Create transcript import handlers
+	transcriptImportService := transcriptionServices.NewTranscriptImportService(transcriptionRepo, meetingRepo, eventBus)
+	transcriptImportHandlers := transcriptionHandlers.NewTranscriptImportHandlers(transcriptImportService)
+
+	// Create audio upload handlers; recordings are transcribed by background processing jobs
+	processingJobRepo := seedworkRepos.NewGormProcessingJobRepository()
+	uploadConfig := container.GetConfig().Upload
+	audioStore, err := transcriptionProviders.NewLocalAudioStore(uploadConfig.LocalDir)
+	if err != nil {
+		log.Fatalf("Failed to initialize audio upload storage: %v", err)
+	}
+	audioUploadService := transcriptionServices.NewAudioUploadService(
+		transcriptionRepo,
+		meetingRepo,
+		vocabularyRepo,
+		redactionSettingsRepo,
+		audioFactory,
+		eventBus,
+		processingJobRepo,
+		audioStore,
+	)
+	if uploadConfig.FetchURLs {
+		audioUploadService.WithFetcher(transcriptionProviders.NewAudioURLFetcher())
+	}
+	go func() {
+		if err := audioUploadService.ResumePendingJobs(context.Background()); err != nil {
+			log.Printf("Failed to resume transcribe jobs: %v", err)
+		}
+	}()
+	audioUploadHandlers := transcriptionHandlers.NewAudioUploadHandlers(audioUploadService)
+
+	// Create analytics handlers; computed analytics use the meeting owner's active taxonomy, are cached
+	// until the transcript or taxonomy changes and are snapshotted per meeting for trends
+	taxonomyRepo := transcriptionRepos.NewGormTaxonomyRepository()
+	analyticsService := transcriptionServices.NewAnalyticsService(transcriptionRepo, meetingRepo).WithTaxonomies(taxonomyRepo)
+	analyticsService.SubscribeToEvents(eventBus)
+	analyticsSnapshotRepo := transcriptionRepos.NewGormAnalyticsSnapshotRepository()
+	analyticsSnapshotService := transcriptionServices.NewAnalyticsSnapshotService(analyticsService, analyticsSnapshotRepo, meetingRepo)
+	analyticsSnapshotService.SubscribeToEvents(eventBus)
+	go func() {
+		if err := analyticsSnapshotService.SnapshotMissing(context.Background()); err != nil {
+			log.Printf("Failed to backfill analytics snapshots: %v", err)
+		}
+	}()
+	transcriptAnalyticsService := transcriptionServices.NewTranscriptAnalyticsService(analyticsService, transcriptionRepo, meetingRepo, analyticsSnapshotRepo, teamRepo)
+	analyticsHandlers := transcriptionHandlers.NewAnalyticsHandlers(transcriptAnalyticsService)
+	taxonomyService := transcriptionServices.NewTaxonomyService(taxonomyRepo, teamRepo).WithSnapshots(analyticsSnapshotService)
+	taxonomyHandlers := transcriptionHandlers.NewTaxonomyHandlers(taxonomyService)
+
+	// Create summary handlers; completed transcriptions are summarized by background processing jobs,
+	// with LeMUR for AssemblyAI transcripts and the extractive summarizer as the fallback
+	summaryService := transcriptionServices.NewSummaryService(
+		transcriptionRepo,
+		meetingRepo,
+		transcriptionRepos.NewGormTranscriptSummaryRepository(),
+		processingJobRepo,
+		newSummarizers(container.GetConfig().AssemblyAI)...,
+	)
+	summaryService.SubscribeToEvents(eventBus)
+	go func() {
+		if err := summaryService.ResumePendingJobs(context.Background()); err != nil {
+			log.Printf("Failed to resume summarize jobs: %v", err)
+		}
+	}()
+	summaryHandlers := transcriptionHandlers.NewSummaryHandlers(summaryService)
+
+	// Create question handlers; answers are based on the passages retrieved from the meeting's
+	// transcripts and cite the segments they use
+	questionService := transcriptionServices.NewQuestionService(
+		transcriptionRepo,
+		meetingRepo,
+		transcriptionRepos.NewGormMeetingQuestionRepository(),
+		passageIndexService,
+		newQuestionAnswerers(container.GetConfig().AssemblyAI, container.GetConfig().LLM)...,
+	)
+	questionHandlers := transcriptionHandlers.NewQuestionHandlers(questionService)
+
+	// Create export template handlers
+	exportTemplateRepo := transcriptionRepos.NewGormExportTemplateRepository()
+	exportTemplateService := transcriptionServices.NewExportTemplateService(exportTemplateRepo)
+	exportTemplateHandlers := transcriptionHandlers.NewExportTemplateHandlers(exportTemplateService)
+
+	// Create export handlers; large exports run as background processing jobs
+	exportConfig := container.GetConfig().Export
+	documentStorage, documentFiles, err := newDocumentStorage(exportConfig, container.GetConfig().Firebase)
+	if err != nil {
+		log.Fatalf("Failed to initialize export storage: %v", err)
+	}
+	exportService := transcriptionServices.NewExportService(documentStorage).
+		WithLinkTTL(exportConfig.LinkTTL).
+		WithTemplates(exportTemplateRepo).
+		WithExportContext(transcriptionRepos.NewGormExportContextRepository())
+	if exportConfig.PDFFontPath != "" {
+		pdfFont, err := transcriptionServices.LoadPDFFont(exportConfig.PDFFontPath, exportConfig.PDFBoldFontPath)
+		if err != nil {
+			log.Fatalf("Failed to load PDF font: %v", err)
+		}
+		exportService.WithPDFFont(pdfFont)
+	}
+	transcriptExportService := transcriptionServices.NewTranscriptExportService(
+		exportService,
+		transcriptionRepo,
+		meetingRepo,
+		processingJobRepo,
+	).WithAnalytics(analyticsService)
+	if audioSource := audioFactory.AudioSource(); audioSource != nil {
+		transcriptExportService.WithAudioSource(audioSource)
+	}
+	go func() {
+		if err := transcriptExportService.ResumePendingJobs(context.Background()); err != nil {
+			log.Printf("Failed to resume export jobs: %v", err)
+		}
+	}()
+	exportHandlers := transcriptionHandlers.NewExportHandlers(transcriptExportService, documentFiles)
+
+	// Create meeting handlers
+	meetingService := meetingServices.NewMeetingService(meetingRepo)
+	meetingHTTPHandlers := meetingHandlers.NewMeetingHandlers(meetingService)
+
+	// Create action item handlers; action items are extracted from completed transcriptions by
+	// background processing jobs and wait for review
+	actionItemRepo := actionItemRepos.NewGormActionItemRepository()
+	integrationConfigRepo := userRepos.NewGormIntegrationConfigRepository()
+	ticketingProviders := actionItemTicketing.NewProviderRegistry()
+
+	// Assignees are matched to the meeting's participants and users and to ticketing users
+	assigneeResolutionService := actionItemServices.NewAssigneeResolutionService(
+		actionItemRepo,
+		meetingRepo,
+		container.GetUserRepository(),
+		transcriptionRepo,
+		revisionRepo,
+		integrationConfigRepo,
+		ticketingProviders,
+	)
+	actionItemService := actionItemServices.NewActionItemService(actionItemRepo, meetingRepo, assigneeResolutionService)
+	actionItemExtractionService := actionItemServices.NewActionItemExtractionService(
+		transcriptionRepo,
+		meetingRepo,
+		actionItemRepo,
+		processingJobRepo,
+		assigneeResolutionService,
+		newActionItemExtractors(container.GetConfig().AssemblyAI, container.GetConfig().LLM)...,
+	)
+	actionItemExtractionService.SubscribeToEvents(eventBus)
+	go func() {
+		if err := actionItemExtractionService.ResumePendingJobs(context.Background()); err != nil {
+			log.Printf("Failed to resume extract actions jobs: %v", err)
+		}
+	}()
+	actionItemHTTPHandlers := actionItemHandlers.NewActionItemHandlers(actionItemService, actionItemExtractionService, assigneeResolutionService)
+
+	// Approved action items become tickets with the providers users configure as integrations
+	ticketingService := actionItemServices.NewTicketingService(
+		actionItemRepo,
+		meetingRepo,
+		integrationConfigRepo,
+		processingJobRepo,
+		ticketingProviders,
+	)
+	go func() {
+		if err := ticketingService.ResumePendingJobs(context.Background()); err != nil {
+			log.Printf("Failed to resume create tickets jobs: %v", err)
+		}
+	}()
+
+	// Closed tickets complete their action items, through webhooks and periodic reconciliation
+	ticketSyncService := actionItemServices.NewTicketSyncService(
+		actionItemRepo,
+		meetingRepo,
+		integrationConfigRepo,
+		ticketingProviders,
+	)
+	if interval := container.GetConfig().Ticketing.SyncInterval; interval > 0 {
+		go func() {
+			for range time.Tick(interval) {
+				if _, err := ticketSyncService.Reconcile(context.Background()); err != nil {
+					log.Printf("Failed to reconcile tickets: %v", err)
+				}
+			}
+		}()
+	}
+	ticketingHTTPHandlers := actionItemHandlers.NewTicketingHandlers(ticketingService, ticketSyncService)
+
 	// Create routes
 	userRoutes := routes.NewUserRoutes(userHandlers, container.GetAuthMiddleware())
+	teamRoutes := routes.NewTeamRoutes(teamHandlers, container.GetAuthMiddleware())
+	transcriptEditRoutes := transcriptionRoutes.NewTranscriptEditRoutes(transcriptEditHandlers, container.GetAuthMiddleware())
+	transcriptSearchRoutes := transcriptionRoutes.NewTranscriptSearchRoutes(transcriptSearchHandlers, container.GetAuthMiddleware())
+	vocabularyRoutes := transcriptionRoutes.NewVocabularyRoutes(vocabularyHandlers, container.GetAuthMiddleware())
+	redactionSettingsRoutes := transcriptionRoutes.NewRedactionSettingsRoutes(redactionSettingsHandlers, container.GetAuthMiddleware())
+	transcriptImportRoutes := transcriptionRoutes.NewTranscriptImportRoutes(transcriptImportHandlers, container.GetAuthMiddleware())
+	audioUploadRoutes := transcriptionRoutes.NewAudioUploadRoutes(audioUploadHandlers, container.GetAuthMiddleware())
+	analyticsRoutes := transcriptionRoutes.NewAnalyticsRoutes(analyticsHandlers, container.GetAuthMiddleware())
+	taxonomyRoutes := transcriptionRoutes.NewTaxonomyRoutes(taxonomyHandlers, container.GetAuthMiddleware())
+	summaryRoutes := transcriptionRoutes.NewSummaryRoutes(summaryHandlers, container.GetAuthMiddleware())
+	questionRoutes := transcriptionRoutes.NewQuestionRoutes(questionHandlers, container.GetAuthMiddleware())
+	exportTemplateRoutes := transcriptionRoutes.NewExportTemplateRoutes(exportTemplateHandlers, container.GetAuthMiddleware())
+	exportRoutes := transcriptionRoutes.NewExportRoutes(exportHandlers, container.GetAuthMiddleware())
+	meetingHTTPRoutes := meetingRoutes.NewMeetingRoutes(meetingHTTPHandlers, container.GetAuthMiddleware())
+	actionItemHTTPRoutes := actionItemRoutes.NewActionItemRoutes(actionItemHTTPHandlers, container.GetAuthMiddleware())
+	ticketingHTTPRoutes := actionItemRoutes.NewTicketingRoutes(ticketingHTTPHandlers, container.GetAuthMiddleware())
 
 	// Setup enhanced transcription routes directly (bypass the basic routes)
 	enhancedTranscriptionHandler := audioHandlers
@@ -137,6 +411,8 @@ func main() {
 	// Public routes (no authentication required)
 	public := router.Group("")
 	userRoutes.SetupPublicRoutes(public)
+	exportRoutes.SetupPublicRoutes(public)
+	ticketingHTTPRoutes.SetupPublicRoutes(public)
 
 	// Enhanced transcription routes (WebSocket doesn't work well with auth middleware)
 	public.GET("/ws/enhanced-audio", gin.WrapH(http.HandlerFunc(enhancedTranscriptionHandler.HandleWebSocketConnection)))
@@ -145,6 +421,24 @@ func main() {
 	protected := router.Group("")
 	userRoutes.SetupProtectedRoutes(protected)
 
+	// Each route set applies auth to its own group so the middleware runs once per request
+	teamRoutes.SetupProtectedRoutes(router.Group(""))
+	meetingHTTPRoutes.SetupProtectedRoutes(router.Group(""))
+	actionItemHTTPRoutes.SetupProtectedRoutes(router.Group(""))
+	ticketingHTTPRoutes.SetupProtectedRoutes(router.Group(""))
+	transcriptEditRoutes.SetupProtectedRoutes(router.Group(""))
+	transcriptSearchRoutes.SetupProtectedRoutes(router.Group(""))
+	vocabularyRoutes.SetupProtectedRoutes(router.Group(""))
+	redactionSettingsRoutes.SetupProtectedRoutes(router.Group(""))
+	transcriptImportRoutes.SetupProtectedRoutes(router.Group(""))
+	audioUploadRoutes.SetupProtectedRoutes(router.Group(""))
+	analyticsRoutes.SetupProtectedRoutes(router.Group(""))
+	taxonomyRoutes.SetupProtectedRoutes(router.Group(""))
+	summaryRoutes.SetupProtectedRoutes(router.Group(""))
+	questionRoutes.SetupProtectedRoutes(router.Group(""))
+	exportTemplateRoutes.SetupProtectedRoutes(router.Group(""))
+	exportRoutes.SetupProtectedRoutes(router.Group(""))
+
 	// Get port from environment or use default
 	port := container.GetConfig().Server.Port
 	if port == "" {
@@ -157,3 +451,80 @@ func main() {
 		log.Fatalf("Failed to start server: %v", err)
 	}
 }
+
+// newSummarizers creates the summarizers in the order they are tried. LeMUR is only available
+// with an AssemblyAI key.
+func newSummarizers(assemblyAIConfig config.AssemblyAIConfig) []transcriptionDomainServices.Summarizer {
+	summarizers := []transcriptionDomainServices.Summarizer{}
+	if assemblyAIConfig.APIKey != "" {
+		client := assemblyai.NewClient(assemblyAIConfig.APIKey)
+		summarizers = append(summarizers, transcriptionSummarizers.NewLemurSummarizer(client, assemblyAIConfig.LemurModel))
+	}
+	return append(summarizers, transcriptionSummarizers.NewExtractiveSummarizer())
+}
+
+// newQuestionAnswerers creates the meeting question answerers in the order they are tried. LeMUR
+// needs an AssemblyAI key and the language model needs a model name.
+func newQuestionAnswerers(assemblyAIConfig config.AssemblyAIConfig, llmConfig config.LLMConfig) []transcriptionDomainServices.QuestionAnswerer {
+	answerers := []transcriptionDomainServices.QuestionAnswerer{}
+	if assemblyAIConfig.APIKey != "" {
+		client := assemblyai.NewClient(assemblyAIConfig.APIKey)
+		answerers = append(answerers, transcriptionAnswerers.NewLemurAnswerer(client, assemblyAIConfig.LemurModel))
+	}
+	if llmConfig.Model != "" {
+		model := transcriptionLLM.NewOpenAIChatModel(llmConfig.BaseURL, llmConfig.APIKey, llmConfig.Model)
+		answerers = append(answerers, transcriptionAnswerers.NewLLMAnswerer(model))
+	}
+	return append(answerers, transcriptionAnswerers.NewExtractiveAnswerer())
+}
+
+// newActionItemExtractors creates the action item extractors in the order they are tried. LeMUR
+// needs an AssemblyAI key and the language model needs a model name.
+func newActionItemExtractors(assemblyAIConfig config.AssemblyAIConfig, llmConfig config.LLMConfig) []actionItemDomainServices.ActionItemExtractor {
+	extractors := []actionItemDomainServices.ActionItemExtractor{}
+	if assemblyAIConfig.APIKey != "" {
+		client := assemblyai.NewClient(assemblyAIConfig.APIKey)
+		extractors = append(extractors, actionItemExtractors.NewLemurExtractor(client, assemblyAIConfig.LemurModel))
+	}
+	if llmConfig.Model != "" {
+		model := transcriptionLLM.NewOpenAIChatModel(llmConfig.BaseURL, llmConfig.APIKey, llmConfig.Model)
+		extractors = append(extractors, actionItemExtractors.NewLLMExtractor(model))
+	}
+	return append(extractors, actionItemExtractors.NewRuleBasedExtractor())
+}
+
+// newDocumentStorage creates the storage for export documents. Local storage also returns the
+// resolver for its signed download links, which the API serves itself.
+func newDocumentStorage(exportConfig config.ExportConfig, firebaseConfig config.FirebaseConfig) (transcriptionServices.StorageUploader, transcriptionHandlers.DocumentFiles, error) {
+	if exportConfig.Storage == "firebase" {
+		uploader, err := transcriptionProviders.NewFirebaseStorageUploader(exportConfig.StorageBucket, firebaseConfig.CredentialsPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		return uploader, nil, nil
+	}
+
+	secret := []byte(exportConfig.SigningSecret)
+	if len(secret) == 0 {
+		log.Println("Warning: EXPORT_SIGNING_SECRET not set, download links will not survive a restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	storage, err := transcriptionProviders.NewLocalDocumentStorage(exportConfig.LocalDir, exportConfig.BaseURL, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Remove exports whose download links have expired
+	go func() {
+		for range time.Tick(time.Hour) {
+			if _, err := storage.DeleteOlderThan(time.Now().Add(-exportConfig.LinkTTL)); err != nil {
+				log.Printf("Failed to clean up expired exports: %v", err)
+			}
+		}
+	}()
+	return storage, storage, nil
+}
@@ -0,0 +1,6 @@
+-- Drop transcript revision history table
+-- Migration: 000006_create_transcript_revisions (DOWN)
+
+DROP INDEX IF EXISTS idx_transcript_revisions_edited_by;
+DROP INDEX IF EXISTS idx_transcript_revisions_transcription_id;
+DROP TABLE IF EXISTS transcript_revisions;
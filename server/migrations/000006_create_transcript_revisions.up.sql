@@ -0,0 +1,25 @@
+-- Create transcript revision history table
+-- Migration: 000006_create_transcript_revisions
+
+-- Transcript revisions table (append-only; rows are never updated)
+CREATE TABLE transcript_revisions (
+    id VARCHAR(128) PRIMARY KEY,
+    transcription_id VARCHAR(128) NOT NULL REFERENCES transcriptions(id) ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL,
+    edit_type VARCHAR(50) NOT NULL CHECK (edit_type IN ('original', 'edit_text', 'edit_speaker', 'edit_segment', 'split', 'merge', 'restore')),
+    edited_by VARCHAR(128) NULL,
+    segment_ids JSONB NOT NULL DEFAULT '[]',
+    before_segments JSONB NOT NULL DEFAULT '[]',
+    after_segments JSONB NOT NULL DEFAULT '[]',
+    snapshot JSONB NOT NULL DEFAULT '[]',
+    restored_from INTEGER NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(transcription_id, revision_number)
+);
+
+CREATE INDEX idx_transcript_revisions_transcription_id ON transcript_revisions(transcription_id);
+CREATE INDEX idx_transcript_revisions_edited_by ON transcript_revisions(edited_by);
+
+COMMENT ON TABLE transcript_revisions IS 'Immutable history of manual edits made to transcript segments';
+COMMENT ON COLUMN transcript_revisions.snapshot IS 'Full list of transcript segments after the edit was applied';
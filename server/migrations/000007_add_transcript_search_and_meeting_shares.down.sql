@@ -0,0 +1,10 @@
+-- Remove full-text search over transcript segments and meeting sharing
+-- Down migration: 000007_add_transcript_search_and_meeting_shares
+
+DROP INDEX IF EXISTS idx_meeting_shares_deleted_at;
+DROP INDEX IF EXISTS idx_meeting_shares_shared_with_user_id;
+DROP INDEX IF EXISTS idx_meeting_shares_meeting_user;
+DROP TABLE IF EXISTS meeting_shares;
+
+DROP INDEX IF EXISTS idx_transcript_segments_search_vector;
+ALTER TABLE transcript_segments DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,29 @@
+-- Add full-text search over transcript segments and meeting sharing
+-- Migration: 000007_add_transcript_search_and_meeting_shares
+
+-- Full-text search vector, kept in sync with the segment text by Postgres
+ALTER TABLE transcript_segments
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('english', COALESCE(text, ''))) STORED;
+
+CREATE INDEX idx_transcript_segments_search_vector ON transcript_segments USING GIN (search_vector);
+
+-- Meeting shares table
+CREATE TABLE meeting_shares (
+    id VARCHAR(128) PRIMARY KEY,
+    meeting_id VARCHAR(128) NOT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+    shared_with_user_id VARCHAR(128) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    shared_by_user_id VARCHAR(128) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    permission VARCHAR(20) NOT NULL CHECK (permission IN ('view', 'edit')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP WITH TIME ZONE NULL
+);
+
+-- Only one active share per meeting and user
+CREATE UNIQUE INDEX idx_meeting_shares_meeting_user ON meeting_shares(meeting_id, shared_with_user_id) WHERE deleted_at IS NULL;
+CREATE INDEX idx_meeting_shares_shared_with_user_id ON meeting_shares(shared_with_user_id);
+CREATE INDEX idx_meeting_shares_deleted_at ON meeting_shares(deleted_at);
+
+-- Add comments
+COMMENT ON COLUMN transcript_segments.search_vector IS 'Generated full-text search vector of the segment text';
+COMMENT ON TABLE meeting_shares IS 'Grants other users view or edit access to a meeting and its transcripts';
@@ -0,0 +1,6 @@
+-- Drop passage index over transcript segments
+-- Down migration: 000008_create_transcript_chunks
+
+DROP INDEX IF EXISTS idx_transcript_chunks_embedder;
+DROP INDEX IF EXISTS idx_transcript_chunks_meeting_id;
+DROP TABLE IF EXISTS transcript_chunks;
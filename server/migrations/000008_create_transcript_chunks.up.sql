@@ -0,0 +1,26 @@
+-- Create passage index over transcript segments
+-- Migration: 000008_create_transcript_chunks
+
+-- Transcript chunks table (groups of consecutive segments with their embeddings)
+CREATE TABLE transcript_chunks (
+    id VARCHAR(128) PRIMARY KEY,
+    transcription_id VARCHAR(128) NOT NULL REFERENCES transcriptions(id) ON DELETE CASCADE,
+    meeting_id VARCHAR(128) NOT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+    chunk_index INTEGER NOT NULL,
+    text TEXT NOT NULL,
+    speakers JSONB NOT NULL DEFAULT '[]',
+    segment_ids JSONB NOT NULL DEFAULT '[]',
+    start_time DECIMAL(10,3) NOT NULL,
+    end_time DECIMAL(10,3) NOT NULL,
+    embedder VARCHAR(100) NOT NULL,
+    embedding JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE(transcription_id, embedder, chunk_index)
+);
+
+CREATE INDEX idx_transcript_chunks_meeting_id ON transcript_chunks(meeting_id);
+CREATE INDEX idx_transcript_chunks_embedder ON transcript_chunks(embedder);
+
+COMMENT ON TABLE transcript_chunks IS 'Passages of completed transcripts indexed for retrieval';
+COMMENT ON COLUMN transcript_chunks.embedding IS 'Embedder output: sparse term weights for local embedders or a dense vector for remote ones';
@@ -0,0 +1,6 @@
+-- Drop custom vocabulary table
+-- Migration: 000009_create_vocabularies (DOWN)
+
+DROP INDEX IF EXISTS idx_vocabularies_user_meeting_type;
+DROP INDEX IF EXISTS idx_vocabularies_user_id;
+DROP TABLE IF EXISTS vocabularies;
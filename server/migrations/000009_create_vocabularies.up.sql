@@ -0,0 +1,22 @@
+-- Create custom vocabulary table
+-- Migration: 000009_create_vocabularies
+
+-- Vocabularies hold per-user word boost terms and spelling rules passed to transcription providers
+CREATE TABLE vocabularies (
+    id VARCHAR(128) PRIMARY KEY,
+    user_id VARCHAR(128) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    meeting_type VARCHAR(50) NOT NULL DEFAULT '' CHECK (meeting_type IN ('', 'zoom', 'google_meet', 'microsoft_teams', 'generic')),
+    terms JSONB NOT NULL DEFAULT '[]',
+    boost_param VARCHAR(20) NOT NULL DEFAULT 'default' CHECK (boost_param IN ('low', 'default', 'high')),
+    spellings JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_vocabularies_user_id ON vocabularies(user_id);
+CREATE INDEX idx_vocabularies_user_meeting_type ON vocabularies(user_id, meeting_type);
+
+COMMENT ON TABLE vocabularies IS 'Per-user custom vocabulary applied to transcriptions of matching meetings';
+COMMENT ON COLUMN vocabularies.meeting_type IS 'Meeting type the vocabulary applies to; empty applies to all meeting types';
+COMMENT ON COLUMN vocabularies.spellings IS 'Spelling rules as [{"from": [...], "to": "..."}], applied case-insensitively to whole words';
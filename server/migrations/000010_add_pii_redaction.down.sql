@@ -0,0 +1,6 @@
+-- Remove PII redaction settings and redacted span tracking
+-- Down migration: 000010_add_pii_redaction
+
+DROP TABLE IF EXISTS redaction_settings;
+
+ALTER TABLE transcript_segments DROP COLUMN IF EXISTS redactions;
@@ -0,0 +1,21 @@
+-- Add PII redaction settings and redacted span tracking
+-- Migration: 000010_add_pii_redaction
+
+-- Redacted spans of each transcript segment, used by exports to mark redactions
+ALTER TABLE transcript_segments
+ADD COLUMN redactions JSONB NULL;
+
+-- Per-user default redaction applied to transcriptions of the user's meetings
+CREATE TABLE redaction_settings (
+    id VARCHAR(128) PRIMARY KEY,
+    user_id VARCHAR(128) NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    redact_pii BOOLEAN NOT NULL DEFAULT FALSE,
+    redact_pii_audio BOOLEAN NOT NULL DEFAULT FALSE,
+    pii_policies JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON COLUMN transcript_segments.redactions IS 'Redaction placeholders in the text as [{"type": "email_address", "start": 10, "end": 25}] (character offsets)';
+COMMENT ON TABLE redaction_settings IS 'Default PII redaction per user; sessions can add redaction but not remove it';
+COMMENT ON COLUMN redaction_settings.pii_policies IS 'Redaction policies (AssemblyAI policy names) applied when redact_pii is enabled';
@@ -0,0 +1,4 @@
+-- Remove per-word timings from transcript segments
+-- Down migration: 000011_add_segment_word_timings
+
+ALTER TABLE transcript_segments DROP COLUMN IF EXISTS words;
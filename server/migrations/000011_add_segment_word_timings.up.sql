@@ -0,0 +1,8 @@
+-- Add per-word timings to transcript segments
+-- Migration: 000011_add_segment_word_timings
+
+-- Word timings reported by the provider, used to time subtitle cues
+ALTER TABLE transcript_segments
+ADD COLUMN words JSONB NULL;
+
+COMMENT ON COLUMN transcript_segments.words IS 'Word timings as [{"text": "hello", "start": 1.2, "end": 1.5, "confidence": 0.98}] (seconds)';
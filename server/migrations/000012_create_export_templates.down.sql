@@ -0,0 +1,4 @@
+-- Drop export templates table
+-- Migration: 000012_create_export_templates (DOWN)
+
+DROP TABLE IF EXISTS export_templates;
@@ -0,0 +1,19 @@
+-- Create export templates table
+-- Migration: 000012_create_export_templates
+
+-- Export templates are user-uploaded text/template bodies rendered by Markdown and HTML exports
+CREATE TABLE export_templates (
+    id VARCHAR(128) PRIMARY KEY,
+    user_id VARCHAR(128) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    format VARCHAR(20) NOT NULL CHECK (format IN ('markdown', 'html')),
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_export_templates_user_name UNIQUE (user_id, name)
+);
+
+COMMENT ON TABLE export_templates IS 'Per-user export templates, referenced by name from export options';
+COMMENT ON COLUMN export_templates.name IS 'Template name, unique per user; names of built-in templates are reserved';
+COMMENT ON COLUMN export_templates.body IS 'Go text/template source; html templates are escaped like html/template';
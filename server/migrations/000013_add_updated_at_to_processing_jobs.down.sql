@@ -0,0 +1,5 @@
+-- Remove updated_at from background jobs
+-- Migration: 000013_add_updated_at_to_processing_jobs (DOWN)
+
+DROP INDEX IF EXISTS idx_processing_jobs_entity_job_type;
+ALTER TABLE processing_jobs DROP COLUMN IF EXISTS updated_at;
@@ -0,0 +1,9 @@
+-- Track changes to background jobs
+-- Migration: 000013_add_updated_at_to_processing_jobs
+
+ALTER TABLE processing_jobs
+ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX idx_processing_jobs_entity_job_type ON processing_jobs(entity_type, entity_id, job_type);
+
+COMMENT ON COLUMN processing_jobs.updated_at IS 'Last status change of the job';
@@ -0,0 +1,4 @@
+-- Drop analytics snapshots table
+-- Migration: 000014_create_analytics_snapshots (DOWN)
+
+DROP TABLE IF EXISTS analytics_snapshots;
@@ -0,0 +1,24 @@
+-- Create analytics snapshots table
+-- Migration: 000014_create_analytics_snapshots
+
+-- Analytics snapshots hold the figures of each meeting's latest completed transcription,
+-- so trends across meetings are aggregated without reprocessing transcripts
+CREATE TABLE analytics_snapshots (
+    id VARCHAR(128) PRIMARY KEY,
+    meeting_id VARCHAR(128) NOT NULL UNIQUE REFERENCES meetings(id) ON DELETE CASCADE,
+    transcription_id VARCHAR(128) NOT NULL REFERENCES transcriptions(id) ON DELETE CASCADE,
+    duration_seconds DECIMAL(10,3) NOT NULL DEFAULT 0,
+    word_count INTEGER NOT NULL DEFAULT 0,
+    sentiment VARCHAR(20) NOT NULL DEFAULT 'neutral',
+    sentiment_score DECIMAL(5,4) NOT NULL DEFAULT 0,
+    speakers JSONB NOT NULL DEFAULT '[]',
+    topics JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_analytics_snapshots_transcription_id ON analytics_snapshots(transcription_id);
+
+COMMENT ON TABLE analytics_snapshots IS 'Per-meeting analytics snapshots aggregated by cross-meeting trends';
+COMMENT ON COLUMN analytics_snapshots.speakers IS 'Speaking time and word count per speaker';
+COMMENT ON COLUMN analytics_snapshots.topics IS 'Top topics of the meeting, most relevant first';
@@ -0,0 +1,4 @@
+-- Drop analytics taxonomies table
+-- Migration: 000015_create_analytics_taxonomies (DOWN)
+
+DROP TABLE IF EXISTS analytics_taxonomies;
@@ -0,0 +1,23 @@
+-- Create analytics taxonomies table
+-- Migration: 000015_create_analytics_taxonomies
+
+-- Analytics taxonomies are user-defined topics and sentiment lexicons used by transcript analytics
+CREATE TABLE analytics_taxonomies (
+    id VARCHAR(128) PRIMARY KEY,
+    user_id VARCHAR(128) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    topics JSONB NOT NULL DEFAULT '[]',
+    sentiment_lexicon JSONB NOT NULL DEFAULT '{}',
+    stemming BOOLEAN NOT NULL DEFAULT TRUE,
+    active BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_analytics_taxonomies_user_id ON analytics_taxonomies(user_id);
+CREATE UNIQUE INDEX uq_analytics_taxonomies_active ON analytics_taxonomies(user_id) WHERE active;
+
+COMMENT ON TABLE analytics_taxonomies IS 'Per-user topic taxonomies and sentiment lexicons; at most one is active per user';
+COMMENT ON COLUMN analytics_taxonomies.topics IS 'Topics with weighted terms and synonyms';
+COMMENT ON COLUMN analytics_taxonomies.sentiment_lexicon IS 'Weighted positive and negative terms; empty lists fall back to the built-in lexicon';
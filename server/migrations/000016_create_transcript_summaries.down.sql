@@ -0,0 +1,6 @@
+-- Drop transcript summaries table
+-- Migration: 000016_create_transcript_summaries (DOWN)
+
+DROP TABLE IF EXISTS transcript_summaries;
+
+ALTER TABLE transcriptions DROP COLUMN IF EXISTS provider_transcript_id;
@@ -0,0 +1,28 @@
+-- Create transcript summaries table
+-- Migration: 000016_create_transcript_summaries
+
+-- The provider's own transcript ID, needed to run LeMUR against AssemblyAI transcripts
+ALTER TABLE transcriptions
+ADD COLUMN provider_transcript_id VARCHAR(128) NULL;
+
+-- Transcript summaries hold the latest structured summary of each transcription
+CREATE TABLE transcript_summaries (
+    id VARCHAR(128) PRIMARY KEY,
+    transcription_id VARCHAR(128) NOT NULL UNIQUE REFERENCES transcriptions(id) ON DELETE CASCADE,
+    meeting_id VARCHAR(128) NOT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+    overview TEXT NOT NULL DEFAULT '',
+    key_points JSONB NOT NULL DEFAULT '[]',
+    decisions JSONB NOT NULL DEFAULT '[]',
+    answer_format TEXT NOT NULL DEFAULT '',
+    method VARCHAR(20) NOT NULL,
+    model VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_transcript_summaries_meeting_id ON transcript_summaries(meeting_id);
+
+COMMENT ON COLUMN transcriptions.provider_transcript_id IS 'Transcript ID assigned by the transcription provider';
+COMMENT ON TABLE transcript_summaries IS 'Latest summary of each transcription, regenerated on demand';
+COMMENT ON COLUMN transcript_summaries.answer_format IS 'Answer format requested from LeMUR for the overview; empty for the default';
+COMMENT ON COLUMN transcript_summaries.method IS 'How the summary was produced: lemur or extractive';
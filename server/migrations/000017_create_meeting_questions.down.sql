@@ -0,0 +1,4 @@
+-- Drop meeting questions table
+-- Migration: 000017_create_meeting_questions (DOWN)
+
+DROP TABLE IF EXISTS meeting_questions;
@@ -0,0 +1,24 @@
+-- Create meeting questions table
+-- Migration: 000017_create_meeting_questions
+
+-- Meeting questions keep the questions asked about a meeting, their answers and the transcript
+-- segments each answer cites
+CREATE TABLE meeting_questions (
+    id VARCHAR(128) PRIMARY KEY,
+    meeting_id VARCHAR(128) NOT NULL REFERENCES meetings(id) ON DELETE CASCADE,
+    user_id VARCHAR(128) NOT NULL,
+    question TEXT NOT NULL,
+    answer TEXT NOT NULL DEFAULT '',
+    citations JSONB NOT NULL DEFAULT '[]',
+    method VARCHAR(20) NOT NULL,
+    model VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_meeting_questions_meeting_id_created_at ON meeting_questions(meeting_id, created_at DESC);
+
+COMMENT ON TABLE meeting_questions IS 'Question and answer history of each meeting';
+COMMENT ON COLUMN meeting_questions.user_id IS 'User who asked the question';
+COMMENT ON COLUMN meeting_questions.citations IS 'Transcript segments the answer is based on, with their text and timestamps when asked';
+COMMENT ON COLUMN meeting_questions.method IS 'How the answer was produced: lemur, llm or extractive';
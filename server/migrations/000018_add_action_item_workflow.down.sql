@@ -0,0 +1,6 @@
+-- Revert action item review workflow changes
+-- Migration: 000018_add_action_item_workflow (DOWN)
+
+DROP INDEX IF EXISTS idx_action_items_due_date;
+
+ALTER TABLE ticket_references DROP COLUMN IF EXISTS updated_at;
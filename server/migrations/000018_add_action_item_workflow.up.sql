@@ -0,0 +1,12 @@
+-- Prepare action items for the review workflow
+-- Migration: 000018_add_action_item_workflow
+
+-- Ticket references are updated when their ticket changes, like every other entity
+ALTER TABLE ticket_references
+ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP;
+
+-- Overdue action items are found by due date
+CREATE INDEX idx_action_items_due_date ON action_items(due_date) WHERE due_date IS NOT NULL AND deleted_at IS NULL;
+
+COMMENT ON COLUMN action_items.assignee IS 'Name or email of the person responsible for the action item';
+COMMENT ON COLUMN action_items.status IS 'Review status: extracted, pending, approved, rejected, or created once a ticket exists';
@@ -0,0 +1,7 @@
+-- Remove the source segment of action items
+-- Migration: 000019_add_action_item_sources (DOWN)
+
+ALTER TABLE action_items
+DROP COLUMN IF EXISTS source_segment_id,
+DROP COLUMN IF EXISTS source_start_time,
+DROP COLUMN IF EXISTS source_end_time;
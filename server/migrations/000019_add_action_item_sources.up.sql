@@ -0,0 +1,11 @@
+-- Link extracted action items to the transcript segment they were found in
+-- Migration: 000019_add_action_item_sources
+
+ALTER TABLE action_items
+ADD COLUMN source_segment_id VARCHAR(128) NULL,
+ADD COLUMN source_start_time DOUBLE PRECISION NULL,
+ADD COLUMN source_end_time DOUBLE PRECISION NULL;
+
+COMMENT ON COLUMN action_items.source_segment_id IS 'Transcript segment the action item was extracted from, if it could be located';
+COMMENT ON COLUMN action_items.source_start_time IS 'Start of the source segment in seconds from the beginning of the recording';
+COMMENT ON COLUMN action_items.source_end_time IS 'End of the source segment in seconds from the beginning of the recording';
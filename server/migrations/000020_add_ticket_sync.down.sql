@@ -0,0 +1,13 @@
+-- Revert ticket sync changes
+-- Migration: 000020_add_ticket_sync (DOWN)
+
+DROP INDEX IF EXISTS idx_ticket_references_system_project_ticket;
+
+ALTER TABLE ticket_references DROP COLUMN IF EXISTS synced_at;
+
+UPDATE action_items SET status = 'created' WHERE status = 'completed';
+ALTER TABLE action_items DROP COLUMN IF EXISTS completed_at;
+
+ALTER TABLE action_items DROP CONSTRAINT IF EXISTS action_items_status_check;
+ALTER TABLE action_items
+ADD CONSTRAINT action_items_status_check CHECK (status IN ('extracted', 'pending', 'approved', 'created', 'rejected'));
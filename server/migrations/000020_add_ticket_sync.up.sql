@@ -0,0 +1,20 @@
+-- Follow the tickets of action items so closing a ticket completes its action item
+-- Migration: 000020_add_ticket_sync
+
+-- Action items are completed once their ticket is closed
+ALTER TABLE action_items DROP CONSTRAINT IF EXISTS action_items_status_check;
+ALTER TABLE action_items
+ADD CONSTRAINT action_items_status_check CHECK (status IN ('extracted', 'pending', 'approved', 'created', 'completed', 'rejected'));
+
+ALTER TABLE action_items
+ADD COLUMN completed_at TIMESTAMP WITH TIME ZONE NULL;
+
+ALTER TABLE ticket_references
+ADD COLUMN synced_at TIMESTAMP WITH TIME ZONE NULL;
+
+-- Webhooks find the references of a ticket within its project
+CREATE INDEX idx_ticket_references_system_project_ticket ON ticket_references(system, project_key, ticket_id) WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN action_items.status IS 'Review status: extracted, pending, approved, rejected, created once a ticket exists, or completed once it is closed';
+COMMENT ON COLUMN action_items.completed_at IS 'When the ticket of the action item was closed';
+COMMENT ON COLUMN ticket_references.synced_at IS 'When the state of the ticket was last received from its ticketing system';
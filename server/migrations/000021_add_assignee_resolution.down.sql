@@ -0,0 +1,6 @@
+-- Revert assignee resolution
+-- Migration: 000021_add_assignee_resolution (DOWN)
+
+DROP INDEX IF EXISTS idx_action_items_assignee_ambiguous;
+
+ALTER TABLE action_items DROP COLUMN IF EXISTS assignee_resolution;
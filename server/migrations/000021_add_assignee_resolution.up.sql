@@ -0,0 +1,10 @@
+-- Record who the assignee of an action item was matched to
+-- Migration: 000021_add_assignee_resolution
+
+ALTER TABLE action_items
+ADD COLUMN assignee_resolution JSONB NULL;
+
+-- Action items waiting for their assignee to be confirmed
+CREATE INDEX idx_action_items_assignee_ambiguous ON action_items(meeting_id) WHERE assignee_resolution->>'status' = 'ambiguous' AND deleted_at IS NULL;
+
+COMMENT ON COLUMN action_items.assignee_resolution IS 'How the assignee was matched to meeting participants, users and ticketing users: mention, status, confidence, match and candidates';
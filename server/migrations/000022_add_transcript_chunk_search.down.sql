@@ -0,0 +1,6 @@
+-- Remove full-text search over indexed passages
+-- Down migration: 000022_add_transcript_chunk_search
+
+DROP INDEX IF EXISTS idx_transcript_chunks_segment_ids;
+DROP INDEX IF EXISTS idx_transcript_chunks_search_vector;
+ALTER TABLE transcript_chunks DROP COLUMN IF EXISTS search_vector;
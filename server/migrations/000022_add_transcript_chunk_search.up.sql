@@ -0,0 +1,14 @@
+-- Add full-text search over indexed passages
+-- Migration: 000022_add_transcript_chunk_search
+
+-- Full-text search vector used to pick passage candidates before they are ranked by the embedder
+ALTER TABLE transcript_chunks
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('english', COALESCE(text, ''))) STORED;
+
+CREATE INDEX idx_transcript_chunks_search_vector ON transcript_chunks USING GIN (search_vector);
+
+-- Finds the passage that contains a segment
+CREATE INDEX idx_transcript_chunks_segment_ids ON transcript_chunks USING GIN (segment_ids jsonb_path_ops);
+
+-- Add comments
+COMMENT ON COLUMN transcript_chunks.search_vector IS 'Generated full-text search vector of the passage text';
@@ -0,0 +1,5 @@
+-- Drop teams and team members tables
+-- Migration: 000023_create_teams (DOWN)
+
+DROP TABLE IF EXISTS team_members;
+DROP TABLE IF EXISTS teams;
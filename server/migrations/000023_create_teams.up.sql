@@ -0,0 +1,28 @@
+-- Create teams and team members tables
+-- Migration: 000023_create_teams
+
+-- Teams group users whose meetings are analyzed together
+CREATE TABLE teams (
+    id VARCHAR(128) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_by VARCHAR(128) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE team_members (
+    id VARCHAR(128) PRIMARY KEY,
+    team_id VARCHAR(128) NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id VARCHAR(128) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'member')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A user is a member of a team once
+CREATE UNIQUE INDEX idx_team_members_team_user ON team_members(team_id, user_id);
+CREATE INDEX idx_team_members_user_id ON team_members(user_id);
+
+-- Add comments
+COMMENT ON TABLE teams IS 'Groups of users who see trends across each other''s meetings';
+COMMENT ON COLUMN team_members.role IS 'Owners manage the members of the team';
@@ -0,0 +1,9 @@
+-- Remove team analytics taxonomies
+-- Down migration: 000024_add_team_taxonomies
+
+DELETE FROM analytics_taxonomies WHERE team_id IS NOT NULL;
+DROP INDEX IF EXISTS uq_analytics_taxonomies_team_active;
+DROP INDEX IF EXISTS uq_analytics_taxonomies_active;
+DROP INDEX IF EXISTS idx_analytics_taxonomies_team_id;
+ALTER TABLE analytics_taxonomies DROP COLUMN IF EXISTS team_id;
+CREATE UNIQUE INDEX uq_analytics_taxonomies_active ON analytics_taxonomies(user_id) WHERE active;
@@ -0,0 +1,16 @@
+-- Let teams own analytics taxonomies
+-- Migration: 000024_add_team_taxonomies
+
+-- A team taxonomy is managed by the team's owners; user_id is who created it
+ALTER TABLE analytics_taxonomies
+ADD COLUMN team_id VARCHAR(128) NULL REFERENCES teams(id) ON DELETE CASCADE;
+
+CREATE INDEX idx_analytics_taxonomies_team_id ON analytics_taxonomies(team_id);
+
+-- At most one active taxonomy per user among their own, and one per team
+DROP INDEX IF EXISTS uq_analytics_taxonomies_active;
+CREATE UNIQUE INDEX uq_analytics_taxonomies_active ON analytics_taxonomies(user_id) WHERE active AND team_id IS NULL;
+CREATE UNIQUE INDEX uq_analytics_taxonomies_team_active ON analytics_taxonomies(team_id) WHERE active AND team_id IS NOT NULL;
+
+-- Add comments
+COMMENT ON COLUMN analytics_taxonomies.team_id IS 'Team that shares the taxonomy; NULL for a personal taxonomy';
@@ -0,0 +1,46 @@
+package commands
+
+import "time"
+
+// ApproveActionItemCommand represents the command to approve an extracted action item
+type ApproveActionItemCommand struct {
+	ActionItemID string `json:"action_item_id" validate:"required"`
+	UserID       string `json:"user_id" validate:"required"`
+}
+
+// RejectActionItemCommand represents the command to reject an extracted action item
+type RejectActionItemCommand struct {
+	ActionItemID string `json:"action_item_id" validate:"required"`
+	UserID       string `json:"user_id" validate:"required"`
+}
+
+// SetActionItemAssigneeCommand represents the command to assign an action item; an empty
+// assignee unassigns it
+type SetActionItemAssigneeCommand struct {
+	ActionItemID string `json:"action_item_id" validate:"required"`
+	UserID       string `json:"user_id" validate:"required"`
+	Assignee     string `json:"assignee" validate:"max=255"`
+}
+
+// ResolveAssigneeCommand represents the command to match the assignee of an action item to a person again
+type ResolveAssigneeCommand struct {
+	ActionItemID string `json:"action_item_id" validate:"required"`
+	UserID       string `json:"user_id" validate:"required"`
+}
+
+// ConfirmAssigneeCommand represents the command to confirm which candidate the assignee of an
+// action item refers to. Accounts add or correct the candidate's user IDs at ticketing providers.
+type ConfirmAssigneeCommand struct {
+	ActionItemID string            `json:"action_item_id" validate:"required"`
+	UserID       string            `json:"user_id" validate:"required"`
+	Candidate    int               `json:"candidate" validate:"min=0"`
+	Accounts     map[string]string `json:"accounts,omitempty"`
+}
+
+// SetActionItemDueDateCommand represents the command to set the due date of an action item; a nil
+// due date clears it
+type SetActionItemDueDateCommand struct {
+	ActionItemID string     `json:"action_item_id" validate:"required"`
+	UserID       string     `json:"user_id" validate:"required"`
+	DueDate      *time.Time `json:"due_date,omitempty"`
+}
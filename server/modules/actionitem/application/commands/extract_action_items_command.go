@@ -0,0 +1,8 @@
+package commands
+
+// ExtractActionItemsCommand represents the command to extract the action items of a transcription
+// again; unreviewed action items are replaced
+type ExtractActionItemsCommand struct {
+	TranscriptionID string `json:"transcription_id" validate:"required"`
+	UserID          string `json:"user_id" validate:"required"`
+}
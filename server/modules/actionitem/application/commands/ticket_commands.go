@@ -0,0 +1,38 @@
+package commands
+
+import "net/http"
+
+// ConfigureTicketingCommand represents the command to configure a user's ticketing provider.
+// Settings left out of the configuration keep their current value.
+type ConfigureTicketingCommand struct {
+	UserID   string                 `json:"user_id" validate:"required"`
+	Provider string                 `json:"provider" validate:"required"`
+	Config   map[string]interface{} `json:"config"`
+	IsActive *bool                  `json:"is_active,omitempty"`
+}
+
+// CreateTicketsCommand represents the command to open tickets for approved action items
+type CreateTicketsCommand struct {
+	UserID        string   `json:"user_id" validate:"required"`
+	Provider      string   `json:"provider" validate:"required"`
+	ActionItemIDs []string `json:"action_item_ids" validate:"required,min=1"`
+	// Project overrides the provider's default project: a GitHub repository, Jira project key or Linear team ID
+	Project string `json:"project,omitempty"`
+}
+
+// LinkTicketCommand represents the command to link an action item to a ticket that already exists
+type LinkTicketCommand struct {
+	ActionItemID string `json:"action_item_id" validate:"required"`
+	UserID       string `json:"user_id" validate:"required"`
+	Provider     string `json:"provider" validate:"required"`
+	// Ticket is the ticket's ID, key or URL
+	Ticket string `json:"ticket" validate:"required"`
+}
+
+// HandleTicketWebhookCommand represents a delivery to the webhook of a user's ticketing integration
+type HandleTicketWebhookCommand struct {
+	Provider      string      `json:"provider" validate:"required"`
+	IntegrationID string      `json:"integration_id" validate:"required"`
+	Header        http.Header `json:"-"`
+	Body          []byte      `json:"-"`
+}
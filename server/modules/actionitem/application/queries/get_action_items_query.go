@@ -0,0 +1,28 @@
+package queries
+
+import "teammate/server/modules/actionitem/domain/entities"
+
+// GetActionItemsQuery represents the query to list the action items a user can see
+type GetActionItemsQuery struct {
+	UserID    string                    `json:"user_id" validate:"required"`
+	MeetingID string                    `json:"meeting_id,omitempty"`
+	Status    entities.ActionItemStatus `json:"status,omitempty"`
+	Assignee  string                    `json:"assignee,omitempty"`
+	Overdue   bool                      `json:"overdue,omitempty"`
+	// NeedsConfirmation lists only action items whose assignee matches several people
+	NeedsConfirmation bool `json:"needs_confirmation,omitempty"`
+	Limit             int  `json:"limit,omitempty"`
+	Offset            int  `json:"offset,omitempty"`
+}
+
+// GetActionItemByIDQuery represents the query to get a specific action item by ID
+type GetActionItemByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// GetFollowUpReportQuery represents the query to report on the follow-ups of a meeting
+type GetFollowUpReportQuery struct {
+	MeetingID string `json:"meeting_id" validate:"required"`
+	UserID    string `json:"user_id" validate:"required"`
+}
@@ -0,0 +1,271 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/repositories"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	transcriptionCommands "teammate/server/modules/transcription/application/commands"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+	transcriptionRepos "teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/application/jobs"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+	jobRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+const (
+	// extractActionsJobWorkers bounds how many transcriptions are read for action items at the same time
+	extractActionsJobWorkers = 2
+	// extractActionsJobTimeout bounds extracting the action items of a single transcription
+	extractActionsJobTimeout = 10 * time.Minute
+)
+
+// ExtractedActionItems is the outcome of a completed extract actions job
+type ExtractedActionItems struct {
+	Count  int                       `json:"count"`
+	Method services.ExtractionMethod `json:"method"`
+	Model  string                    `json:"model,omitempty"`
+}
+
+// ActionItemExtractionService extracts the action items of completed transcriptions in background
+// processing jobs. Extracted items wait for review before anything is created outside the app.
+type ActionItemExtractionService struct {
+	transcriptionRepo transcriptionRepos.TranscriptionRepository
+	actionItemRepo    repositories.ActionItemRepository
+	jobRepo           jobRepos.ProcessingJobRepository
+	assignees         *AssigneeResolutionService
+	accessService     *meetingServices.MeetingAccessService
+	extractors        []services.ActionItemExtractor
+	runner            *jobs.Runner
+}
+
+// NewActionItemExtractionService creates a new action item extraction service. Extractors are
+// tried in order, so the rule-based extractor belongs last as the fallback for every transcription.
+func NewActionItemExtractionService(
+	transcriptionRepo transcriptionRepos.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	actionItemRepo repositories.ActionItemRepository,
+	jobRepo jobRepos.ProcessingJobRepository,
+	assignees *AssigneeResolutionService,
+	extractors ...services.ActionItemExtractor,
+) *ActionItemExtractionService {
+	s := &ActionItemExtractionService{
+		transcriptionRepo: transcriptionRepo,
+		actionItemRepo:    actionItemRepo,
+		jobRepo:           jobRepo,
+		assignees:         assignees,
+		accessService:     meetingServices.NewMeetingAccessService(meetingRepo),
+		extractors:        extractors,
+	}
+	s.runner = jobs.NewRunner(jobRepo, "extract actions", extractActionsJobWorkers, extractActionsJobTimeout, s.runJob)
+	return s
+}
+
+// SubscribeToEvents extracts action items from transcriptions when they complete
+func (s *ActionItemExtractionService) SubscribeToEvents(eventBus events.EventBus) {
+	eventBus.Subscribe("transcription.completed", func(event interface{}) {
+		if completedEvent, ok := event.(*transcriptionCommands.TranscriptionCompletedEvent); ok {
+			if _, err := s.enqueue(context.Background(), completedEvent.TranscriptionID, completedEvent.MeetingID, ""); err != nil {
+				log.Printf("Failed to queue action item extraction of transcription %s: %v", completedEvent.TranscriptionID, err)
+			}
+		}
+	})
+}
+
+// ExtractActionItems queues a new extraction of a transcription in a meeting the user can edit
+func (s *ActionItemExtractionService) ExtractActionItems(ctx context.Context, cmd commands.ExtractActionItemsCommand) (*jobEntities.ProcessingJob, error) {
+	transcription, err := s.transcriptionRepo.FindByID(ctx, cmd.TranscriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if _, err := s.accessService.VerifyEditAccess(ctx, transcription.MeetingID, cmd.UserID); err != nil {
+		return nil, err
+	}
+	if !transcription.IsCompleted() {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_COMPLETED", "Action items can only be extracted from completed transcriptions", domain.ErrInvalidInput)
+	}
+
+	return s.enqueue(ctx, transcription.ID, transcription.MeetingID, cmd.UserID)
+}
+
+// GetJob returns an extract actions job of a transcription in a meeting the user can view
+func (s *ActionItemExtractionService) GetJob(ctx context.Context, transcriptionID, jobID, userID string) (*jobEntities.ProcessingJob, error) {
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if _, err := s.accessService.VerifyViewAccess(ctx, transcription.MeetingID, userID); err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, domain.NewDomainError("EXTRACTION_JOB_NOT_FOUND", "Extraction job not found", err)
+	}
+	if job.JobType != jobEntities.ExtractActionsJobType || job.EntityID != transcriptionID {
+		return nil, domain.NewDomainError("EXTRACTION_JOB_NOT_FOUND", "Extraction job not found", domain.ErrNotFound)
+	}
+	return job, nil
+}
+
+// ResumePendingJobs restarts extract actions jobs that were queued or running when the server stopped
+func (s *ActionItemExtractionService) ResumePendingJobs(ctx context.Context) error {
+	return s.runner.Resume(ctx, jobEntities.ExtractActionsJobType)
+}
+
+// enqueue saves an extract actions job and starts it in the background. Jobs queued when a
+// transcription completes have no requesting user.
+func (s *ActionItemExtractionService) enqueue(ctx context.Context, transcriptionID, meetingID, userID string) (*jobEntities.ProcessingJob, error) {
+	job := jobEntities.NewProcessingJob("transcription", transcriptionID, jobEntities.ExtractActionsJobType, map[string]interface{}{
+		"meeting_id":   meetingID,
+		"requested_by": userID,
+	})
+	if err := s.jobRepo.Save(ctx, &job); err != nil {
+		return nil, domain.NewDomainError("SAVE_EXTRACTION_JOB_FAILED", "Failed to queue action item extraction", err)
+	}
+
+	s.runner.Enqueue(job.GetID())
+	return &job, nil
+}
+
+// runJob extracts the action items of a job's transcription and stores the outcome on the job
+func (s *ActionItemExtractionService) runJob(ctx context.Context, job *jobEntities.ProcessingJob) error {
+	result, err := s.extract(ctx, job.EntityID)
+	if err != nil {
+		return err
+	}
+	job.SetPayloadValue("result", result)
+	return nil
+}
+
+// extract runs the first extractor that supports the transcription and succeeds, then replaces
+// the transcription's unreviewed action items. Items matching one that was already reviewed are
+// skipped so a second extraction does not bring back rejected items.
+func (s *ActionItemExtractionService) extract(ctx context.Context, transcriptionID string) (*ExtractedActionItems, error) {
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if !transcription.IsCompleted() {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_COMPLETED", "Action items can only be extracted from completed transcriptions", domain.ErrInvalidInput)
+	}
+
+	segments, err := s.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+	}
+
+	request := services.ExtractionRequest{Transcription: transcription, Segments: segments}
+	var extraction *services.ExtractionResult
+	var lastErr error
+	for _, extractor := range s.extractors {
+		if !extractor.Supports(transcription) {
+			continue
+		}
+		extraction, lastErr = extractor.Extract(ctx, request)
+		if lastErr == nil {
+			break
+		}
+		log.Printf("Action item extractor failed for transcription %s, trying the next one: %v", transcriptionID, lastErr)
+	}
+	if extraction == nil {
+		if lastErr == nil {
+			lastErr = domain.ErrInvalidInput
+		}
+		return nil, domain.NewDomainError("EXTRACT_ACTION_ITEMS_FAILED", "Failed to extract action items", lastErr)
+	}
+
+	existing, err := s.actionItemRepo.FindByTranscriptionID(ctx, transcriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find action items: %w", err)
+	}
+	reviewed := make(map[string]bool)
+	for _, actionItem := range existing {
+		if actionItem.Status != entities.Extracted {
+			reviewed[strings.ToLower(actionItem.Title)] = true
+		}
+	}
+
+	var actionItems []*entities.ActionItem
+	for _, extracted := range extraction.ActionItems {
+		if extracted.Title == "" || reviewed[strings.ToLower(extracted.Title)] {
+			continue
+		}
+		actionItems = append(actionItems, newActionItem(transcription, segments, extracted))
+	}
+
+	// Match assignees such as "Sam" or "Speaker B" to people before anyone reviews them
+	s.assignees.ResolveAssignees(ctx, transcription.MeetingID, transcriptionID, actionItems)
+
+	if err := s.actionItemRepo.ReplaceExtracted(ctx, transcriptionID, actionItems); err != nil {
+		return nil, domain.NewDomainError("SAVE_ACTION_ITEMS_FAILED", "Failed to save action items", err)
+	}
+
+	return &ExtractedActionItems{Count: len(actionItems), Method: extraction.Method, Model: extraction.Model}, nil
+}
+
+// newActionItem creates the action item for an extracted one. Its context is the source segment
+// and the one before it, so reviewers can see what was said.
+func newActionItem(transcription *transcriptionEntities.Transcription, segments []transcriptionEntities.TranscriptSegment, extracted services.ExtractedActionItem) *entities.ActionItem {
+	var contextLines []string
+	if extracted.Source >= 0 && extracted.Source < len(segments) {
+		for i := max(extracted.Source-1, 0); i <= extracted.Source; i++ {
+			contextLines = append(contextLines, formatContextLine(segments[i]))
+		}
+	}
+
+	priority := extracted.Priority
+	if priority == "" {
+		priority = entities.Medium
+	}
+
+	actionItem := entities.NewActionItem(transcription.MeetingID, transcription.ID, extracted.Title, extracted.Description, strings.Join(contextLines, "\n"), priority)
+	actionItem.SetAssignee(extracted.Assignee)
+	if extracted.DueDate != nil {
+		actionItem.SetDueDate(*extracted.DueDate)
+	}
+	if extracted.Source >= 0 && extracted.Source < len(segments) {
+		source := segments[extracted.Source]
+		actionItem.SetSource(source.GetID(), source.StartTime, source.EndTime)
+	}
+	return &actionItem
+}
+
+func formatContextLine(segment transcriptionEntities.TranscriptSegment) string {
+	if segment.Speaker == "" {
+		return strings.TrimSpace(segment.Text)
+	}
+	return segment.Speaker + ": " + strings.TrimSpace(segment.Text)
+}
+
+// ExtractionJobResult returns the outcome of a completed extract actions job, or nil
+func ExtractionJobResult(job *jobEntities.ProcessingJob) *ExtractedActionItems {
+	var result ExtractedActionItems
+	if !job.IsCompleted() || decodePayloadValue(job, "result", &result) != nil {
+		return nil
+	}
+	return &result
+}
+
+func decodePayloadValue(job *jobEntities.ProcessingJob, key string, target interface{}) error {
+	value, ok := job.GetPayloadValue(key)
+	if !ok {
+		return domain.NewDomainError("INVALID_JOB_PAYLOAD", "Job has no "+key, domain.ErrInvalidInput)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/application/jobs/jobstest"
+	jobEntities "teammate/server/seedwork/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubExtractor returns fixed action items, or an error
+type stubExtractor struct {
+	method      services.ExtractionMethod
+	actionItems []services.ExtractedActionItem
+	err         error
+}
+
+func (e *stubExtractor) Supports(transcription *transcriptionEntities.Transcription) bool {
+	return true
+}
+
+func (e *stubExtractor) Extract(ctx context.Context, request services.ExtractionRequest) (*services.ExtractionResult, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return &services.ExtractionResult{ActionItems: e.actionItems, Method: e.method}, nil
+}
+
+func newTestExtractionService(extractors ...services.ActionItemExtractor) (*ActionItemExtractionService, *memoryActionItemRepository, *transcriptionEntities.Transcription) {
+	transcription := transcriptionEntities.NewTranscription("meeting-1", "", "assemblyai")
+	transcription.SetID("tr-1")
+	transcription.CompleteTranscription("", 0.9, []transcriptionEntities.TranscriptSegment{
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Anna", "Who can send the launch plan?", 0, 2, 0.9, 1),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Ben", "I'll send the launch plan to marketing.", 2, 5, 0.9, 2),
+	})
+
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+
+	repo := &memoryActionItemRepository{}
+	transcriptionRepo := &stubTranscriptionRepository{transcription: &transcription}
+	meetingRepo := &stubMeetingRepository{meeting: meeting}
+	service := NewActionItemExtractionService(
+		transcriptionRepo,
+		meetingRepo,
+		repo,
+		jobstest.NewMemoryProcessingJobRepository(),
+		newTestAssigneeResolutionService(repo, meetingRepo, transcriptionRepo, &stubRevisionRepository{}, nil),
+		extractors...,
+	)
+	return service, repo, &transcription
+}
+
+func TestActionItemExtractionService_Extract(t *testing.T) {
+	failing := &stubExtractor{method: services.LemurExtractionMethod, err: errors.New("LeMUR unavailable")}
+	fallback := &stubExtractor{method: services.RuleBasedExtractionMethod, actionItems: []services.ExtractedActionItem{
+		{Title: "Send the launch plan to marketing", Description: "Send the launch plan to marketing.", Assignee: "Ben", Source: 1},
+		{Title: "Book the venue", Priority: entities.High, Source: -1},
+		{Title: "Update the roadmap", Source: 0},
+	}}
+	service, repo, transcription := newTestExtractionService(failing, fallback)
+
+	rejected := entities.NewActionItem("meeting-1", "tr-1", "Update the roadmap", "", "", entities.Low)
+	rejected.Reject()
+	stale := entities.NewActionItem("meeting-1", "tr-1", "Stale item", "", "", entities.Low)
+	repo.actionItems = []*entities.ActionItem{&rejected, &stale}
+
+	result, err := service.extract(context.Background(), "tr-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Count)
+	assert.Equal(t, services.RuleBasedExtractionMethod, result.Method)
+
+	require.Len(t, repo.actionItems, 3)
+	assert.Equal(t, &rejected, repo.actionItems[0])
+
+	sent := repo.actionItems[1]
+	assert.Equal(t, "Send the launch plan to marketing", sent.Title)
+	assert.Equal(t, entities.Extracted, sent.Status)
+	assert.Equal(t, entities.Medium, sent.Priority)
+	assert.Equal(t, "Ben", sent.Assignee)
+	assert.Equal(t, "tr-1", sent.TranscriptionID)
+	assert.Equal(t, "Anna: Who can send the launch plan?\nBen: I'll send the launch plan to marketing.", sent.Context)
+	assert.Equal(t, transcription.Segments[1].GetID(), sent.SourceSegmentID)
+	require.NotNil(t, sent.SourceStartTime)
+	assert.Equal(t, 2.0, *sent.SourceStartTime)
+	assert.Equal(t, 5.0, *sent.SourceEndTime)
+
+	venue := repo.actionItems[2]
+	assert.Equal(t, entities.High, venue.Priority)
+	assert.False(t, venue.HasSource())
+	assert.Empty(t, venue.Context)
+}
+
+func TestActionItemExtractionService_ExtractFails(t *testing.T) {
+	service, _, _ := newTestExtractionService(&stubExtractor{err: errors.New("rate limited")})
+
+	_, err := service.extract(context.Background(), "tr-1")
+	assertDomainErrorCode(t, err, "EXTRACT_ACTION_ITEMS_FAILED")
+}
+
+func TestActionItemExtractionService_ExtractActionItems(t *testing.T) {
+	service, repo, _ := newTestExtractionService(&stubExtractor{method: services.RuleBasedExtractionMethod, actionItems: []services.ExtractedActionItem{
+		{Title: "Send the launch plan to marketing", Assignee: "Ben", Source: 1},
+	}})
+
+	job, err := service.ExtractActionItems(context.Background(), commands.ExtractActionItemsCommand{TranscriptionID: "tr-1", UserID: "owner"})
+	require.NoError(t, err)
+	assert.Equal(t, jobEntities.ExtractActionsJobType, job.JobType)
+
+	require.Eventually(t, func() bool {
+		job, err = service.GetJob(context.Background(), "tr-1", job.GetID(), "owner")
+		return err == nil && (job.IsCompleted() || job.IsFailed())
+	}, 5*time.Second, 10*time.Millisecond)
+	require.True(t, job.IsCompleted(), job.ErrorMessage)
+
+	result := ExtractionJobResult(job)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.Count)
+	assert.Equal(t, services.RuleBasedExtractionMethod, result.Method)
+	require.Len(t, repo.actionItems, 1)
+	assert.Equal(t, "Send the launch plan to marketing", repo.actionItems[0].Title)
+}
+
+func TestActionItemExtractionService_ExtractActionItemsAccess(t *testing.T) {
+	service, _, transcription := newTestExtractionService(&stubExtractor{})
+
+	_, err := service.ExtractActionItems(context.Background(), commands.ExtractActionItemsCommand{TranscriptionID: "tr-1", UserID: "viewer"})
+	assertDomainErrorCode(t, err, "UNAUTHORIZED")
+
+	_, err = service.ExtractActionItems(context.Background(), commands.ExtractActionItemsCommand{TranscriptionID: "missing", UserID: "owner"})
+	assertDomainErrorCode(t, err, "TRANSCRIPTION_NOT_FOUND")
+
+	transcription.Status = transcriptionEntities.Processing
+	_, err = service.ExtractActionItems(context.Background(), commands.ExtractActionItemsCommand{TranscriptionID: "tr-1", UserID: "owner"})
+	assertDomainErrorCode(t, err, "TRANSCRIPTION_NOT_COMPLETED")
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/application/queries"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/repositories"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	defaultActionItemsLimit = 50
+	maxActionItemsLimit     = 200
+	maxAssigneeLength       = 255
+)
+
+// ActionItemService handles the review workflow of the action items extracted from meetings.
+// Anyone who can view a meeting can see its action items; changing them needs edit access.
+type ActionItemService struct {
+	actionItemRepo repositories.ActionItemRepository
+	accessService  *meetingServices.MeetingAccessService
+	assignees      *AssigneeResolutionService
+	now            func() time.Time
+}
+
+// NewActionItemService creates a new action item service
+func NewActionItemService(actionItemRepo repositories.ActionItemRepository, meetingRepo meetingRepos.MeetingRepository, assignees *AssigneeResolutionService) *ActionItemService {
+	return &ActionItemService{
+		actionItemRepo: actionItemRepo,
+		accessService:  meetingServices.NewMeetingAccessService(meetingRepo),
+		assignees:      assignees,
+		now:            time.Now,
+	}
+}
+
+// GetActionItems lists the action items the user can see that match the query, with the
+// earliest due date first
+func (s *ActionItemService) GetActionItems(ctx context.Context, query queries.GetActionItemsQuery) ([]*entities.ActionItem, int64, error) {
+	if query.Status != "" && !isValidStatus(query.Status) {
+		return nil, 0, domain.NewDomainError("INVALID_ACTION_ITEM_FILTER", fmt.Sprintf("Unknown action item status %q", query.Status), nil)
+	}
+	if query.Limit < 0 || query.Offset < 0 {
+		return nil, 0, domain.NewDomainError("INVALID_ACTION_ITEM_FILTER", "Limit and offset must not be negative", nil)
+	}
+	if query.MeetingID != "" {
+		if _, err := s.accessService.VerifyViewAccess(ctx, query.MeetingID, query.UserID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultActionItemsLimit
+	}
+	if limit > maxActionItemsLimit {
+		limit = maxActionItemsLimit
+	}
+
+	actionItems, total, err := s.actionItemRepo.Find(ctx, repositories.ActionItemFilter{
+		UserID:            query.UserID,
+		MeetingID:         query.MeetingID,
+		Status:            query.Status,
+		Assignee:          strings.TrimSpace(query.Assignee),
+		Overdue:           query.Overdue,
+		NeedsConfirmation: query.NeedsConfirmation,
+		Now:               s.now(),
+		Limit:             limit,
+		Offset:            query.Offset,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to find action items: %w", err)
+	}
+	return actionItems, total, nil
+}
+
+// GetActionItemByID returns an action item in a meeting the user can view
+func (s *ActionItemService) GetActionItemByID(ctx context.Context, query queries.GetActionItemByIDQuery) (*entities.ActionItem, error) {
+	actionItem, err := s.findActionItem(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.accessService.VerifyViewAccess(ctx, actionItem.MeetingID, query.UserID); err != nil {
+		return nil, err
+	}
+	return actionItem, nil
+}
+
+// GetFollowUpReport reports on the follow-ups of a meeting the user can view: how many are done,
+// which are still open and who they are waiting on
+func (s *ActionItemService) GetFollowUpReport(ctx context.Context, query queries.GetFollowUpReportQuery) (*FollowUpReport, error) {
+	if _, err := s.accessService.VerifyViewAccess(ctx, query.MeetingID, query.UserID); err != nil {
+		return nil, err
+	}
+
+	actionItems, err := s.actionItemRepo.FindByMeetingID(ctx, query.MeetingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find action items: %w", err)
+	}
+	return buildFollowUpReport(query.MeetingID, actionItems, s.now()), nil
+}
+
+// ApproveActionItem approves an action item so a ticket can be created for it
+func (s *ActionItemService) ApproveActionItem(ctx context.Context, cmd commands.ApproveActionItemCommand) (*entities.ActionItem, error) {
+	return s.update(ctx, cmd.ActionItemID, cmd.UserID, func(actionItem *entities.ActionItem) error {
+		if !actionItem.CanBeReviewed() {
+			return domain.NewDomainError("INVALID_ACTION_ITEM_STATUS", "A ticket has already been created for this action item", nil)
+		}
+		actionItem.Approve()
+		return nil
+	})
+}
+
+// RejectActionItem rejects an action item that should not become a ticket
+func (s *ActionItemService) RejectActionItem(ctx context.Context, cmd commands.RejectActionItemCommand) (*entities.ActionItem, error) {
+	return s.update(ctx, cmd.ActionItemID, cmd.UserID, func(actionItem *entities.ActionItem) error {
+		if !actionItem.CanBeReviewed() {
+			return domain.NewDomainError("INVALID_ACTION_ITEM_STATUS", "A ticket has already been created for this action item", nil)
+		}
+		actionItem.Reject()
+		return nil
+	})
+}
+
+// SetAssignee assigns an action item, or unassigns it if the assignee is empty. The assignee is
+// matched to a person right away.
+func (s *ActionItemService) SetAssignee(ctx context.Context, cmd commands.SetActionItemAssigneeCommand) (*entities.ActionItem, error) {
+	assignee := strings.TrimSpace(cmd.Assignee)
+	if len(assignee) > maxAssigneeLength {
+		return nil, domain.NewDomainError("INVALID_ASSIGNEE", fmt.Sprintf("Assignee must be at most %d characters", maxAssigneeLength), nil)
+	}
+
+	return s.update(ctx, cmd.ActionItemID, cmd.UserID, func(actionItem *entities.ActionItem) error {
+		actionItem.SetAssignee(assignee)
+		s.assignees.ResolveAssignees(ctx, actionItem.MeetingID, actionItem.TranscriptionID, []*entities.ActionItem{actionItem})
+		return nil
+	})
+}
+
+// SetDueDate sets the due date of an action item, or clears it if no due date is given
+func (s *ActionItemService) SetDueDate(ctx context.Context, cmd commands.SetActionItemDueDateCommand) (*entities.ActionItem, error) {
+	return s.update(ctx, cmd.ActionItemID, cmd.UserID, func(actionItem *entities.ActionItem) error {
+		if cmd.DueDate == nil {
+			actionItem.ClearDueDate()
+		} else {
+			actionItem.SetDueDate(cmd.DueDate.UTC())
+		}
+		return nil
+	})
+}
+
+// update applies a change to an action item in a meeting the user can edit and stores it
+func (s *ActionItemService) update(ctx context.Context, actionItemID, userID string, change func(*entities.ActionItem) error) (*entities.ActionItem, error) {
+	actionItem, err := s.findActionItem(ctx, actionItemID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.accessService.VerifyEditAccess(ctx, actionItem.MeetingID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := change(actionItem); err != nil {
+		return nil, err
+	}
+	if err := s.actionItemRepo.Update(ctx, actionItem); err != nil {
+		return nil, fmt.Errorf("failed to update action item: %w", err)
+	}
+	return actionItem, nil
+}
+
+func (s *ActionItemService) findActionItem(ctx context.Context, id string) (*entities.ActionItem, error) {
+	actionItem, err := s.actionItemRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find action item: %w", err)
+	}
+	if actionItem == nil {
+		return nil, domain.NewDomainError("ACTION_ITEM_NOT_FOUND", "Action item not found", nil)
+	}
+	return actionItem, nil
+}
+
+func isValidStatus(status entities.ActionItemStatus) bool {
+	switch status {
+	case entities.Extracted, entities.Pending, entities.Approved, entities.Created, entities.Completed, entities.Rejected:
+		return true
+	}
+	return false
+}
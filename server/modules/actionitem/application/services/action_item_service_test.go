@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/application/queries"
+	"teammate/server/modules/actionitem/domain/entities"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/seedwork/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestActionItemService() (*ActionItemService, *memoryActionItemRepository, *entities.ActionItem) {
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+
+	actionItem := entities.NewActionItem("meeting-1", "tr-1", "Send the launch plan", "Share the plan with marketing", "Ben: I'll send the plan.", entities.High)
+	repo := &memoryActionItemRepository{actionItems: []*entities.ActionItem{&actionItem}}
+
+	meetingRepo := &stubMeetingRepository{meeting: meeting}
+	assignees := newTestAssigneeResolutionService(repo, meetingRepo, &stubTranscriptionRepository{}, &stubRevisionRepository{}, nil)
+	service := NewActionItemService(repo, meetingRepo, assignees)
+	service.now = func() time.Time { return time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC) }
+	return service, repo, &actionItem
+}
+
+func assertDomainErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, code, domainErr.Code)
+}
+
+func TestActionItemService_ReviewWorkflow(t *testing.T) {
+	service, _, actionItem := newTestActionItemService()
+	ctx := context.Background()
+
+	approved, err := service.ApproveActionItem(ctx, commands.ApproveActionItemCommand{ActionItemID: actionItem.GetID(), UserID: "editor"})
+	require.NoError(t, err)
+	assert.Equal(t, entities.Approved, approved.Status)
+
+	rejected, err := service.RejectActionItem(ctx, commands.RejectActionItemCommand{ActionItemID: actionItem.GetID(), UserID: "owner"})
+	require.NoError(t, err)
+	assert.Equal(t, entities.Rejected, rejected.Status)
+
+	actionItem.MarkAsCreated()
+	_, err = service.ApproveActionItem(ctx, commands.ApproveActionItemCommand{ActionItemID: actionItem.GetID(), UserID: "owner"})
+	assertDomainErrorCode(t, err, "INVALID_ACTION_ITEM_STATUS")
+	assert.Equal(t, entities.Created, actionItem.Status)
+}
+
+func TestActionItemService_AssigneeAndDueDate(t *testing.T) {
+	service, _, actionItem := newTestActionItemService()
+	ctx := context.Background()
+
+	updated, err := service.SetAssignee(ctx, commands.SetActionItemAssigneeCommand{ActionItemID: actionItem.GetID(), UserID: "owner", Assignee: "  ben@example.com "})
+	require.NoError(t, err)
+	assert.Equal(t, "ben@example.com", updated.Assignee)
+
+	_, err = service.SetAssignee(ctx, commands.SetActionItemAssigneeCommand{ActionItemID: actionItem.GetID(), UserID: "owner", Assignee: strings.Repeat("a", 256)})
+	assertDomainErrorCode(t, err, "INVALID_ASSIGNEE")
+
+	dueDate := time.Date(2026, 3, 12, 17, 0, 0, 0, time.FixedZone("CET", 3600))
+	updated, err = service.SetDueDate(ctx, commands.SetActionItemDueDateCommand{ActionItemID: actionItem.GetID(), UserID: "owner", DueDate: &dueDate})
+	require.NoError(t, err)
+	require.NotNil(t, updated.DueDate)
+	assert.Equal(t, time.UTC, updated.DueDate.Location())
+	assert.True(t, dueDate.Equal(*updated.DueDate))
+
+	updated, err = service.SetDueDate(ctx, commands.SetActionItemDueDateCommand{ActionItemID: actionItem.GetID(), UserID: "owner"})
+	require.NoError(t, err)
+	assert.Nil(t, updated.DueDate)
+}
+
+func TestActionItemService_Access(t *testing.T) {
+	service, _, actionItem := newTestActionItemService()
+	ctx := context.Background()
+
+	found, err := service.GetActionItemByID(ctx, queries.GetActionItemByIDQuery{ID: actionItem.GetID(), UserID: "viewer"})
+	require.NoError(t, err)
+	assert.Equal(t, actionItem.GetID(), found.GetID())
+
+	_, err = service.ApproveActionItem(ctx, commands.ApproveActionItemCommand{ActionItemID: actionItem.GetID(), UserID: "viewer"})
+	assertDomainErrorCode(t, err, "UNAUTHORIZED")
+	assert.Equal(t, entities.Extracted, actionItem.Status)
+
+	_, err = service.GetActionItemByID(ctx, queries.GetActionItemByIDQuery{ID: actionItem.GetID(), UserID: "stranger"})
+	assertDomainErrorCode(t, err, "UNAUTHORIZED")
+
+	_, err = service.GetActionItemByID(ctx, queries.GetActionItemByIDQuery{ID: "missing", UserID: "owner"})
+	assertDomainErrorCode(t, err, "ACTION_ITEM_NOT_FOUND")
+}
+
+func TestActionItemService_GetActionItems(t *testing.T) {
+	service, repo, actionItem := newTestActionItemService()
+	ctx := context.Background()
+
+	overdue := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC)
+	actionItem.SetDueDate(overdue)
+	actionItem.SetAssignee("Ben")
+	later := entities.NewActionItem("meeting-1", "tr-1", "Book the venue", "", "", entities.Low)
+	later.SetDueDate(time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC))
+	repo.actionItems = append(repo.actionItems, &later)
+
+	actionItems, total, err := service.GetActionItems(ctx, queries.GetActionItemsQuery{UserID: "viewer", MeetingID: "meeting-1", Assignee: " ben ", Overdue: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, actionItem.GetID(), actionItems[0].GetID())
+	assert.Equal(t, "ben", repo.filter.Assignee)
+	assert.Equal(t, defaultActionItemsLimit, repo.filter.Limit)
+
+	_, _, err = service.GetActionItems(ctx, queries.GetActionItemsQuery{UserID: "owner", Limit: 1000})
+	require.NoError(t, err)
+	assert.Equal(t, maxActionItemsLimit, repo.filter.Limit)
+
+	_, _, err = service.GetActionItems(ctx, queries.GetActionItemsQuery{UserID: "owner", Status: "done"})
+	assertDomainErrorCode(t, err, "INVALID_ACTION_ITEM_FILTER")
+
+	_, _, err = service.GetActionItems(ctx, queries.GetActionItemsQuery{UserID: "stranger", MeetingID: "meeting-1"})
+	assertDomainErrorCode(t, err, "UNAUTHORIZED")
+}
+
+func TestActionItemService_GetFollowUpReport(t *testing.T) {
+	service, repo, extracted := newTestActionItemService()
+	ctx := context.Background()
+
+	overdue := entities.NewActionItem("meeting-1", "tr-1", "Book the venue", "", "", entities.Low)
+	overdue.Approve()
+	overdue.SetAssignee("Ben")
+	overdue.SetDueDate(time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC))
+	undated := entities.NewActionItem("meeting-1", "tr-1", "Draft the agenda", "", "", entities.Medium)
+	undated.MarkAsCreated()
+	completed := entities.NewActionItem("meeting-1", "tr-1", "Order badges", "", "", entities.Medium)
+	completed.SetAssignee("ben")
+	completed.MarkAsCompleted(time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC))
+	rejected := entities.NewActionItem("meeting-1", "tr-1", "Call the caterer", "", "", entities.Low)
+	rejected.Reject()
+	repo.actionItems = append(repo.actionItems, &undated, &overdue, &completed, &rejected)
+
+	report, err := service.GetFollowUpReport(ctx, queries.GetFollowUpReportQuery{MeetingID: "meeting-1", UserID: "viewer"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Total)
+	assert.Equal(t, 1, report.Completed)
+	assert.Equal(t, 1, report.Overdue)
+	assert.Equal(t, 1, report.Unreviewed)
+	assert.InDelta(t, 1.0/3, report.CompletionRate, 0.001)
+	require.Len(t, report.Open, 2)
+	assert.Equal(t, overdue.GetID(), report.Open[0].GetID())
+	assert.Equal(t, undated.GetID(), report.Open[1].GetID())
+	assert.Equal(t, []AssigneeFollowUps{{Assignee: "", Open: 1}, {Assignee: "Ben", Open: 1, Overdue: 1, Completed: 1}}, report.ByAssignee)
+	assert.Equal(t, entities.Extracted, extracted.Status)
+
+	_, err = service.GetFollowUpReport(ctx, queries.GetFollowUpReportQuery{MeetingID: "meeting-1", UserID: "stranger"})
+	assertDomainErrorCode(t, err, "UNAUTHORIZED")
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// candidateThreshold is the lowest score at which a person is offered as a candidate
+	candidateThreshold = 0.6
+	// resolveThreshold is the lowest score at which the best candidate is taken without confirmation
+	resolveThreshold = 0.75
+	// ambiguityMargin is how far ahead of the runner-up the best candidate has to be
+	ambiguityMargin = 0.15
+	// accountThreshold is the lowest score at which a tracker user is taken to be a person
+	accountThreshold = 0.8
+	// directoryWeight discounts people only known from a tracker over people from the meeting
+	directoryWeight = 0.9
+	// maxAssigneeCandidates bounds the candidates kept with a resolution
+	maxAssigneeCandidates = 5
+)
+
+// matchScore scores how well a mention such as "Sam", "Sam L." or "sam.lee@example.com" refers to a
+// person with the given name, email and tracker login, from 0 (not at all) to 1 (exactly)
+func matchScore(mention, name, email, login string) float64 {
+	mention = strings.ToLower(strings.TrimSpace(mention))
+	email = strings.ToLower(strings.TrimSpace(email))
+	if mention == "" {
+		return 0
+	}
+
+	if strings.Contains(mention, "@") {
+		if mention == email {
+			return 1
+		}
+		// Another address with the same local part may be the same person at another domain, but
+		// it may as well be someone else, so it always stays below resolveThreshold
+		local := compact(localPart(mention))
+		if local != "" && (local == compact(localPart(email)) || local == compact(name) || local == compact(login)) {
+			return 0.7
+		}
+		return 0
+	}
+
+	mentionTokens := nameTokens(mention)
+	tokens := nameTokens(name)
+	if mentionTokens[0] == "" {
+		return 0
+	}
+
+	var score float64
+	raise := func(value float64) {
+		if value > score {
+			score = value
+		}
+	}
+
+	if equalTokens(mentionTokens, tokens) {
+		return 1
+	}
+	whole := compact(mention)
+	if login != "" && whole == compact(login) {
+		raise(0.95)
+	}
+	if whole == compact(name) || (email != "" && whole == compact(localPart(email))) {
+		raise(0.9)
+	}
+
+	if len(mentionTokens) == 1 {
+		token := mentionTokens[0]
+		if len(tokens) > 1 && token == tokens[0] {
+			raise(0.8)
+		}
+		if len(tokens) > 1 && token == tokens[len(tokens)-1] {
+			raise(0.7)
+		}
+		if email != "" && token == nameTokens(localPart(email))[0] {
+			raise(0.7)
+		}
+		// Short forms such as "Sam" for "Samantha" or "Alex" for "Alexander"
+		if len(token) >= 3 && (strings.HasPrefix(tokens[0], token) || (len(tokens[0]) >= 3 && strings.HasPrefix(token, tokens[0]))) {
+			raise(0.6)
+		}
+		if len(token) >= 3 && login != "" && strings.HasPrefix(strings.ToLower(login), token) {
+			raise(0.6)
+		}
+		return score
+	}
+
+	if containsTokens(tokens, mentionTokens) {
+		raise(0.85)
+	}
+	// "Sam L" for "Sam Lee"
+	last := mentionTokens[len(mentionTokens)-1]
+	if len(tokens) > 1 && len(last) == 1 && mentionTokens[0] == tokens[0] && strings.HasPrefix(tokens[len(tokens)-1], last) {
+		raise(0.75)
+	}
+	return score
+}
+
+// nameTokens splits a name into lower case words. A name without any returns a single empty word.
+func nameTokens(name string) []string {
+	tokens := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(tokens) == 0 {
+		return []string{""}
+	}
+	return tokens
+}
+
+// compact returns a name in lower case without spaces or punctuation, e.g. "samlee" for "Sam Lee"
+func compact(name string) string {
+	return strings.Join(nameTokens(name), "")
+}
+
+// localPart returns the part of an email address before the @
+func localPart(email string) string {
+	if i := strings.Index(email, "@"); i >= 0 {
+		return email[:i]
+	}
+	return email
+}
+
+func equalTokens(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// containsTokens returns true if every word of part is a word of whole
+func containsTokens(whole, part []string) bool {
+	for _, token := range part {
+		found := false
+		for _, candidate := range whole {
+			if candidate == token {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,467 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/repositories"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	transcriptionRepos "teammate/server/modules/transcription/domain/repositories"
+	userEntities "teammate/server/modules/user/domain/entities"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// speakerRenameWeight is the confidence in a name a speaker label was renamed to that matches
+// nobody the meeting knows
+const speakerRenameWeight = 0.9
+
+// AssigneeResolutionService matches the free-form assignees of action items, such as "Sam" or
+// "Speaker B", to people: first the participants of the meeting, the names its speakers were
+// renamed to and the users it belongs to, and then the users of the meeting owner's ticketing
+// integrations. Every match gets a confidence; when several people match about as well, a user
+// has to confirm who was meant.
+type AssigneeResolutionService struct {
+	actionItemRepo    repositories.ActionItemRepository
+	meetingRepo       meetingRepos.MeetingRepository
+	userRepo          userRepos.UserRepository
+	transcriptionRepo transcriptionRepos.TranscriptionRepository
+	revisionRepo      transcriptionRepos.TranscriptRevisionRepository
+	integrationRepo   userRepos.IntegrationConfigRepository
+	providers         services.TicketingProviderFactory
+	accessService     *meetingServices.MeetingAccessService
+	now               func() time.Time
+}
+
+// NewAssigneeResolutionService creates a new assignee resolution service
+func NewAssigneeResolutionService(
+	actionItemRepo repositories.ActionItemRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	userRepo userRepos.UserRepository,
+	transcriptionRepo transcriptionRepos.TranscriptionRepository,
+	revisionRepo transcriptionRepos.TranscriptRevisionRepository,
+	integrationRepo userRepos.IntegrationConfigRepository,
+	providers services.TicketingProviderFactory,
+) *AssigneeResolutionService {
+	return &AssigneeResolutionService{
+		actionItemRepo:    actionItemRepo,
+		meetingRepo:       meetingRepo,
+		userRepo:          userRepo,
+		transcriptionRepo: transcriptionRepo,
+		revisionRepo:      revisionRepo,
+		integrationRepo:   integrationRepo,
+		providers:         providers,
+		accessService:     meetingServices.NewMeetingAccessService(meetingRepo),
+		now:               time.Now,
+	}
+}
+
+// ResolveAssignees matches the assignees of action items of one meeting and transcription to
+// people without storing them. Confirmed assignees are kept. People that cannot be looked up are
+// left out rather than failing the resolution.
+func (s *AssigneeResolutionService) ResolveAssignees(ctx context.Context, meetingID, transcriptionID string, actionItems []*entities.ActionItem) {
+	var pending []*entities.ActionItem
+	for _, actionItem := range actionItems {
+		resolution := actionItem.AssigneeResolution
+		if actionItem.Assignee != "" && (resolution == nil || resolution.Status != entities.AssigneeConfirmed) {
+			pending = append(pending, actionItem)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	people := s.loadPeople(ctx, meetingID, transcriptionID)
+	for _, actionItem := range pending {
+		actionItem.ResolveAssignee(people.resolve(actionItem.AssigneeMention(), s.now().UTC()))
+	}
+}
+
+// ResolveAssignee matches the assignee of an action item in a meeting the user can edit again,
+// e.g. after participants were added or a ticketing integration was configured
+func (s *AssigneeResolutionService) ResolveAssignee(ctx context.Context, cmd commands.ResolveAssigneeCommand) (*entities.ActionItem, error) {
+	actionItem, err := s.findEditableActionItem(ctx, cmd.ActionItemID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if actionItem.Assignee == "" {
+		return nil, domain.NewDomainError("INVALID_ASSIGNEE", "Action item has no assignee to resolve", domain.ErrInvalidInput)
+	}
+
+	people := s.loadPeople(ctx, actionItem.MeetingID, actionItem.TranscriptionID)
+	actionItem.ResolveAssignee(people.resolve(actionItem.AssigneeMention(), s.now().UTC()))
+	if err := s.actionItemRepo.Update(ctx, actionItem); err != nil {
+		return nil, fmt.Errorf("failed to update action item: %w", err)
+	}
+	return actionItem, nil
+}
+
+// ConfirmAssignee assigns an action item in a meeting the user can edit to one of its assignee
+// candidates. Accounts add or correct the candidate's user IDs at ticketing providers; an empty
+// ID removes one.
+func (s *AssigneeResolutionService) ConfirmAssignee(ctx context.Context, cmd commands.ConfirmAssigneeCommand) (*entities.ActionItem, error) {
+	actionItem, err := s.findEditableActionItem(ctx, cmd.ActionItemID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	resolution := actionItem.AssigneeResolution
+	if resolution == nil || cmd.Candidate < 0 || cmd.Candidate >= len(resolution.Candidates) {
+		return nil, domain.NewDomainError("INVALID_ASSIGNEE_CANDIDATE", fmt.Sprintf("Candidate %d is not one of the action item's assignee candidates", cmd.Candidate), domain.ErrInvalidInput)
+	}
+
+	candidate := resolution.Candidates[cmd.Candidate]
+	accounts := make(map[string]string, len(candidate.Accounts)+len(cmd.Accounts))
+	for provider, id := range candidate.Accounts {
+		accounts[provider] = id
+	}
+	for provider, id := range cmd.Accounts {
+		if !s.providers.Supports(provider) {
+			return nil, domain.NewDomainError("UNSUPPORTED_TICKETING_PROVIDER", fmt.Sprintf("Ticketing provider %q is not supported", provider), domain.ErrInvalidInput)
+		}
+		if id = strings.TrimSpace(id); id == "" {
+			delete(accounts, provider)
+		} else {
+			accounts[provider] = id
+		}
+	}
+	candidate.Accounts = accounts
+
+	actionItem.ConfirmAssignee(candidate, s.now().UTC())
+	if err := s.actionItemRepo.Update(ctx, actionItem); err != nil {
+		return nil, fmt.Errorf("failed to update action item: %w", err)
+	}
+	return actionItem, nil
+}
+
+// assigneeDirectory is who tickets can be assigned to at one of the meeting owner's ticketing providers
+type assigneeDirectory struct {
+	provider string
+	users    []services.TicketUser
+}
+
+// meetingPeople is everyone the assignees of a meeting's action items may refer to
+type meetingPeople struct {
+	people []entities.AssigneeCandidate
+	// speakers maps speaker labels to the names they were renamed to and in how many segments
+	speakers    map[string]map[string]int
+	directories []assigneeDirectory
+}
+
+// loadPeople looks up the participants and users of a meeting, how the speakers of the
+// transcription were renamed and the users of the owner's ticketing integrations
+func (s *AssigneeResolutionService) loadPeople(ctx context.Context, meetingID, transcriptionID string) *meetingPeople {
+	people := &meetingPeople{speakers: s.loadSpeakerNames(ctx, transcriptionID)}
+
+	participants, err := s.meetingRepo.FindParticipantsByMeetingID(ctx, meetingID)
+	if err != nil {
+		log.Printf("Failed to load participants of meeting %s: %v", meetingID, err)
+	}
+	for _, participant := range participants {
+		candidate := entities.AssigneeCandidate{Name: participant.Name, Source: entities.ParticipantSource}
+		if participant.Email != nil {
+			candidate.Email = strings.ToLower(strings.TrimSpace(*participant.Email))
+		}
+		people.people = append(people.people, candidate)
+	}
+
+	meeting, err := s.meetingRepo.FindMeetingByID(ctx, meetingID)
+	if err != nil {
+		log.Printf("Failed to load meeting %s: %v", meetingID, err)
+		return people
+	}
+	userIDs := []string{meeting.UserID}
+	shares, err := s.meetingRepo.FindMeetingSharesByMeetingID(ctx, meetingID)
+	if err != nil {
+		log.Printf("Failed to load shares of meeting %s: %v", meetingID, err)
+	}
+	for _, share := range shares {
+		userIDs = append(userIDs, share.SharedWithUserID)
+	}
+	for _, userID := range uniqueIDs(userIDs) {
+		user, err := s.userRepo.FindByID(userID)
+		if err != nil {
+			continue
+		}
+		people.addUser(user)
+	}
+
+	people.directories = s.loadDirectories(ctx, meeting.UserID)
+	return people
+}
+
+// loadSpeakerNames compares the speakers of the transcription as it was produced with the edited
+// segments to find what each speaker label was renamed to
+func (s *AssigneeResolutionService) loadSpeakerNames(ctx context.Context, transcriptionID string) map[string]map[string]int {
+	names := make(map[string]map[string]int)
+	latest, err := s.revisionRepo.FindLatest(ctx, transcriptionID)
+	if err != nil || latest == nil {
+		return names
+	}
+	original, err := s.revisionRepo.FindByNumber(ctx, transcriptionID, 0)
+	if err != nil {
+		log.Printf("Failed to load the original revision of transcription %s: %v", transcriptionID, err)
+		return names
+	}
+	segments, err := s.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, transcriptionID)
+	if err != nil {
+		log.Printf("Failed to load the segments of transcription %s: %v", transcriptionID, err)
+		return names
+	}
+
+	speakers := make(map[string]string, len(segments))
+	for _, segment := range segments {
+		speakers[segment.GetID()] = strings.TrimSpace(segment.Speaker)
+	}
+	for _, segment := range original.Snapshot {
+		label := speakerKey(segment.Speaker)
+		speaker, ok := speakers[segment.GetID()]
+		if !ok || label == "" || speaker == "" || speakerKey(speaker) == label {
+			continue
+		}
+		if names[label] == nil {
+			names[label] = make(map[string]int)
+		}
+		names[label][speaker]++
+	}
+	return names
+}
+
+// loadDirectories lists who tickets can be assigned to at each active ticketing integration of a user
+func (s *AssigneeResolutionService) loadDirectories(ctx context.Context, userID string) []assigneeDirectory {
+	configs, err := s.integrationRepo.FindByUserID(ctx, userID, userEntities.TicketingProvider)
+	if err != nil {
+		log.Printf("Failed to load ticketing integrations of user %s: %v", userID, err)
+		return nil
+	}
+
+	var directories []assigneeDirectory
+	for _, config := range configs {
+		if !config.IsActive || !s.providers.Supports(config.ProviderName) {
+			continue
+		}
+		provider, err := s.providers.NewProvider(config.ProviderName, config.Config)
+		if err != nil {
+			continue
+		}
+		directory, ok := provider.(services.UserDirectory)
+		if !ok {
+			continue
+		}
+		users, err := directory.AssignableUsers(ctx)
+		if err != nil {
+			log.Printf("Failed to list the %s users of integration %s: %v", config.ProviderName, config.GetID(), err)
+			continue
+		}
+		directories = append(directories, assigneeDirectory{provider: config.ProviderName, users: users})
+	}
+	return directories
+}
+
+// addUser adds a user of the meeting, or completes the participant with the same email
+func (p *meetingPeople) addUser(user *userEntities.User) {
+	email := user.Email.String()
+	for i := range p.people {
+		if email != "" && p.people[i].Email == email {
+			p.people[i].UserID = user.GetID()
+			return
+		}
+	}
+	p.people = append(p.people, entities.AssigneeCandidate{Name: user.Name, Email: email, UserID: user.GetID(), Source: entities.UserSource})
+}
+
+// weightedMention is a way an assignee may be written, with how likely it is the one meant
+type weightedMention struct {
+	text    string
+	weight  float64
+	speaker bool
+}
+
+// resolve matches an assignee mention to the people of the meeting. A speaker label stands for
+// the names it was renamed to, weighted by how many segments were renamed to each. Only when
+// nobody from the meeting matches are the users of ticketing integrations considered as candidates.
+func (p *meetingPeople) resolve(mention string, now time.Time) entities.AssigneeResolution {
+	mentions := []weightedMention{{text: mention, weight: 1}}
+	if renamed := p.speakers[speakerKey(mention)]; len(renamed) > 0 {
+		mentions = speakerMentions(renamed)
+	}
+
+	candidates := &candidateSet{index: make(map[string]int)}
+	for _, m := range mentions {
+		matched := false
+		for _, person := range p.people {
+			if score := matchScore(m.text, person.Name, person.Email, ""); score >= candidateThreshold {
+				candidate := person
+				candidate.Confidence = score * m.weight
+				candidates.add(candidate)
+				matched = true
+			}
+		}
+		if !matched && m.speaker {
+			candidates.add(entities.AssigneeCandidate{Name: m.text, Source: entities.SpeakerSource, Confidence: speakerRenameWeight * m.weight})
+		}
+	}
+
+	if len(candidates.list) == 0 {
+		for _, m := range mentions {
+			for _, directory := range p.directories {
+				for _, user := range directory.users {
+					if score := matchScore(m.text, user.Name, user.Email, user.ID); score >= candidateThreshold {
+						name := user.Name
+						if name == "" {
+							name = user.ID
+						}
+						candidates.add(entities.AssigneeCandidate{
+							Name:       name,
+							Email:      strings.ToLower(user.Email),
+							Source:     entities.DirectorySource,
+							Accounts:   map[string]string{directory.provider: user.ID},
+							Confidence: score * m.weight * directoryWeight,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	list := candidates.list
+	for i := range list {
+		list[i].Confidence = math.Round(list[i].Confidence*100) / 100
+		p.attachAccounts(&list[i])
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Confidence > list[j].Confidence })
+	if len(list) > maxAssigneeCandidates {
+		list = list[:maxAssigneeCandidates]
+	}
+
+	resolution := entities.AssigneeResolution{Mention: mention, Status: entities.AssigneeUnresolved, Candidates: list, ResolvedAt: now}
+	if len(list) == 0 {
+		resolution.Candidates = []entities.AssigneeCandidate{}
+		return resolution
+	}
+
+	best := list[0]
+	resolution.Confidence = best.Confidence
+	resolution.Status = entities.AssigneeAmbiguous
+	if best.Confidence >= resolveThreshold && (len(list) == 1 || best.Confidence-list[1].Confidence >= ambiguityMargin) {
+		resolution.Status = entities.AssigneeResolved
+		resolution.Match = &best
+	}
+	return resolution
+}
+
+// attachAccounts adds the user IDs of a person at the ticketing providers where exactly one user
+// clearly is that person
+func (p *meetingPeople) attachAccounts(candidate *entities.AssigneeCandidate) {
+	for _, directory := range p.directories {
+		if candidate.Accounts[directory.provider] != "" {
+			continue
+		}
+
+		var best *services.TicketUser
+		bestScore, tied := 0.0, false
+		for i, user := range directory.users {
+			score := matchScore(candidate.Name, user.Name, user.Email, user.ID)
+			if candidate.Email != "" {
+				score = math.Max(score, matchScore(candidate.Email, user.Name, user.Email, user.ID))
+			}
+			switch {
+			case score > bestScore:
+				best, bestScore, tied = &directory.users[i], score, false
+			case score == bestScore && score > 0:
+				tied = true
+			}
+		}
+		if best != nil && bestScore >= accountThreshold && !tied {
+			if candidate.Accounts == nil {
+				candidate.Accounts = make(map[string]string)
+			}
+			candidate.Accounts[directory.provider] = best.ID
+		}
+	}
+}
+
+// candidateSet collects candidates, merging those with the same email or name
+type candidateSet struct {
+	list  []entities.AssigneeCandidate
+	index map[string]int
+}
+
+func (c *candidateSet) add(candidate entities.AssigneeCandidate) {
+	key := "name:" + compact(candidate.Name)
+	if candidate.Email != "" {
+		key = "email:" + candidate.Email
+	}
+
+	i, ok := c.index[key]
+	if !ok {
+		c.index[key] = len(c.list)
+		c.list = append(c.list, candidate)
+		return
+	}
+
+	existing := &c.list[i]
+	if candidate.Confidence > existing.Confidence {
+		existing.Confidence = candidate.Confidence
+		existing.Source = candidate.Source
+	}
+	if existing.UserID == "" {
+		existing.UserID = candidate.UserID
+	}
+	for provider, id := range candidate.Accounts {
+		if existing.Accounts == nil {
+			existing.Accounts = make(map[string]string)
+		}
+		if existing.Accounts[provider] == "" {
+			existing.Accounts[provider] = id
+		}
+	}
+}
+
+// speakerMentions weights the names a speaker label was renamed to by how many segments were
+// renamed to each, most frequent first
+func speakerMentions(renamed map[string]int) []weightedMention {
+	total := 0
+	for _, count := range renamed {
+		total += count
+	}
+	mentions := make([]weightedMention, 0, len(renamed))
+	for name, count := range renamed {
+		mentions = append(mentions, weightedMention{text: name, weight: float64(count) / float64(total), speaker: true})
+	}
+	sort.Slice(mentions, func(i, j int) bool {
+		if mentions[i].weight != mentions[j].weight {
+			return mentions[i].weight > mentions[j].weight
+		}
+		return mentions[i].text < mentions[j].text
+	})
+	return mentions
+}
+
+// speakerKey normalizes a speaker label so that "Speaker B", "speaker_b" and "SPEAKER B" are the same
+func speakerKey(label string) string {
+	return strings.Join(nameTokens(label), " ")
+}
+
+// findEditableActionItem loads an action item in a meeting the user can edit
+func (s *AssigneeResolutionService) findEditableActionItem(ctx context.Context, id, userID string) (*entities.ActionItem, error) {
+	actionItem, err := s.actionItemRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find action item: %w", err)
+	}
+	if actionItem == nil {
+		return nil, domain.NewDomainError("ACTION_ITEM_NOT_FOUND", "Action item not found", nil)
+	}
+	if _, err := s.accessService.VerifyEditAccess(ctx, actionItem.MeetingID, userID); err != nil {
+		return nil, err
+	}
+	return actionItem, nil
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+	transcriptionRepos "teammate/server/modules/transcription/domain/repositories"
+	userEntities "teammate/server/modules/user/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAssigneeResolutionService resolves assignees against the stub users and, if a provider
+// is given, the users of the owner's stub ticketing integration
+func newTestAssigneeResolutionService(repo *memoryActionItemRepository, meetingRepo *stubMeetingRepository, transcriptionRepo transcriptionRepos.TranscriptionRepository, revisionRepo *stubRevisionRepository, provider *stubTicketingProvider) *AssigneeResolutionService {
+	integrations := &memoryIntegrationConfigRepository{}
+	if provider != nil {
+		config := userEntities.NewIntegrationConfig("owner", userEntities.TicketingProvider, "stub", map[string]interface{}{"token": "secret"})
+		integrations.configs = append(integrations.configs, &config)
+	}
+
+	service := NewAssigneeResolutionService(repo, meetingRepo, &stubUserRepository{}, transcriptionRepo, revisionRepo, integrations, &stubTicketingFactory{provider: provider})
+	service.now = func() time.Time { return time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC) }
+	return service
+}
+
+func newTestAssigneeFixture() (*AssigneeResolutionService, *memoryActionItemRepository, *stubRevisionRepository, *transcriptionEntities.Transcription) {
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+	leeEmail, ortizEmail := "Sam.Lee@example.com", "sam.ortiz@example.com"
+	meetingRepo := &stubMeetingRepository{meeting: meeting, participants: []*meetingRepos.Participant{
+		{MeetingID: "meeting-1", Name: "Sam Lee", Email: &leeEmail},
+		{MeetingID: "meeting-1", Name: "Sam Ortiz", Email: &ortizEmail},
+		{MeetingID: "meeting-1", Name: "Anna Berg"},
+	}}
+
+	transcription := transcriptionEntities.NewTranscription("meeting-1", "", "assemblyai")
+	transcription.SetID("tr-1")
+	transcription.CompleteTranscription("", 0.9, []transcriptionEntities.TranscriptSegment{
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Speaker A", "Can someone book the venue?", 0, 2, 0.9, 1),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Speaker B", "I'll book it.", 2, 4, 0.9, 2),
+	})
+
+	provider := &stubTicketingProvider{users: []services.TicketUser{
+		{ID: "slee", Name: "Sam Lee"},
+		{ID: "sortiz", Name: "Sam Ortiz"},
+		{ID: "zkim", Name: "Zoe Kim"},
+	}}
+
+	repo := &memoryActionItemRepository{}
+	revisions := &stubRevisionRepository{}
+	service := newTestAssigneeResolutionService(repo, meetingRepo, &stubTranscriptionRepository{transcription: &transcription}, revisions, provider)
+	return service, repo, revisions, &transcription
+}
+
+func newAssignedActionItem(repo *memoryActionItemRepository, assignee string) *entities.ActionItem {
+	actionItem := entities.NewActionItem("meeting-1", "tr-1", "Book the venue", "", "", entities.Medium)
+	actionItem.SetAssignee(assignee)
+	repo.actionItems = append(repo.actionItems, &actionItem)
+	return &actionItem
+}
+
+func TestMatchScore(t *testing.T) {
+	tests := []struct {
+		mention, name, email, login string
+		score                       float64
+	}{
+		{"sam lee", "Sam Lee", "", "", 1},
+		{"Sam.Lee@example.com", "Sam Lee", "sam.lee@example.com", "", 1},
+		{"sam.lee@other.com", "Sam Lee", "sam.lee@example.com", "", 0.7},
+		{"slee", "Sam Lee", "", "slee", 0.95},
+		{"SamLee", "Sam Lee", "", "", 0.9},
+		{"Sam Lee", "Samuel Sam Lee", "", "", 0.85},
+		{"Sam", "Sam Lee", "", "", 0.8},
+		{"Sam L", "Sam Lee", "", "", 0.75},
+		{"Lee", "Sam Lee", "", "", 0.7},
+		{"Sam", "Samantha Cruz", "", "", 0.6},
+		{"Bob", "Sam Lee", "", "", 0},
+		{"", "Sam Lee", "", "", 0},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.score, matchScore(test.mention, test.name, test.email, test.login), test.mention)
+	}
+}
+
+func TestAssigneeResolutionService_ResolveAssignees(t *testing.T) {
+	service, repo, _, _ := newTestAssigneeFixture()
+
+	sam := newAssignedActionItem(repo, "Sam")
+	anna := newAssignedActionItem(repo, "anna")
+	zoe := newAssignedActionItem(repo, "Zoe Kim")
+	otherDomain := newAssignedActionItem(repo, "anna.berg@partner.com")
+	nobody := newAssignedActionItem(repo, "Bob")
+	unassigned := newAssignedActionItem(repo, "")
+
+	service.ResolveAssignees(context.Background(), "meeting-1", "tr-1", repo.actionItems)
+
+	// Two participants are called Sam
+	assert.Equal(t, "Sam", sam.Assignee)
+	require.NotNil(t, sam.AssigneeResolution)
+	assert.True(t, sam.AssigneeResolution.NeedsConfirmation())
+	assert.Nil(t, sam.AssigneeResolution.Match)
+	require.Len(t, sam.AssigneeResolution.Candidates, 2)
+	assert.Equal(t, entities.AssigneeCandidate{
+		Name:       "Sam Lee",
+		Email:      "sam.lee@example.com",
+		UserID:     "viewer",
+		Source:     entities.ParticipantSource,
+		Accounts:   map[string]string{"stub": "slee"},
+		Confidence: 0.8,
+	}, sam.AssigneeResolution.Candidates[0])
+	assert.Equal(t, "sortiz", sam.AssigneeResolution.Candidates[1].Accounts["stub"])
+	assert.Empty(t, sam.AssigneeResolution.Account("stub"))
+
+	assert.Equal(t, "Anna Berg", anna.Assignee)
+	assert.Equal(t, entities.AssigneeResolved, anna.AssigneeResolution.Status)
+	assert.Equal(t, "anna", anna.AssigneeResolution.Mention)
+	assert.Equal(t, 0.8, anna.AssigneeResolution.Confidence)
+
+	// An address at another domain is never taken without confirmation
+	assert.Equal(t, entities.AssigneeAmbiguous, otherDomain.AssigneeResolution.Status)
+	assert.Nil(t, otherDomain.AssigneeResolution.Match)
+	require.NotEmpty(t, otherDomain.AssigneeResolution.Candidates)
+	assert.Equal(t, "Anna Berg", otherDomain.AssigneeResolution.Candidates[0].Name)
+
+	// Zoe only has an account at the tracker
+	assert.Equal(t, entities.AssigneeResolved, zoe.AssigneeResolution.Status)
+	assert.Equal(t, entities.DirectorySource, zoe.AssigneeResolution.Match.Source)
+	assert.Equal(t, 0.9, zoe.AssigneeResolution.Confidence)
+	assert.Equal(t, "zkim", zoe.AssigneeResolution.Account("stub"))
+
+	assert.Equal(t, "Bob", nobody.Assignee)
+	assert.Equal(t, entities.AssigneeUnresolved, nobody.AssigneeResolution.Status)
+	assert.Empty(t, nobody.AssigneeResolution.Candidates)
+
+	assert.Nil(t, unassigned.AssigneeResolution)
+}
+
+func TestAssigneeResolutionService_ResolveRenamedSpeakers(t *testing.T) {
+	service, repo, revisions, transcription := newTestAssigneeFixture()
+
+	original := transcriptionEntities.NewOriginalRevision("tr-1", transcription.Segments)
+	transcription.Segments[0].Speaker = "Priya"
+	transcription.Segments[1].Speaker = "Sam Ortiz"
+	edit := transcriptionEntities.NewTranscriptRevision("tr-1", 1, transcriptionEntities.EditSpeakerRevision, "owner", nil, nil, transcription.Segments)
+	revisions.revisions = []transcriptionEntities.TranscriptRevision{original, edit}
+
+	speakerB := newAssignedActionItem(repo, "Speaker B")
+	speakerA := newAssignedActionItem(repo, "speaker_a")
+
+	service.ResolveAssignees(context.Background(), "meeting-1", "tr-1", repo.actionItems)
+
+	assert.Equal(t, "Sam Ortiz", speakerB.Assignee)
+	assert.Equal(t, "Speaker B", speakerB.AssigneeResolution.Mention)
+	assert.Equal(t, entities.ParticipantSource, speakerB.AssigneeResolution.Match.Source)
+	assert.Equal(t, "sortiz", speakerB.AssigneeResolution.Account("stub"))
+
+	// Priya is not a participant but a speaker was renamed to her
+	assert.Equal(t, "Priya", speakerA.Assignee)
+	assert.Equal(t, entities.SpeakerSource, speakerA.AssigneeResolution.Match.Source)
+	assert.Equal(t, speakerRenameWeight, speakerA.AssigneeResolution.Confidence)
+}
+
+func TestAssigneeResolutionService_ConfirmAssignee(t *testing.T) {
+	service, repo, _, _ := newTestAssigneeFixture()
+	ctx := context.Background()
+
+	sam := newAssignedActionItem(repo, "Sam")
+	unassigned := newAssignedActionItem(repo, "")
+
+	_, err := service.ResolveAssignee(ctx, commands.ResolveAssigneeCommand{ActionItemID: unassigned.GetID(), UserID: "owner"})
+	assertDomainErrorCode(t, err, "INVALID_ASSIGNEE")
+
+	_, err = service.ResolveAssignee(ctx, commands.ResolveAssigneeCommand{ActionItemID: sam.GetID(), UserID: "viewer"})
+	assertDomainErrorCode(t, err, "UNAUTHORIZED")
+
+	resolved, err := service.ResolveAssignee(ctx, commands.ResolveAssigneeCommand{ActionItemID: sam.GetID(), UserID: "editor"})
+	require.NoError(t, err)
+	assert.True(t, resolved.AssigneeResolution.NeedsConfirmation())
+
+	_, err = service.ConfirmAssignee(ctx, commands.ConfirmAssigneeCommand{ActionItemID: sam.GetID(), UserID: "editor", Candidate: 2})
+	assertDomainErrorCode(t, err, "INVALID_ASSIGNEE_CANDIDATE")
+
+	_, err = service.ConfirmAssignee(ctx, commands.ConfirmAssigneeCommand{ActionItemID: sam.GetID(), UserID: "editor", Candidate: 1, Accounts: map[string]string{"jira": "5b10a"}})
+	assertDomainErrorCode(t, err, "UNSUPPORTED_TICKETING_PROVIDER")
+
+	confirmed, err := service.ConfirmAssignee(ctx, commands.ConfirmAssigneeCommand{ActionItemID: sam.GetID(), UserID: "editor", Candidate: 1, Accounts: map[string]string{"stub": " sam-o "}})
+	require.NoError(t, err)
+	assert.Equal(t, "Sam Ortiz", confirmed.Assignee)
+	assert.Equal(t, entities.AssigneeConfirmed, confirmed.AssigneeResolution.Status)
+	assert.Equal(t, "Sam", confirmed.AssigneeResolution.Mention)
+	assert.Equal(t, 1.0, confirmed.AssigneeResolution.Confidence)
+	assert.Equal(t, "sam-o", confirmed.AssigneeResolution.Account("stub"))
+	assert.Len(t, confirmed.AssigneeResolution.Candidates, 2)
+
+	// A confirmed assignee is kept when the meeting's action items are resolved again
+	service.ResolveAssignees(ctx, "meeting-1", "tr-1", repo.actionItems)
+	assert.Equal(t, entities.AssigneeConfirmed, sam.AssigneeResolution.Status)
+	assert.Equal(t, "Sam Ortiz", sam.Assignee)
+}
@@ -0,0 +1,335 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/repositories"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+	transcriptionRepos "teammate/server/modules/transcription/domain/repositories"
+	userEntities "teammate/server/modules/user/domain/entities"
+	userRepos "teammate/server/modules/user/domain/repositories"
+)
+
+// memoryActionItemRepository keeps action items in memory and applies filters like the database
+type memoryActionItemRepository struct {
+	actionItems []*entities.ActionItem
+	filter      repositories.ActionItemFilter
+
+	ticketReferences  []*entities.TicketReference
+	updatedReferences []*entities.TicketReference
+}
+
+func (r *memoryActionItemRepository) Save(ctx context.Context, actionItem *entities.ActionItem) error {
+	r.actionItems = append(r.actionItems, actionItem)
+	return nil
+}
+
+func (r *memoryActionItemRepository) Update(ctx context.Context, actionItem *entities.ActionItem) error {
+	return nil
+}
+
+func (r *memoryActionItemRepository) SaveTicketReference(ctx context.Context, actionItem *entities.ActionItem, reference *entities.TicketReference) error {
+	r.ticketReferences = append(r.ticketReferences, reference)
+	return nil
+}
+
+func (r *memoryActionItemRepository) UpdateTicketReference(ctx context.Context, actionItem *entities.ActionItem, reference *entities.TicketReference) error {
+	r.updatedReferences = append(r.updatedReferences, reference)
+	return nil
+}
+
+func (r *memoryActionItemRepository) FindByID(ctx context.Context, id string) (*entities.ActionItem, error) {
+	for _, actionItem := range r.actionItems {
+		if actionItem.GetID() == id {
+			return actionItem, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryActionItemRepository) FindByTranscriptionID(ctx context.Context, transcriptionID string) ([]*entities.ActionItem, error) {
+	var actionItems []*entities.ActionItem
+	for _, actionItem := range r.actionItems {
+		if actionItem.TranscriptionID == transcriptionID {
+			actionItems = append(actionItems, actionItem)
+		}
+	}
+	return actionItems, nil
+}
+
+func (r *memoryActionItemRepository) FindByMeetingID(ctx context.Context, meetingID string) ([]*entities.ActionItem, error) {
+	var actionItems []*entities.ActionItem
+	for _, actionItem := range r.actionItems {
+		if actionItem.MeetingID == meetingID {
+			actionItems = append(actionItems, actionItem)
+		}
+	}
+	return actionItems, nil
+}
+
+func (r *memoryActionItemRepository) FindByTicket(ctx context.Context, lookup repositories.TicketLookup) ([]*entities.ActionItem, error) {
+	var actionItems []*entities.ActionItem
+	for _, actionItem := range r.actionItems {
+		for _, reference := range actionItem.TicketReferences {
+			if reference.System == lookup.System && reference.TicketID == lookup.TicketID &&
+				(lookup.ProjectKey == "" || strings.EqualFold(reference.ProjectKey, lookup.ProjectKey)) {
+				actionItems = append(actionItems, actionItem)
+				break
+			}
+		}
+	}
+	return actionItems, nil
+}
+
+func (r *memoryActionItemRepository) FindTracked(ctx context.Context, afterID string, limit int) ([]*entities.ActionItem, error) {
+	var actionItems []*entities.ActionItem
+	for _, actionItem := range r.actionItems {
+		if actionItem.HasTicket() && len(actionItem.TicketReferences) > 0 && actionItem.GetID() > afterID && len(actionItems) < limit {
+			actionItems = append(actionItems, actionItem)
+		}
+	}
+	return actionItems, nil
+}
+
+func (r *memoryActionItemRepository) ReplaceExtracted(ctx context.Context, transcriptionID string, actionItems []*entities.ActionItem) error {
+	var kept []*entities.ActionItem
+	for _, actionItem := range r.actionItems {
+		if actionItem.TranscriptionID != transcriptionID || actionItem.Status != entities.Extracted {
+			kept = append(kept, actionItem)
+		}
+	}
+	r.actionItems = append(kept, actionItems...)
+	return nil
+}
+
+func (r *memoryActionItemRepository) Find(ctx context.Context, filter repositories.ActionItemFilter) ([]*entities.ActionItem, int64, error) {
+	r.filter = filter
+	var actionItems []*entities.ActionItem
+	for _, actionItem := range r.actionItems {
+		if filter.Status != "" && actionItem.Status != filter.Status {
+			continue
+		}
+		if filter.Assignee != "" && !strings.EqualFold(actionItem.Assignee, filter.Assignee) {
+			continue
+		}
+		if filter.Overdue && (actionItem.DueDate == nil || !actionItem.DueDate.Before(filter.Now) || actionItem.IsRejected() || actionItem.IsCompleted()) {
+			continue
+		}
+		if filter.NeedsConfirmation && (actionItem.AssigneeResolution == nil || !actionItem.AssigneeResolution.NeedsConfirmation()) {
+			continue
+		}
+		actionItems = append(actionItems, actionItem)
+	}
+	return actionItems, int64(len(actionItems)), nil
+}
+
+// stubMeetingRepository serves a single meeting shared with a viewer and an editor
+type stubMeetingRepository struct {
+	meetingRepos.MeetingRepository
+	meeting      *meetingRepos.Meeting
+	participants []*meetingRepos.Participant
+}
+
+func (r *stubMeetingRepository) FindMeetingByID(ctx context.Context, id string) (*meetingRepos.Meeting, error) {
+	if id != r.meeting.ID {
+		return nil, fmt.Errorf("record not found")
+	}
+	return r.meeting, nil
+}
+
+func (r *stubMeetingRepository) FindMeetingShare(ctx context.Context, meetingID, userID string) (*meetingRepos.MeetingShare, error) {
+	switch userID {
+	case "viewer":
+		return &meetingRepos.MeetingShare{MeetingID: meetingID, SharedWithUserID: userID, Permission: "view"}, nil
+	case "editor":
+		return &meetingRepos.MeetingShare{MeetingID: meetingID, SharedWithUserID: userID, Permission: "edit"}, nil
+	}
+	return nil, fmt.Errorf("record not found")
+}
+
+func (r *stubMeetingRepository) FindMeetingSharesByMeetingID(ctx context.Context, meetingID string) ([]*meetingRepos.MeetingShare, error) {
+	return []*meetingRepos.MeetingShare{
+		{MeetingID: meetingID, SharedWithUserID: "viewer", Permission: "view"},
+		{MeetingID: meetingID, SharedWithUserID: "editor", Permission: "edit"},
+	}, nil
+}
+
+func (r *stubMeetingRepository) FindParticipantsByMeetingID(ctx context.Context, meetingID string) ([]*meetingRepos.Participant, error) {
+	return r.participants, nil
+}
+
+// stubTranscriptionRepository serves a single transcription with its segments
+type stubTranscriptionRepository struct {
+	transcriptionRepos.TranscriptionRepository
+	transcription *transcriptionEntities.Transcription
+}
+
+func (r *stubTranscriptionRepository) FindByID(ctx context.Context, id string) (*transcriptionEntities.Transcription, error) {
+	if id != r.transcription.ID {
+		return nil, errors.New("record not found")
+	}
+	return r.transcription, nil
+}
+
+func (r *stubTranscriptionRepository) FindSegmentsByTranscriptionID(ctx context.Context, transcriptionID string) ([]transcriptionEntities.TranscriptSegment, error) {
+	return r.transcription.Segments, nil
+}
+
+// memoryIntegrationConfigRepository keeps integration configurations in memory
+type memoryIntegrationConfigRepository struct {
+	configs []*userEntities.IntegrationConfig
+}
+
+func (r *memoryIntegrationConfigRepository) FindByID(ctx context.Context, id string) (*userEntities.IntegrationConfig, error) {
+	for _, config := range r.configs {
+		if config.GetID() == id {
+			return config, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryIntegrationConfigRepository) FindByProvider(ctx context.Context, userID string, providerType userEntities.ProviderType, providerName string) (*userEntities.IntegrationConfig, error) {
+	for _, config := range r.configs {
+		if config.UserID == userID && config.ProviderType == providerType && config.ProviderName == providerName {
+			return config, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryIntegrationConfigRepository) FindByUserID(ctx context.Context, userID string, providerType userEntities.ProviderType) ([]*userEntities.IntegrationConfig, error) {
+	var configs []*userEntities.IntegrationConfig
+	for _, config := range r.configs {
+		if config.UserID == userID && config.ProviderType == providerType {
+			configs = append(configs, config)
+		}
+	}
+	return configs, nil
+}
+
+func (r *memoryIntegrationConfigRepository) Save(ctx context.Context, config *userEntities.IntegrationConfig) error {
+	for _, existing := range r.configs {
+		if existing.GetID() == config.GetID() {
+			return nil
+		}
+	}
+	r.configs = append(r.configs, config)
+	return nil
+}
+
+func (r *memoryIntegrationConfigRepository) Delete(ctx context.Context, id string) error {
+	for i, config := range r.configs {
+		if config.GetID() == id {
+			r.configs = append(r.configs[:i], r.configs[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// stubTicketingProvider numbers the tickets it opens and fails for titles in failFor
+type stubTicketingProvider struct {
+	requests []services.TicketRequest
+	failFor  map[string]bool
+	tickets  map[string]*services.Ticket
+	users    []services.TicketUser
+	// opened is called after every ticket is opened
+	opened func()
+}
+
+func (p *stubTicketingProvider) Name() string {
+	return "stub"
+}
+
+func (p *stubTicketingProvider) CreateTicket(ctx context.Context, request services.TicketRequest) (*services.Ticket, error) {
+	if p.failFor[request.Title] {
+		return nil, errors.New("tracker unavailable")
+	}
+	p.requests = append(p.requests, request)
+	if p.opened != nil {
+		p.opened()
+	}
+	id := fmt.Sprint(len(p.requests))
+	return &services.Ticket{ID: id, URL: "https://tracker.example.com/" + id, Project: "team/app", Status: "open"}, nil
+}
+
+func (p *stubTicketingProvider) FindTicket(ctx context.Context, reference string) (*services.Ticket, error) {
+	if reference == "bad" {
+		return nil, fmt.Errorf("%w: %q", services.ErrInvalidTicketReference, reference)
+	}
+	if ticket, ok := p.tickets[reference]; ok {
+		return ticket, nil
+	}
+	return nil, services.ErrTicketNotFound
+}
+
+func (p *stubTicketingProvider) AssignableUsers(ctx context.Context) ([]services.TicketUser, error) {
+	return p.users, nil
+}
+
+// stubTicketingFactory creates the stub provider when a token is configured
+type stubTicketingFactory struct {
+	provider *stubTicketingProvider
+	webhook  services.TicketWebhook
+}
+
+func (f *stubTicketingFactory) Supports(providerName string) bool {
+	return providerName == "stub"
+}
+
+func (f *stubTicketingFactory) NewProvider(providerName string, config map[string]interface{}) (services.TicketingProvider, error) {
+	if config["token"] == nil {
+		return nil, errors.New("token is required")
+	}
+	return f.provider, nil
+}
+
+func (f *stubTicketingFactory) Webhook(providerName string) (services.TicketWebhook, bool) {
+	return f.webhook, f.webhook != nil && providerName == "stub"
+}
+
+// stubUserRepository serves the owner of the test meeting and the users it is shared with
+type stubUserRepository struct {
+	userRepos.UserRepository
+}
+
+func (r *stubUserRepository) FindByID(id string) (*userEntities.User, error) {
+	names := map[string]string{"owner": "Olivia Owner", "viewer": "Sam Lee", "editor": "Edith Editor"}
+	emails := map[string]string{"owner": "owner@example.com", "viewer": "sam.lee@example.com", "editor": "edith@example.com"}
+	name, ok := names[id]
+	if !ok {
+		return nil, fmt.Errorf("record not found")
+	}
+	email, _ := userEntities.NewEmail(emails[id])
+	user := userEntities.NewUser(id, name, email)
+	return &user, nil
+}
+
+// stubRevisionRepository keeps the revisions of a transcription in order
+type stubRevisionRepository struct {
+	transcriptionRepos.TranscriptRevisionRepository
+	revisions []transcriptionEntities.TranscriptRevision
+}
+
+func (r *stubRevisionRepository) FindByNumber(ctx context.Context, transcriptionID string, revisionNumber int) (*transcriptionEntities.TranscriptRevision, error) {
+	for i := range r.revisions {
+		if r.revisions[i].RevisionNumber == revisionNumber {
+			return &r.revisions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("record not found")
+}
+
+func (r *stubRevisionRepository) FindLatest(ctx context.Context, transcriptionID string) (*transcriptionEntities.TranscriptRevision, error) {
+	if len(r.revisions) == 0 {
+		return nil, nil
+	}
+	return &r.revisions[len(r.revisions)-1], nil
+}
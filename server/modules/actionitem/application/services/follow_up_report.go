@@ -0,0 +1,84 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+)
+
+// FollowUpReport summarises how far the follow-ups of a meeting have got. Follow-ups are the action
+// items that were approved, whether or not their ticket has been created or closed yet.
+type FollowUpReport struct {
+	MeetingID      string
+	Total          int
+	Completed      int
+	Overdue        int
+	Unreviewed     int
+	CompletionRate float64
+	Open           []*entities.ActionItem
+	ByAssignee     []AssigneeFollowUps
+}
+
+// AssigneeFollowUps counts the follow-ups of one assignee. Unassigned follow-ups have an empty assignee.
+type AssigneeFollowUps struct {
+	Assignee  string
+	Open      int
+	Overdue   int
+	Completed int
+}
+
+// buildFollowUpReport builds the follow-up report of a meeting from its action items. Open
+// follow-ups are listed with the earliest due date first, followed by those without one.
+func buildFollowUpReport(meetingID string, actionItems []*entities.ActionItem, now time.Time) *FollowUpReport {
+	report := &FollowUpReport{MeetingID: meetingID, Open: []*entities.ActionItem{}, ByAssignee: []AssigneeFollowUps{}}
+	assignees := make(map[string]*AssigneeFollowUps)
+	var order []string
+
+	for _, actionItem := range actionItems {
+		switch actionItem.Status {
+		case entities.Extracted, entities.Pending:
+			report.Unreviewed++
+			continue
+		case entities.Rejected:
+			continue
+		}
+
+		key := strings.ToLower(actionItem.Assignee)
+		counts, ok := assignees[key]
+		if !ok {
+			counts = &AssigneeFollowUps{Assignee: actionItem.Assignee}
+			assignees[key] = counts
+			order = append(order, key)
+		}
+
+		report.Total++
+		if actionItem.IsCompleted() {
+			report.Completed++
+			counts.Completed++
+			continue
+		}
+		report.Open = append(report.Open, actionItem)
+		counts.Open++
+		if actionItem.DueDate != nil && actionItem.DueDate.Before(now) {
+			report.Overdue++
+			counts.Overdue++
+		}
+	}
+
+	if report.Total > 0 {
+		report.CompletionRate = float64(report.Completed) / float64(report.Total)
+	}
+	sort.SliceStable(report.Open, func(i, j int) bool {
+		a, b := report.Open[i].DueDate, report.Open[j].DueDate
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+	for _, key := range order {
+		report.ByAssignee = append(report.ByAssignee, *assignees[key])
+	}
+	return report
+}
@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/repositories"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	userEntities "teammate/server/modules/user/domain/entities"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	// reconcileBatchSize is how many action items are reconciled per database query
+	reconcileBatchSize = 100
+	// integrationIDMetadataKey records which integration a ticket was created or linked with
+	integrationIDMetadataKey = "integration_id"
+)
+
+// TicketSyncService keeps action items in step with their tickets. Ticketing systems report
+// changes through webhooks, and a periodic reconciliation catches the deliveries that were
+// missed. Closing a ticket completes its action item; reopening it makes it open again.
+type TicketSyncService struct {
+	actionItemRepo  repositories.ActionItemRepository
+	meetingRepo     meetingRepos.MeetingRepository
+	integrationRepo userRepos.IntegrationConfigRepository
+	providers       services.TicketingProviderFactory
+	now             func() time.Time
+}
+
+// NewTicketSyncService creates a new ticket sync service
+func NewTicketSyncService(
+	actionItemRepo repositories.ActionItemRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	integrationRepo userRepos.IntegrationConfigRepository,
+	providers services.TicketingProviderFactory,
+) *TicketSyncService {
+	return &TicketSyncService{
+		actionItemRepo:  actionItemRepo,
+		meetingRepo:     meetingRepo,
+		integrationRepo: integrationRepo,
+		providers:       providers,
+		now:             time.Now,
+	}
+}
+
+// HandleWebhook applies the ticket changes of a webhook delivery to the integration's action items.
+// Deliveries must be signed with the webhook_secret of the integration. It returns how many action
+// items were updated.
+func (s *TicketSyncService) HandleWebhook(ctx context.Context, cmd commands.HandleTicketWebhookCommand) (int, error) {
+	config, err := s.integrationRepo.FindByID(ctx, cmd.IntegrationID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find integration: %w", err)
+	}
+	webhook, supported := s.providers.Webhook(cmd.Provider)
+	if config == nil || !supported || !config.IsActive || config.ProviderType != userEntities.TicketingProvider || config.ProviderName != cmd.Provider {
+		return 0, domain.NewDomainError("WEBHOOK_NOT_FOUND", "Webhook not found", domain.ErrNotFound)
+	}
+
+	secret, _ := config.Config["webhook_secret"].(string)
+	if err := webhook.VerifySignature(cmd.Header, cmd.Body, secret); err != nil {
+		return 0, domain.NewDomainError("INVALID_WEBHOOK_SIGNATURE", "Webhook signature does not match", err)
+	}
+	tickets, err := webhook.ParseDelivery(cmd.Header, cmd.Body)
+	if err != nil {
+		return 0, domain.NewDomainError("INVALID_WEBHOOK_PAYLOAD", err.Error(), domain.ErrInvalidInput)
+	}
+
+	updated := 0
+	for i := range tickets {
+		ticket := &tickets[i]
+		actionItems, err := s.actionItemRepo.FindByTicket(ctx, repositories.TicketLookup{
+			System:     cmd.Provider,
+			TicketID:   ticket.ID,
+			ProjectKey: ticket.Project,
+			UserID:     config.UserID,
+		})
+		if err != nil {
+			return updated, fmt.Errorf("failed to find action items of ticket %s: %w", ticket.ID, err)
+		}
+
+		for _, actionItem := range actionItems {
+			reference := findTicketReference(actionItem, cmd.Provider, ticket)
+			if reference == nil {
+				continue
+			}
+			if applyTicketState(actionItem, reference, ticket, s.now()) {
+				updated++
+			}
+			if err := s.actionItemRepo.UpdateTicketReference(ctx, actionItem, reference); err != nil {
+				return updated, fmt.Errorf("failed to update action item %s: %w", actionItem.GetID(), err)
+			}
+		}
+	}
+	return updated, nil
+}
+
+// Reconcile looks up the latest ticket of every action item that has one and applies its state.
+// It returns how many action items were completed or reopened.
+func (s *TicketSyncService) Reconcile(ctx context.Context) (int, error) {
+	providers := make(map[string]services.TicketingProvider)
+	updated := 0
+	afterID := ""
+	for {
+		actionItems, err := s.actionItemRepo.FindTracked(ctx, afterID, reconcileBatchSize)
+		if err != nil {
+			return updated, fmt.Errorf("failed to find action items with tickets: %w", err)
+		}
+
+		for _, actionItem := range actionItems {
+			reference := latestTicketReference(actionItem)
+			provider, err := s.reconcileProvider(ctx, actionItem, reference, providers)
+			if err != nil {
+				log.Printf("Failed to reconcile action item %s: %v", actionItem.GetID(), err)
+				continue
+			}
+			if provider == nil {
+				continue
+			}
+
+			ticket, err := provider.FindTicket(ctx, reference.TicketURL)
+			if err != nil {
+				log.Printf("Failed to look up ticket %s of action item %s: %v", reference.TicketID, actionItem.GetID(), err)
+				continue
+			}
+			if applyTicketState(actionItem, reference, ticket, s.now()) {
+				updated++
+			}
+			if err := s.actionItemRepo.UpdateTicketReference(ctx, actionItem, reference); err != nil {
+				log.Printf("Failed to update action item %s: %v", actionItem.GetID(), err)
+			}
+		}
+
+		if len(actionItems) < reconcileBatchSize {
+			return updated, nil
+		}
+		afterID = actionItems[len(actionItems)-1].GetID()
+	}
+}
+
+// reconcileProvider returns the provider of the integration a ticket was created or linked with,
+// or of the meeting owner's integration for references that did not record one. It returns nil if
+// the integration is gone or inactive. Providers are cached by integration for a reconciliation.
+func (s *TicketSyncService) reconcileProvider(ctx context.Context, actionItem *entities.ActionItem, reference *entities.TicketReference, providers map[string]services.TicketingProvider) (services.TicketingProvider, error) {
+	integrationID, _ := reference.Metadata[integrationIDMetadataKey].(string)
+	cacheKey := integrationID
+	if integrationID == "" {
+		cacheKey = "meeting:" + actionItem.MeetingID + ":" + reference.System
+	}
+	if provider, ok := providers[cacheKey]; ok {
+		return provider, nil
+	}
+
+	var config *userEntities.IntegrationConfig
+	var err error
+	if integrationID != "" {
+		config, err = s.integrationRepo.FindByID(ctx, integrationID)
+	} else {
+		var meeting *meetingRepos.Meeting
+		if meeting, err = s.meetingRepo.FindMeetingByID(ctx, actionItem.MeetingID); err == nil {
+			config, err = s.integrationRepo.FindByProvider(ctx, meeting.UserID, userEntities.TicketingProvider, reference.System)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var provider services.TicketingProvider
+	if config != nil && config.IsActive && s.providers.Supports(config.ProviderName) {
+		if provider, err = s.providers.NewProvider(config.ProviderName, config.Config); err != nil {
+			return nil, err
+		}
+	}
+	providers[cacheKey] = provider
+	return provider, nil
+}
+
+// applyTicketState records the state, assignee and closing time of a ticket on its reference and,
+// if it is the action item's latest ticket, completes or reopens the action item. It returns true
+// if the action item's status changed.
+func applyTicketState(actionItem *entities.ActionItem, reference *entities.TicketReference, ticket *services.Ticket, now time.Time) bool {
+	for key, value := range ticket.Metadata {
+		reference.SetMetadataValue(key, value)
+	}
+	closedAt := ""
+	if ticket.Closed && ticket.ClosedAt != nil {
+		closedAt = ticket.ClosedAt.UTC().Format(time.RFC3339)
+	}
+	setTicketMetadata(reference, "state", ticket.Status)
+	setTicketMetadata(reference, "assignee", ticket.Assignee)
+	setTicketMetadata(reference, "closed_at", closedAt)
+	reference.MarkAsSynced(now)
+
+	if reference != latestTicketReference(actionItem) {
+		return false
+	}
+	switch {
+	case ticket.Closed && actionItem.IsCreated():
+		completedAt := now
+		if ticket.ClosedAt != nil {
+			completedAt = *ticket.ClosedAt
+		}
+		actionItem.MarkAsCompleted(completedAt.UTC())
+		return true
+	case !ticket.Closed && actionItem.IsCompleted():
+		actionItem.Reopen()
+		return true
+	}
+	return false
+}
+
+// setTicketMetadata records a value on a ticket reference and removes it when the ticket no longer has one
+func setTicketMetadata(reference *entities.TicketReference, key, value string) {
+	if value == "" {
+		delete(reference.Metadata, key)
+		return
+	}
+	reference.SetMetadataValue(key, value)
+}
+
+// latestTicketReference returns the most recently recorded ticket reference of an action item
+func latestTicketReference(actionItem *entities.ActionItem) *entities.TicketReference {
+	var latest *entities.TicketReference
+	for i := range actionItem.TicketReferences {
+		reference := &actionItem.TicketReferences[i]
+		if latest == nil || !reference.GetCreatedAt().Before(latest.GetCreatedAt()) {
+			latest = reference
+		}
+	}
+	return latest
+}
+
+// findTicketReference returns the reference of an action item to a ticket
+func findTicketReference(actionItem *entities.ActionItem, system string, ticket *services.Ticket) *entities.TicketReference {
+	for i := range actionItem.TicketReferences {
+		reference := &actionItem.TicketReferences[i]
+		if reference.System == system && reference.TicketID == ticket.ID &&
+			(ticket.Project == "" || strings.EqualFold(reference.ProjectKey, ticket.Project)) {
+			return reference
+		}
+	}
+	return nil
+}
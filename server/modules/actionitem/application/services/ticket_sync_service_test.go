@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	userEntities "teammate/server/modules/user/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubTicketWebhook accepts deliveries signed with the secret itself and delivers the stub's tickets
+type stubTicketWebhook struct {
+	tickets []services.Ticket
+}
+
+func (w *stubTicketWebhook) VerifySignature(header http.Header, body []byte, secret string) error {
+	if secret == "" || header.Get("X-Signature") != secret {
+		return services.ErrInvalidWebhookSignature
+	}
+	return nil
+}
+
+func (w *stubTicketWebhook) ParseDelivery(header http.Header, body []byte) ([]services.Ticket, error) {
+	return w.tickets, nil
+}
+
+func newTestTicketSyncService() (*TicketSyncService, *memoryActionItemRepository, *stubTicketingFactory, *userEntities.IntegrationConfig) {
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+
+	config := userEntities.NewIntegrationConfig("owner", userEntities.TicketingProvider, "stub", map[string]interface{}{"token": "secret", "webhook_secret": "hook"})
+	integrations := &memoryIntegrationConfigRepository{configs: []*userEntities.IntegrationConfig{&config}}
+
+	created := entities.NewActionItem("meeting-1", "tr-1", "Send the launch plan", "", "", entities.High)
+	created.SetID("created")
+	created.Approve()
+	created.AddTicketReference("stub", "7", "https://tracker.example.com/7", "team/app", entities.CreatedTicketReference,
+		map[string]interface{}{integrationIDMetadataKey: config.GetID()})
+	created.MarkAsCreated()
+
+	// Linked before integrations were recorded on references
+	linked := entities.NewActionItem("meeting-1", "tr-1", "Book the venue", "", "", entities.Low)
+	linked.SetID("linked")
+	linked.AddTicketReference("stub", "8", "https://tracker.example.com/8", "team/app", entities.ExistingTicketReference, nil)
+	linked.MarkAsCreated()
+	repo := &memoryActionItemRepository{actionItems: []*entities.ActionItem{&created, &linked}}
+
+	factory := &stubTicketingFactory{provider: &stubTicketingProvider{tickets: map[string]*services.Ticket{}}, webhook: &stubTicketWebhook{}}
+	service := NewTicketSyncService(repo, &stubMeetingRepository{meeting: meeting}, integrations, factory)
+	service.now = func() time.Time { return time.Date(2026, 3, 12, 9, 0, 0, 0, time.UTC) }
+	return service, repo, factory, &config
+}
+
+func TestTicketSyncService_HandleWebhook(t *testing.T) {
+	service, repo, factory, config := newTestTicketSyncService()
+	ctx := context.Background()
+	closedAt := time.Date(2026, 3, 11, 16, 30, 0, 0, time.UTC)
+	webhook := factory.webhook.(*stubTicketWebhook)
+	webhook.tickets = []services.Ticket{{ID: "7", Project: "Team/App", Status: "closed", Closed: true, ClosedAt: &closedAt, Assignee: "ben"}}
+
+	cmd := commands.HandleTicketWebhookCommand{Provider: "stub", IntegrationID: config.GetID(), Header: http.Header{"X-Signature": {"hook"}}}
+	updated, err := service.HandleWebhook(ctx, cmd)
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+
+	actionItem, _ := repo.FindByID(ctx, "created")
+	assert.Equal(t, entities.Completed, actionItem.Status)
+	require.NotNil(t, actionItem.CompletedAt)
+	assert.True(t, closedAt.Equal(*actionItem.CompletedAt))
+	require.Len(t, repo.updatedReferences, 1)
+	reference := repo.updatedReferences[0]
+	assert.Equal(t, "closed", reference.Metadata["state"])
+	assert.Equal(t, "ben", reference.Metadata["assignee"])
+	assert.Equal(t, "2026-03-11T16:30:00Z", reference.Metadata["closed_at"])
+	assert.NotNil(t, reference.SyncedAt)
+
+	// Reopening the ticket reopens the action item
+	webhook.tickets = []services.Ticket{{ID: "7", Project: "team/app", Status: "open"}}
+	updated, err = service.HandleWebhook(ctx, cmd)
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Equal(t, entities.Created, actionItem.Status)
+	assert.Nil(t, actionItem.CompletedAt)
+	assert.NotContains(t, reference.Metadata, "closed_at")
+	assert.NotContains(t, reference.Metadata, "assignee")
+}
+
+func TestTicketSyncService_HandleWebhookRejected(t *testing.T) {
+	service, repo, _, config := newTestTicketSyncService()
+	ctx := context.Background()
+
+	_, err := service.HandleWebhook(ctx, commands.HandleTicketWebhookCommand{Provider: "stub", IntegrationID: config.GetID(), Header: http.Header{"X-Signature": {"wrong"}}})
+	assertDomainErrorCode(t, err, "INVALID_WEBHOOK_SIGNATURE")
+
+	_, err = service.HandleWebhook(ctx, commands.HandleTicketWebhookCommand{Provider: "github", IntegrationID: config.GetID(), Header: http.Header{"X-Signature": {"hook"}}})
+	assertDomainErrorCode(t, err, "WEBHOOK_NOT_FOUND")
+
+	_, err = service.HandleWebhook(ctx, commands.HandleTicketWebhookCommand{Provider: "stub", IntegrationID: "missing", Header: http.Header{"X-Signature": {"hook"}}})
+	assertDomainErrorCode(t, err, "WEBHOOK_NOT_FOUND")
+
+	config.IsActive = false
+	_, err = service.HandleWebhook(ctx, commands.HandleTicketWebhookCommand{Provider: "stub", IntegrationID: config.GetID(), Header: http.Header{"X-Signature": {"hook"}}})
+	assertDomainErrorCode(t, err, "WEBHOOK_NOT_FOUND")
+	assert.Empty(t, repo.updatedReferences)
+}
+
+func TestTicketSyncService_Reconcile(t *testing.T) {
+	service, repo, factory, _ := newTestTicketSyncService()
+	ctx := context.Background()
+	factory.provider.tickets["https://tracker.example.com/7"] = &services.Ticket{ID: "7", Project: "team/app", Status: "open", Assignee: "ben"}
+	factory.provider.tickets["https://tracker.example.com/8"] = &services.Ticket{ID: "8", Project: "team/app", Status: "done", Closed: true}
+
+	updated, err := service.Reconcile(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated)
+	assert.Len(t, repo.updatedReferences, 2)
+
+	created, _ := repo.FindByID(ctx, "created")
+	assert.Equal(t, entities.Created, created.Status)
+	assert.Equal(t, "ben", created.TicketReferences[0].Metadata["assignee"])
+
+	// Without a recorded integration the meeting owner's integration is used
+	linked, _ := repo.FindByID(ctx, "linked")
+	assert.Equal(t, entities.Completed, linked.Status)
+	require.NotNil(t, linked.CompletedAt)
+	assert.Equal(t, service.now(), *linked.CompletedAt)
+
+	// Tickets that cannot be found are left alone
+	delete(factory.provider.tickets, "https://tracker.example.com/8")
+	updated, err = service.Reconcile(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, updated)
+	assert.Equal(t, entities.Completed, linked.Status)
+}
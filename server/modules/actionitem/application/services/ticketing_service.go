@@ -0,0 +1,409 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/repositories"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	userEntities "teammate/server/modules/user/domain/entities"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/application/jobs"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+	jobRepos "teammate/server/seedwork/domain/repositories"
+)
+
+const (
+	// MaxTicketsPerJob bounds how many action items a single create tickets job opens tickets for
+	MaxTicketsPerJob = 100
+
+	// createTicketsJobWorkers bounds how many create tickets jobs run at the same time
+	createTicketsJobWorkers = 2
+	// createTicketsJobTimeout bounds opening the tickets of a single job
+	createTicketsJobTimeout = 10 * time.Minute
+	// recordTicketTimeout bounds saving the reference to a ticket that was opened
+	recordTicketTimeout = 30 * time.Second
+)
+
+// CreatedTicket is a ticket opened for an action item
+type CreatedTicket struct {
+	ActionItemID string `json:"action_item_id"`
+	TicketID     string `json:"ticket_id"`
+	TicketURL    string `json:"ticket_url"`
+}
+
+// FailedTicket is an action item no ticket could be opened for
+type FailedTicket struct {
+	ActionItemID string `json:"action_item_id"`
+	Error        string `json:"error"`
+}
+
+// CreatedTickets is the outcome of a completed create tickets job
+type CreatedTickets struct {
+	Provider string          `json:"provider"`
+	Created  []CreatedTicket `json:"created"`
+	Failed   []FailedTicket  `json:"failed"`
+}
+
+// TicketingService turns approved action items into tickets in the ticketing systems users have
+// configured, or links them to tickets that already exist. Either way the action item is marked as
+// created and keeps a reference to its ticket.
+type TicketingService struct {
+	actionItemRepo  repositories.ActionItemRepository
+	integrationRepo userRepos.IntegrationConfigRepository
+	jobRepo         jobRepos.ProcessingJobRepository
+	accessService   *meetingServices.MeetingAccessService
+	providers       services.TicketingProviderFactory
+	runner          *jobs.Runner
+}
+
+// NewTicketingService creates a new ticketing service
+func NewTicketingService(
+	actionItemRepo repositories.ActionItemRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	integrationRepo userRepos.IntegrationConfigRepository,
+	jobRepo jobRepos.ProcessingJobRepository,
+	providers services.TicketingProviderFactory,
+) *TicketingService {
+	s := &TicketingService{
+		actionItemRepo:  actionItemRepo,
+		integrationRepo: integrationRepo,
+		jobRepo:         jobRepo,
+		accessService:   meetingServices.NewMeetingAccessService(meetingRepo),
+		providers:       providers,
+	}
+	s.runner = jobs.NewRunner(jobRepo, "create tickets", createTicketsJobWorkers, createTicketsJobTimeout, s.runJob)
+	return s
+}
+
+// GetIntegrations returns the ticketing providers the user has configured
+func (s *TicketingService) GetIntegrations(ctx context.Context, userID string) ([]*userEntities.IntegrationConfig, error) {
+	configs, err := s.integrationRepo.FindByUserID(ctx, userID, userEntities.TicketingProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find integrations: %w", err)
+	}
+	return configs, nil
+}
+
+// ConfigureIntegration creates or updates the user's configuration of a ticketing provider. The
+// configuration is checked by creating the provider from it.
+func (s *TicketingService) ConfigureIntegration(ctx context.Context, cmd commands.ConfigureTicketingCommand) (*userEntities.IntegrationConfig, error) {
+	if !s.providers.Supports(cmd.Provider) {
+		return nil, domain.NewDomainError("UNSUPPORTED_TICKETING_PROVIDER", fmt.Sprintf("Ticketing provider %q is not supported", cmd.Provider), domain.ErrInvalidInput)
+	}
+
+	config, err := s.integrationRepo.FindByProvider(ctx, cmd.UserID, userEntities.TicketingProvider, cmd.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find integration: %w", err)
+	}
+	if config == nil {
+		created := userEntities.NewIntegrationConfig(cmd.UserID, userEntities.TicketingProvider, cmd.Provider, nil)
+		config = &created
+	}
+	for key, value := range cmd.Config {
+		if value == nil {
+			config.RemoveConfigValue(key)
+		} else {
+			config.SetConfigValue(key, value)
+		}
+	}
+	if cmd.IsActive != nil {
+		if *cmd.IsActive {
+			config.Activate()
+		} else {
+			config.Deactivate()
+		}
+	}
+
+	if _, err := s.providers.NewProvider(cmd.Provider, config.Config); err != nil {
+		return nil, domain.NewDomainError("INVALID_TICKETING_CONFIG", err.Error(), domain.ErrInvalidInput)
+	}
+	if err := s.integrationRepo.Save(ctx, config); err != nil {
+		return nil, fmt.Errorf("failed to save integration: %w", err)
+	}
+	return config, nil
+}
+
+// DeleteIntegration removes the user's configuration of a ticketing provider
+func (s *TicketingService) DeleteIntegration(ctx context.Context, userID, providerName string) error {
+	config, err := s.integrationRepo.FindByProvider(ctx, userID, userEntities.TicketingProvider, providerName)
+	if err != nil {
+		return fmt.Errorf("failed to find integration: %w", err)
+	}
+	if config == nil {
+		return domain.NewDomainError("INTEGRATION_NOT_FOUND", fmt.Sprintf("Ticketing provider %q is not configured", providerName), domain.ErrNotFound)
+	}
+	if err := s.integrationRepo.Delete(ctx, config.GetID()); err != nil {
+		return fmt.Errorf("failed to delete integration: %w", err)
+	}
+	return nil
+}
+
+// CreateTickets queues a job that opens a ticket for each of the approved action items, which
+// must all be in meetings the user can edit
+func (s *TicketingService) CreateTickets(ctx context.Context, cmd commands.CreateTicketsCommand) (*jobEntities.ProcessingJob, error) {
+	actionItemIDs := uniqueIDs(cmd.ActionItemIDs)
+	if len(actionItemIDs) == 0 {
+		return nil, domain.NewDomainError("INVALID_TICKET_REQUEST", "At least one action item is required", domain.ErrInvalidInput)
+	}
+	if len(actionItemIDs) > MaxTicketsPerJob {
+		return nil, domain.NewDomainError("INVALID_TICKET_REQUEST", fmt.Sprintf("At most %d action items can be turned into tickets at once", MaxTicketsPerJob), domain.ErrInvalidInput)
+	}
+
+	if _, _, err := s.provider(ctx, cmd.UserID, cmd.Provider); err != nil {
+		return nil, err
+	}
+
+	for _, id := range actionItemIDs {
+		actionItem, err := s.findEditableActionItem(ctx, id, cmd.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !actionItem.IsApproved() {
+			return nil, domain.NewDomainError("INVALID_ACTION_ITEM_STATUS", fmt.Sprintf("Action item %s must be approved before a ticket is created", id), domain.ErrInvalidInput)
+		}
+	}
+
+	job := jobEntities.NewProcessingJob("user", cmd.UserID, jobEntities.CreateTicketsJobType, map[string]interface{}{
+		"provider":        cmd.Provider,
+		"project":         strings.TrimSpace(cmd.Project),
+		"action_item_ids": actionItemIDs,
+		"requested_by":    cmd.UserID,
+	})
+	if err := s.jobRepo.Save(ctx, &job); err != nil {
+		return nil, domain.NewDomainError("SAVE_TICKET_JOB_FAILED", "Failed to queue ticket creation", err)
+	}
+
+	s.runner.Enqueue(job.GetID())
+	return &job, nil
+}
+
+// GetTicketJob returns a create tickets job the user started
+func (s *TicketingService) GetTicketJob(ctx context.Context, jobID, userID string) (*jobEntities.ProcessingJob, error) {
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, domain.NewDomainError("TICKET_JOB_NOT_FOUND", "Ticket job not found", err)
+	}
+	if job.JobType != jobEntities.CreateTicketsJobType || job.EntityID != userID {
+		return nil, domain.NewDomainError("TICKET_JOB_NOT_FOUND", "Ticket job not found", domain.ErrNotFound)
+	}
+	return job, nil
+}
+
+// LinkTicket links an action item in a meeting the user can edit to a ticket that already exists
+// and marks it as created. Items that already have a ticket cannot be linked again.
+func (s *TicketingService) LinkTicket(ctx context.Context, cmd commands.LinkTicketCommand) (*entities.ActionItem, error) {
+	actionItem, err := s.findEditableActionItem(ctx, cmd.ActionItemID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if actionItem.HasTicket() {
+		return nil, domain.NewDomainError("INVALID_ACTION_ITEM_STATUS", "A ticket has already been created for this action item", domain.ErrInvalidInput)
+	}
+
+	provider, config, err := s.provider(ctx, cmd.UserID, cmd.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	ticket, err := provider.FindTicket(ctx, cmd.Ticket)
+	switch {
+	case errors.Is(err, services.ErrTicketNotFound):
+		return nil, domain.NewDomainError("TICKET_NOT_FOUND", "Ticket not found", err)
+	case errors.Is(err, services.ErrInvalidTicketReference):
+		return nil, domain.NewDomainError("INVALID_TICKET_REFERENCE", err.Error(), domain.ErrInvalidInput)
+	case err != nil:
+		return nil, domain.NewDomainError("TICKETING_FAILED", "Failed to look up the ticket", err)
+	}
+
+	if err := s.recordTicket(ctx, actionItem, provider, config, ticket, entities.ExistingTicketReference); err != nil {
+		return nil, err
+	}
+	return actionItem, nil
+}
+
+// ResumePendingJobs restarts create tickets jobs that were queued or running when the server
+// stopped. Action items that already got their ticket are skipped when the job runs again.
+func (s *TicketingService) ResumePendingJobs(ctx context.Context) error {
+	return s.runner.Resume(ctx, jobEntities.CreateTicketsJobType)
+}
+
+// runJob opens the tickets of a job and stores which were opened on the job, also when the job ran
+// out of time part-way. The job fails only if no ticket could be opened.
+func (s *TicketingService) runJob(ctx context.Context, job *jobEntities.ProcessingJob) error {
+	result, err := s.createTickets(ctx, job)
+	if err != nil {
+		return err
+	}
+	job.SetPayloadValue("result", result)
+	if len(result.Created) == 0 && len(result.Failed) > 0 {
+		return fmt.Errorf("no ticket could be created: %s", result.Failed[0].Error)
+	}
+	return nil
+}
+
+// createTickets opens a ticket for every action item of a job that is still approved
+func (s *TicketingService) createTickets(ctx context.Context, job *jobEntities.ProcessingJob) (*CreatedTickets, error) {
+	userID := job.EntityID
+	providerName := payloadString(job, "provider")
+	project := payloadString(job, "project")
+
+	var actionItemIDs []string
+	if err := decodePayloadValue(job, "action_item_ids", &actionItemIDs); err != nil {
+		return nil, err
+	}
+
+	provider, config, err := s.provider(ctx, userID, providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CreatedTickets{Provider: providerName, Created: []CreatedTicket{}, Failed: []FailedTicket{}}
+	for _, id := range actionItemIDs {
+		if ctx.Err() != nil {
+			result.Failed = append(result.Failed, FailedTicket{ActionItemID: id, Error: "Timed out before a ticket could be created"})
+			continue
+		}
+		actionItem, err := s.findEditableActionItem(ctx, id, userID)
+		if err != nil {
+			result.Failed = append(result.Failed, FailedTicket{ActionItemID: id, Error: err.Error()})
+			continue
+		}
+		if actionItem.HasTicket() {
+			continue
+		}
+		if !actionItem.IsApproved() {
+			result.Failed = append(result.Failed, FailedTicket{ActionItemID: id, Error: "Action item is no longer approved"})
+			continue
+		}
+
+		ticket, err := provider.CreateTicket(ctx, services.TicketRequest{
+			Title:       actionItem.Title,
+			Description: actionItem.Description,
+			Assignee:    actionItem.Assignee,
+			AssigneeID:  assigneeAccount(actionItem, providerName),
+			Priority:    actionItem.Priority,
+			DueDate:     actionItem.DueDate,
+			Context:     actionItem.Context,
+			Project:     project,
+		})
+		if err == nil {
+			// A ticket opened just before the job ran out of time must still be recorded
+			recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recordTicketTimeout)
+			err = s.recordTicket(recordCtx, actionItem, provider, config, ticket, entities.CreatedTicketReference)
+			cancel()
+		}
+		if err != nil {
+			log.Printf("Failed to create ticket for action item %s: %v", id, err)
+			result.Failed = append(result.Failed, FailedTicket{ActionItemID: id, Error: err.Error()})
+			continue
+		}
+		result.Created = append(result.Created, CreatedTicket{ActionItemID: id, TicketID: ticket.ID, TicketURL: ticket.URL})
+	}
+	return result, nil
+}
+
+// recordTicket adds a reference to the ticket to the action item and marks it as created. The
+// reference remembers the integration so that reconciliation can look the ticket up again, and a
+// linked ticket that is already closed completes the action item right away.
+func (s *TicketingService) recordTicket(ctx context.Context, actionItem *entities.ActionItem, provider services.TicketingProvider, config *userEntities.IntegrationConfig, ticket *services.Ticket, referenceType string) error {
+	metadata := ticket.Metadata
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata[integrationIDMetadataKey] = config.GetID()
+
+	actionItem.AddTicketReference(provider.Name(), ticket.ID, ticket.URL, ticket.Project, referenceType, metadata)
+	actionItem.MarkAsCreated()
+	reference := &actionItem.TicketReferences[len(actionItem.TicketReferences)-1]
+	applyTicketState(actionItem, reference, ticket, time.Now())
+	if err := s.actionItemRepo.SaveTicketReference(ctx, actionItem, reference); err != nil {
+		return domain.NewDomainError("SAVE_TICKET_REFERENCE_FAILED", "Failed to save ticket reference", err)
+	}
+	return nil
+}
+
+// provider creates the ticketing provider the user configured under that name and returns it with
+// the integration it was configured by
+func (s *TicketingService) provider(ctx context.Context, userID, providerName string) (services.TicketingProvider, *userEntities.IntegrationConfig, error) {
+	if !s.providers.Supports(providerName) {
+		return nil, nil, domain.NewDomainError("UNSUPPORTED_TICKETING_PROVIDER", fmt.Sprintf("Ticketing provider %q is not supported", providerName), domain.ErrInvalidInput)
+	}
+
+	config, err := s.integrationRepo.FindByProvider(ctx, userID, userEntities.TicketingProvider, providerName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find integration: %w", err)
+	}
+	if config == nil || !config.IsActive {
+		return nil, nil, domain.NewDomainError("TICKETING_NOT_CONFIGURED", fmt.Sprintf("Ticketing provider %q is not configured", providerName), domain.ErrInvalidInput)
+	}
+
+	provider, err := s.providers.NewProvider(providerName, config.Config)
+	if err != nil {
+		return nil, nil, domain.NewDomainError("INVALID_TICKETING_CONFIG", err.Error(), domain.ErrInvalidInput)
+	}
+	return provider, config, nil
+}
+
+// findEditableActionItem loads an action item in a meeting the user can edit
+func (s *TicketingService) findEditableActionItem(ctx context.Context, id, userID string) (*entities.ActionItem, error) {
+	actionItem, err := s.actionItemRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find action item: %w", err)
+	}
+	if actionItem == nil {
+		return nil, domain.NewDomainError("ACTION_ITEM_NOT_FOUND", "Action item not found", nil)
+	}
+	if _, err := s.accessService.VerifyEditAccess(ctx, actionItem.MeetingID, userID); err != nil {
+		return nil, err
+	}
+	return actionItem, nil
+}
+
+// TicketJobResult returns the outcome of a create tickets job that has run, or nil. Jobs that
+// failed because no ticket could be opened still report which action items failed.
+func TicketJobResult(job *jobEntities.ProcessingJob) *CreatedTickets {
+	var result CreatedTickets
+	if (!job.IsCompleted() && !job.IsFailed()) || decodePayloadValue(job, "result", &result) != nil {
+		return nil
+	}
+	return &result
+}
+
+// uniqueIDs trims the IDs and drops blanks and duplicates, keeping their order
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]bool, len(ids))
+	var unique []string
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id != "" && !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// assigneeAccount returns the user ID at a ticketing provider the assignee of an action item was
+// resolved to, or an empty string
+func assigneeAccount(actionItem *entities.ActionItem, providerName string) string {
+	if actionItem.AssigneeResolution == nil {
+		return ""
+	}
+	return actionItem.AssigneeResolution.Account(providerName)
+}
+
+func payloadString(job *jobEntities.ProcessingJob, key string) string {
+	value, _ := job.GetPayloadValue(key)
+	text, _ := value.(string)
+	return text
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	userEntities "teammate/server/modules/user/domain/entities"
+	"teammate/server/seedwork/application/jobs/jobstest"
+	jobEntities "teammate/server/seedwork/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTicketingService() (*TicketingService, *memoryActionItemRepository, *stubTicketingProvider) {
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+
+	approved := entities.NewActionItem("meeting-1", "tr-1", "Send the launch plan", "Share the plan with marketing", "Ben: I'll send the plan.", entities.High)
+	approved.SetID("approved")
+	approved.Approve()
+	approved.SetAssignee("Ben")
+	approved.ConfirmAssignee(entities.AssigneeCandidate{Name: "Ben Ortiz", Accounts: map[string]string{"stub": "bortiz"}}, time.Now())
+	extracted := entities.NewActionItem("meeting-1", "tr-1", "Book the venue", "", "", entities.Low)
+	extracted.SetID("extracted")
+	repo := &memoryActionItemRepository{actionItems: []*entities.ActionItem{&approved, &extracted}}
+
+	config := userEntities.NewIntegrationConfig("owner", userEntities.TicketingProvider, "stub", map[string]interface{}{"token": "secret"})
+	integrations := &memoryIntegrationConfigRepository{configs: []*userEntities.IntegrationConfig{&config}}
+
+	provider := &stubTicketingProvider{
+		failFor: map[string]bool{},
+		tickets: map[string]*services.Ticket{"#7": {ID: "7", URL: "https://tracker.example.com/7", Project: "team/app", Status: "open"}},
+	}
+	service := NewTicketingService(repo, &stubMeetingRepository{meeting: meeting}, integrations, jobstest.NewMemoryProcessingJobRepository(), &stubTicketingFactory{provider: provider})
+	return service, repo, provider
+}
+
+func TestTicketingService_CreateTickets(t *testing.T) {
+	service, repo, provider := newTestTicketingService()
+	ctx := context.Background()
+
+	job := jobEntities.NewProcessingJob("user", "owner", jobEntities.CreateTicketsJobType, map[string]interface{}{
+		"provider":        "stub",
+		"project":         "team/other",
+		"action_item_ids": []string{"approved", "extracted"},
+	})
+	result, err := service.createTickets(ctx, &job)
+	require.NoError(t, err)
+
+	require.Len(t, result.Created, 1)
+	assert.Equal(t, CreatedTicket{ActionItemID: "approved", TicketID: "1", TicketURL: "https://tracker.example.com/1"}, result.Created[0])
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, "extracted", result.Failed[0].ActionItemID)
+
+	require.Len(t, provider.requests, 1)
+	assert.Equal(t, "team/other", provider.requests[0].Project)
+	assert.Equal(t, entities.High, provider.requests[0].Priority)
+	assert.Equal(t, "Ben: I'll send the plan.", provider.requests[0].Context)
+	assert.Equal(t, "bortiz", provider.requests[0].AssigneeID)
+
+	actionItem, _ := repo.FindByID(ctx, "approved")
+	assert.Equal(t, entities.Created, actionItem.Status)
+	require.Len(t, repo.ticketReferences, 1)
+	assert.Equal(t, "stub", repo.ticketReferences[0].System)
+	assert.Equal(t, entities.CreatedTicketReference, repo.ticketReferences[0].ReferenceType)
+	assert.Equal(t, "open", repo.ticketReferences[0].Metadata["state"])
+
+	// Running the job again skips action items that already have their ticket
+	result, err = service.createTickets(ctx, &job)
+	require.NoError(t, err)
+	assert.Empty(t, result.Created)
+	assert.Len(t, provider.requests, 1)
+}
+
+func TestTicketingService_CreateTicketsJob(t *testing.T) {
+	service, repo, _ := newTestTicketingService()
+	ctx := context.Background()
+
+	job, err := service.CreateTickets(ctx, commands.CreateTicketsCommand{UserID: "owner", Provider: "stub", ActionItemIDs: []string{"approved"}})
+	require.NoError(t, err)
+	assert.Equal(t, jobEntities.CreateTicketsJobType, job.JobType)
+
+	require.Eventually(t, func() bool {
+		job, err = service.GetTicketJob(ctx, job.GetID(), "owner")
+		return err == nil && (job.IsCompleted() || job.IsFailed())
+	}, 5*time.Second, 10*time.Millisecond)
+	require.True(t, job.IsCompleted(), job.ErrorMessage)
+
+	result := TicketJobResult(job)
+	require.NotNil(t, result)
+	require.Len(t, result.Created, 1)
+	assert.Equal(t, "approved", result.Created[0].ActionItemID)
+	assert.Len(t, repo.ticketReferences, 1)
+
+	// Jobs are private to the user who started them
+	_, err = service.GetTicketJob(ctx, job.GetID(), "editor")
+	assertDomainErrorCode(t, err, "TICKET_JOB_NOT_FOUND")
+}
+
+func TestTicketingService_CreateTicketsTimeout(t *testing.T) {
+	service, repo, provider := newTestTicketingService()
+	second := entities.NewActionItem("meeting-1", "tr-1", "Book the venue", "", "", entities.Low)
+	second.SetID("second")
+	second.Approve()
+	repo.actionItems = append(repo.actionItems, &second)
+
+	// The job runs out of time right after the first ticket is opened
+	ctx, cancel := context.WithCancel(context.Background())
+	provider.opened = cancel
+
+	job := jobEntities.NewProcessingJob("user", "owner", jobEntities.CreateTicketsJobType, map[string]interface{}{
+		"provider":        "stub",
+		"action_item_ids": []string{"approved", "second"},
+	})
+	require.NoError(t, service.runJob(ctx, &job))
+
+	job.Complete()
+	result := TicketJobResult(&job)
+	require.NotNil(t, result)
+	require.Len(t, result.Created, 1)
+	assert.Equal(t, "approved", result.Created[0].ActionItemID)
+	require.Len(t, result.Failed, 1)
+	assert.Equal(t, "second", result.Failed[0].ActionItemID)
+	assert.Len(t, repo.ticketReferences, 1)
+	assert.Len(t, provider.requests, 1)
+}
+
+func TestTicketingService_CreateTicketsValidation(t *testing.T) {
+	service, _, _ := newTestTicketingService()
+	ctx := context.Background()
+
+	_, err := service.CreateTickets(ctx, commands.CreateTicketsCommand{UserID: "owner", Provider: "stub", ActionItemIDs: []string{" "}})
+	assertDomainErrorCode(t, err, "INVALID_TICKET_REQUEST")
+
+	_, err = service.CreateTickets(ctx, commands.CreateTicketsCommand{UserID: "owner", Provider: "jira", ActionItemIDs: []string{"approved"}})
+	assertDomainErrorCode(t, err, "UNSUPPORTED_TICKETING_PROVIDER")
+
+	_, err = service.CreateTickets(ctx, commands.CreateTicketsCommand{UserID: "editor", Provider: "stub", ActionItemIDs: []string{"approved"}})
+	assertDomainErrorCode(t, err, "TICKETING_NOT_CONFIGURED")
+
+	_, err = service.CreateTickets(ctx, commands.CreateTicketsCommand{UserID: "owner", Provider: "stub", ActionItemIDs: []string{"approved", "extracted"}})
+	assertDomainErrorCode(t, err, "INVALID_ACTION_ITEM_STATUS")
+}
+
+func TestTicketingService_LinkTicket(t *testing.T) {
+	service, repo, _ := newTestTicketingService()
+	ctx := context.Background()
+
+	_, err := service.LinkTicket(ctx, commands.LinkTicketCommand{ActionItemID: "extracted", UserID: "owner", Provider: "stub", Ticket: "#8"})
+	assertDomainErrorCode(t, err, "TICKET_NOT_FOUND")
+
+	_, err = service.LinkTicket(ctx, commands.LinkTicketCommand{ActionItemID: "extracted", UserID: "owner", Provider: "stub", Ticket: "bad"})
+	assertDomainErrorCode(t, err, "INVALID_TICKET_REFERENCE")
+
+	_, err = service.LinkTicket(ctx, commands.LinkTicketCommand{ActionItemID: "extracted", UserID: "viewer", Provider: "stub", Ticket: "#7"})
+	assertDomainErrorCode(t, err, "UNAUTHORIZED")
+
+	linked, err := service.LinkTicket(ctx, commands.LinkTicketCommand{ActionItemID: "extracted", UserID: "owner", Provider: "stub", Ticket: "#7"})
+	require.NoError(t, err)
+	assert.Equal(t, entities.Created, linked.Status)
+	require.Len(t, repo.ticketReferences, 1)
+	assert.Equal(t, "7", repo.ticketReferences[0].TicketID)
+	assert.Equal(t, "team/app", repo.ticketReferences[0].ProjectKey)
+	assert.Equal(t, entities.ExistingTicketReference, repo.ticketReferences[0].ReferenceType)
+
+	_, err = service.LinkTicket(ctx, commands.LinkTicketCommand{ActionItemID: "extracted", UserID: "owner", Provider: "stub", Ticket: "#7"})
+	assertDomainErrorCode(t, err, "INVALID_ACTION_ITEM_STATUS")
+}
+
+func TestTicketingService_ConfigureIntegration(t *testing.T) {
+	service, _, _ := newTestTicketingService()
+	ctx := context.Background()
+
+	_, err := service.ConfigureIntegration(ctx, commands.ConfigureTicketingCommand{UserID: "editor", Provider: "stub", Config: map[string]interface{}{"repository": "team/app"}})
+	assertDomainErrorCode(t, err, "INVALID_TICKETING_CONFIG")
+
+	// The token is kept when only other settings change
+	inactive := false
+	config, err := service.ConfigureIntegration(ctx, commands.ConfigureTicketingCommand{UserID: "owner", Provider: "stub", Config: map[string]interface{}{"repository": "team/app"}, IsActive: &inactive})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", config.Config["token"])
+	assert.Equal(t, "team/app", config.Config["repository"])
+	assert.False(t, config.IsActive)
+
+	_, err = service.LinkTicket(ctx, commands.LinkTicketCommand{ActionItemID: "extracted", UserID: "owner", Provider: "stub", Ticket: "#7"})
+	assertDomainErrorCode(t, err, "TICKETING_NOT_CONFIGURED")
+
+	require.NoError(t, service.DeleteIntegration(ctx, "owner", "stub"))
+	err = service.DeleteIntegration(ctx, "owner", "stub")
+	assertDomainErrorCode(t, err, "INTEGRATION_NOT_FOUND")
+}
@@ -21,22 +21,29 @@ const (
 	Pending   ActionItemStatus = "pending"
 	Approved  ActionItemStatus = "approved"
 	Created   ActionItemStatus = "created"
+	Completed ActionItemStatus = "completed"
 	Rejected  ActionItemStatus = "rejected"
 )
 
 // ActionItem represents an extracted action item from a meeting
 type ActionItem struct {
 	domain.BaseEntity
-	MeetingID        string            `json:"meeting_id" gorm:"column:meeting_id;not null"`
-	TranscriptionID  string            `json:"transcription_id" gorm:"column:transcription_id;not null"`
-	Title            string            `json:"title" gorm:"column:title;not null"`
-	Description      string            `json:"description" gorm:"column:description;type:text;not null"`
-	Assignee         string            `json:"assignee,omitempty" gorm:"column:assignee"`
-	Priority         Priority          `json:"priority" gorm:"column:priority;not null"`
-	DueDate          *time.Time        `json:"due_date,omitempty" gorm:"column:due_date"`
-	Status           ActionItemStatus  `json:"status" gorm:"column:status;not null"`
-	Context          string            `json:"context" gorm:"column:context;type:text;not null"`
-	TicketReferences []TicketReference `json:"ticket_references" gorm:"foreignKey:ActionItemID"`
+	MeetingID       string `json:"meeting_id" gorm:"column:meeting_id;not null"`
+	TranscriptionID string `json:"transcription_id" gorm:"column:transcription_id;not null"`
+	Title           string `json:"title" gorm:"column:title;not null"`
+	Description     string `json:"description" gorm:"column:description;type:text;not null"`
+	Assignee        string `json:"assignee,omitempty" gorm:"column:assignee"`
+	// AssigneeResolution records who the assignee was matched to; nil until it has been resolved
+	AssigneeResolution *AssigneeResolution `json:"assignee_resolution,omitempty" gorm:"column:assignee_resolution;type:jsonb;serializer:json"`
+	Priority           Priority            `json:"priority" gorm:"column:priority;not null"`
+	DueDate            *time.Time          `json:"due_date,omitempty" gorm:"column:due_date"`
+	Status             ActionItemStatus    `json:"status" gorm:"column:status;not null"`
+	CompletedAt        *time.Time          `json:"completed_at,omitempty" gorm:"column:completed_at"`
+	Context            string              `json:"context" gorm:"column:context;type:text;not null"`
+	SourceSegmentID    string              `json:"source_segment_id,omitempty" gorm:"column:source_segment_id"`
+	SourceStartTime    *float64            `json:"source_start_time,omitempty" gorm:"column:source_start_time"`
+	SourceEndTime      *float64            `json:"source_end_time,omitempty" gorm:"column:source_end_time"`
+	TicketReferences   []TicketReference   `json:"ticket_references" gorm:"foreignKey:ActionItemID"`
 }
 
 // NewActionItem creates a new ActionItem entity
@@ -69,16 +76,69 @@ func (a *ActionItem) MarkAsCreated() {
 	a.Status = Created
 }
 
+// MarkAsCompleted transitions the action item to completed status once its ticket is closed
+func (a *ActionItem) MarkAsCompleted(completedAt time.Time) {
+	a.Status = Completed
+	a.CompletedAt = &completedAt
+}
+
+// Reopen transitions a completed action item back to created status when its ticket is reopened
+func (a *ActionItem) Reopen() {
+	a.Status = Created
+	a.CompletedAt = nil
+}
+
 // SetPending transitions the action item to pending status
 func (a *ActionItem) SetPending() {
 	a.Status = Pending
 }
 
-// SetAssignee sets the assignee for the action item
+// SetAssignee sets the assignee for the action item. A resolution of the previous assignee no longer applies.
 func (a *ActionItem) SetAssignee(assignee string) {
+	if assignee != a.Assignee {
+		a.AssigneeResolution = nil
+	}
 	a.Assignee = assignee
 }
 
+// ResolveAssignee records who the assignee was matched to. A clear match replaces the assignee with
+// the person's name; the mention is kept with the resolution.
+func (a *ActionItem) ResolveAssignee(resolution AssigneeResolution) {
+	if resolution.Status == AssigneeResolved && resolution.Match != nil && resolution.Match.Name != "" {
+		a.Assignee = resolution.Match.Name
+	}
+	a.AssigneeResolution = &resolution
+}
+
+// ConfirmAssignee assigns the action item to the person a user picked
+func (a *ActionItem) ConfirmAssignee(candidate AssigneeCandidate, confirmedAt time.Time) {
+	mention := a.Assignee
+	candidates := []AssigneeCandidate{}
+	if a.AssigneeResolution != nil {
+		mention = a.AssigneeResolution.Mention
+		candidates = a.AssigneeResolution.Candidates
+	}
+
+	candidate.Confidence = 1
+	a.Assignee = candidate.Name
+	a.AssigneeResolution = &AssigneeResolution{
+		Mention:    mention,
+		Status:     AssigneeConfirmed,
+		Confidence: 1,
+		Match:      &candidate,
+		Candidates: candidates,
+		ResolvedAt: confirmedAt,
+	}
+}
+
+// AssigneeMention returns the assignee as it was originally written
+func (a *ActionItem) AssigneeMention() string {
+	if a.AssigneeResolution != nil && a.AssigneeResolution.Mention != "" {
+		return a.AssigneeResolution.Mention
+	}
+	return a.Assignee
+}
+
 // SetDueDate sets the due date for the action item
 func (a *ActionItem) SetDueDate(dueDate time.Time) {
 	a.DueDate = &dueDate
@@ -89,6 +149,18 @@ func (a *ActionItem) ClearDueDate() {
 	a.DueDate = nil
 }
 
+// SetSource links the action item to the transcript segment it was extracted from
+func (a *ActionItem) SetSource(segmentID string, startTime, endTime float64) {
+	a.SourceSegmentID = segmentID
+	a.SourceStartTime = &startTime
+	a.SourceEndTime = &endTime
+}
+
+// HasSource returns true if the transcript segment the action item came from is known
+func (a *ActionItem) HasSource() bool {
+	return a.SourceSegmentID != ""
+}
+
 // IsApproved returns true if the action item has been approved
 func (a *ActionItem) IsApproved() bool {
 	return a.Status == Approved
@@ -99,6 +171,16 @@ func (a *ActionItem) IsCreated() bool {
 	return a.Status == Created
 }
 
+// IsCompleted returns true if the ticket of the action item has been closed
+func (a *ActionItem) IsCompleted() bool {
+	return a.Status == Completed
+}
+
+// HasTicket returns true once a ticket has been created for the action item, whether or not it is closed
+func (a *ActionItem) HasTicket() bool {
+	return a.IsCreated() || a.IsCompleted()
+}
+
 // IsPending returns true if the action item is pending approval
 func (a *ActionItem) IsPending() bool {
 	return a.Status == Pending
@@ -109,6 +191,12 @@ func (a *ActionItem) IsRejected() bool {
 	return a.Status == Rejected
 }
 
+// CanBeReviewed returns true while the action item can still be approved or rejected.
+// Once a ticket has been created for it, the decision is final.
+func (a *ActionItem) CanBeReviewed() bool {
+	return !a.HasTicket()
+}
+
 // HasAssignee returns true if the action item has an assignee
 func (a *ActionItem) HasAssignee() bool {
 	return a.Assignee != ""
@@ -121,7 +209,7 @@ func (a *ActionItem) HasDueDate() bool {
 
 // IsOverdue returns true if the action item is past its due date
 func (a *ActionItem) IsOverdue() bool {
-	if a.DueDate == nil {
+	if a.DueDate == nil || a.IsCompleted() {
 		return false
 	}
 	return time.Now().After(*a.DueDate)
@@ -179,7 +267,8 @@ type TicketReference struct {
 	TicketURL     string                 `json:"ticket_url" gorm:"column:ticket_url;not null"`
 	ProjectKey    string                 `json:"project_key,omitempty" gorm:"column:project_key"`
 	ReferenceType string                 `json:"reference_type" gorm:"column:reference_type;not null"`
-	Metadata      map[string]interface{} `json:"metadata,omitempty" gorm:"column:metadata;type:jsonb"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty" gorm:"column:metadata;type:jsonb;serializer:json"`
+	SyncedAt      *time.Time             `json:"synced_at,omitempty" gorm:"column:synced_at"`
 }
 
 const (
@@ -233,6 +322,11 @@ func (tr *TicketReference) SetMetadataValue(key string, value interface{}) {
 	tr.Metadata[key] = value
 }
 
+// MarkAsSynced records when the state of the ticket was last received
+func (tr *TicketReference) MarkAsSynced(syncedAt time.Time) {
+	tr.SyncedAt = &syncedAt
+}
+
 // TableName sets the table name for GORM
 func (TicketReference) TableName() string {
 	return "ticket_references"
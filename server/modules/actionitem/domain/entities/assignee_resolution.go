@@ -0,0 +1,68 @@
+package entities
+
+import "time"
+
+type AssigneeResolutionStatus string
+
+const (
+	// AssigneeResolved means a single person clearly matches the assignee
+	AssigneeResolved AssigneeResolutionStatus = "resolved"
+	// AssigneeAmbiguous means several people match and the assignee needs to be confirmed
+	AssigneeAmbiguous AssigneeResolutionStatus = "ambiguous"
+	// AssigneeUnresolved means nobody matches the assignee
+	AssigneeUnresolved AssigneeResolutionStatus = "unresolved"
+	// AssigneeConfirmed means a user picked the person the assignee refers to
+	AssigneeConfirmed AssigneeResolutionStatus = "confirmed"
+)
+
+type AssigneeSource string
+
+const (
+	// ParticipantSource is a participant of the meeting
+	ParticipantSource AssigneeSource = "participant"
+	// SpeakerSource is the name a speaker label of the transcript was renamed to
+	SpeakerSource AssigneeSource = "speaker"
+	// UserSource is the owner of the meeting or a user it is shared with
+	UserSource AssigneeSource = "user"
+	// DirectorySource is a user of a ticketing integration
+	DirectorySource AssigneeSource = "directory"
+)
+
+// AssigneeCandidate is a person an assignee may refer to
+type AssigneeCandidate struct {
+	Name   string         `json:"name"`
+	Email  string         `json:"email,omitempty"`
+	UserID string         `json:"user_id,omitempty"`
+	Source AssigneeSource `json:"source"`
+	// Accounts maps ticketing providers to the person's user ID there, e.g. a GitHub login or a Jira account ID
+	Accounts map[string]string `json:"accounts,omitempty"`
+	// Confidence is how likely the assignee refers to this person, from 0 to 1
+	Confidence float64 `json:"confidence"`
+}
+
+// AssigneeResolution records who the assignee of an action item was matched to
+type AssigneeResolution struct {
+	// Mention is the assignee as the extractor or a user wrote it, e.g. "Sam" or "Speaker B"
+	Mention    string                   `json:"mention"`
+	Status     AssigneeResolutionStatus `json:"status"`
+	Confidence float64                  `json:"confidence"`
+	// Match is the person the assignee was resolved to or confirmed as
+	Match *AssigneeCandidate `json:"match,omitempty"`
+	// Candidates are the people the assignee may refer to, most likely first
+	Candidates []AssigneeCandidate `json:"candidates"`
+	ResolvedAt time.Time           `json:"resolved_at"`
+}
+
+// NeedsConfirmation returns true if a user has to pick who the assignee refers to
+func (r *AssigneeResolution) NeedsConfirmation() bool {
+	return r.Status == AssigneeAmbiguous
+}
+
+// Account returns the user ID of the resolved or confirmed person at a ticketing provider, or an
+// empty string
+func (r *AssigneeResolution) Account(provider string) string {
+	if r.Match == nil || (r.Status != AssigneeResolved && r.Status != AssigneeConfirmed) {
+		return ""
+	}
+	return r.Match.Accounts[provider]
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+)
+
+// ActionItemFilter narrows down the action items a user can see. Empty fields do not filter.
+type ActionItemFilter struct {
+	// UserID limits the results to meetings the user owns or that have been shared with them
+	UserID    string
+	MeetingID string
+	Status    entities.ActionItemStatus
+	// Assignee matches the assignee case-insensitively
+	Assignee string
+	// Overdue limits the results to items past their due date that are neither rejected nor completed
+	Overdue bool
+	// NeedsConfirmation limits the results to items whose assignee matches several people
+	NeedsConfirmation bool
+	// Now is the reference time for Overdue
+	Now    time.Time
+	Limit  int
+	Offset int
+}
+
+// TicketLookup finds the action items that reference a ticket
+type TicketLookup struct {
+	// System is the ticketing provider the reference was recorded under
+	System   string
+	TicketID string
+	// ProjectKey is the ticket's repository, project or team, matched case-insensitively; empty matches any
+	ProjectKey string
+	// UserID limits the results to meetings the user owns or can edit
+	UserID string
+}
+
+// ActionItemRepository defines the interface for action item persistence
+type ActionItemRepository interface {
+	Save(ctx context.Context, actionItem *entities.ActionItem) error
+	// Update stores the action item's own fields; ticket references are left unchanged
+	Update(ctx context.Context, actionItem *entities.ActionItem) error
+	// SaveTicketReference stores a new ticket reference of the action item together with the
+	// action item's own fields
+	SaveTicketReference(ctx context.Context, actionItem *entities.ActionItem, reference *entities.TicketReference) error
+	// UpdateTicketReference stores an existing ticket reference of the action item together with
+	// the action item's own fields
+	UpdateTicketReference(ctx context.Context, actionItem *entities.ActionItem, reference *entities.TicketReference) error
+	// FindByID returns nil if the action item does not exist
+	FindByID(ctx context.Context, id string) (*entities.ActionItem, error)
+	// FindByTranscriptionID returns the action items extracted from a transcription
+	FindByTranscriptionID(ctx context.Context, transcriptionID string) ([]*entities.ActionItem, error)
+	// FindByMeetingID returns all action items of a meeting with their ticket references
+	FindByMeetingID(ctx context.Context, meetingID string) ([]*entities.ActionItem, error)
+	// FindByTicket returns the action items with a reference to the ticket
+	FindByTicket(ctx context.Context, lookup TicketLookup) ([]*entities.ActionItem, error)
+	// FindTracked returns up to limit action items with a ticket, created or completed, ordered by
+	// ID and after the given ID, so all of them can be walked in batches
+	FindTracked(ctx context.Context, afterID string, limit int) ([]*entities.ActionItem, error)
+	// ReplaceExtracted replaces the unreviewed action items of a transcription with new ones;
+	// items that have been reviewed are kept
+	ReplaceExtracted(ctx context.Context, transcriptionID string, actionItems []*entities.ActionItem) error
+	// Find returns a page of the action items matching the filter, most urgent due date first, and their total
+	Find(ctx context.Context, filter ActionItemFilter) ([]*entities.ActionItem, int64, error)
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+)
+
+// ExtractionMethod identifies how action items were extracted from a transcript
+type ExtractionMethod string
+
+const (
+	LemurExtractionMethod     ExtractionMethod = "lemur"
+	LLMExtractionMethod       ExtractionMethod = "llm"
+	RuleBasedExtractionMethod ExtractionMethod = "rule_based"
+)
+
+// ExtractionRequest is a completed transcription to extract action items from
+type ExtractionRequest struct {
+	Transcription *transcriptionEntities.Transcription
+	Segments      []transcriptionEntities.TranscriptSegment
+}
+
+// ExtractedActionItem is an action item found in a transcript, before it is reviewed
+type ExtractedActionItem struct {
+	Title       string
+	Description string
+	// Assignee is the name of the person who took the task on, if the transcript says
+	Assignee string
+	Priority entities.Priority
+	DueDate  *time.Time
+	// Source is the index in the request's segments of the segment the item was found in, or -1
+	Source int
+}
+
+// ExtractionResult is the outcome of extracting action items from a transcript
+type ExtractionResult struct {
+	ActionItems []ExtractedActionItem
+	Method      ExtractionMethod
+	Model       string
+}
+
+// ActionItemExtractor finds the tasks people took on or were asked to do in a meeting
+type ActionItemExtractor interface {
+	// Supports returns true if the extractor can read the transcription
+	Supports(transcription *transcriptionEntities.Transcription) bool
+
+	// Extract returns the action items of a transcript; finding none is not an error
+	Extract(ctx context.Context, request ExtractionRequest) (*ExtractionResult, error)
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+)
+
+var (
+	// ErrTicketNotFound is returned when a ticket to link does not exist
+	ErrTicketNotFound = errors.New("ticket not found")
+	// ErrInvalidTicketReference is returned when a ticket to link is not written the way the provider expects
+	ErrInvalidTicketReference = errors.New("invalid ticket reference")
+	// ErrInvalidWebhookSignature is returned when a webhook delivery was not signed with the integration's secret
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+)
+
+// TicketRequest is a ticket to open for an approved action item
+type TicketRequest struct {
+	Title       string
+	Description string
+	Assignee    string
+	// AssigneeID is the tracker's user ID the assignee was resolved to; it takes precedence over the
+	// provider's field mapping
+	AssigneeID string
+	Priority   entities.Priority
+	DueDate    *time.Time
+	// Context is what was said in the meeting when the task came up
+	Context string
+	// Project overrides the provider's default project: a GitHub repository as owner/name, a Jira
+	// project key or a Linear team ID
+	Project string
+}
+
+// Ticket is a ticket in an external ticketing system
+type Ticket struct {
+	// ID identifies the ticket within its project, e.g. a GitHub issue number
+	ID      string
+	URL     string
+	Project string
+	Status  string
+	// Closed is true once the ticket is done or has been cancelled
+	Closed   bool
+	ClosedAt *time.Time
+	// Assignee is the tracker's name or login of the person the ticket is assigned to
+	Assignee string
+	// Metadata holds provider specific details worth keeping with the ticket reference
+	Metadata map[string]interface{}
+}
+
+// TicketUser is a user of a ticketing system that tickets can be assigned to
+type TicketUser struct {
+	// ID is what tickets are assigned with: a GitHub login, a Jira account ID or a Linear user ID
+	ID    string
+	Name  string
+	Email string
+}
+
+// UserDirectory is implemented by ticketing providers that can list who tickets can be assigned to
+type UserDirectory interface {
+	// AssignableUsers returns the users tickets in the provider's default project can be assigned to
+	AssignableUsers(ctx context.Context) ([]TicketUser, error)
+}
+
+// TicketingProvider opens tickets for action items in an external ticketing system
+type TicketingProvider interface {
+	// Name is the provider name ticket references are recorded under
+	Name() string
+
+	// CreateTicket opens a new ticket
+	CreateTicket(ctx context.Context, request TicketRequest) (*Ticket, error)
+
+	// FindTicket looks up an existing ticket by the ID, key or URL a user would paste. It returns
+	// ErrTicketNotFound if there is no such ticket.
+	FindTicket(ctx context.Context, reference string) (*Ticket, error)
+}
+
+// TicketWebhook reads the ticket changes a ticketing system delivers to its webhook
+type TicketWebhook interface {
+	// VerifySignature returns ErrInvalidWebhookSignature unless the delivery was signed with the secret
+	VerifySignature(header http.Header, body []byte, secret string) error
+
+	// ParseDelivery returns the tickets a delivery reports changes of. Deliveries about anything
+	// else return no tickets.
+	ParseDelivery(header http.Header, body []byte) ([]Ticket, error)
+}
+
+// TicketingProviderFactory creates the ticketing provider a user configured
+type TicketingProviderFactory interface {
+	// Supports returns true if providers of that name can be created
+	Supports(providerName string) bool
+
+	// NewProvider creates a provider from a user's integration configuration, or returns an error
+	// explaining what is missing or invalid
+	NewProvider(providerName string, config map[string]interface{}) (TicketingProvider, error)
+
+	// Webhook returns how deliveries of the provider's webhooks are read
+	Webhook(providerName string) (TicketWebhook, bool)
+}
@@ -0,0 +1,136 @@
+package extractors
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+)
+
+// maxTitleLength bounds the title derived from a task; the full task stays in the description
+const maxTitleLength = 120
+
+var (
+	// listMarker matches the bullets and numbering language models add to list items
+	listMarker = regexp.MustCompile(`^\s*(?:[-*•]+|\d+[.)])\s*`)
+	// wordPattern matches the words compared when locating a task in the transcript
+	wordPattern    = regexp.MustCompile(`[\p{L}\p{N}']+`)
+	urgentPattern  = regexp.MustCompile(`(?i)\b(?:urgent(?:ly)?|asap|as soon as possible|immediately|right away)\b`)
+	highPattern    = regexp.MustCompile(`(?i)\b(?:important|critical|blocker|blocking|high priority|top priority)\b`)
+	lowPattern     = regexp.MustCompile(`(?i)\b(?:eventually|at some point|when you have time|low priority|nice to have)\b`)
+	matchStopWords = map[string]bool{
+		"the": true, "a": true, "an": true, "and": true, "or": true, "to": true, "of": true, "for": true,
+		"in": true, "on": true, "with": true, "by": true, "it": true, "is": true, "be": true, "that": true,
+		"this": true, "we": true, "i": true, "you": true, "will": true, "i'll": true, "can": true,
+	}
+)
+
+// newExtractedActionItem turns a task as it was phrased into an action item with a short title
+func newExtractedActionItem(task, assignee string, source int) services.ExtractedActionItem {
+	description := capitalize(strings.TrimSpace(task))
+	if description != "" && !strings.ContainsAny(description[len(description)-1:], ".!?") {
+		description += "."
+	}
+
+	return services.ExtractedActionItem{
+		Title:       titleFromTask(task),
+		Description: description,
+		Assignee:    strings.TrimSpace(assignee),
+		Priority:    priorityOf(task),
+		Source:      source,
+	}
+}
+
+// titleFromTask shortens a task to its first sentence and at most maxTitleLength characters
+func titleFromTask(task string) string {
+	title := strings.TrimSpace(task)
+	if end := strings.IndexAny(title, ".!?;\n"); end > 0 {
+		title = title[:end]
+	}
+	title = strings.TrimRight(title, " ,:-")
+
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		runes := []rune(title)
+		cut := string(runes[:maxTitleLength])
+		if space := strings.LastIndex(cut, " "); space > maxTitleLength/2 {
+			cut = cut[:space]
+		}
+		title = strings.TrimRight(cut, " ,:-") + "…"
+	}
+	return capitalize(title)
+}
+
+// priorityOf guesses the priority of a task from how urgently it was phrased
+func priorityOf(task string) entities.Priority {
+	switch {
+	case urgentPattern.MatchString(task):
+		return entities.Urgent
+	case highPattern.MatchString(task):
+		return entities.High
+	case lowPattern.MatchString(task):
+		return entities.Low
+	}
+	return entities.Medium
+}
+
+// parsePriority reads a priority named by a language model, defaulting to medium
+func parsePriority(priority string) entities.Priority {
+	switch entities.Priority(strings.ToLower(strings.TrimSpace(priority))) {
+	case entities.Low:
+		return entities.Low
+	case entities.High:
+		return entities.High
+	case entities.Urgent:
+		return entities.Urgent
+	}
+	return entities.Medium
+}
+
+// matchSegment returns the index of the segment sharing the most words with the text, or -1 if
+// none share at least half of the text's significant words
+func matchSegment(segments []transcriptionEntities.TranscriptSegment, text string) int {
+	words := significantWords(text)
+	if len(words) == 0 {
+		return -1
+	}
+
+	best, bestShared := -1, 0
+	for i, segment := range segments {
+		segmentWords := significantWords(segment.Text)
+		shared := 0
+		for word := range words {
+			if segmentWords[word] {
+				shared++
+			}
+		}
+		if shared > bestShared {
+			best, bestShared = i, shared
+		}
+	}
+	if bestShared*2 < len(words) {
+		return -1
+	}
+	return best
+}
+
+func significantWords(text string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range wordPattern.FindAllString(strings.ToLower(text), -1) {
+		if !matchStopWords[word] {
+			words[word] = true
+		}
+	}
+	return words
+}
+
+func capitalize(text string) string {
+	r, size := utf8.DecodeRuneInString(text)
+	if r == utf8.RuneError {
+		return text
+	}
+	return string(unicode.ToUpper(r)) + text[size:]
+}
@@ -0,0 +1,171 @@
+package extractors
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
+)
+
+func newTestSegments() []transcriptionEntities.TranscriptSegment {
+	return []transcriptionEntities.TranscriptSegment{
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Anna", "Thanks everyone for joining. Can you hear me?", 0, 4, 0.9, 1),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Anna", "Ben, can you send the launch plan to marketing? It's urgent.", 4, 9, 0.9, 2),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Ben", "Sure. I'll also update the pricing page.", 9, 12, 0.9, 3),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Anna", "Okay, could you review the budget?", 12, 14, 0.9, 4),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Chloe", "Yes. TODO: book the venue for the offsite. Let me know if that works.", 14, 19, 0.9, 5),
+	}
+}
+
+func TestRuleBasedExtractor_Extract(t *testing.T) {
+	result, err := NewRuleBasedExtractor().Extract(context.Background(), services.ExtractionRequest{Segments: newTestSegments()})
+	require.NoError(t, err)
+	assert.Equal(t, services.RuleBasedExtractionMethod, result.Method)
+
+	require.Len(t, result.ActionItems, 4)
+	assert.Equal(t, services.ExtractedActionItem{
+		Title: "Send the launch plan to marketing", Description: "Send the launch plan to marketing.",
+		Assignee: "Ben", Priority: entities.Medium, Source: 1,
+	}, result.ActionItems[0])
+	assert.Equal(t, "Also update the pricing page", result.ActionItems[1].Title)
+	assert.Equal(t, "Ben", result.ActionItems[1].Assignee)
+	assert.Equal(t, "Review the budget", result.ActionItems[2].Title)
+	assert.Equal(t, "Chloe", result.ActionItems[2].Assignee)
+	assert.Equal(t, "Book the venue for the offsite", result.ActionItems[3].Title)
+	assert.Empty(t, result.ActionItems[3].Assignee)
+	assert.Equal(t, 4, result.ActionItems[3].Source)
+}
+
+func TestTitleFromTaskAndPriority(t *testing.T) {
+	assert.Equal(t, "Send the deck", titleFromTask("send the deck. Then call Ben"))
+	long := titleFromTask(strings.Repeat("review the quarterly numbers ", 10))
+	assert.LessOrEqual(t, len([]rune(long)), maxTitleLength+1)
+	assert.True(t, strings.HasSuffix(long, "…"))
+
+	assert.Equal(t, entities.Urgent, priorityOf("send it ASAP"))
+	assert.Equal(t, entities.High, priorityOf("this is a blocker"))
+	assert.Equal(t, entities.Low, priorityOf("clean up the wiki at some point"))
+	assert.Equal(t, entities.Medium, priorityOf("send the deck"))
+	assert.Equal(t, entities.Urgent, parsePriority(" Urgent "))
+	assert.Equal(t, entities.Medium, parsePriority("p1"))
+}
+
+// stubLanguageModel returns a fixed response and records the prompts
+type stubLanguageModel struct {
+	response string
+	err      error
+	prompts  []string
+}
+
+func (m *stubLanguageModel) Name() string {
+	return "test-model"
+}
+
+func (m *stubLanguageModel) Complete(ctx context.Context, system, prompt string) (string, error) {
+	m.prompts = append(m.prompts, prompt)
+	return m.response, m.err
+}
+
+func TestLLMExtractor_Extract(t *testing.T) {
+	model := &stubLanguageModel{response: "```json\n" + `[
+		{"title": "Send launch plan", "description": "Send the launch plan to marketing", "assignee": "Ben", "priority": "urgent", "due_date": "2026-03-12", "source": 2},
+		{"title": "Review the budget", "description": "", "assignee": "", "priority": "whenever", "due_date": null, "source": 42}
+	]` + "\n```"}
+
+	result, err := NewLLMExtractor(model).Extract(context.Background(), services.ExtractionRequest{Segments: newTestSegments()})
+	require.NoError(t, err)
+	assert.Equal(t, services.LLMExtractionMethod, result.Method)
+	assert.Equal(t, "test-model", result.Model)
+	require.Len(t, model.prompts, 1)
+	assert.Contains(t, model.prompts[0], "[3] Ben: Sure. I'll also update the pricing page.")
+
+	require.Len(t, result.ActionItems, 2)
+	sent := result.ActionItems[0]
+	assert.Equal(t, "Send launch plan", sent.Title)
+	assert.Equal(t, "Send the launch plan to marketing.", sent.Description)
+	assert.Equal(t, "Ben", sent.Assignee)
+	assert.Equal(t, entities.Urgent, sent.Priority)
+	assert.Equal(t, 1, sent.Source)
+	require.NotNil(t, sent.DueDate)
+	assert.Equal(t, time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC), *sent.DueDate)
+
+	budget := result.ActionItems[1]
+	assert.Equal(t, entities.Medium, budget.Priority)
+	assert.Equal(t, 3, budget.Source)
+	assert.Nil(t, budget.DueDate)
+}
+
+func TestLLMExtractor_ExtractError(t *testing.T) {
+	_, err := NewLLMExtractor(&stubLanguageModel{err: errors.New("rate limited")}).Extract(context.Background(), services.ExtractionRequest{Segments: newTestSegments()})
+	assert.Error(t, err)
+
+	_, err = NewLLMExtractor(&stubLanguageModel{response: "There are no action items."}).Extract(context.Background(), services.ExtractionRequest{Segments: newTestSegments()})
+	assert.Error(t, err)
+}
+
+func TestTranscriptParts(t *testing.T) {
+	long := strings.Repeat("word ", maxPromptTranscriptLength/10)
+	segments := []transcriptionEntities.TranscriptSegment{
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Anna", long, 0, 1, 0.9, 1),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Ben", long, 1, 2, 0.9, 2),
+		transcriptionEntities.NewTranscriptSegment("tr-1", "Anna", "Short.", 2, 3, 0.9, 3),
+	}
+	assert.Equal(t, [][2]int{{0, 1}, {1, 3}}, transcriptParts(segments))
+	assert.Empty(t, transcriptParts(nil))
+}
+
+// stubLemurClient returns fixed action items and records the request
+type stubLemurClient struct {
+	response *assemblyai.LemurActionItemsResponse
+	request  *assemblyai.LemurActionItemsRequest
+}
+
+func (c *stubLemurClient) LemurActionItems(ctx context.Context, request *assemblyai.LemurActionItemsRequest) (*assemblyai.LemurActionItemsResponse, error) {
+	c.request = request
+	return c.response, nil
+}
+
+func TestLemurExtractor(t *testing.T) {
+	client := &stubLemurClient{response: &assemblyai.LemurActionItemsResponse{
+		Response: "- Ben: Send the launch plan to marketing\n- Unassigned: Review the budget\n\nNone",
+	}}
+	extractor := NewLemurExtractor(client, "")
+
+	transcription := transcriptionEntities.NewTranscription("meeting-1", "", "assemblyai")
+	transcription.ProviderTranscriptID = "aai-1"
+	other := transcriptionEntities.NewTranscription("meeting-1", "", "deepgram")
+	assert.True(t, extractor.Supports(&transcription))
+	assert.False(t, extractor.Supports(&other))
+
+	result, err := extractor.Extract(context.Background(), services.ExtractionRequest{Transcription: &transcription, Segments: newTestSegments()})
+	require.NoError(t, err)
+	assert.Equal(t, services.LemurExtractionMethod, result.Method)
+	assert.Equal(t, []string{"aai-1"}, client.request.TranscriptIDs)
+	assert.Nil(t, client.request.FinalModel)
+
+	require.Len(t, result.ActionItems, 2)
+	assert.Equal(t, "Ben", result.ActionItems[0].Assignee)
+	assert.Equal(t, "Send the launch plan to marketing", result.ActionItems[0].Title)
+	assert.Equal(t, 1, result.ActionItems[0].Source)
+	assert.Empty(t, result.ActionItems[1].Assignee)
+	assert.Equal(t, 3, result.ActionItems[1].Source)
+}
+
+func TestSplitOwner(t *testing.T) {
+	owner, task := splitOwner("Anna Smith: book the venue")
+	assert.Equal(t, "Anna Smith", owner)
+	assert.Equal(t, "book the venue", task)
+
+	owner, task = splitOwner("Follow up with legal about the following: contract terms")
+	assert.Empty(t, owner)
+	assert.Equal(t, "Follow up with legal about the following: contract terms", task)
+}
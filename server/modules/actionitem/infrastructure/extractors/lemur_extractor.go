@@ -0,0 +1,95 @@
+package extractors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"teammate/server/modules/actionitem/domain/services"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
+)
+
+// Ensure LemurExtractor implements ActionItemExtractor
+var _ services.ActionItemExtractor = (*LemurExtractor)(nil)
+
+const (
+	lemurActionItemsContext      = "This is a transcript of a work meeting."
+	lemurActionItemsAnswerFormat = "One action item per line in the form 'Owner: task'. Use 'Unassigned' as the owner when nobody took the task on. Answer None if there are none."
+	// maxOwnerWords bounds the words before a colon that are read as the owner of a task
+	maxOwnerWords = 4
+)
+
+// LemurClient is the part of the AssemblyAI client used for LeMUR action items
+type LemurClient interface {
+	LemurActionItems(ctx context.Context, request *assemblyai.LemurActionItemsRequest) (*assemblyai.LemurActionItemsResponse, error)
+}
+
+// LemurExtractor extracts action items from AssemblyAI transcripts with LeMUR. LeMUR does not say
+// where in the transcript a task came from, so each task is located by the words it shares with
+// the segments.
+type LemurExtractor struct {
+	client LemurClient
+	model  string
+}
+
+// NewLemurExtractor creates a LeMUR extractor. An empty model uses AssemblyAI's default.
+func NewLemurExtractor(client LemurClient, model string) *LemurExtractor {
+	return &LemurExtractor{client: client, model: model}
+}
+
+// Supports returns true for transcriptions made by AssemblyAI
+func (e *LemurExtractor) Supports(transcription *transcriptionEntities.Transcription) bool {
+	return transcription.Provider == "assemblyai" && transcription.ProviderTranscriptID != ""
+}
+
+// Extract asks LeMUR for the action items of the transcript
+func (e *LemurExtractor) Extract(ctx context.Context, request services.ExtractionRequest) (*services.ExtractionResult, error) {
+	var finalModel *string
+	if e.model != "" {
+		finalModel = assemblyai.String(e.model)
+	}
+
+	response, err := e.client.LemurActionItems(ctx, &assemblyai.LemurActionItemsRequest{
+		TranscriptIDs: []string{request.Transcription.ProviderTranscriptID},
+		Context:       assemblyai.String(lemurActionItemsContext),
+		AnswerFormat:  assemblyai.String(lemurActionItemsAnswerFormat),
+		FinalModel:    finalModel,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("LeMUR action items failed: %w", err)
+	}
+
+	lines := response.ActionItems
+	if len(lines) == 0 {
+		lines = strings.Split(response.Response, "\n")
+	}
+
+	result := &services.ExtractionResult{
+		ActionItems: []services.ExtractedActionItem{},
+		Method:      services.LemurExtractionMethod,
+		Model:       e.model,
+	}
+	for _, line := range lines {
+		owner, task := splitOwner(listMarker.ReplaceAllString(strings.TrimSpace(line), ""))
+		if task == "" || strings.EqualFold(strings.TrimRight(task, "."), "none") {
+			continue
+		}
+		result.ActionItems = append(result.ActionItems, newExtractedActionItem(task, owner, matchSegment(request.Segments, task)))
+	}
+	return result, nil
+}
+
+// splitOwner splits "Owner: task" lines; unassigned tasks and lines without an owner have none
+func splitOwner(line string) (string, string) {
+	owner, task, found := strings.Cut(line, ":")
+	if !found || len(strings.Fields(owner)) > maxOwnerWords {
+		return "", strings.TrimSpace(line)
+	}
+	owner = strings.TrimSpace(owner)
+	if strings.EqualFold(owner, "unassigned") || strings.EqualFold(owner, "none") {
+		owner = ""
+	}
+	return owner, strings.TrimSpace(task)
+}
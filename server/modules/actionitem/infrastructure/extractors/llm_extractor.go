@@ -0,0 +1,152 @@
+package extractors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/services"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+	transcriptionServices "teammate/server/modules/transcription/domain/services"
+)
+
+// Ensure LLMExtractor implements ActionItemExtractor
+var _ services.ActionItemExtractor = (*LLMExtractor)(nil)
+
+const (
+	// maxPromptTranscriptLength bounds the transcript sent in a single prompt; longer
+	// transcripts are read in several parts
+	maxPromptTranscriptLength = 24000
+
+	llmExtractionSystemPrompt = `You extract action items from meeting transcripts. An action item is a task someone took on or was asked to do. ` +
+		`Reply with a JSON array only, without any other text. Each element has the fields "title" (a short imperative title), ` +
+		`"description" (the task in one or two sentences), "assignee" (the name of the person responsible, or an empty string), ` +
+		`"priority" (low, medium, high or urgent), "due_date" (YYYY-MM-DD if a date was agreed, otherwise null) ` +
+		`and "source" (the number of the transcript line the task was mentioned in). Reply [] if there are no action items.`
+)
+
+// llmActionItem is an action item as the language model is asked to return it
+type llmActionItem struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Assignee    string  `json:"assignee"`
+	Priority    string  `json:"priority"`
+	DueDate     *string `json:"due_date"`
+	Source      int     `json:"source"`
+}
+
+// LLMExtractor extracts action items with a language model that is given the numbered transcript
+// lines and names the line each task came from
+type LLMExtractor struct {
+	model transcriptionServices.LanguageModel
+}
+
+// NewLLMExtractor creates a language model extractor
+func NewLLMExtractor(model transcriptionServices.LanguageModel) *LLMExtractor {
+	return &LLMExtractor{model: model}
+}
+
+// Supports returns true for every transcription
+func (e *LLMExtractor) Supports(transcription *transcriptionEntities.Transcription) bool {
+	return true
+}
+
+// Extract asks the language model for the action items of each part of the transcript
+func (e *LLMExtractor) Extract(ctx context.Context, request services.ExtractionRequest) (*services.ExtractionResult, error) {
+	result := &services.ExtractionResult{
+		ActionItems: []services.ExtractedActionItem{},
+		Method:      services.LLMExtractionMethod,
+		Model:       e.model.Name(),
+	}
+
+	for _, part := range transcriptParts(request.Segments) {
+		response, err := e.model.Complete(ctx, llmExtractionSystemPrompt, formatTranscript(request.Segments, part[0], part[1]))
+		if err != nil {
+			return nil, fmt.Errorf("language model extraction failed: %w", err)
+		}
+		items, err := parseLLMActionItems(response)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			task := item.Description
+			if strings.TrimSpace(task) == "" {
+				task = item.Title
+			}
+			if strings.TrimSpace(task) == "" {
+				continue
+			}
+
+			source := item.Source - 1
+			if source < part[0] || source >= part[1] {
+				source = matchSegment(request.Segments[part[0]:part[1]], task)
+				if source >= 0 {
+					source += part[0]
+				}
+			}
+
+			extracted := newExtractedActionItem(task, item.Assignee, source)
+			if title := strings.TrimSpace(item.Title); title != "" {
+				extracted.Title = titleFromTask(title)
+			}
+			extracted.Priority = parsePriority(item.Priority)
+			if item.DueDate != nil {
+				if dueDate, err := time.Parse("2006-01-02", strings.TrimSpace(*item.DueDate)); err == nil {
+					extracted.DueDate = &dueDate
+				}
+			}
+			result.ActionItems = append(result.ActionItems, extracted)
+		}
+	}
+	return result, nil
+}
+
+// transcriptParts splits the segments into [start, end) ranges that each fit in one prompt
+func transcriptParts(segments []transcriptionEntities.TranscriptSegment) [][2]int {
+	var parts [][2]int
+	start, length := 0, 0
+	for i, segment := range segments {
+		lineLength := len(segment.Speaker) + len(segment.Text) + 16
+		if length+lineLength > maxPromptTranscriptLength && i > start {
+			parts = append(parts, [2]int{start, i})
+			start, length = i, 0
+		}
+		length += lineLength
+	}
+	if start < len(segments) {
+		parts = append(parts, [2]int{start, len(segments)})
+	}
+	return parts
+}
+
+// formatTranscript numbers the transcript lines from start to end as [n] Speaker: text
+func formatTranscript(segments []transcriptionEntities.TranscriptSegment, start, end int) string {
+	var prompt strings.Builder
+	prompt.WriteString("Transcript:\n")
+	for i := start; i < end; i++ {
+		speaker := segments[i].Speaker
+		if speaker == "" {
+			speaker = "Unknown speaker"
+		}
+		fmt.Fprintf(&prompt, "[%d] %s: %s\n", i+1, speaker, strings.TrimSpace(segments[i].Text))
+	}
+	return prompt.String()
+}
+
+// parseLLMActionItems reads the JSON array in a response, ignoring any text or code fences around it
+func parseLLMActionItems(response string) ([]llmActionItem, error) {
+	start := strings.Index(response, "[")
+	end := strings.LastIndex(response, "]")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("language model did not return a JSON array")
+	}
+
+	var items []llmActionItem
+	if err := json.Unmarshal([]byte(response[start:end+1]), &items); err != nil {
+		return nil, fmt.Errorf("failed to parse action items from language model: %w", err)
+	}
+	return items, nil
+}
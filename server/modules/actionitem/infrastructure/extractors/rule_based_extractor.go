@@ -0,0 +1,118 @@
+package extractors
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"teammate/server/modules/actionitem/domain/services"
+	transcriptionEntities "teammate/server/modules/transcription/domain/entities"
+)
+
+// Ensure RuleBasedExtractor implements ActionItemExtractor
+var _ services.ActionItemExtractor = (*RuleBasedExtractor)(nil)
+
+// minTaskWords is the fewest words a phrase needs to be taken for a task
+const minTaskWords = 2
+
+var (
+	sentencePattern = regexp.MustCompile(`[^.!?]+[.!?]*`)
+	// commitmentPattern matches the speaker taking a task on, e.g. "I'll send the deck"
+	commitmentPattern = regexp.MustCompile(`(?i)^(?:(?:ok(?:ay)?|so|sure|yes|yeah|and|then)\W+)*(?:i'll|i will|i'm going to|i am going to|i can|let me)\s+(.+)$`)
+	// requestPattern matches someone being asked to do something, e.g. "Ben, can you send the deck?"
+	requestPattern = regexp.MustCompile(`(?i)^(?:([\p{L}][\p{L}'-]*)\W+)?(?:can|could|would|will) you(?: please)?\s+(.+)$`)
+	// todoPattern matches tasks called out as such, e.g. "TODO: update the roadmap"
+	todoPattern = regexp.MustCompile(`(?i)\b(?:todo|to-do|action item)\b\W*(.+)$`)
+	// notATask matches the small talk the patterns above would otherwise pick up
+	notATask = regexp.MustCompile(`(?i)^(?:know|think|see|guess|say|hear|repeat|tell|explain|imagine|understand|be honest|share my screen|mute|unmute)\b`)
+	// fillerWords are words before "can you" that do not name the person asked
+	fillerWords = map[string]bool{
+		"ok": true, "okay": true, "so": true, "and": true, "also": true, "then": true, "hey": true,
+		"well": true, "yes": true, "yeah": true, "um": true, "actually": true, "great": true,
+	}
+)
+
+// RuleBasedExtractor finds action items offline with phrasing rules: commitments ("I'll…"),
+// requests ("can you…") and explicit TODOs. It works for every transcription and is the fallback
+// when no language model is available.
+type RuleBasedExtractor struct{}
+
+// NewRuleBasedExtractor creates a rule-based extractor
+func NewRuleBasedExtractor() *RuleBasedExtractor {
+	return &RuleBasedExtractor{}
+}
+
+// Supports returns true for every transcription
+func (e *RuleBasedExtractor) Supports(transcription *transcriptionEntities.Transcription) bool {
+	return true
+}
+
+// Extract applies the phrasing rules to every sentence of the transcript. Commitments are assigned
+// to the speaker, requests to the person addressed or else the next speaker to reply.
+func (e *RuleBasedExtractor) Extract(ctx context.Context, request services.ExtractionRequest) (*services.ExtractionResult, error) {
+	result := &services.ExtractionResult{
+		ActionItems: []services.ExtractedActionItem{},
+		Method:      services.RuleBasedExtractionMethod,
+	}
+	seen := make(map[string]bool)
+
+	for i, segment := range request.Segments {
+		for _, sentence := range sentencePattern.FindAllString(segment.Text, -1) {
+			task, assignee, ok := matchTask(strings.TrimSpace(sentence), request.Segments, i)
+			if !ok {
+				continue
+			}
+			item := newExtractedActionItem(task, assignee, i)
+			key := strings.ToLower(item.Title)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result.ActionItems = append(result.ActionItems, item)
+		}
+	}
+	return result, nil
+}
+
+// matchTask returns the task in a sentence of the segment at index and who it is for
+func matchTask(sentence string, segments []transcriptionEntities.TranscriptSegment, index int) (string, string, bool) {
+	speaker := segments[index].Speaker
+
+	if match := todoPattern.FindStringSubmatch(sentence); match != nil {
+		task, ok := cleanTask(match[1])
+		return task, "", ok
+	}
+	if match := commitmentPattern.FindStringSubmatch(sentence); match != nil {
+		task, ok := cleanTask(match[1])
+		return task, speaker, ok
+	}
+	if match := requestPattern.FindStringSubmatch(sentence); match != nil {
+		task, ok := cleanTask(match[2])
+		assignee := match[1]
+		if assignee == "" || fillerWords[strings.ToLower(assignee)] {
+			assignee = nextSpeaker(segments, index)
+		}
+		return task, assignee, ok
+	}
+	return "", "", false
+}
+
+// cleanTask trims a task and rejects phrases that are too short or not tasks at all
+func cleanTask(task string) (string, bool) {
+	task = strings.TrimSpace(strings.TrimRight(strings.TrimSpace(task), ".!?"))
+	if len(strings.Fields(task)) < minTaskWords || notATask.MatchString(task) {
+		return "", false
+	}
+	return task, true
+}
+
+// nextSpeaker returns the first other person to speak after the segment at index
+func nextSpeaker(segments []transcriptionEntities.TranscriptSegment, index int) string {
+	speaker := segments[index].Speaker
+	for _, segment := range segments[index+1:] {
+		if segment.Speaker != "" && segment.Speaker != speaker {
+			return segment.Speaker
+		}
+	}
+	return ""
+}
@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/repositories"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormActionItemRepository implements ActionItemRepository using GORM
+type GormActionItemRepository struct {
+	db *gorm.DB
+}
+
+// NewGormActionItemRepository creates a new GORM action item repository
+func NewGormActionItemRepository() *GormActionItemRepository {
+	return &GormActionItemRepository{db: database.GetDB()}
+}
+
+// Save stores a new action item together with its ticket references
+func (r *GormActionItemRepository) Save(ctx context.Context, actionItem *entities.ActionItem) error {
+	return r.db.WithContext(ctx).Create(actionItem).Error
+}
+
+// Update stores the action item's own fields; ticket references are left unchanged
+func (r *GormActionItemRepository) Update(ctx context.Context, actionItem *entities.ActionItem) error {
+	return r.db.WithContext(ctx).Omit("TicketReferences").Save(actionItem).Error
+}
+
+// SaveTicketReference stores a new ticket reference and the action item's own fields in a single transaction
+func (r *GormActionItemRepository) SaveTicketReference(ctx context.Context, actionItem *entities.ActionItem, reference *entities.TicketReference) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(reference).Error; err != nil {
+			return err
+		}
+		return tx.Omit("TicketReferences").Save(actionItem).Error
+	})
+}
+
+// UpdateTicketReference stores a ticket reference and the action item's own fields in a single transaction
+func (r *GormActionItemRepository) UpdateTicketReference(ctx context.Context, actionItem *entities.ActionItem, reference *entities.TicketReference) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(reference).Error; err != nil {
+			return err
+		}
+		return tx.Omit("TicketReferences").Save(actionItem).Error
+	})
+}
+
+// FindByID retrieves an action item with its ticket references, or nil if it does not exist
+func (r *GormActionItemRepository) FindByID(ctx context.Context, id string) (*entities.ActionItem, error) {
+	var actionItem entities.ActionItem
+	err := r.db.WithContext(ctx).
+		Preload("TicketReferences", "deleted_at IS NULL").
+		Where("deleted_at IS NULL").
+		First(&actionItem, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &actionItem, nil
+}
+
+// FindByTranscriptionID retrieves the action items extracted from a transcription
+func (r *GormActionItemRepository) FindByTranscriptionID(ctx context.Context, transcriptionID string) ([]*entities.ActionItem, error) {
+	var actionItems []*entities.ActionItem
+	err := r.db.WithContext(ctx).
+		Where("transcription_id = ? AND deleted_at IS NULL", transcriptionID).
+		Order("created_at").
+		Find(&actionItems).Error
+	return actionItems, err
+}
+
+// FindByMeetingID retrieves all action items of a meeting with their ticket references
+func (r *GormActionItemRepository) FindByMeetingID(ctx context.Context, meetingID string) ([]*entities.ActionItem, error) {
+	var actionItems []*entities.ActionItem
+	err := r.db.WithContext(ctx).
+		Preload("TicketReferences", "deleted_at IS NULL").
+		Where("meeting_id = ? AND deleted_at IS NULL", meetingID).
+		Order("due_date ASC NULLS LAST").
+		Order("created_at").
+		Find(&actionItems).Error
+	return actionItems, err
+}
+
+// FindByTicket retrieves the action items referencing a ticket in meetings the user owns or can edit
+func (r *GormActionItemRepository) FindByTicket(ctx context.Context, lookup repositories.TicketLookup) ([]*entities.ActionItem, error) {
+	references := r.db.
+		Table("ticket_references t").
+		Select("1").
+		Where("t.action_item_id = action_items.id AND t.deleted_at IS NULL").
+		Where("t.system = ? AND t.ticket_id = ?", lookup.System, lookup.TicketID)
+	if lookup.ProjectKey != "" {
+		references = references.Where("LOWER(t.project_key) = LOWER(?)", lookup.ProjectKey)
+	}
+
+	var actionItems []*entities.ActionItem
+	err := r.db.WithContext(ctx).
+		Select("action_items.*").
+		Joins("JOIN meetings m ON m.id = action_items.meeting_id AND m.deleted_at IS NULL").
+		Where("action_items.deleted_at IS NULL").
+		Where("EXISTS (?)", references).
+		Where("(m.user_id = ? OR EXISTS (SELECT 1 FROM meeting_shares s WHERE s.meeting_id = m.id AND s.shared_with_user_id = ? AND s.permission = 'edit' AND s.deleted_at IS NULL))",
+			lookup.UserID, lookup.UserID).
+		Preload("TicketReferences", "deleted_at IS NULL").
+		Order("action_items.id").
+		Find(&actionItems).Error
+	return actionItems, err
+}
+
+// FindTracked retrieves a batch of the action items whose tickets are followed
+func (r *GormActionItemRepository) FindTracked(ctx context.Context, afterID string, limit int) ([]*entities.ActionItem, error) {
+	var actionItems []*entities.ActionItem
+	err := r.db.WithContext(ctx).
+		Preload("TicketReferences", "deleted_at IS NULL").
+		Where("deleted_at IS NULL AND status IN ? AND id > ?", []string{string(entities.Created), string(entities.Completed)}, afterID).
+		Where("EXISTS (SELECT 1 FROM ticket_references t WHERE t.action_item_id = action_items.id AND t.deleted_at IS NULL)").
+		Order("id").
+		Limit(limit).
+		Find(&actionItems).Error
+	return actionItems, err
+}
+
+// ReplaceExtracted soft-deletes the unreviewed action items of a transcription and stores the
+// new ones in a single transaction
+func (r *GormActionItemRepository) ReplaceExtracted(ctx context.Context, transcriptionID string, actionItems []*entities.ActionItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&entities.ActionItem{}).
+			Where("transcription_id = ? AND status = ? AND deleted_at IS NULL", transcriptionID, string(entities.Extracted)).
+			Update("deleted_at", time.Now()).Error
+		if err != nil {
+			return err
+		}
+		if len(actionItems) == 0 {
+			return nil
+		}
+		return tx.Create(actionItems).Error
+	})
+}
+
+// Find retrieves a page of the action items in meetings the user can view that match the filter,
+// with the earliest due date first and undated items last, and their total
+func (r *GormActionItemRepository) Find(ctx context.Context, filter repositories.ActionItemFilter) ([]*entities.ActionItem, int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&entities.ActionItem{}).
+		Joins("JOIN meetings m ON m.id = action_items.meeting_id AND m.deleted_at IS NULL").
+		Where("action_items.deleted_at IS NULL").
+		Where("(m.user_id = ? OR EXISTS (SELECT 1 FROM meeting_shares s WHERE s.meeting_id = m.id AND s.shared_with_user_id = ? AND s.deleted_at IS NULL))",
+			filter.UserID, filter.UserID)
+
+	if filter.MeetingID != "" {
+		query = query.Where("action_items.meeting_id = ?", filter.MeetingID)
+	}
+	if filter.Status != "" {
+		query = query.Where("action_items.status = ?", string(filter.Status))
+	}
+	if filter.Assignee != "" {
+		query = query.Where("LOWER(action_items.assignee) = LOWER(?)", filter.Assignee)
+	}
+	if filter.Overdue {
+		query = query.Where("action_items.due_date < ? AND action_items.status NOT IN ?", filter.Now, []string{string(entities.Rejected), string(entities.Completed)})
+	}
+	if filter.NeedsConfirmation {
+		query = query.Where("action_items.assignee_resolution->>'status' = ?", string(entities.AssigneeAmbiguous))
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var actionItems []*entities.ActionItem
+	err := query.
+		Select("action_items.*").
+		Preload("TicketReferences", "deleted_at IS NULL").
+		Order("action_items.due_date ASC NULLS LAST").
+		Order("action_items.created_at DESC").
+		Order("action_items.id").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&actionItems).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return actionItems, total, nil
+}
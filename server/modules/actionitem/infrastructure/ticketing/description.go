@@ -0,0 +1,43 @@
+package ticketing
+
+import (
+	"fmt"
+	"strings"
+
+	"teammate/server/modules/actionitem/domain/services"
+)
+
+// markdownDescription writes the description of an action item as Markdown, followed by its
+// priority, due date and assignee and the part of the meeting it came from
+func markdownDescription(request services.TicketRequest) string {
+	var body strings.Builder
+	body.WriteString(strings.TrimSpace(request.Description))
+	body.WriteString("\n\n")
+
+	for _, detail := range ticketDetails(request) {
+		fmt.Fprintf(&body, "**%s:** %s\n", detail[0], detail[1])
+	}
+
+	if quote := strings.TrimSpace(request.Context); quote != "" {
+		body.WriteString("\nFrom the meeting:\n\n")
+		for _, line := range strings.Split(quote, "\n") {
+			fmt.Fprintf(&body, "> %s\n", line)
+		}
+	}
+	return strings.TrimSpace(body.String())
+}
+
+// ticketDetails returns the labelled priority, due date and assignee of an action item that are set
+func ticketDetails(request services.TicketRequest) [][2]string {
+	var details [][2]string
+	if request.Priority != "" {
+		details = append(details, [2]string{"Priority", string(request.Priority)})
+	}
+	if request.DueDate != nil {
+		details = append(details, [2]string{"Due", request.DueDate.Format("2006-01-02")})
+	}
+	if request.Assignee != "" {
+		details = append(details, [2]string{"Assignee", request.Assignee})
+	}
+	return details
+}
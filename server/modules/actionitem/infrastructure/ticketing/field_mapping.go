@@ -0,0 +1,95 @@
+package ticketing
+
+import (
+	"fmt"
+	"strings"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+)
+
+// FieldMapping maps the fields of an action item to the fields of a tracker. Users store it in
+// the field_mapping setting of their integration configuration:
+//
+//	"field_mapping": {
+//	  "priority": {"urgent": "Highest", "high": "High", "medium": "Medium", "low": "Low"},
+//	  "assignees": {"Ben": "5b10ac8d82e05b22cc7d4ef5", "anna@example.com": "712020:ad5c..."},
+//	  "due_date": "duedate"
+//	}
+type FieldMapping struct {
+	// Priorities maps action item priorities to the tracker's priorities; an empty value leaves
+	// the priority unset
+	Priorities map[entities.Priority]string
+	// Assignees maps assignee names or emails, ignoring case, to the tracker's user IDs
+	Assignees map[string]string
+	// DueDateField is the field that receives the due date where the tracker lets it be chosen;
+	// empty leaves the due date out
+	DueDateField string
+}
+
+// fieldMappingFromConfig reads the field_mapping setting of an integration configuration on top
+// of a provider's defaults
+func fieldMappingFromConfig(config map[string]interface{}, defaults FieldMapping) FieldMapping {
+	mapping := FieldMapping{
+		Priorities:   make(map[entities.Priority]string, len(defaults.Priorities)),
+		Assignees:    make(map[string]string),
+		DueDateField: defaults.DueDateField,
+	}
+	for priority, value := range defaults.Priorities {
+		mapping.Priorities[priority] = value
+	}
+
+	settings, _ := config["field_mapping"].(map[string]interface{})
+	if priorities, ok := settings["priority"].(map[string]interface{}); ok {
+		for priority, value := range priorities {
+			mapping.Priorities[entities.Priority(strings.ToLower(strings.TrimSpace(priority)))] = mappedValue(value)
+		}
+	}
+	if assignees, ok := settings["assignees"].(map[string]interface{}); ok {
+		for name, value := range assignees {
+			if id := mappedValue(value); id != "" {
+				mapping.Assignees[normalizeAssignee(name)] = id
+			}
+		}
+	}
+	if dueDateField, ok := settings["due_date"].(string); ok {
+		mapping.DueDateField = strings.TrimSpace(dueDateField)
+	}
+	return mapping
+}
+
+// Priority returns the tracker's priority for an action item priority
+func (m FieldMapping) Priority(priority entities.Priority) (string, bool) {
+	value := m.Priorities[priority]
+	return value, value != ""
+}
+
+// Assignee returns the tracker's user ID for an assignee
+func (m FieldMapping) Assignee(assignee string) (string, bool) {
+	id, ok := m.Assignees[normalizeAssignee(assignee)]
+	return id, ok && assignee != ""
+}
+
+// TicketAssignee returns the tracker's user ID a ticket is assigned to: the ID its assignee was
+// resolved to, or else the mapped ID of the assignee
+func (m FieldMapping) TicketAssignee(request services.TicketRequest) (string, bool) {
+	if request.AssigneeID != "" {
+		return request.AssigneeID, true
+	}
+	return m.Assignee(request.Assignee)
+}
+
+// mappedValue returns a mapped value written as a string or a number
+func mappedValue(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return strings.TrimSpace(value)
+	case float64:
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+func normalizeAssignee(assignee string) string {
+	return strings.ToLower(strings.TrimSpace(assignee))
+}
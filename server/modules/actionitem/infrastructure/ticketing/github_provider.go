@@ -0,0 +1,258 @@
+package ticketing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/services"
+)
+
+// Ensure GitHubProvider implements TicketingProvider and UserDirectory
+var (
+	_ services.TicketingProvider = (*GitHubProvider)(nil)
+	_ services.UserDirectory     = (*GitHubProvider)(nil)
+)
+
+const (
+	// GitHubProviderName is the name GitHub integrations and ticket references are recorded under
+	GitHubProviderName = "github"
+	// DefaultGitHubBaseURL is the GitHub REST API; GitHub Enterprise Server uses https://HOST/api/v3
+	DefaultGitHubBaseURL = "https://api.github.com"
+
+	githubRequestTimeout = 30 * time.Second
+	githubAPIVersion     = "2022-11-28"
+)
+
+var (
+	// githubRepositoryPattern matches repositories written as owner/name
+	githubRepositoryPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+	// githubIssueReference matches "123", "#123" and "owner/name#123"
+	githubIssueReference = regexp.MustCompile(`^(?:([A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+))?#?(\d+)$`)
+	// githubIssueURLPath matches the path of an issue or pull request page, /owner/name/issues/123
+	githubIssueURLPath = regexp.MustCompile(`^/([A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+)/(?:issues|pull)/(\d+)/?$`)
+)
+
+// GitHubConfig configures the GitHub issues of a user
+type GitHubConfig struct {
+	// Token is a personal access token allowed to read and write issues
+	Token string
+	// Repository is the default repository issues are opened in, as owner/name
+	Repository string
+	// Labels are added to every issue that is opened
+	Labels []string
+	// BaseURL is the REST API root; empty uses DefaultGitHubBaseURL
+	BaseURL string
+	// FieldMapping maps assignees to GitHub logins and priorities to labels; nothing is mapped by default
+	FieldMapping FieldMapping
+}
+
+// GitHubProvider opens GitHub issues for action items through the REST API
+type GitHubProvider struct {
+	config     GitHubConfig
+	httpClient *http.Client
+}
+
+// NewGitHubProvider creates a GitHub provider, checking that the configuration is complete
+func NewGitHubProvider(config GitHubConfig) (*GitHubProvider, error) {
+	if config.Token == "" {
+		return nil, errors.New("GitHub token is required")
+	}
+	if !githubRepositoryPattern.MatchString(config.Repository) {
+		return nil, errors.New("GitHub repository must be written as owner/name")
+	}
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultGitHubBaseURL
+	}
+	if parsed, err := url.Parse(config.BaseURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, errors.New("GitHub base URL must be an http(s) URL")
+	}
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+
+	return &GitHubProvider{
+		config:     config,
+		httpClient: &http.Client{Timeout: githubRequestTimeout},
+	}, nil
+}
+
+// newGitHubProviderFromConfig creates a GitHub provider from the settings token, repository,
+// labels, base_url and field_mapping of an integration configuration
+func newGitHubProviderFromConfig(config map[string]interface{}) (services.TicketingProvider, error) {
+	return NewGitHubProvider(GitHubConfig{
+		Token:        configString(config, "token"),
+		Repository:   configString(config, "repository"),
+		Labels:       configStrings(config, "labels"),
+		BaseURL:      configString(config, "base_url"),
+		FieldMapping: fieldMappingFromConfig(config, FieldMapping{}),
+	})
+}
+
+// githubIssue is the part of a GitHub issue the provider reads
+type githubIssue struct {
+	ID       int64      `json:"id"`
+	Number   int        `json:"number"`
+	HTMLURL  string     `json:"html_url"`
+	State    string     `json:"state"`
+	ClosedAt *time.Time `json:"closed_at"`
+	Assignee *struct {
+		Login string `json:"login"`
+	} `json:"assignee"`
+}
+
+// Name returns the name ticket references are recorded under
+func (p *GitHubProvider) Name() string {
+	return GitHubProviderName
+}
+
+// CreateTicket opens an issue in the requested or the default repository, assigned to the mapped
+// login of the assignee and labelled with the mapped priority
+func (p *GitHubProvider) CreateTicket(ctx context.Context, request services.TicketRequest) (*services.Ticket, error) {
+	repository := p.config.Repository
+	if request.Project != "" {
+		if !githubRepositoryPattern.MatchString(request.Project) {
+			return nil, fmt.Errorf("GitHub repository %q must be written as owner/name", request.Project)
+		}
+		repository = request.Project
+	}
+
+	body := map[string]interface{}{
+		"title": request.Title,
+		"body":  markdownDescription(request),
+	}
+	labels := append([]string{}, p.config.Labels...)
+	if label, ok := p.config.FieldMapping.Priority(request.Priority); ok {
+		labels = append(labels, label)
+	}
+	if len(labels) > 0 {
+		body["labels"] = labels
+	}
+	if login, ok := p.config.FieldMapping.TicketAssignee(request); ok {
+		body["assignees"] = []string{login}
+	}
+
+	var issue githubIssue
+	if err := p.do(ctx, http.MethodPost, "/repos/"+repository+"/issues", body, &issue); err != nil {
+		return nil, err
+	}
+	return githubTicket(repository, issue), nil
+}
+
+// FindTicket looks up an issue by number (#123) in the default repository, by owner/name#123,
+// or by the URL of its page
+func (p *GitHubProvider) FindTicket(ctx context.Context, reference string) (*services.Ticket, error) {
+	repository, number, err := p.parseReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue githubIssue
+	if err := p.do(ctx, http.MethodGet, "/repos/"+repository+"/issues/"+number, nil, &issue); err != nil {
+		return nil, err
+	}
+	return githubTicket(repository, issue), nil
+}
+
+// AssignableUsers returns the logins issues in the default repository can be assigned to
+func (p *GitHubProvider) AssignableUsers(ctx context.Context) ([]services.TicketUser, error) {
+	var assignees []struct {
+		Login string `json:"login"`
+	}
+	if err := p.do(ctx, http.MethodGet, "/repos/"+p.config.Repository+"/assignees?per_page=100", nil, &assignees); err != nil {
+		return nil, err
+	}
+
+	users := make([]services.TicketUser, len(assignees))
+	for i, assignee := range assignees {
+		users[i] = services.TicketUser{ID: assignee.Login, Name: assignee.Login}
+	}
+	return users, nil
+}
+
+// parseReference returns the repository and number of an issue reference
+func (p *GitHubProvider) parseReference(reference string) (string, string, error) {
+	reference = strings.TrimSpace(reference)
+	if match := githubIssueReference.FindStringSubmatch(reference); match != nil {
+		repository := match[1]
+		if repository == "" {
+			repository = p.config.Repository
+		}
+		return repository, match[2], nil
+	}
+	if parsed, err := url.Parse(reference); err == nil && parsed.Host != "" {
+		if match := githubIssueURLPath.FindStringSubmatch(parsed.Path); match != nil {
+			return match[1], match[2], nil
+		}
+	}
+	return "", "", fmt.Errorf("%w: %q is not a GitHub issue number, owner/name#number or issue URL", services.ErrInvalidTicketReference, reference)
+}
+
+// githubTicket converts an issue of a repository; closed issues are done
+func githubTicket(repository string, issue githubIssue) *services.Ticket {
+	ticket := &services.Ticket{
+		ID:       strconv.Itoa(issue.Number),
+		URL:      issue.HTMLURL,
+		Project:  repository,
+		Status:   issue.State,
+		Closed:   issue.State == "closed",
+		ClosedAt: issue.ClosedAt,
+		Metadata: map[string]interface{}{"issue_id": issue.ID},
+	}
+	if issue.Assignee != nil {
+		ticket.Assignee = issue.Assignee.Login
+	}
+	return ticket
+}
+
+// do sends a request to the GitHub API and decodes the response into result
+func (p *GitHubProvider) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, p.config.BaseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+p.config.Token)
+	request.Header.Set("Accept", "application/vnd.github+json")
+	request.Header.Set("X-GitHub-Api-Version", githubAPIVersion)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("GitHub request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound && method == http.MethodGet {
+		return services.ErrTicketNotFound
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		var apiError struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(io.LimitReader(response.Body, 1<<20)).Decode(&apiError)
+		if apiError.Message == "" {
+			apiError.Message = response.Status
+		}
+		return fmt.Errorf("GitHub API returned %d: %s", response.StatusCode, apiError.Message)
+	}
+
+	return json.NewDecoder(response.Body).Decode(result)
+}
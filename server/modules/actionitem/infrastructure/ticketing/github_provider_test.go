@@ -0,0 +1,129 @@
+package ticketing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGitHubStandIn serves issue 42 of team/app and records the issues opened in any repository
+func newGitHubStandIn(t *testing.T, opened *[]map[string]interface{}) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/team/app/issues/42", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 900, "number": 42, "html_url": "https://github.com/team/app/issues/42", "state": "closed"})
+	})
+	mux.HandleFunc("/repos/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"message": "Not Found"})
+			return
+		}
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/vnd.github+json", r.Header.Get("Accept"))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*opened = append(*opened, body)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": 901, "number": 43, "html_url": "https://github.com" + r.URL.Path[len("/repos"):len(r.URL.Path)-len("/issues")] + "/issues/43", "state": "open"})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGitHubProvider_CreateTicket(t *testing.T) {
+	var opened []map[string]interface{}
+	server := newGitHubStandIn(t, &opened)
+
+	provider, err := NewProviderRegistry().NewProvider(GitHubProviderName, map[string]interface{}{
+		"token":      "test-token",
+		"repository": "team/app",
+		"labels":     []interface{}{"meeting", " follow-up "},
+		"base_url":   server.URL,
+		"field_mapping": map[string]interface{}{
+			"priority":  map[string]interface{}{"high": "priority: high"},
+			"assignees": map[string]interface{}{"Ben": "ben-dev"},
+		},
+	})
+	require.NoError(t, err)
+
+	dueDate := time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
+	ticket, err := provider.CreateTicket(context.Background(), services.TicketRequest{
+		Title:       "Send the launch plan",
+		Description: "Share the plan with marketing",
+		Assignee:    "Ben",
+		Priority:    entities.High,
+		DueDate:     &dueDate,
+		Context:     "Anna: Who sends the plan?\nBen: I'll send it.",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "43", ticket.ID)
+	assert.Equal(t, "https://github.com/team/app/issues/43", ticket.URL)
+	assert.Equal(t, "team/app", ticket.Project)
+	assert.Equal(t, "open", ticket.Status)
+
+	require.Len(t, opened, 1)
+	assert.Equal(t, "Send the launch plan", opened[0]["title"])
+	assert.Equal(t, []interface{}{"meeting", "follow-up", "priority: high"}, opened[0]["labels"])
+	assert.Equal(t, []interface{}{"ben-dev"}, opened[0]["assignees"])
+	assert.Contains(t, opened[0]["body"], "**Priority:** high")
+	assert.Contains(t, opened[0]["body"], "**Due:** 2026-03-12")
+	assert.Contains(t, opened[0]["body"], "> Ben: I'll send it.")
+
+	ticket, err = provider.CreateTicket(context.Background(), services.TicketRequest{Title: "Book the venue", Project: "team/events"})
+	require.NoError(t, err)
+	assert.Equal(t, "team/events", ticket.Project)
+
+	_, err = provider.CreateTicket(context.Background(), services.TicketRequest{Title: "Book the venue", Project: "events"})
+	assert.Error(t, err)
+}
+
+func TestGitHubProvider_FindTicket(t *testing.T) {
+	var opened []map[string]interface{}
+	server := newGitHubStandIn(t, &opened)
+
+	provider, err := NewGitHubProvider(GitHubConfig{Token: "test-token", Repository: "team/app", BaseURL: server.URL})
+	require.NoError(t, err)
+
+	for _, reference := range []string{"42", "#42", "team/app#42", "https://github.com/team/app/issues/42"} {
+		ticket, err := provider.FindTicket(context.Background(), reference)
+		require.NoError(t, err, reference)
+		assert.Equal(t, "42", ticket.ID)
+		assert.Equal(t, "closed", ticket.Status)
+		assert.Equal(t, int64(900), ticket.Metadata["issue_id"])
+	}
+
+	_, err = provider.FindTicket(context.Background(), "#41")
+	assert.True(t, errors.Is(err, services.ErrTicketNotFound))
+
+	_, err = provider.FindTicket(context.Background(), "launch plan")
+	assert.True(t, errors.Is(err, services.ErrInvalidTicketReference))
+}
+
+func TestGitHubProvider_Config(t *testing.T) {
+	registry := NewProviderRegistry()
+	assert.True(t, registry.Supports(GitHubProviderName))
+	assert.False(t, registry.Supports("trello"))
+
+	_, err := registry.NewProvider(GitHubProviderName, map[string]interface{}{"repository": "team/app"})
+	assert.Error(t, err)
+	_, err = registry.NewProvider(GitHubProviderName, map[string]interface{}{"token": "t", "repository": "app"})
+	assert.Error(t, err)
+	_, err = registry.NewProvider(GitHubProviderName, map[string]interface{}{"token": "t", "repository": "team/app", "base_url": "ftp://example.com"})
+	assert.Error(t, err)
+	_, err = registry.NewProvider("trello", map[string]interface{}{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,367 @@
+package ticketing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+)
+
+// Ensure JiraProvider implements TicketingProvider and UserDirectory
+var (
+	_ services.TicketingProvider = (*JiraProvider)(nil)
+	_ services.UserDirectory     = (*JiraProvider)(nil)
+)
+
+const (
+	// JiraProviderName is the name Jira integrations and ticket references are recorded under
+	JiraProviderName = "jira"
+	// DefaultJiraIssueType is the issue type action items are opened as
+	DefaultJiraIssueType = "Task"
+
+	jiraRequestTimeout = 30 * time.Second
+)
+
+var (
+	// jiraProjectKeyPattern matches project keys such as OPS or WEB2
+	jiraProjectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+$`)
+	// jiraIssueKeyPattern matches issue keys such as OPS-123
+	jiraIssueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-\d+$`)
+	// jiraIssueURLPath matches the path of an issue page, /browse/OPS-123
+	jiraIssueURLPath = regexp.MustCompile(`/browse/([A-Z][A-Z0-9_]+-\d+)/?$`)
+)
+
+// defaultJiraFieldMapping maps action item priorities to Jira's default priority scheme and due
+// dates to the system due date field
+var defaultJiraFieldMapping = FieldMapping{
+	Priorities: map[entities.Priority]string{
+		entities.Urgent: "Highest",
+		entities.High:   "High",
+		entities.Medium: "Medium",
+		entities.Low:    "Low",
+	},
+	DueDateField: "duedate",
+}
+
+// JiraConfig configures the Jira issues of a user
+type JiraConfig struct {
+	// BaseURL is the site, e.g. https://acme.atlassian.net
+	BaseURL string
+	// Email and APIToken authenticate against Jira Cloud. Without an email the token is sent as a
+	// personal access token, as Jira Data Center expects.
+	Email    string
+	APIToken string
+	// ProjectKey is the default project issues are opened in
+	ProjectKey string
+	// IssueType is the type issues are opened as; empty uses DefaultJiraIssueType
+	IssueType string
+	// Labels are added to every issue that is opened
+	Labels []string
+	// FieldMapping maps priorities to Jira priority names, assignees to account IDs and the due
+	// date to a field
+	FieldMapping FieldMapping
+}
+
+// JiraProvider opens Jira issues for action items through the REST API v3
+type JiraProvider struct {
+	config     JiraConfig
+	httpClient *http.Client
+}
+
+// NewJiraProvider creates a Jira provider, checking that the configuration is complete
+func NewJiraProvider(config JiraConfig) (*JiraProvider, error) {
+	if parsed, err := url.Parse(config.BaseURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, errors.New("Jira base URL must be an http(s) URL such as https://acme.atlassian.net")
+	}
+	if config.APIToken == "" {
+		return nil, errors.New("Jira API token is required")
+	}
+	if !jiraProjectKeyPattern.MatchString(config.ProjectKey) {
+		return nil, errors.New("Jira project key must be written in capitals, such as OPS")
+	}
+	if config.IssueType == "" {
+		config.IssueType = DefaultJiraIssueType
+	}
+	if config.FieldMapping.Priorities == nil {
+		config.FieldMapping = fieldMappingFromConfig(nil, defaultJiraFieldMapping)
+	}
+	config.BaseURL = strings.TrimRight(config.BaseURL, "/")
+
+	return &JiraProvider{
+		config:     config,
+		httpClient: &http.Client{Timeout: jiraRequestTimeout},
+	}, nil
+}
+
+// newJiraProviderFromConfig creates a Jira provider from the settings base_url, email, api_token,
+// project_key, issue_type, labels and field_mapping of an integration configuration
+func newJiraProviderFromConfig(config map[string]interface{}) (services.TicketingProvider, error) {
+	return NewJiraProvider(JiraConfig{
+		BaseURL:      configString(config, "base_url"),
+		Email:        configString(config, "email"),
+		APIToken:     configString(config, "api_token"),
+		ProjectKey:   configString(config, "project_key"),
+		IssueType:    configString(config, "issue_type"),
+		Labels:       configStrings(config, "labels"),
+		FieldMapping: fieldMappingFromConfig(config, defaultJiraFieldMapping),
+	})
+}
+
+// jiraTimeLayout is how Jira writes timestamps, such as 2026-03-10T12:00:00.000+0000
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// jiraIssue is the part of a Jira issue the provider reads
+type jiraIssue struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Self   string `json:"self"`
+	Fields struct {
+		Status *struct {
+			Name           string `json:"name"`
+			StatusCategory struct {
+				Key string `json:"key"`
+			} `json:"statusCategory"`
+		} `json:"status"`
+		Assignee *struct {
+			AccountID   string `json:"accountId"`
+			DisplayName string `json:"displayName"`
+		} `json:"assignee"`
+		ResolutionDate string `json:"resolutiondate"`
+	} `json:"fields"`
+}
+
+// Name returns the name ticket references are recorded under
+func (p *JiraProvider) Name() string {
+	return JiraProviderName
+}
+
+// CreateTicket opens an issue in the requested or the default project. The priority, assignee
+// and due date are set through the field mapping; an assignee without an account ID is only
+// named in the description.
+func (p *JiraProvider) CreateTicket(ctx context.Context, request services.TicketRequest) (*services.Ticket, error) {
+	projectKey := p.config.ProjectKey
+	if request.Project != "" {
+		if !jiraProjectKeyPattern.MatchString(request.Project) {
+			return nil, fmt.Errorf("Jira project key %q must be written in capitals, such as OPS", request.Project)
+		}
+		projectKey = request.Project
+	}
+
+	fields := map[string]interface{}{
+		"project":     map[string]string{"key": projectKey},
+		"issuetype":   map[string]string{"name": p.config.IssueType},
+		"summary":     request.Title,
+		"description": adfDocument(request),
+	}
+	if len(p.config.Labels) > 0 {
+		fields["labels"] = p.config.Labels
+	}
+	if priority, ok := p.config.FieldMapping.Priority(request.Priority); ok {
+		fields["priority"] = map[string]string{"name": priority}
+	}
+	if accountID, ok := p.config.FieldMapping.TicketAssignee(request); ok {
+		fields["assignee"] = map[string]string{"accountId": accountID}
+	}
+	if request.DueDate != nil && p.config.FieldMapping.DueDateField != "" {
+		fields[p.config.FieldMapping.DueDateField] = request.DueDate.Format("2006-01-02")
+	}
+
+	var issue jiraIssue
+	if err := p.do(ctx, http.MethodPost, "/rest/api/3/issue", map[string]interface{}{"fields": fields}, &issue); err != nil {
+		return nil, err
+	}
+	return jiraTicket(p.config.BaseURL, issue), nil
+}
+
+// FindTicket looks up an issue by key (OPS-123) or by the URL of its page
+func (p *JiraProvider) FindTicket(ctx context.Context, reference string) (*services.Ticket, error) {
+	key, ok := jiraIssueKey(reference)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a Jira issue key or issue URL", services.ErrInvalidTicketReference, reference)
+	}
+
+	var issue jiraIssue
+	if err := p.do(ctx, http.MethodGet, "/rest/api/3/issue/"+key+"?fields=status,assignee,resolutiondate", nil, &issue); err != nil {
+		return nil, err
+	}
+	return jiraTicket(p.config.BaseURL, issue), nil
+}
+
+// AssignableUsers returns the people issues in the default project can be assigned to. Jira only
+// returns email addresses its users chose to make visible.
+func (p *JiraProvider) AssignableUsers(ctx context.Context) ([]services.TicketUser, error) {
+	var accounts []struct {
+		AccountID    string `json:"accountId"`
+		AccountType  string `json:"accountType"`
+		DisplayName  string `json:"displayName"`
+		EmailAddress string `json:"emailAddress"`
+		Active       bool   `json:"active"`
+	}
+	path := "/rest/api/3/user/assignable/search?maxResults=1000&project=" + url.QueryEscape(p.config.ProjectKey)
+	if err := p.do(ctx, http.MethodGet, path, nil, &accounts); err != nil {
+		return nil, err
+	}
+
+	var users []services.TicketUser
+	for _, account := range accounts {
+		// Apps and integrations are assignable too but are not people
+		if !account.Active || (account.AccountType != "" && account.AccountType != "atlassian") {
+			continue
+		}
+		users = append(users, services.TicketUser{ID: account.AccountID, Name: account.DisplayName, Email: account.EmailAddress})
+	}
+	return users, nil
+}
+
+// jiraIssueKey returns the issue key of a reference written as a key or an issue URL
+func jiraIssueKey(reference string) (string, bool) {
+	reference = strings.TrimSpace(reference)
+	if jiraIssueKeyPattern.MatchString(reference) {
+		return reference, true
+	}
+	if parsed, err := url.Parse(reference); err == nil && parsed.Host != "" {
+		if match := jiraIssueURLPath.FindStringSubmatch(parsed.Path); match != nil {
+			return match[1], true
+		}
+	}
+	return "", false
+}
+
+// jiraTicket converts an issue of a site; issues whose status is in the done category are closed
+func jiraTicket(baseURL string, issue jiraIssue) *services.Ticket {
+	ticket := &services.Ticket{
+		ID:       issue.Key,
+		URL:      baseURL + "/browse/" + issue.Key,
+		Project:  issue.Key[:strings.LastIndex(issue.Key, "-")],
+		Metadata: map[string]interface{}{"issue_id": issue.ID},
+	}
+	if status := issue.Fields.Status; status != nil {
+		ticket.Status = status.Name
+		ticket.Closed = status.StatusCategory.Key == "done"
+		ticket.Metadata["status_category"] = status.StatusCategory.Key
+	}
+	if assignee := issue.Fields.Assignee; assignee != nil {
+		ticket.Assignee = assignee.DisplayName
+		ticket.Metadata["assignee_account_id"] = assignee.AccountID
+	}
+	if resolved, err := time.Parse(jiraTimeLayout, issue.Fields.ResolutionDate); err == nil {
+		ticket.ClosedAt = &resolved
+	}
+	return ticket
+}
+
+// do sends a request to the Jira API and decodes the response into result
+func (p *JiraProvider) do(ctx context.Context, method, path string, body, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, p.config.BaseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if p.config.Email != "" {
+		request.SetBasicAuth(p.config.Email, p.config.APIToken)
+	} else {
+		request.Header.Set("Authorization", "Bearer "+p.config.APIToken)
+	}
+	request.Header.Set("Accept", "application/json")
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("Jira request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound && method == http.MethodGet {
+		return services.ErrTicketNotFound
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("Jira API returned %d: %s", response.StatusCode, jiraErrorMessage(response))
+	}
+
+	return json.NewDecoder(response.Body).Decode(result)
+}
+
+// jiraErrorMessage returns the error messages of a failed Jira response, including the fields
+// that were rejected
+func jiraErrorMessage(response *http.Response) string {
+	var apiError struct {
+		ErrorMessages []string          `json:"errorMessages"`
+		Errors        map[string]string `json:"errors"`
+	}
+	json.NewDecoder(io.LimitReader(response.Body, 1<<20)).Decode(&apiError)
+
+	messages := apiError.ErrorMessages
+	fields := make([]string, 0, len(apiError.Errors))
+	for field := range apiError.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		messages = append(messages, field+": "+apiError.Errors[field])
+	}
+	if len(messages) == 0 {
+		return response.Status
+	}
+	return strings.Join(messages, "; ")
+}
+
+// adfDocument writes the description of an action item in the Atlassian Document Format that
+// Jira's REST API v3 expects
+func adfDocument(request services.TicketRequest) map[string]interface{} {
+	content := []interface{}{}
+	for _, paragraph := range strings.Split(strings.TrimSpace(request.Description), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			content = append(content, adfParagraph(adfText(paragraph, false)))
+		}
+	}
+
+	for _, detail := range ticketDetails(request) {
+		content = append(content, adfParagraph(adfText(detail[0]+": ", true), adfText(detail[1], false)))
+	}
+
+	if quote := strings.TrimSpace(request.Context); quote != "" {
+		content = append(content, adfParagraph(adfText("From the meeting:", false)))
+		lines := []interface{}{}
+		for _, line := range strings.Split(quote, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, adfParagraph(adfText(line, false)))
+			}
+		}
+		content = append(content, map[string]interface{}{"type": "blockquote", "content": lines})
+	}
+
+	return map[string]interface{}{"type": "doc", "version": 1, "content": content}
+}
+
+func adfParagraph(nodes ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"type": "paragraph", "content": nodes}
+}
+
+func adfText(text string, strong bool) map[string]interface{} {
+	node := map[string]interface{}{"type": "text", "text": text}
+	if strong {
+		node["marks"] = []interface{}{map[string]string{"type": "strong"}}
+	}
+	return node
+}
@@ -0,0 +1,171 @@
+package ticketing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newJiraStandIn serves issue OPS-7 and the users of project OPS, and records the fields of the issues opened
+func newJiraStandIn(t *testing.T, opened *[]map[string]interface{}) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/3/user/assignable/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "OPS", r.URL.Query().Get("project"))
+		json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"accountId": "acc-sam", "accountType": "atlassian", "displayName": "Sam Lee", "emailAddress": "sam@example.com", "active": true},
+			{"accountId": "acc-left", "accountType": "atlassian", "displayName": "Former Colleague", "active": false},
+			{"accountId": "acc-bot", "accountType": "app", "displayName": "Automation for Jira", "active": true},
+		})
+	})
+	mux.HandleFunc("/rest/api/3/issue", func(w http.ResponseWriter, r *http.Request) {
+		user, token, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "anna@example.com", user)
+		assert.Equal(t, "jira-token", token)
+
+		var body struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		*opened = append(*opened, body.Fields)
+
+		project := body.Fields["project"].(map[string]interface{})["key"].(string)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"id": "10001", "key": project + "-8"})
+	})
+	mux.HandleFunc("/rest/api/3/issue/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/OPS-7" {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]interface{}{"errorMessages": []string{"Issue does not exist"}})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"id":     "10000",
+			"key":    "OPS-7",
+			"fields": map[string]interface{}{"status": map[string]interface{}{"name": "Done", "statusCategory": map[string]string{"key": "done"}}},
+		})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestJiraProvider_CreateTicket(t *testing.T) {
+	var opened []map[string]interface{}
+	server := newJiraStandIn(t, &opened)
+
+	provider, err := NewProviderRegistry().NewProvider(JiraProviderName, map[string]interface{}{
+		"base_url":    server.URL + "/",
+		"email":       "anna@example.com",
+		"api_token":   "jira-token",
+		"project_key": "OPS",
+		"labels":      "meeting",
+		"field_mapping": map[string]interface{}{
+			"priority":  map[string]interface{}{"urgent": "P1", "low": ""},
+			"assignees": map[string]interface{}{"Ben": "acc-ben"},
+			"due_date":  "customfield_10015",
+		},
+	})
+	require.NoError(t, err)
+
+	dueDate := time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
+	ticket, err := provider.CreateTicket(context.Background(), services.TicketRequest{
+		Title:       "Send the launch plan",
+		Description: "Share the plan with marketing",
+		Assignee:    "ben",
+		Priority:    entities.Urgent,
+		DueDate:     &dueDate,
+		Context:     "Ben: I'll send it.",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "OPS-8", ticket.ID)
+	assert.Equal(t, server.URL+"/browse/OPS-8", ticket.URL)
+	assert.Equal(t, "OPS", ticket.Project)
+
+	require.Len(t, opened, 1)
+	fields := opened[0]
+	assert.Equal(t, "Send the launch plan", fields["summary"])
+	assert.Equal(t, map[string]interface{}{"name": "Task"}, fields["issuetype"])
+	assert.Equal(t, []interface{}{"meeting"}, fields["labels"])
+	assert.Equal(t, map[string]interface{}{"name": "P1"}, fields["priority"])
+	assert.Equal(t, map[string]interface{}{"accountId": "acc-ben"}, fields["assignee"])
+	assert.Equal(t, "2026-03-12", fields["customfield_10015"])
+	assert.NotContains(t, fields, "duedate")
+	assert.Equal(t, "doc", fields["description"].(map[string]interface{})["type"])
+
+	// Priorities mapped to nothing and unknown assignees are left unset
+	ticket, err = provider.CreateTicket(context.Background(), services.TicketRequest{Title: "Book the venue", Assignee: "Carla", Priority: entities.Low, Project: "EVT"})
+	require.NoError(t, err)
+	assert.Equal(t, "EVT-8", ticket.ID)
+	assert.NotContains(t, opened[1], "priority")
+	assert.NotContains(t, opened[1], "assignee")
+
+	// The account an assignee was resolved to wins over the field mapping
+	_, err = provider.CreateTicket(context.Background(), services.TicketRequest{Title: "Book the venue", Assignee: "Ben", AssigneeID: "acc-benjamin"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"accountId": "acc-benjamin"}, opened[2]["assignee"])
+
+	_, err = provider.CreateTicket(context.Background(), services.TicketRequest{Title: "Book the venue", Project: "events"})
+	assert.Error(t, err)
+}
+
+func TestJiraProvider_FindTicket(t *testing.T) {
+	var opened []map[string]interface{}
+	server := newJiraStandIn(t, &opened)
+
+	provider, err := NewJiraProvider(JiraConfig{BaseURL: server.URL, Email: "anna@example.com", APIToken: "jira-token", ProjectKey: "OPS"})
+	require.NoError(t, err)
+
+	for _, reference := range []string{"OPS-7", "https://acme.atlassian.net/browse/OPS-7"} {
+		ticket, err := provider.FindTicket(context.Background(), reference)
+		require.NoError(t, err, reference)
+		assert.Equal(t, "OPS-7", ticket.ID)
+		assert.Equal(t, "OPS", ticket.Project)
+		assert.Equal(t, "Done", ticket.Status)
+		assert.Equal(t, "done", ticket.Metadata["status_category"])
+	}
+
+	_, err = provider.FindTicket(context.Background(), "OPS-9")
+	assert.True(t, errors.Is(err, services.ErrTicketNotFound))
+
+	_, err = provider.FindTicket(context.Background(), "#7")
+	assert.True(t, errors.Is(err, services.ErrInvalidTicketReference))
+}
+
+func TestJiraProvider_AssignableUsers(t *testing.T) {
+	var opened []map[string]interface{}
+	server := newJiraStandIn(t, &opened)
+
+	provider, err := NewJiraProvider(JiraConfig{BaseURL: server.URL, Email: "anna@example.com", APIToken: "jira-token", ProjectKey: "OPS"})
+	require.NoError(t, err)
+
+	users, err := provider.AssignableUsers(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []services.TicketUser{{ID: "acc-sam", Name: "Sam Lee", Email: "sam@example.com"}}, users)
+}
+
+func TestJiraProvider_Config(t *testing.T) {
+	_, err := NewJiraProvider(JiraConfig{BaseURL: "acme.atlassian.net", APIToken: "t", ProjectKey: "OPS"})
+	assert.Error(t, err)
+	_, err = NewJiraProvider(JiraConfig{BaseURL: "https://acme.atlassian.net", ProjectKey: "OPS"})
+	assert.Error(t, err)
+	_, err = NewJiraProvider(JiraConfig{BaseURL: "https://acme.atlassian.net", APIToken: "t", ProjectKey: "ops"})
+	assert.Error(t, err)
+
+	provider, err := NewJiraProvider(JiraConfig{BaseURL: "https://acme.atlassian.net", APIToken: "t", ProjectKey: "OPS"})
+	require.NoError(t, err)
+	priority, _ := provider.config.FieldMapping.Priority(entities.Urgent)
+	assert.Equal(t, "Highest", priority)
+	assert.Equal(t, "duedate", provider.config.FieldMapping.DueDateField)
+}
@@ -0,0 +1,327 @@
+package ticketing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+)
+
+// Ensure LinearProvider implements TicketingProvider and UserDirectory
+var (
+	_ services.TicketingProvider = (*LinearProvider)(nil)
+	_ services.UserDirectory     = (*LinearProvider)(nil)
+)
+
+const (
+	// LinearProviderName is the name Linear integrations and ticket references are recorded under
+	LinearProviderName = "linear"
+	// DefaultLinearAPIURL is Linear's GraphQL endpoint
+	DefaultLinearAPIURL = "https://api.linear.app/graphql"
+
+	linearRequestTimeout = 30 * time.Second
+)
+
+var (
+	// linearIssueIdentifierPattern matches issue identifiers such as ENG-123
+	linearIssueIdentifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*-\d+$`)
+	// linearIssueURLPath matches the path of an issue page, /acme/issue/ENG-123/title-slug
+	linearIssueURLPath = regexp.MustCompile(`/issue/([A-Za-z][A-Za-z0-9]*-\d+)(?:/|$)`)
+)
+
+// defaultLinearFieldMapping maps action item priorities to Linear's priorities, where 1 is urgent
+// and 4 is low. Linear issues always have a due date field.
+var defaultLinearFieldMapping = FieldMapping{
+	Priorities: map[entities.Priority]string{
+		entities.Urgent: "1",
+		entities.High:   "2",
+		entities.Medium: "3",
+		entities.Low:    "4",
+	},
+}
+
+// errLinearEntityNotFound is returned when a GraphQL request refers to an entity that does not exist
+var errLinearEntityNotFound = errors.New("Linear entity not found")
+
+const linearIssueFields = `id identifier url state { name type } team { key } assignee { name } completedAt canceledAt`
+
+// LinearConfig configures the Linear issues of a user
+type LinearConfig struct {
+	// APIKey is a personal API key
+	APIKey string
+	// TeamID is the ID of the default team issues are opened in
+	TeamID string
+	// LabelIDs are the IDs of labels added to every issue that is opened
+	LabelIDs []string
+	// APIURL is the GraphQL endpoint; empty uses DefaultLinearAPIURL
+	APIURL string
+	// FieldMapping maps priorities to Linear priorities (0 to 4) and assignees to user IDs
+	FieldMapping FieldMapping
+}
+
+// LinearProvider opens Linear issues for action items through the GraphQL API
+type LinearProvider struct {
+	config     LinearConfig
+	httpClient *http.Client
+}
+
+// NewLinearProvider creates a Linear provider, checking that the configuration is complete
+func NewLinearProvider(config LinearConfig) (*LinearProvider, error) {
+	if config.APIKey == "" {
+		return nil, errors.New("Linear API key is required")
+	}
+	if config.TeamID == "" {
+		return nil, errors.New("Linear team ID is required")
+	}
+	if config.APIURL == "" {
+		config.APIURL = DefaultLinearAPIURL
+	}
+	if parsed, err := url.Parse(config.APIURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, errors.New("Linear API URL must be an http(s) URL")
+	}
+	if config.FieldMapping.Priorities == nil {
+		config.FieldMapping = fieldMappingFromConfig(nil, defaultLinearFieldMapping)
+	}
+
+	return &LinearProvider{
+		config:     config,
+		httpClient: &http.Client{Timeout: linearRequestTimeout},
+	}, nil
+}
+
+// newLinearProviderFromConfig creates a Linear provider from the settings api_key, team_id,
+// label_ids, api_url and field_mapping of an integration configuration
+func newLinearProviderFromConfig(config map[string]interface{}) (services.TicketingProvider, error) {
+	return NewLinearProvider(LinearConfig{
+		APIKey:       configString(config, "api_key"),
+		TeamID:       configString(config, "team_id"),
+		LabelIDs:     configStrings(config, "label_ids"),
+		APIURL:       configString(config, "api_url"),
+		FieldMapping: fieldMappingFromConfig(config, defaultLinearFieldMapping),
+	})
+}
+
+// linearIssue is the part of a Linear issue the provider reads
+type linearIssue struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	URL        string `json:"url"`
+	State      *struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"state"`
+	Team struct {
+		Key string `json:"key"`
+	} `json:"team"`
+	Assignee *struct {
+		Name string `json:"name"`
+	} `json:"assignee"`
+	CompletedAt *time.Time `json:"completedAt"`
+	CanceledAt  *time.Time `json:"canceledAt"`
+}
+
+// Name returns the name ticket references are recorded under
+func (p *LinearProvider) Name() string {
+	return LinearProviderName
+}
+
+// CreateTicket opens an issue in the requested or the default team with the mapped priority and
+// assignee and the due date
+func (p *LinearProvider) CreateTicket(ctx context.Context, request services.TicketRequest) (*services.Ticket, error) {
+	teamID := p.config.TeamID
+	if request.Project != "" {
+		teamID = request.Project
+	}
+
+	input := map[string]interface{}{
+		"teamId":      teamID,
+		"title":       request.Title,
+		"description": markdownDescription(request),
+	}
+	if len(p.config.LabelIDs) > 0 {
+		input["labelIds"] = p.config.LabelIDs
+	}
+	if value, ok := p.config.FieldMapping.Priority(request.Priority); ok {
+		priority, err := strconv.Atoi(value)
+		if err != nil || priority < 0 || priority > 4 {
+			return nil, fmt.Errorf("Linear priority for %s must be a number from 0 to 4, not %q", request.Priority, value)
+		}
+		input["priority"] = priority
+	}
+	if userID, ok := p.config.FieldMapping.TicketAssignee(request); ok {
+		input["assigneeId"] = userID
+	}
+	if request.DueDate != nil {
+		input["dueDate"] = request.DueDate.Format("2006-01-02")
+	}
+
+	var data struct {
+		IssueCreate struct {
+			Success bool         `json:"success"`
+			Issue   *linearIssue `json:"issue"`
+		} `json:"issueCreate"`
+	}
+	query := `mutation IssueCreate($input: IssueCreateInput!) { issueCreate(input: $input) { success issue { ` + linearIssueFields + ` } } }`
+	if err := p.do(ctx, query, map[string]interface{}{"input": input}, &data); err != nil {
+		return nil, err
+	}
+	if !data.IssueCreate.Success || data.IssueCreate.Issue == nil {
+		return nil, errors.New("Linear did not create the issue")
+	}
+	return toLinearTicket(*data.IssueCreate.Issue), nil
+}
+
+// FindTicket looks up an issue by identifier (ENG-123) or by the URL of its page
+func (p *LinearProvider) FindTicket(ctx context.Context, reference string) (*services.Ticket, error) {
+	identifier, ok := linearIssueIdentifier(reference)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a Linear issue identifier or issue URL", services.ErrInvalidTicketReference, reference)
+	}
+
+	var data struct {
+		Issue *linearIssue `json:"issue"`
+	}
+	query := `query Issue($id: String!) { issue(id: $id) { ` + linearIssueFields + ` } }`
+	err := p.do(ctx, query, map[string]interface{}{"id": identifier}, &data)
+	if errors.Is(err, errLinearEntityNotFound) {
+		return nil, services.ErrTicketNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data.Issue == nil {
+		return nil, services.ErrTicketNotFound
+	}
+	return toLinearTicket(*data.Issue), nil
+}
+
+// AssignableUsers returns the active members of the default team
+func (p *LinearProvider) AssignableUsers(ctx context.Context) ([]services.TicketUser, error) {
+	var data struct {
+		Team *struct {
+			Members struct {
+				Nodes []struct {
+					ID     string `json:"id"`
+					Name   string `json:"name"`
+					Email  string `json:"email"`
+					Active bool   `json:"active"`
+				} `json:"nodes"`
+			} `json:"members"`
+		} `json:"team"`
+	}
+	query := `query TeamMembers($id: String!) { team(id: $id) { members(first: 250) { nodes { id name email active } } } }`
+	if err := p.do(ctx, query, map[string]interface{}{"id": p.config.TeamID}, &data); err != nil {
+		return nil, err
+	}
+	if data.Team == nil {
+		return nil, fmt.Errorf("Linear team %q not found", p.config.TeamID)
+	}
+
+	var users []services.TicketUser
+	for _, member := range data.Team.Members.Nodes {
+		if member.Active {
+			users = append(users, services.TicketUser{ID: member.ID, Name: member.Name, Email: member.Email})
+		}
+	}
+	return users, nil
+}
+
+// linearIssueIdentifier returns the identifier of a reference written as an identifier or an issue URL
+func linearIssueIdentifier(reference string) (string, bool) {
+	reference = strings.TrimSpace(reference)
+	if linearIssueIdentifierPattern.MatchString(reference) {
+		return strings.ToUpper(reference), true
+	}
+	if parsed, err := url.Parse(reference); err == nil && parsed.Host != "" {
+		if match := linearIssueURLPath.FindStringSubmatch(parsed.Path); match != nil {
+			return strings.ToUpper(match[1]), true
+		}
+	}
+	return "", false
+}
+
+// toLinearTicket converts an issue; completed and cancelled issues are closed
+func toLinearTicket(issue linearIssue) *services.Ticket {
+	ticket := &services.Ticket{
+		ID:       issue.Identifier,
+		URL:      issue.URL,
+		Project:  issue.Team.Key,
+		Metadata: map[string]interface{}{"issue_id": issue.ID},
+	}
+	if issue.State != nil {
+		ticket.Status = issue.State.Name
+		ticket.Closed = issue.State.Type == "completed" || issue.State.Type == "canceled"
+		ticket.Metadata["state_type"] = issue.State.Type
+	}
+	if issue.Assignee != nil {
+		ticket.Assignee = issue.Assignee.Name
+	}
+	if ticket.Closed {
+		ticket.ClosedAt = issue.CompletedAt
+		if ticket.ClosedAt == nil {
+			ticket.ClosedAt = issue.CanceledAt
+		}
+	}
+	return ticket
+}
+
+// do sends a GraphQL request to Linear and decodes its data into result
+func (p *LinearProvider) do(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.APIURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", p.config.APIKey)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("Linear request failed: %w", err)
+	}
+	defer response.Body.Close()
+
+	var payload struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message    string `json:"message"`
+			Extensions struct {
+				Code string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(io.LimitReader(response.Body, 10<<20)).Decode(&payload); err != nil && response.StatusCode >= 200 && response.StatusCode < 300 {
+		return fmt.Errorf("failed to decode Linear response: %w", err)
+	}
+
+	if len(payload.Errors) > 0 {
+		messages := make([]string, len(payload.Errors))
+		for i, graphqlError := range payload.Errors {
+			if graphqlError.Extensions.Code == "ENTITY_NOT_FOUND" || strings.Contains(strings.ToLower(graphqlError.Message), "entity not found") {
+				return fmt.Errorf("%w: %s", errLinearEntityNotFound, graphqlError.Message)
+			}
+			messages[i] = graphqlError.Message
+		}
+		return fmt.Errorf("Linear API returned %d: %s", response.StatusCode, strings.Join(messages, "; "))
+	}
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("Linear API returned %d: %s", response.StatusCode, response.Status)
+	}
+
+	return json.Unmarshal(payload.Data, result)
+}
@@ -0,0 +1,143 @@
+package ticketing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/entities"
+	"teammate/server/modules/actionitem/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLinearStandIn serves issue ENG-12 and records the input of the issues opened
+func newLinearStandIn(t *testing.T, opened *[]map[string]interface{}) *httptest.Server {
+	issue := func(identifier string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":         "uuid-" + identifier,
+			"identifier": identifier,
+			"url":        "https://linear.app/acme/issue/" + identifier + "/slug",
+			"state":      map[string]string{"name": "Todo", "type": "unstarted"},
+			"team":       map[string]string{"key": "ENG"},
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "lin_api_key", r.Header.Get("Authorization"))
+
+		var request struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		switch {
+		case strings.HasPrefix(request.Query, "mutation IssueCreate"):
+			input := request.Variables["input"].(map[string]interface{})
+			*opened = append(*opened, input)
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+				"issueCreate": map[string]interface{}{"success": true, "issue": issue("ENG-13")},
+			}})
+		case request.Variables["id"] == "ENG-12":
+			json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"issue": issue("ENG-12")}})
+		default:
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"data":   nil,
+				"errors": []interface{}{map[string]interface{}{"message": "Entity not found: Issue", "extensions": map[string]string{"code": "ENTITY_NOT_FOUND"}}},
+			})
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestLinearProvider_CreateTicket(t *testing.T) {
+	var opened []map[string]interface{}
+	server := newLinearStandIn(t, &opened)
+
+	provider, err := NewProviderRegistry().NewProvider(LinearProviderName, map[string]interface{}{
+		"api_key":   "lin_api_key",
+		"team_id":   "team-uuid",
+		"label_ids": []interface{}{"label-uuid"},
+		"api_url":   server.URL,
+		"field_mapping": map[string]interface{}{
+			"priority":  map[string]interface{}{"medium": float64(0)},
+			"assignees": map[string]interface{}{"ben@example.com": "user-ben"},
+		},
+	})
+	require.NoError(t, err)
+
+	dueDate := time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
+	ticket, err := provider.CreateTicket(context.Background(), services.TicketRequest{
+		Title:       "Send the launch plan",
+		Description: "Share the plan with marketing",
+		Assignee:    "Ben@example.com",
+		Priority:    entities.High,
+		DueDate:     &dueDate,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "ENG-13", ticket.ID)
+	assert.Equal(t, "ENG", ticket.Project)
+	assert.Equal(t, "Todo", ticket.Status)
+	assert.Equal(t, "uuid-ENG-13", ticket.Metadata["issue_id"])
+
+	require.Len(t, opened, 1)
+	input := opened[0]
+	assert.Equal(t, "team-uuid", input["teamId"])
+	assert.Equal(t, "Send the launch plan", input["title"])
+	assert.Equal(t, []interface{}{"label-uuid"}, input["labelIds"])
+	assert.Equal(t, float64(2), input["priority"])
+	assert.Equal(t, "user-ben", input["assigneeId"])
+	assert.Equal(t, "2026-03-12", input["dueDate"])
+
+	_, err = provider.CreateTicket(context.Background(), services.TicketRequest{Title: "Book the venue", Priority: entities.Medium, Project: "other-team"})
+	require.NoError(t, err)
+	assert.Equal(t, "other-team", opened[1]["teamId"])
+	assert.Equal(t, float64(0), opened[1]["priority"])
+	assert.NotContains(t, opened[1], "assigneeId")
+}
+
+func TestLinearProvider_FindTicket(t *testing.T) {
+	var opened []map[string]interface{}
+	server := newLinearStandIn(t, &opened)
+
+	provider, err := NewLinearProvider(LinearConfig{APIKey: "lin_api_key", TeamID: "team-uuid", APIURL: server.URL})
+	require.NoError(t, err)
+
+	for _, reference := range []string{"ENG-12", "eng-12", "https://linear.app/acme/issue/ENG-12/send-the-launch-plan"} {
+		ticket, err := provider.FindTicket(context.Background(), reference)
+		require.NoError(t, err, reference)
+		assert.Equal(t, "ENG-12", ticket.ID)
+		assert.Equal(t, "unstarted", ticket.Metadata["state_type"])
+	}
+
+	_, err = provider.FindTicket(context.Background(), "ENG-99")
+	assert.True(t, errors.Is(err, services.ErrTicketNotFound))
+
+	_, err = provider.FindTicket(context.Background(), "launch plan")
+	assert.True(t, errors.Is(err, services.ErrInvalidTicketReference))
+}
+
+func TestLinearProvider_Config(t *testing.T) {
+	_, err := NewLinearProvider(LinearConfig{TeamID: "team-uuid"})
+	assert.Error(t, err)
+	_, err = NewLinearProvider(LinearConfig{APIKey: "lin_api_key"})
+	assert.Error(t, err)
+
+	provider, err := NewProviderRegistry().NewProvider(LinearProviderName, map[string]interface{}{
+		"api_key":       "lin_api_key",
+		"team_id":       "team-uuid",
+		"field_mapping": map[string]interface{}{"priority": map[string]interface{}{"high": "highest"}},
+	})
+	require.NoError(t, err)
+	_, err = provider.CreateTicket(context.Background(), services.TicketRequest{Title: "Book the venue", Priority: entities.High})
+	assert.Error(t, err)
+}
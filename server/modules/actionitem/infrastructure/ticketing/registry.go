@@ -0,0 +1,95 @@
+package ticketing
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"teammate/server/modules/actionitem/domain/services"
+)
+
+// Ensure ProviderRegistry implements TicketingProviderFactory
+var _ services.TicketingProviderFactory = (*ProviderRegistry)(nil)
+
+// providerConstructor creates a provider from a user's integration configuration
+type providerConstructor func(config map[string]interface{}) (services.TicketingProvider, error)
+
+// ProviderRegistry creates the ticketing providers users can configure, by name
+type ProviderRegistry struct {
+	constructors map[string]providerConstructor
+	webhooks     map[string]services.TicketWebhook
+}
+
+// NewProviderRegistry creates a registry of every supported ticketing provider
+func NewProviderRegistry() *ProviderRegistry {
+	return &ProviderRegistry{
+		constructors: map[string]providerConstructor{
+			GitHubProviderName: newGitHubProviderFromConfig,
+			JiraProviderName:   newJiraProviderFromConfig,
+			LinearProviderName: newLinearProviderFromConfig,
+		},
+		webhooks: map[string]services.TicketWebhook{
+			GitHubProviderName: githubWebhook{},
+			JiraProviderName:   jiraWebhook{},
+			LinearProviderName: linearWebhook{},
+		},
+	}
+}
+
+// Supports returns true if providers of that name can be created
+func (r *ProviderRegistry) Supports(providerName string) bool {
+	_, ok := r.constructors[providerName]
+	return ok
+}
+
+// NewProvider creates a provider from a user's integration configuration
+func (r *ProviderRegistry) NewProvider(providerName string, config map[string]interface{}) (services.TicketingProvider, error) {
+	constructor, ok := r.constructors[providerName]
+	if !ok {
+		names := make([]string, 0, len(r.constructors))
+		for name := range r.constructors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown ticketing provider %q, expected one of %s", providerName, strings.Join(names, ", "))
+	}
+	return constructor(config)
+}
+
+// Webhook returns how deliveries of a provider's webhooks are read
+func (r *ProviderRegistry) Webhook(providerName string) (services.TicketWebhook, bool) {
+	webhook, ok := r.webhooks[providerName]
+	return webhook, ok
+}
+
+// configString returns a string setting of an integration configuration, or an empty string
+func configString(config map[string]interface{}, key string) string {
+	value, _ := config[key].(string)
+	return strings.TrimSpace(value)
+}
+
+// configStrings returns a list setting of an integration configuration, given either as a list
+// or as a comma separated string
+func configStrings(config map[string]interface{}, key string) []string {
+	var values []string
+	switch value := config[key].(type) {
+	case string:
+		values = strings.Split(value, ",")
+	case []string:
+		values = value
+	case []interface{}:
+		for _, item := range value {
+			if text, ok := item.(string); ok {
+				values = append(values, text)
+			}
+		}
+	}
+
+	var cleaned []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			cleaned = append(cleaned, value)
+		}
+	}
+	return cleaned
+}
@@ -0,0 +1,121 @@
+package ticketing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"teammate/server/modules/actionitem/domain/services"
+)
+
+// Ensure the webhooks implement TicketWebhook
+var (
+	_ services.TicketWebhook = githubWebhook{}
+	_ services.TicketWebhook = jiraWebhook{}
+	_ services.TicketWebhook = linearWebhook{}
+)
+
+// githubWebhook reads the issues events of a GitHub repository webhook
+type githubWebhook struct{}
+
+// VerifySignature checks the X-Hub-Signature-256 header
+func (githubWebhook) VerifySignature(header http.Header, body []byte, secret string) error {
+	return verifyHMAC(strings.TrimPrefix(header.Get("X-Hub-Signature-256"), "sha256="), body, secret)
+}
+
+// ParseDelivery returns the issue of an issues event
+func (githubWebhook) ParseDelivery(header http.Header, body []byte) ([]services.Ticket, error) {
+	if header.Get("X-GitHub-Event") != "issues" {
+		return nil, nil
+	}
+
+	var event struct {
+		Issue      *githubIssue `json:"issue"`
+		Repository struct {
+			FullName string `json:"full_name"`
+		} `json:"repository"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("invalid GitHub issues event: %w", err)
+	}
+	if event.Issue == nil || event.Repository.FullName == "" {
+		return nil, fmt.Errorf("invalid GitHub issues event: issue or repository missing")
+	}
+	return []services.Ticket{*githubTicket(event.Repository.FullName, *event.Issue)}, nil
+}
+
+// jiraWebhook reads the issue events of a Jira webhook registered with a secret
+type jiraWebhook struct{}
+
+// VerifySignature checks the X-Hub-Signature header
+func (jiraWebhook) VerifySignature(header http.Header, body []byte, secret string) error {
+	return verifyHMAC(strings.TrimPrefix(header.Get("X-Hub-Signature"), "sha256="), body, secret)
+}
+
+// ParseDelivery returns the issue of an issue created or updated event
+func (jiraWebhook) ParseDelivery(header http.Header, body []byte) ([]services.Ticket, error) {
+	var event struct {
+		WebhookEvent string     `json:"webhookEvent"`
+		Issue        *jiraIssue `json:"issue"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("invalid Jira event: %w", err)
+	}
+	if event.WebhookEvent != "jira:issue_created" && event.WebhookEvent != "jira:issue_updated" {
+		return nil, nil
+	}
+	if event.Issue == nil || !jiraIssueKeyPattern.MatchString(event.Issue.Key) {
+		return nil, fmt.Errorf("invalid Jira event: issue missing")
+	}
+
+	// The site is only known from the API link of the issue
+	baseURL := event.Issue.Self
+	if i := strings.Index(baseURL, "/rest/"); i >= 0 {
+		baseURL = baseURL[:i]
+	}
+	return []services.Ticket{*jiraTicket(baseURL, *event.Issue)}, nil
+}
+
+// linearWebhook reads the issue events of a Linear webhook
+type linearWebhook struct{}
+
+// VerifySignature checks the Linear-Signature header
+func (linearWebhook) VerifySignature(header http.Header, body []byte, secret string) error {
+	return verifyHMAC(header.Get("Linear-Signature"), body, secret)
+}
+
+// ParseDelivery returns the issue of an issue event
+func (linearWebhook) ParseDelivery(header http.Header, body []byte) ([]services.Ticket, error) {
+	var event struct {
+		Type string       `json:"type"`
+		Data *linearIssue `json:"data"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("invalid Linear event: %w", err)
+	}
+	if event.Type != "Issue" {
+		return nil, nil
+	}
+	if event.Data == nil || event.Data.Identifier == "" {
+		return nil, fmt.Errorf("invalid Linear event: issue missing")
+	}
+	return []services.Ticket{*toLinearTicket(*event.Data)}, nil
+}
+
+// verifyHMAC checks a hex encoded HMAC-SHA256 signature of the body
+func verifyHMAC(signature string, body []byte, secret string) error {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || secret == "" {
+		return services.ErrInvalidWebhookSignature
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(expected, mac.Sum(nil)) {
+		return services.ErrInvalidWebhookSignature
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package ticketing
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"testing"
+	"time"
+
+	"teammate/server/modules/actionitem/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sign(body, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestWebhooks_VerifySignature(t *testing.T) {
+	body := `{"action":"closed"}`
+	registry := NewProviderRegistry()
+
+	cases := map[string]http.Header{
+		GitHubProviderName: {"X-Hub-Signature-256": {"sha256=" + sign(body, "s3cret")}},
+		JiraProviderName:   {"X-Hub-Signature": {"sha256=" + sign(body, "s3cret")}},
+		LinearProviderName: {"Linear-Signature": {sign(body, "s3cret")}},
+	}
+	for provider, header := range cases {
+		webhook, ok := registry.Webhook(provider)
+		require.True(t, ok, provider)
+
+		assert.NoError(t, webhook.VerifySignature(header, []byte(body), "s3cret"), provider)
+		assert.ErrorIs(t, webhook.VerifySignature(header, []byte(body), "other"), services.ErrInvalidWebhookSignature, provider)
+		assert.ErrorIs(t, webhook.VerifySignature(header, []byte(`{"action":"opened"}`), "s3cret"), services.ErrInvalidWebhookSignature, provider)
+		assert.ErrorIs(t, webhook.VerifySignature(header, []byte(body), ""), services.ErrInvalidWebhookSignature, provider)
+		assert.ErrorIs(t, webhook.VerifySignature(http.Header{}, []byte(body), "s3cret"), services.ErrInvalidWebhookSignature, provider)
+	}
+
+	_, ok := registry.Webhook("trello")
+	assert.False(t, ok)
+}
+
+func TestGitHubWebhook_ParseDelivery(t *testing.T) {
+	body := []byte(`{
+		"action": "closed",
+		"issue": {"id": 900, "number": 42, "html_url": "https://github.com/team/app/issues/42", "state": "closed",
+			"closed_at": "2026-03-11T10:00:00Z", "assignee": {"login": "ben"}},
+		"repository": {"full_name": "team/app"}
+	}`)
+
+	tickets, err := githubWebhook{}.ParseDelivery(http.Header{"X-Github-Event": {"issues"}}, body)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	assert.Equal(t, "42", tickets[0].ID)
+	assert.Equal(t, "team/app", tickets[0].Project)
+	assert.True(t, tickets[0].Closed)
+	assert.Equal(t, "ben", tickets[0].Assignee)
+	require.NotNil(t, tickets[0].ClosedAt)
+	assert.True(t, time.Date(2026, 3, 11, 10, 0, 0, 0, time.UTC).Equal(*tickets[0].ClosedAt))
+
+	// Other events are acknowledged without changes
+	tickets, err = githubWebhook{}.ParseDelivery(http.Header{"X-Github-Event": {"ping"}}, []byte(`{"zen":"Keep it simple."}`))
+	require.NoError(t, err)
+	assert.Empty(t, tickets)
+
+	_, err = githubWebhook{}.ParseDelivery(http.Header{"X-Github-Event": {"issues"}}, []byte(`{"action":"closed"}`))
+	assert.Error(t, err)
+}
+
+func TestJiraWebhook_ParseDelivery(t *testing.T) {
+	body := []byte(`{
+		"webhookEvent": "jira:issue_updated",
+		"issue": {"id": "10001", "key": "OPS-7", "self": "https://acme.atlassian.net/rest/api/3/issue/10001",
+			"fields": {"status": {"name": "Done", "statusCategory": {"key": "done"}},
+				"assignee": {"accountId": "5b10ac8d82e05b22cc7d4ef5", "displayName": "Ben Ortiz"},
+				"resolutiondate": "2026-03-11T10:00:00.000+0000"}}
+	}`)
+
+	tickets, err := jiraWebhook{}.ParseDelivery(http.Header{}, body)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	assert.Equal(t, "OPS-7", tickets[0].ID)
+	assert.Equal(t, "OPS", tickets[0].Project)
+	assert.Equal(t, "https://acme.atlassian.net/browse/OPS-7", tickets[0].URL)
+	assert.True(t, tickets[0].Closed)
+	assert.Equal(t, "Ben Ortiz", tickets[0].Assignee)
+	require.NotNil(t, tickets[0].ClosedAt)
+
+	tickets, err = jiraWebhook{}.ParseDelivery(http.Header{}, []byte(`{"webhookEvent":"comment_created"}`))
+	require.NoError(t, err)
+	assert.Empty(t, tickets)
+}
+
+func TestLinearWebhook_ParseDelivery(t *testing.T) {
+	body := []byte(`{
+		"action": "update",
+		"type": "Issue",
+		"data": {"id": "a1b2", "identifier": "ENG-12", "url": "https://linear.app/acme/issue/ENG-12",
+			"state": {"name": "In Progress", "type": "started"}, "team": {"key": "ENG"}, "assignee": {"name": "Ben"}}
+	}`)
+
+	tickets, err := linearWebhook{}.ParseDelivery(http.Header{}, body)
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	assert.Equal(t, "ENG-12", tickets[0].ID)
+	assert.Equal(t, "ENG", tickets[0].Project)
+	assert.Equal(t, "In Progress", tickets[0].Status)
+	assert.False(t, tickets[0].Closed)
+	assert.Nil(t, tickets[0].ClosedAt)
+
+	tickets, err = linearWebhook{}.ParseDelivery(http.Header{}, []byte(`{"type":"Comment","data":{}}`))
+	require.NoError(t, err)
+	assert.Empty(t, tickets)
+}
@@ -0,0 +1,316 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/actionitem/application/services"
+	"teammate/server/modules/actionitem/domain/entities"
+	domainServices "teammate/server/modules/actionitem/domain/services"
+	"teammate/server/modules/transcription/application/queries"
+	jobEntities "teammate/server/seedwork/domain/entities"
+)
+
+// ListActionItemsRequest represents the filters for listing action items
+type ListActionItemsRequest struct {
+	MeetingID string                    `form:"meeting_id"`
+	Status    entities.ActionItemStatus `form:"status"`
+	Assignee  string                    `form:"assignee"`
+	Overdue   bool                      `form:"overdue"`
+	// NeedsConfirmation lists only action items whose assignee needs to be confirmed
+	NeedsConfirmation bool `form:"needs_confirmation"`
+	Limit             int  `form:"limit" binding:"omitempty,min=1"`
+	Offset            int  `form:"offset" binding:"omitempty,min=0"`
+}
+
+// SetAssigneeRequest represents the request to assign an action item
+type SetAssigneeRequest struct {
+	// Assignee is the name or email of the person responsible; empty unassigns the item
+	Assignee string `json:"assignee" binding:"max=255"`
+}
+
+// ConfirmAssigneeRequest represents the request to confirm who the assignee of an action item is
+type ConfirmAssigneeRequest struct {
+	// Candidate is the position of the person in assignee_resolution.candidates
+	Candidate *int `json:"candidate" binding:"required,min=0"`
+	// Accounts maps ticketing providers to the person's user ID there; an empty ID removes one
+	Accounts map[string]string `json:"accounts"`
+}
+
+// SetDueDateRequest represents the request to set the due date of an action item
+type SetDueDateRequest struct {
+	// DueDate is an RFC 3339 timestamp; null clears the due date
+	DueDate *time.Time `json:"due_date"`
+}
+
+// TicketReferenceResponse represents a ticket linked to an action item
+type TicketReferenceResponse struct {
+	ID            string     `json:"id"`
+	System        string     `json:"system"`
+	TicketID      string     `json:"ticket_id"`
+	TicketURL     string     `json:"ticket_url"`
+	ProjectKey    string     `json:"project_key,omitempty"`
+	ReferenceType string     `json:"reference_type"`
+	State         string     `json:"state,omitempty"`
+	Assignee      string     `json:"assignee,omitempty"`
+	ClosedAt      *time.Time `json:"closed_at,omitempty"`
+	SyncedAt      *time.Time `json:"synced_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// AssigneeCandidateResponse represents a person the assignee of an action item may refer to
+type AssigneeCandidateResponse struct {
+	Name       string                  `json:"name"`
+	Email      string                  `json:"email,omitempty"`
+	UserID     string                  `json:"user_id,omitempty"`
+	Source     entities.AssigneeSource `json:"source"`
+	Accounts   map[string]string       `json:"accounts,omitempty"`
+	Confidence float64                 `json:"confidence"`
+}
+
+// AssigneeResolutionResponse represents who the assignee of an action item was matched to
+type AssigneeResolutionResponse struct {
+	Mention           string                            `json:"mention"`
+	Status            entities.AssigneeResolutionStatus `json:"status"`
+	Confidence        float64                           `json:"confidence"`
+	NeedsConfirmation bool                              `json:"needs_confirmation"`
+	Match             *AssigneeCandidateResponse        `json:"match,omitempty"`
+	Candidates        []AssigneeCandidateResponse       `json:"candidates"`
+	ResolvedAt        time.Time                         `json:"resolved_at"`
+}
+
+// ActionItemSourceResponse represents the transcript segment an action item was extracted from
+type ActionItemSourceResponse struct {
+	SegmentID string  `json:"segment_id"`
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+	DeepLink  string  `json:"deep_link"`
+}
+
+// ActionItemResponse represents the response containing action item data
+type ActionItemResponse struct {
+	ID                 string                      `json:"id"`
+	MeetingID          string                      `json:"meeting_id"`
+	TranscriptionID    string                      `json:"transcription_id"`
+	Title              string                      `json:"title"`
+	Description        string                      `json:"description"`
+	Assignee           string                      `json:"assignee,omitempty"`
+	AssigneeResolution *AssigneeResolutionResponse `json:"assignee_resolution,omitempty"`
+	Priority           entities.Priority           `json:"priority"`
+	DueDate            *time.Time                  `json:"due_date,omitempty"`
+	Overdue            bool                        `json:"overdue"`
+	Status             entities.ActionItemStatus   `json:"status"`
+	CompletedAt        *time.Time                  `json:"completed_at,omitempty"`
+	Context            string                      `json:"context"`
+	Source             *ActionItemSourceResponse   `json:"source,omitempty"`
+	TicketReferences   []TicketReferenceResponse   `json:"ticket_references"`
+	CreatedAt          time.Time                   `json:"created_at"`
+	UpdatedAt          time.Time                   `json:"updated_at"`
+}
+
+// ActionItemsListResponse represents the response containing a list of action items
+type ActionItemsListResponse struct {
+	ActionItems []ActionItemResponse `json:"action_items"`
+	Total       int64                `json:"total"`
+}
+
+// ToActionItemResponse converts an ActionItem entity to ActionItemResponse DTO
+func ToActionItemResponse(actionItem *entities.ActionItem) ActionItemResponse {
+	ticketReferences := make([]TicketReferenceResponse, len(actionItem.TicketReferences))
+	for i, ticket := range actionItem.TicketReferences {
+		ticketReferences[i] = TicketReferenceResponse{
+			ID:            ticket.GetID(),
+			System:        ticket.System,
+			TicketID:      ticket.TicketID,
+			TicketURL:     ticket.TicketURL,
+			ProjectKey:    ticket.ProjectKey,
+			ReferenceType: ticket.ReferenceType,
+			State:         metadataString(ticket.Metadata, "state"),
+			Assignee:      metadataString(ticket.Metadata, "assignee"),
+			SyncedAt:      ticket.SyncedAt,
+			CreatedAt:     ticket.GetCreatedAt(),
+		}
+		if closedAt, err := time.Parse(time.RFC3339, metadataString(ticket.Metadata, "closed_at")); err == nil {
+			ticketReferences[i].ClosedAt = &closedAt
+		}
+	}
+
+	response := ActionItemResponse{
+		ID:               actionItem.GetID(),
+		MeetingID:        actionItem.MeetingID,
+		TranscriptionID:  actionItem.TranscriptionID,
+		Title:            actionItem.Title,
+		Description:      actionItem.Description,
+		Assignee:         actionItem.Assignee,
+		Priority:         actionItem.Priority,
+		DueDate:          actionItem.DueDate,
+		Overdue:          actionItem.IsOverdue() && !actionItem.IsRejected(),
+		Status:           actionItem.Status,
+		CompletedAt:      actionItem.CompletedAt,
+		Context:          actionItem.Context,
+		TicketReferences: ticketReferences,
+		CreatedAt:        actionItem.GetCreatedAt(),
+		UpdatedAt:        actionItem.GetUpdatedAt(),
+	}
+
+	if actionItem.HasSource() && actionItem.SourceStartTime != nil && actionItem.SourceEndTime != nil {
+		response.Source = &ActionItemSourceResponse{
+			SegmentID: actionItem.SourceSegmentID,
+			StartTime: *actionItem.SourceStartTime,
+			EndTime:   *actionItem.SourceEndTime,
+			DeepLink:  queries.SegmentDeepLink(actionItem.MeetingID, actionItem.TranscriptionID, actionItem.SourceSegmentID, *actionItem.SourceStartTime),
+		}
+	}
+	if actionItem.AssigneeResolution != nil {
+		response.AssigneeResolution = toAssigneeResolutionResponse(actionItem.AssigneeResolution)
+	}
+	return response
+}
+
+func toAssigneeResolutionResponse(resolution *entities.AssigneeResolution) *AssigneeResolutionResponse {
+	candidates := make([]AssigneeCandidateResponse, len(resolution.Candidates))
+	for i, candidate := range resolution.Candidates {
+		candidates[i] = toAssigneeCandidateResponse(candidate)
+	}
+
+	response := &AssigneeResolutionResponse{
+		Mention:           resolution.Mention,
+		Status:            resolution.Status,
+		Confidence:        resolution.Confidence,
+		NeedsConfirmation: resolution.NeedsConfirmation(),
+		Candidates:        candidates,
+		ResolvedAt:        resolution.ResolvedAt,
+	}
+	if resolution.Match != nil {
+		match := toAssigneeCandidateResponse(*resolution.Match)
+		response.Match = &match
+	}
+	return response
+}
+
+func toAssigneeCandidateResponse(candidate entities.AssigneeCandidate) AssigneeCandidateResponse {
+	return AssigneeCandidateResponse{
+		Name:       candidate.Name,
+		Email:      candidate.Email,
+		UserID:     candidate.UserID,
+		Source:     candidate.Source,
+		Accounts:   candidate.Accounts,
+		Confidence: candidate.Confidence,
+	}
+}
+
+// ToActionItemsListResponse converts a slice of ActionItem entities to ActionItemsListResponse DTO
+func ToActionItemsListResponse(actionItems []*entities.ActionItem, total int64) ActionItemsListResponse {
+	responses := make([]ActionItemResponse, len(actionItems))
+	for i, actionItem := range actionItems {
+		responses[i] = ToActionItemResponse(actionItem)
+	}
+
+	return ActionItemsListResponse{
+		ActionItems: responses,
+		Total:       total,
+	}
+}
+
+// ExtractionJobResultResponse represents the outcome of an extract actions job
+type ExtractionJobResultResponse struct {
+	Count  int                             `json:"count"`
+	Method domainServices.ExtractionMethod `json:"method"`
+	Model  string                          `json:"model,omitempty"`
+}
+
+// ExtractionJobResponse represents the action items of a transcription being extracted in the background
+type ExtractionJobResponse struct {
+	ID              string                          `json:"id"`
+	TranscriptionID string                          `json:"transcription_id"`
+	MeetingID       string                          `json:"meeting_id"`
+	Status          jobEntities.ProcessingJobStatus `json:"status"`
+	Error           string                          `json:"error,omitempty"`
+	Result          *ExtractionJobResultResponse    `json:"result,omitempty"`
+	ScheduledAt     *time.Time                      `json:"scheduled_at"`
+	StartedAt       *time.Time                      `json:"started_at,omitempty"`
+	CompletedAt     *time.Time                      `json:"completed_at,omitempty"`
+}
+
+// ToExtractionJobResponse converts an extract actions ProcessingJob to ExtractionJobResponse DTO
+func ToExtractionJobResponse(job *jobEntities.ProcessingJob) ExtractionJobResponse {
+	response := ExtractionJobResponse{
+		ID:              job.GetID(),
+		TranscriptionID: job.EntityID,
+		MeetingID:       payloadString(job, "meeting_id"),
+		Status:          job.Status,
+		Error:           job.ErrorMessage,
+		ScheduledAt:     job.ScheduledAt,
+		StartedAt:       job.StartedAt,
+		CompletedAt:     job.CompletedAt,
+	}
+
+	if result := services.ExtractionJobResult(job); result != nil {
+		response.Result = &ExtractionJobResultResponse{
+			Count:  result.Count,
+			Method: result.Method,
+			Model:  result.Model,
+		}
+	}
+	return response
+}
+
+// payloadString returns a string value of a job's payload, or an empty string
+func payloadString(job *jobEntities.ProcessingJob, key string) string {
+	value, _ := job.GetPayloadValue(key)
+	text, _ := value.(string)
+	return text
+}
+
+// metadataString returns a string value of ticket metadata, or an empty string
+func metadataString(metadata map[string]interface{}, key string) string {
+	text, _ := metadata[key].(string)
+	return text
+}
+
+// AssigneeFollowUpsResponse represents the follow-ups of one assignee
+type AssigneeFollowUpsResponse struct {
+	Assignee  string `json:"assignee"`
+	Open      int    `json:"open"`
+	Overdue   int    `json:"overdue"`
+	Completed int    `json:"completed"`
+}
+
+// FollowUpReportResponse represents the open follow-ups of a meeting and how many are done
+type FollowUpReportResponse struct {
+	MeetingID      string                      `json:"meeting_id"`
+	Total          int                         `json:"total"`
+	Completed      int                         `json:"completed"`
+	Overdue        int                         `json:"overdue"`
+	Unreviewed     int                         `json:"unreviewed"`
+	CompletionRate float64                     `json:"completion_rate"`
+	Open           []ActionItemResponse        `json:"open"`
+	ByAssignee     []AssigneeFollowUpsResponse `json:"by_assignee"`
+}
+
+// ToFollowUpReportResponse converts a FollowUpReport to FollowUpReportResponse DTO
+func ToFollowUpReportResponse(report *services.FollowUpReport) FollowUpReportResponse {
+	open := make([]ActionItemResponse, len(report.Open))
+	for i, actionItem := range report.Open {
+		open[i] = ToActionItemResponse(actionItem)
+	}
+	byAssignee := make([]AssigneeFollowUpsResponse, len(report.ByAssignee))
+	for i, counts := range report.ByAssignee {
+		byAssignee[i] = AssigneeFollowUpsResponse{
+			Assignee:  counts.Assignee,
+			Open:      counts.Open,
+			Overdue:   counts.Overdue,
+			Completed: counts.Completed,
+		}
+	}
+
+	return FollowUpReportResponse{
+		MeetingID:      report.MeetingID,
+		Total:          report.Total,
+		Completed:      report.Completed,
+		Overdue:        report.Overdue,
+		Unreviewed:     report.Unreviewed,
+		CompletionRate: report.CompletionRate,
+		Open:           open,
+		ByAssignee:     byAssignee,
+	}
+}
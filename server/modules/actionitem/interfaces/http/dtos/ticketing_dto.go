@@ -0,0 +1,158 @@
+package dtos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"teammate/server/modules/actionitem/application/services"
+	userEntities "teammate/server/modules/user/domain/entities"
+	jobEntities "teammate/server/seedwork/domain/entities"
+)
+
+// ConfigureTicketingRequest represents the request to configure a ticketing provider
+type ConfigureTicketingRequest struct {
+	// Config holds the provider's settings, e.g. token, repository and labels for GitHub. Settings
+	// that are left out keep their current value; null removes a setting.
+	Config   map[string]interface{} `json:"config"`
+	IsActive *bool                  `json:"is_active,omitempty"`
+}
+
+// CreateTicketsRequest represents the request to open tickets for approved action items
+type CreateTicketsRequest struct {
+	Provider      string   `json:"provider" binding:"required"`
+	ActionItemIDs []string `json:"action_item_ids" binding:"required,min=1"`
+	// Project overrides the provider's default project: a GitHub repository, Jira project key or Linear team ID
+	Project string `json:"project,omitempty"`
+}
+
+// LinkTicketRequest represents the request to link an action item to an existing ticket
+type LinkTicketRequest struct {
+	Provider string `json:"provider" binding:"required"`
+	// Ticket is the ticket's ID, key or URL, e.g. owner/name#123 for GitHub, OPS-123 for Jira or ENG-123 for Linear
+	Ticket string `json:"ticket" binding:"required"`
+}
+
+// TicketingIntegrationResponse represents a configured ticketing provider. Secrets are masked.
+type TicketingIntegrationResponse struct {
+	ID       string                 `json:"id"`
+	Provider string                 `json:"provider"`
+	Config   map[string]interface{} `json:"config"`
+	IsActive bool                   `json:"is_active"`
+	// WebhookPath is where the ticketing system delivers ticket changes, signed with the webhook_secret setting
+	WebhookPath string    `json:"webhook_path"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TicketingIntegrationsListResponse represents the ticketing providers a user configured
+type TicketingIntegrationsListResponse struct {
+	Integrations []TicketingIntegrationResponse `json:"integrations"`
+}
+
+// CreatedTicketResponse represents a ticket opened for an action item
+type CreatedTicketResponse struct {
+	ActionItemID string `json:"action_item_id"`
+	TicketID     string `json:"ticket_id"`
+	TicketURL    string `json:"ticket_url"`
+}
+
+// FailedTicketResponse represents an action item no ticket could be opened for
+type FailedTicketResponse struct {
+	ActionItemID string `json:"action_item_id"`
+	Error        string `json:"error"`
+}
+
+// TicketJobResultResponse represents the outcome of a create tickets job
+type TicketJobResultResponse struct {
+	Created []CreatedTicketResponse `json:"created"`
+	Failed  []FailedTicketResponse  `json:"failed"`
+}
+
+// TicketJobResponse represents tickets being opened in the background
+type TicketJobResponse struct {
+	ID          string                          `json:"id"`
+	Provider    string                          `json:"provider"`
+	Status      jobEntities.ProcessingJobStatus `json:"status"`
+	Error       string                          `json:"error,omitempty"`
+	Result      *TicketJobResultResponse        `json:"result,omitempty"`
+	ScheduledAt *time.Time                      `json:"scheduled_at"`
+	StartedAt   *time.Time                      `json:"started_at,omitempty"`
+	CompletedAt *time.Time                      `json:"completed_at,omitempty"`
+}
+
+// ToTicketingIntegrationResponse converts an IntegrationConfig entity to TicketingIntegrationResponse DTO
+func ToTicketingIntegrationResponse(config *userEntities.IntegrationConfig) TicketingIntegrationResponse {
+	masked := make(map[string]interface{}, len(config.Config))
+	for key, value := range config.Config {
+		if text, ok := value.(string); ok && isSecretSetting(key) {
+			value = maskSecret(text)
+		}
+		masked[key] = value
+	}
+
+	return TicketingIntegrationResponse{
+		ID:          config.GetID(),
+		Provider:    config.ProviderName,
+		Config:      masked,
+		IsActive:    config.IsActive,
+		WebhookPath: fmt.Sprintf("/webhooks/ticketing/%s/%s", config.ProviderName, config.GetID()),
+		CreatedAt:   config.GetCreatedAt(),
+		UpdatedAt:   config.GetUpdatedAt(),
+	}
+}
+
+// ToTicketingIntegrationsListResponse converts IntegrationConfig entities to TicketingIntegrationsListResponse DTO
+func ToTicketingIntegrationsListResponse(configs []*userEntities.IntegrationConfig) TicketingIntegrationsListResponse {
+	responses := make([]TicketingIntegrationResponse, len(configs))
+	for i, config := range configs {
+		responses[i] = ToTicketingIntegrationResponse(config)
+	}
+	return TicketingIntegrationsListResponse{Integrations: responses}
+}
+
+// ToTicketJobResponse converts a create tickets ProcessingJob to TicketJobResponse DTO
+func ToTicketJobResponse(job *jobEntities.ProcessingJob) TicketJobResponse {
+	response := TicketJobResponse{
+		ID:          job.GetID(),
+		Provider:    payloadString(job, "provider"),
+		Status:      job.Status,
+		Error:       job.ErrorMessage,
+		ScheduledAt: job.ScheduledAt,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+	}
+
+	if result := services.TicketJobResult(job); result != nil {
+		response.Result = &TicketJobResultResponse{
+			Created: make([]CreatedTicketResponse, len(result.Created)),
+			Failed:  make([]FailedTicketResponse, len(result.Failed)),
+		}
+		for i, created := range result.Created {
+			response.Result.Created[i] = CreatedTicketResponse(created)
+		}
+		for i, failed := range result.Failed {
+			response.Result.Failed[i] = FailedTicketResponse(failed)
+		}
+	}
+	return response
+}
+
+// isSecretSetting returns true for integration settings that hold credentials
+func isSecretSetting(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range []string{"token", "api_key", "secret", "password"} {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskSecret hides all but the last four characters of a credential
+func maskSecret(secret string) string {
+	if len(secret) <= 8 {
+		return "********"
+	}
+	return "********" + secret[len(secret)-4:]
+}
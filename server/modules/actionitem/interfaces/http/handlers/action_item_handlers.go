@@ -0,0 +1,496 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/application/queries"
+	"teammate/server/modules/actionitem/application/services"
+	"teammate/server/modules/actionitem/interfaces/http/dtos"
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/seedwork/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ActionItemHandlers contains all action item HTTP handlers
+type ActionItemHandlers struct {
+	actionItemService *services.ActionItemService
+	extractionService *services.ActionItemExtractionService
+	assigneeService   *services.AssigneeResolutionService
+}
+
+// NewActionItemHandlers creates a new action item handlers instance
+func NewActionItemHandlers(actionItemService *services.ActionItemService, extractionService *services.ActionItemExtractionService, assigneeService *services.AssigneeResolutionService) *ActionItemHandlers {
+	return &ActionItemHandlers{
+		actionItemService: actionItemService,
+		extractionService: extractionService,
+		assigneeService:   assigneeService,
+	}
+}
+
+// GetActionItems lists the action items the authenticated user can see
+// @Summary List action items
+// @Description List the action items of all meetings the authenticated user owns or that have been shared with them, with the earliest due date first. Overdue items are past their due date and neither rejected nor completed.
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param meeting_id query string false "Only action items of this meeting"
+// @Param status query string false "Only action items with this status (extracted, pending, approved, created, completed, rejected)"
+// @Param assignee query string false "Only action items assigned to this person (case-insensitive)"
+// @Param overdue query bool false "Only overdue action items"
+// @Param needs_confirmation query bool false "Only action items whose assignee matches several people and needs to be confirmed"
+// @Param limit query int false "Maximum number of action items (default 50, max 200)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} dtos.ActionItemsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items [get]
+func (h *ActionItemHandlers) GetActionItems(c *gin.Context) {
+	h.listActionItems(c, "")
+}
+
+// GetMeetingActionItems lists the action items of a meeting
+// @Summary List the action items of a meeting
+// @Description List the action items of a meeting the authenticated user can view, with the earliest due date first
+// @Tags meetings
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Param status query string false "Only action items with this status (extracted, pending, approved, created, completed, rejected)"
+// @Param assignee query string false "Only action items assigned to this person (case-insensitive)"
+// @Param overdue query bool false "Only overdue action items"
+// @Param needs_confirmation query bool false "Only action items whose assignee matches several people and needs to be confirmed"
+// @Param limit query int false "Maximum number of action items (default 50, max 200)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} dtos.ActionItemsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/action-items [get]
+func (h *ActionItemHandlers) GetMeetingActionItems(c *gin.Context) {
+	h.listActionItems(c, c.Param("id"))
+}
+
+func (h *ActionItemHandlers) listActionItems(c *gin.Context, meetingID string) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.ListActionItemsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if meetingID == "" {
+		meetingID = req.MeetingID
+	}
+
+	query := queries.GetActionItemsQuery{
+		UserID:            userID,
+		MeetingID:         meetingID,
+		Status:            req.Status,
+		Assignee:          req.Assignee,
+		Overdue:           req.Overdue,
+		NeedsConfirmation: req.NeedsConfirmation,
+		Limit:             req.Limit,
+		Offset:            req.Offset,
+	}
+
+	actionItems, total, err := h.actionItemService.GetActionItems(c.Request.Context(), query)
+	if err != nil {
+		respondWithError(c, err, "Failed to retrieve action items")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemsListResponse(actionItems, total))
+}
+
+// GetFollowUpReport reports on the follow-ups of a meeting
+// @Summary Get the open follow-ups of a meeting
+// @Description Report on the approved action items of a meeting the authenticated user can view: how many are completed, which are still open (earliest due date first) and how many each assignee has open, overdue and completed. Action items are completed when their ticket is closed.
+// @Tags meetings
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Success 200 {object} dtos.FollowUpReportResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/follow-ups [get]
+func (h *ActionItemHandlers) GetFollowUpReport(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	query := queries.GetFollowUpReportQuery{
+		MeetingID: c.Param("id"),
+		UserID:    userID,
+	}
+
+	report, err := h.actionItemService.GetFollowUpReport(c.Request.Context(), query)
+	if err != nil {
+		respondWithError(c, err, "Failed to retrieve follow-ups")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToFollowUpReportResponse(report))
+}
+
+// GetActionItemByID returns a specific action item
+// @Summary Get action item
+// @Description Get an action item of a meeting the authenticated user can view, with its ticket references
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/{id} [get]
+func (h *ActionItemHandlers) GetActionItemByID(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	query := queries.GetActionItemByIDQuery{
+		ID:     c.Param("id"),
+		UserID: userID,
+	}
+
+	actionItem, err := h.actionItemService.GetActionItemByID(c.Request.Context(), query)
+	if err != nil {
+		respondWithError(c, err, "Failed to retrieve action item")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// ApproveActionItem approves an action item
+// @Summary Approve action item
+// @Description Approve an action item of a meeting the authenticated user can edit so a ticket can be created for it. Items that already have a ticket cannot be reviewed again.
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/{id}/approve [post]
+func (h *ActionItemHandlers) ApproveActionItem(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.ApproveActionItemCommand{
+		ActionItemID: c.Param("id"),
+		UserID:       userID,
+	}
+
+	actionItem, err := h.actionItemService.ApproveActionItem(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to approve action item")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// RejectActionItem rejects an action item
+// @Summary Reject action item
+// @Description Reject an action item of a meeting the authenticated user can edit. Items that already have a ticket cannot be reviewed again.
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/{id}/reject [post]
+func (h *ActionItemHandlers) RejectActionItem(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.RejectActionItemCommand{
+		ActionItemID: c.Param("id"),
+		UserID:       userID,
+	}
+
+	actionItem, err := h.actionItemService.RejectActionItem(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to reject action item")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// SetAssignee assigns an action item
+// @Summary Assign action item
+// @Description Set the person responsible for an action item of a meeting the authenticated user can edit; an empty assignee unassigns it. The assignee is matched to the meeting's participants and users and to the users of the meeting owner's ticketing integrations; a clear match replaces it with the person's name.
+// @Tags action-items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Param request body dtos.SetAssigneeRequest true "Assignee"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/{id}/assignee [put]
+func (h *ActionItemHandlers) SetAssignee(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.SetAssigneeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := commands.SetActionItemAssigneeCommand{
+		ActionItemID: c.Param("id"),
+		UserID:       userID,
+		Assignee:     req.Assignee,
+	}
+
+	actionItem, err := h.actionItemService.SetAssignee(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to assign action item")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// ResolveAssignee matches the assignee of an action item to a person again
+// @Summary Resolve action item assignee
+// @Description Match the assignee of an action item of a meeting the authenticated user can edit to the meeting's participants, the names its speakers were renamed to, its owner and the users it is shared with, and the users of the owner's ticketing integrations (GitHub logins, Jira account IDs, Linear users). Every candidate has a confidence from 0 to 1; when several match about as well the assignee needs to be confirmed.
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/{id}/assignee/resolve [post]
+func (h *ActionItemHandlers) ResolveAssignee(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.ResolveAssigneeCommand{
+		ActionItemID: c.Param("id"),
+		UserID:       userID,
+	}
+
+	actionItem, err := h.assigneeService.ResolveAssignee(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to resolve assignee")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// ConfirmAssignee confirms who the assignee of an action item is
+// @Summary Confirm action item assignee
+// @Description Assign an action item of a meeting the authenticated user can edit to one of its assignee candidates, by position in assignee_resolution.candidates. Accounts add or correct the person's user IDs at ticketing providers, which tickets are then assigned with; an empty ID removes one.
+// @Tags action-items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Param request body dtos.ConfirmAssigneeRequest true "Candidate"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/{id}/assignee/confirm [post]
+func (h *ActionItemHandlers) ConfirmAssignee(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.ConfirmAssigneeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := commands.ConfirmAssigneeCommand{
+		ActionItemID: c.Param("id"),
+		UserID:       userID,
+		Candidate:    *req.Candidate,
+		Accounts:     req.Accounts,
+	}
+
+	actionItem, err := h.assigneeService.ConfirmAssignee(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to confirm assignee")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// SetDueDate sets the due date of an action item
+// @Summary Set action item due date
+// @Description Set the due date of an action item of a meeting the authenticated user can edit; a null due date clears it
+// @Tags action-items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Param request body dtos.SetDueDateRequest true "Due date"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/{id}/due-date [put]
+func (h *ActionItemHandlers) SetDueDate(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.SetDueDateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := commands.SetActionItemDueDateCommand{
+		ActionItemID: c.Param("id"),
+		UserID:       userID,
+		DueDate:      req.DueDate,
+	}
+
+	actionItem, err := h.actionItemService.SetDueDate(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to set action item due date")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// ExtractActionItems extracts the action items of a transcription again
+// @Summary Extract action items from a transcription
+// @Description Extract the action items of a completed transcription in a meeting the authenticated user can edit in a background job, with LeMUR for AssemblyAI transcripts, the configured language model, or phrasing rules. Unreviewed action items are replaced; reviewed ones are kept. Action items are also extracted automatically when a transcription completes.
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Success 202 {object} dtos.ExtractionJobResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/action-items/extract [post]
+func (h *ActionItemHandlers) ExtractActionItems(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.ExtractActionItemsCommand{
+		TranscriptionID: c.Param("id"),
+		UserID:          userID,
+	}
+
+	job, err := h.extractionService.ExtractActionItems(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to extract action items")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dtos.ToExtractionJobResponse(job))
+}
+
+// GetExtractionJob returns the status of an action item extraction
+// @Summary Get action item extraction status
+// @Description Get the status of the action items of a transcription being extracted, and how many were found once it completes
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param jobId path string true "Extraction job ID"
+// @Success 200 {object} dtos.ExtractionJobResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/action-items/jobs/{jobId} [get]
+func (h *ActionItemHandlers) GetExtractionJob(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.extractionService.GetJob(c.Request.Context(), c.Param("id"), c.Param("jobId"), userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get extraction job")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToExtractionJobResponse(job))
+}
+
+// respondWithError maps action item domain errors to HTTP responses. Meetings the user cannot
+// access are reported as not found so their existence is not revealed.
+func respondWithError(c *gin.Context, err error, fallback string) {
+	if domainErr, ok := err.(*domain.DomainError); ok {
+		switch domainErr.Code {
+		case "ACTION_ITEM_NOT_FOUND", "MEETING_NOT_FOUND", "UNAUTHORIZED", "TRANSCRIPTION_NOT_FOUND", "EXTRACTION_JOB_NOT_FOUND",
+			"TICKET_JOB_NOT_FOUND", "TICKET_NOT_FOUND", "INTEGRATION_NOT_FOUND", "WEBHOOK_NOT_FOUND":
+			c.JSON(http.StatusNotFound, gin.H{"error": domainErr.Message})
+			return
+		case "INVALID_ACTION_ITEM_FILTER", "INVALID_ACTION_ITEM_STATUS", "INVALID_ASSIGNEE", "INVALID_ASSIGNEE_CANDIDATE", "TRANSCRIPTION_NOT_COMPLETED",
+			"INVALID_TICKET_REQUEST", "INVALID_TICKET_REFERENCE", "UNSUPPORTED_TICKETING_PROVIDER", "INVALID_TICKETING_CONFIG", "TICKETING_NOT_CONFIGURED",
+			"INVALID_WEBHOOK_PAYLOAD":
+			c.JSON(http.StatusBadRequest, gin.H{"error": domainErr.Message})
+			return
+		case "INVALID_WEBHOOK_SIGNATURE":
+			c.JSON(http.StatusUnauthorized, gin.H{"error": domainErr.Message})
+			return
+		case "TICKETING_FAILED":
+			c.JSON(http.StatusBadGateway, gin.H{"error": domainErr.Message})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+}
+
+func getUserID(c *gin.Context) (string, bool) {
+	if userInterface, exists := c.Get("user"); exists {
+		if user, ok := userInterface.(*entities.User); ok {
+			return user.GetID(), true
+		}
+	}
+
+	// Try to get user ID directly if user object is not available
+	userIDInterface, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return "", false
+	}
+	userID, ok := userIDInterface.(string)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID"})
+		return "", false
+	}
+	return userID, true
+}
@@ -0,0 +1,267 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"teammate/server/modules/actionitem/application/commands"
+	"teammate/server/modules/actionitem/application/services"
+	"teammate/server/modules/actionitem/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxWebhookBodySize is the largest webhook delivery that is accepted
+const maxWebhookBodySize = 5 << 20
+
+// TicketingHandlers contains the HTTP handlers that turn action items into tickets and receive
+// the changes of those tickets
+type TicketingHandlers struct {
+	ticketingService *services.TicketingService
+	syncService      *services.TicketSyncService
+}
+
+// NewTicketingHandlers creates a new ticketing handlers instance
+func NewTicketingHandlers(ticketingService *services.TicketingService, syncService *services.TicketSyncService) *TicketingHandlers {
+	return &TicketingHandlers{
+		ticketingService: ticketingService,
+		syncService:      syncService,
+	}
+}
+
+// GetIntegrations lists the ticketing providers of the authenticated user
+// @Summary List ticketing integrations
+// @Description List the ticketing providers the authenticated user has configured. Tokens are masked.
+// @Tags integrations
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dtos.TicketingIntegrationsListResponse
+// @Failure 500 {object} map[string]string
+// @Router /integrations/ticketing [get]
+func (h *TicketingHandlers) GetIntegrations(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	configs, err := h.ticketingService.GetIntegrations(c.Request.Context(), userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to retrieve ticketing integrations")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTicketingIntegrationsListResponse(configs))
+}
+
+// ConfigureIntegration configures a ticketing provider of the authenticated user
+// @Summary Configure ticketing integration
+// @Description Create or update the authenticated user's configuration of a ticketing provider. GitHub takes token, repository (owner/name), labels and base_url; Jira takes base_url, email, api_token, project_key, issue_type and labels; Linear takes api_key, team_id and label_ids. All take a field_mapping of priorities, assignees and the due date field. Settings that are left out keep their current value.
+// @Tags integrations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param provider path string true "Ticketing provider (github, jira, linear)"
+// @Param request body dtos.ConfigureTicketingRequest true "Provider settings"
+// @Success 200 {object} dtos.TicketingIntegrationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /integrations/ticketing/{provider} [put]
+func (h *TicketingHandlers) ConfigureIntegration(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.ConfigureTicketingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := commands.ConfigureTicketingCommand{
+		UserID:   userID,
+		Provider: c.Param("provider"),
+		Config:   req.Config,
+		IsActive: req.IsActive,
+	}
+
+	config, err := h.ticketingService.ConfigureIntegration(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to configure ticketing integration")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTicketingIntegrationResponse(config))
+}
+
+// DeleteIntegration removes a ticketing provider of the authenticated user
+// @Summary Delete ticketing integration
+// @Description Remove the authenticated user's configuration of a ticketing provider. Tickets that were already created keep their references.
+// @Tags integrations
+// @Security BearerAuth
+// @Param provider path string true "Ticketing provider (github, jira, linear)"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /integrations/ticketing/{provider} [delete]
+func (h *TicketingHandlers) DeleteIntegration(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.ticketingService.DeleteIntegration(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		respondWithError(c, err, "Failed to delete ticketing integration")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CreateTickets opens tickets for approved action items
+// @Summary Create tickets for action items
+// @Description Open a ticket with a configured provider for each approved action item of meetings the authenticated user can edit, in a background job. Each action item is marked as created and keeps a reference to its ticket.
+// @Tags action-items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dtos.CreateTicketsRequest true "Action items and provider"
+// @Success 202 {object} dtos.TicketJobResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/tickets [post]
+func (h *TicketingHandlers) CreateTickets(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.CreateTicketsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := commands.CreateTicketsCommand{
+		UserID:        userID,
+		Provider:      req.Provider,
+		ActionItemIDs: req.ActionItemIDs,
+		Project:       req.Project,
+	}
+
+	job, err := h.ticketingService.CreateTickets(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to create tickets")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dtos.ToTicketJobResponse(job))
+}
+
+// GetTicketJob returns the status of tickets being created
+// @Summary Get ticket creation status
+// @Description Get the status of tickets being opened for action items, and which tickets were opened once it completes
+// @Tags action-items
+// @Produce json
+// @Security BearerAuth
+// @Param jobId path string true "Ticket job ID"
+// @Success 200 {object} dtos.TicketJobResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /action-items/tickets/jobs/{jobId} [get]
+func (h *TicketingHandlers) GetTicketJob(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.ticketingService.GetTicketJob(c.Request.Context(), c.Param("jobId"), userID)
+	if err != nil {
+		respondWithError(c, err, "Failed to get ticket job")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTicketJobResponse(job))
+}
+
+// LinkTicket links an action item to an existing ticket
+// @Summary Link action item to ticket
+// @Description Link an action item of a meeting the authenticated user can edit to a ticket that already exists with a configured provider, and mark it as created
+// @Tags action-items
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Action item ID"
+// @Param request body dtos.LinkTicketRequest true "Provider and ticket"
+// @Success 200 {object} dtos.ActionItemResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Failure 502 {object} map[string]string
+// @Router /action-items/{id}/ticket/link [post]
+func (h *TicketingHandlers) LinkTicket(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.LinkTicketRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := commands.LinkTicketCommand{
+		ActionItemID: c.Param("id"),
+		UserID:       userID,
+		Provider:     req.Provider,
+		Ticket:       req.Ticket,
+	}
+
+	actionItem, err := h.ticketingService.LinkTicket(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to link ticket")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToActionItemResponse(actionItem))
+}
+
+// HandleWebhook receives the ticket changes a ticketing system delivers
+// @Summary Receive ticket webhook
+// @Description Receive a webhook delivery of GitHub (issues events), Jira (issue created and updated events) or Linear (Issue events) for one ticketing integration. Deliveries must be signed with the integration's webhook_secret. Closing a ticket completes its action item and reopening it makes the action item open again.
+// @Tags integrations
+// @Accept json
+// @Produce json
+// @Param provider path string true "Ticketing provider (github, jira, linear)"
+// @Param integrationId path string true "Ticketing integration ID"
+// @Success 200 {object} map[string]int
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 413 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /webhooks/ticketing/{provider}/{integrationId} [post]
+func (h *TicketingHandlers) HandleWebhook(c *gin.Context) {
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxWebhookBodySize))
+	if err != nil {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Webhook delivery is too large"})
+		return
+	}
+
+	cmd := commands.HandleTicketWebhookCommand{
+		Provider:      c.Param("provider"),
+		IntegrationID: c.Param("integrationId"),
+		Header:        c.Request.Header,
+		Body:          body,
+	}
+
+	updated, err := h.syncService.HandleWebhook(c.Request.Context(), cmd)
+	if err != nil {
+		respondWithError(c, err, "Failed to process webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": updated})
+}
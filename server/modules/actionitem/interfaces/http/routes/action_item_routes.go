@@ -0,0 +1,52 @@
+package routes
+
+import (
+	"teammate/server/modules/actionitem/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ActionItemRoutes sets up all action item routes
+type ActionItemRoutes struct {
+	actionItemHandlers *handlers.ActionItemHandlers
+	authMiddleware     *middleware.AuthMiddleware
+}
+
+// NewActionItemRoutes creates a new action item routes instance
+func NewActionItemRoutes(actionItemHandlers *handlers.ActionItemHandlers, authMiddleware *middleware.AuthMiddleware) *ActionItemRoutes {
+	return &ActionItemRoutes{
+		actionItemHandlers: actionItemHandlers,
+		authMiddleware:     authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected action item routes (authentication required)
+func (r *ActionItemRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	actionItems := protected.Group("/action-items")
+	{
+		actionItems.GET("", r.actionItemHandlers.GetActionItems)        // List action items with filters
+		actionItems.GET("/:id", r.actionItemHandlers.GetActionItemByID) // Get specific action item
+
+		// Review workflow endpoints
+		actionItems.POST("/:id/approve", r.actionItemHandlers.ApproveActionItem)        // Approve an action item
+		actionItems.POST("/:id/reject", r.actionItemHandlers.RejectActionItem)          // Reject an action item
+		actionItems.PUT("/:id/assignee", r.actionItemHandlers.SetAssignee)              // Assign or unassign
+		actionItems.POST("/:id/assignee/resolve", r.actionItemHandlers.ResolveAssignee) // Match the assignee again
+		actionItems.POST("/:id/assignee/confirm", r.actionItemHandlers.ConfirmAssignee) // Confirm an assignee candidate
+		actionItems.PUT("/:id/due-date", r.actionItemHandlers.SetDueDate)               // Set or clear the due date
+	}
+
+	protected.GET("/meetings/:id/action-items", r.actionItemHandlers.GetMeetingActionItems) // Action items of a meeting
+	protected.GET("/meetings/:id/follow-ups", r.actionItemHandlers.GetFollowUpReport)       // Open follow-ups of a meeting
+
+	// Extraction endpoints
+	extraction := protected.Group("/transcriptions/:id/action-items")
+	{
+		extraction.POST("/extract", r.actionItemHandlers.ExtractActionItems)  // Extract action items again
+		extraction.GET("/jobs/:jobId", r.actionItemHandlers.GetExtractionJob) // Extraction status
+	}
+}
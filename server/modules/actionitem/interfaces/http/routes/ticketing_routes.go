@@ -0,0 +1,48 @@
+package routes
+
+import (
+	"teammate/server/modules/actionitem/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TicketingRoutes sets up the routes that turn action items into tickets
+type TicketingRoutes struct {
+	ticketingHandlers *handlers.TicketingHandlers
+	authMiddleware    *middleware.AuthMiddleware
+}
+
+// NewTicketingRoutes creates a new ticketing routes instance
+func NewTicketingRoutes(ticketingHandlers *handlers.TicketingHandlers, authMiddleware *middleware.AuthMiddleware) *TicketingRoutes {
+	return &TicketingRoutes{
+		ticketingHandlers: ticketingHandlers,
+		authMiddleware:    authMiddleware,
+	}
+}
+
+// SetupPublicRoutes sets up the webhook routes ticketing systems deliver to. They are not
+// authenticated; deliveries are verified with the integration's webhook secret instead.
+func (r *TicketingRoutes) SetupPublicRoutes(public *gin.RouterGroup) {
+	public.POST("/webhooks/ticketing/:provider/:integrationId", r.ticketingHandlers.HandleWebhook) // Ticket changes
+}
+
+// SetupProtectedRoutes sets up protected ticketing routes (authentication required)
+func (r *TicketingRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	integrations := protected.Group("/integrations/ticketing")
+	{
+		integrations.GET("", r.ticketingHandlers.GetIntegrations)                // List configured providers
+		integrations.PUT("/:provider", r.ticketingHandlers.ConfigureIntegration) // Configure a provider
+		integrations.DELETE("/:provider", r.ticketingHandlers.DeleteIntegration) // Remove a provider
+	}
+
+	tickets := protected.Group("/action-items")
+	{
+		tickets.POST("/tickets", r.ticketingHandlers.CreateTickets)           // Create tickets for approved items
+		tickets.GET("/tickets/jobs/:jobId", r.ticketingHandlers.GetTicketJob) // Ticket creation status
+		tickets.POST("/:id/ticket/link", r.ticketingHandlers.LinkTicket)      // Link an existing ticket
+	}
+}
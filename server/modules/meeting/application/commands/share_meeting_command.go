@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"teammate/server/modules/meeting/domain/entities"
+)
+
+// ShareMeetingCommand represents the command to share a meeting with another user
+type ShareMeetingCommand struct {
+	MeetingID        string                   `json:"meeting_id" validate:"required"`
+	UserID           string                   `json:"user_id" validate:"required"`
+	SharedWithUserID string                   `json:"shared_with_user_id" validate:"required"`
+	Permission       entities.SharePermission `json:"permission" validate:"required"`
+}
+
+// RevokeMeetingShareCommand represents the command to stop sharing a meeting with a user
+type RevokeMeetingShareCommand struct {
+	MeetingID string `json:"meeting_id" validate:"required"`
+	UserID    string `json:"user_id" validate:"required"`
+	ShareID   string `json:"share_id" validate:"required"`
+}
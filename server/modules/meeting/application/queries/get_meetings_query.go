@@ -12,3 +12,9 @@ type GetMeetingByIDQuery struct {
 	ID     string `json:"id" validate:"required"`
 	UserID string `json:"user_id" validate:"required"`
 }
+
+// GetMeetingSharesQuery represents the query to list the shares of a meeting
+type GetMeetingSharesQuery struct {
+	MeetingID string `json:"meeting_id" validate:"required"`
+	UserID    string `json:"user_id" validate:"required"`
+}
@@ -213,3 +213,47 @@ func (m *BotSessionMapper) ToDomainList(repos []repositories.BotSession) []*enti
 	}
 	return result
 }
+
+// MeetingShareMapper implements DomainMapper for MeetingShare entities
+type MeetingShareMapper struct {
+	domain.BaseDomainMapper
+}
+
+// NewMeetingShareMapper creates a new meeting share mapper
+func NewMeetingShareMapper() *MeetingShareMapper {
+	return &MeetingShareMapper{}
+}
+
+// ToRepository converts domain MeetingShare to repository MeetingShare
+func (m *MeetingShareMapper) ToRepository(share *entities.MeetingShare) repositories.MeetingShare {
+	repo := repositories.MeetingShare{
+		MeetingID:        share.MeetingID,
+		SharedWithUserID: share.SharedWithUserID,
+		SharedByUserID:   share.SharedByUserID,
+		Permission:       string(share.Permission),
+	}
+
+	// Set repository model fields
+	repo.SetID(share.GetID())
+	repo.CreatedAt = share.GetCreatedAt()
+	repo.UpdatedAt = share.GetUpdatedAt()
+
+	return repo
+}
+
+// ToDomain converts repository MeetingShare to domain MeetingShare
+func (m *MeetingShareMapper) ToDomain(repo repositories.MeetingShare) *entities.MeetingShare {
+	share := &entities.MeetingShare{
+		MeetingID:        repo.MeetingID,
+		SharedWithUserID: repo.SharedWithUserID,
+		SharedByUserID:   repo.SharedByUserID,
+		Permission:       entities.SharePermission(repo.Permission),
+	}
+
+	// Set entity metadata
+	share.SetID(repo.GetID())
+	share.CreatedAt = repo.CreatedAt
+	share.UpdatedAt = repo.UpdatedAt
+
+	return share
+}
@@ -8,20 +8,25 @@ import (
 	"teammate/server/modules/meeting/application/queries"
 	"teammate/server/modules/meeting/domain/entities"
 	"teammate/server/modules/meeting/domain/repositories"
+	domainServices "teammate/server/modules/meeting/domain/services"
 	"teammate/server/seedwork/domain"
 )
 
 // MeetingService handles meeting business logic orchestration
 type MeetingService struct {
 	meetingRepo   repositories.MeetingRepository
+	accessService *domainServices.MeetingAccessService
 	meetingMapper *MeetingMapper
+	shareMapper   *MeetingShareMapper
 }
 
 // NewMeetingService creates a new meeting service
 func NewMeetingService(meetingRepo repositories.MeetingRepository) *MeetingService {
 	return &MeetingService{
 		meetingRepo:   meetingRepo,
+		accessService: domainServices.NewMeetingAccessService(meetingRepo),
 		meetingMapper: NewMeetingMapper(),
+		shareMapper:   NewMeetingShareMapper(),
 	}
 }
 
@@ -97,20 +102,81 @@ func (s *MeetingService) GetMeetings(ctx context.Context, query queries.GetMeeti
 
 // GetMeetingByID retrieves a specific meeting by ID (query - no domain logic needed)
 func (s *MeetingService) GetMeetingByID(ctx context.Context, query queries.GetMeetingByIDQuery) (*entities.Meeting, error) {
-	repoMeeting, err := s.meetingRepo.FindMeetingByID(ctx, query.ID)
+	// Verify the user owns the meeting or it has been shared with them
+	repoMeeting, err := s.accessService.VerifyViewAccess(ctx, query.ID, query.UserID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get meeting: %w", err)
-	}
-
-	// Verify ownership
-	if repoMeeting.UserID != query.UserID {
-		return nil, domain.NewDomainError("UNAUTHORIZED", "Meeting not found or access denied", nil)
+		return nil, err
 	}
 
 	// Convert repository model to domain entity using mapper
 	return s.meetingMapper.ToDomain(*repoMeeting), nil
 }
 
+// ShareMeeting grants another user access to a meeting, updating the permission of an existing share
+func (s *MeetingService) ShareMeeting(ctx context.Context, cmd commands.ShareMeetingCommand) (*entities.MeetingShare, error) {
+	// Load aggregate
+	meeting, err := s.getMeetingAggregate(ctx, cmd.MeetingID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Execute domain method with direct parameters
+	share, err := meeting.ShareWith(cmd.UserID, cmd.SharedWithUserID, cmd.Permission)
+	if err != nil {
+		return nil, domain.NewDomainError("INVALID_MEETING_SHARE", err.Error(), err)
+	}
+
+	repoShare := s.shareMapper.ToRepository(share)
+	if existing, err := s.meetingRepo.FindMeetingShare(ctx, cmd.MeetingID, cmd.SharedWithUserID); err == nil && existing != nil {
+		existing.Permission = repoShare.Permission
+		repoShare = *existing
+	}
+
+	if err := s.meetingRepo.SaveMeetingShare(ctx, &repoShare); err != nil {
+		return nil, fmt.Errorf("failed to persist meeting share: %w", err)
+	}
+
+	return s.shareMapper.ToDomain(repoShare), nil
+}
+
+// RevokeMeetingShare removes a user's access to a meeting
+func (s *MeetingService) RevokeMeetingShare(ctx context.Context, cmd commands.RevokeMeetingShareCommand) error {
+	if _, err := s.accessService.VerifyOwnership(ctx, cmd.MeetingID, cmd.UserID); err != nil {
+		return err
+	}
+
+	shares, err := s.meetingRepo.FindMeetingSharesByMeetingID(ctx, cmd.MeetingID)
+	if err != nil {
+		return fmt.Errorf("failed to get meeting shares: %w", err)
+	}
+
+	for _, share := range shares {
+		if share.ID == cmd.ShareID {
+			return s.meetingRepo.DeleteMeetingShare(ctx, share.ID)
+		}
+	}
+
+	return domain.NewDomainError("MEETING_SHARE_NOT_FOUND", "Meeting share not found", domain.ErrNotFound)
+}
+
+// GetMeetingShares lists who a meeting has been shared with (owner only)
+func (s *MeetingService) GetMeetingShares(ctx context.Context, query queries.GetMeetingSharesQuery) ([]*entities.MeetingShare, error) {
+	if _, err := s.accessService.VerifyOwnership(ctx, query.MeetingID, query.UserID); err != nil {
+		return nil, err
+	}
+
+	repoShares, err := s.meetingRepo.FindMeetingSharesByMeetingID(ctx, query.MeetingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meeting shares: %w", err)
+	}
+
+	shares := make([]*entities.MeetingShare, len(repoShares))
+	for i, repoShare := range repoShares {
+		shares[i] = s.shareMapper.ToDomain(*repoShare)
+	}
+	return shares, nil
+}
+
 // Helper methods for aggregate loading/saving
 func (s *MeetingService) getMeetingAggregate(ctx context.Context, meetingID, userID string) (*entities.Meeting, error) {
 	repoMeeting, err := s.meetingRepo.FindMeetingByID(ctx, meetingID)
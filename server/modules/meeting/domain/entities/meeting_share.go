@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"errors"
+	"teammate/server/seedwork/domain"
+)
+
+type SharePermission string
+
+const (
+	ViewPermission SharePermission = "view"
+	EditPermission SharePermission = "edit"
+)
+
+// MeetingShare grants a user other than the owner access to a meeting and its transcripts
+type MeetingShare struct {
+	domain.BaseEntity
+	MeetingID        string          `json:"meeting_id" gorm:"column:meeting_id;not null"`
+	SharedWithUserID string          `json:"shared_with_user_id" gorm:"column:shared_with_user_id;not null"`
+	SharedByUserID   string          `json:"shared_by_user_id" gorm:"column:shared_by_user_id;not null"`
+	Permission       SharePermission `json:"permission" gorm:"column:permission;not null"`
+}
+
+// ShareWith creates a share granting another user access to the meeting. Only the owner may share a meeting.
+func (m *Meeting) ShareWith(sharedByUserID, sharedWithUserID string, permission SharePermission) (*MeetingShare, error) {
+	if sharedByUserID != m.UserID {
+		return nil, errors.New("only the meeting owner can share a meeting")
+	}
+	if sharedWithUserID == "" {
+		return nil, errors.New("user to share with is required")
+	}
+	if sharedWithUserID == m.UserID {
+		return nil, errors.New("a meeting cannot be shared with its owner")
+	}
+	if !permission.IsValid() {
+		return nil, errors.New("invalid share permission")
+	}
+
+	share := &MeetingShare{
+		MeetingID:        m.GetID(),
+		SharedWithUserID: sharedWithUserID,
+		SharedByUserID:   sharedByUserID,
+		Permission:       permission,
+	}
+	share.SetID(domain.GenerateID())
+	return share, nil
+}
+
+// IsValid returns true if the permission is a known share permission
+func (p SharePermission) IsValid() bool {
+	return p == ViewPermission || p == EditPermission
+}
+
+// CanEdit returns true if the share allows modifying the meeting's transcripts
+func (s *MeetingShare) CanEdit() bool {
+	return s.Permission == EditPermission
+}
+
+// TableName sets the table name for GORM
+func (MeetingShare) TableName() string {
+	return "meeting_shares"
+}
@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMeeting_ShareWith(t *testing.T) {
+	meeting, err := CreateMeeting("owner-1", "Weekly sync", GoogleMeet, "https://meet.google.com/abc-defg-hij")
+	require.NoError(t, err)
+
+	share, err := meeting.ShareWith("owner-1", "user-2", ViewPermission)
+	require.NoError(t, err)
+	assert.Equal(t, meeting.GetID(), share.MeetingID)
+	assert.Equal(t, "user-2", share.SharedWithUserID)
+	assert.NotEmpty(t, share.GetID())
+	assert.False(t, share.CanEdit())
+
+	tests := []struct {
+		name       string
+		sharedBy   string
+		sharedWith string
+		permission SharePermission
+	}{
+		{name: "not the owner", sharedBy: "user-3", sharedWith: "user-2", permission: ViewPermission},
+		{name: "missing user", sharedBy: "owner-1", sharedWith: "", permission: ViewPermission},
+		{name: "share with owner", sharedBy: "owner-1", sharedWith: "owner-1", permission: EditPermission},
+		{name: "unknown permission", sharedBy: "owner-1", sharedWith: "user-2", permission: "admin"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := meeting.ShareWith(tt.sharedBy, tt.sharedWith, tt.permission)
+			assert.Error(t, err)
+		})
+	}
+}
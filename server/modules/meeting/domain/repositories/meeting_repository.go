@@ -57,6 +57,20 @@ func (BotSession) TableName() string {
 	return "bot_sessions"
 }
 
+// MeetingShare represents a meeting shared with another user
+type MeetingShare struct {
+	domain.BaseRepositoryModel
+	MeetingID        string `json:"meeting_id"`
+	SharedWithUserID string `json:"shared_with_user_id"`
+	SharedByUserID   string `json:"shared_by_user_id"`
+	Permission       string `json:"permission"`
+}
+
+// TableName returns the database table name for meeting shares
+func (MeetingShare) TableName() string {
+	return "meeting_shares"
+}
+
 // MeetingRepository defines the interface for meeting persistence
 type MeetingRepository interface {
 	// Meeting CRUD operations
@@ -79,6 +93,13 @@ type MeetingRepository interface {
 	FindBotSessionBySessionID(ctx context.Context, sessionID string) (*BotSession, error)
 	UpdateBotSession(ctx context.Context, session *BotSession) error
 
+	// Meeting share operations
+	SaveMeetingShare(ctx context.Context, share *MeetingShare) error
+	FindMeetingSharesByMeetingID(ctx context.Context, meetingID string) ([]*MeetingShare, error)
+	FindMeetingShare(ctx context.Context, meetingID, userID string) (*MeetingShare, error)
+	FindMeetingsSharedWithUser(ctx context.Context, userID string) ([]*Meeting, error)
+	DeleteMeetingShare(ctx context.Context, id string) error
+
 	// Query operations
 	FindMeetingsByStatus(ctx context.Context, status string) ([]*Meeting, error)
 	FindActiveMeetings(ctx context.Context) ([]*Meeting, error)
@@ -102,6 +123,12 @@ const (
 	BotSessionStatusFailed    = "failed"
 )
 
+// Meeting share permission constants
+const (
+	MeetingSharePermissionView = "view"
+	MeetingSharePermissionEdit = "edit"
+)
+
 // Meeting type constants
 const (
 	MeetingTypeZoom           = "zoom"
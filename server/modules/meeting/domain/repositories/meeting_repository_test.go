@@ -42,4 +42,16 @@ func TestRepositoryModelCompliance(t *testing.T) {
 	if botSession.TableName() != "bot_sessions" {
 		t.Errorf("BotSession TableName failed: expected 'bot_sessions', got '%s'", botSession.TableName())
 	}
+
+	// Test MeetingShare model
+	var share domain.RepositoryModel = &MeetingShare{}
+	share.SetID("test-share-id")
+
+	if share.GetID() != "test-share-id" {
+		t.Errorf("MeetingShare GetID failed: expected 'test-share-id', got '%s'", share.GetID())
+	}
+
+	if share.TableName() != "meeting_shares" {
+		t.Errorf("MeetingShare TableName failed: expected 'meeting_shares', got '%s'", share.TableName())
+	}
 }
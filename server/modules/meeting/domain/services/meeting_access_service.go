@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// MeetingAccessService decides who may see or change a meeting and everything recorded in it.
+// Owners have full access; other users need a meeting share, and an edit share to make changes.
+type MeetingAccessService struct {
+	meetingRepo repositories.MeetingRepository
+}
+
+// NewMeetingAccessService creates a new meeting access service
+func NewMeetingAccessService(meetingRepo repositories.MeetingRepository) *MeetingAccessService {
+	return &MeetingAccessService{
+		meetingRepo: meetingRepo,
+	}
+}
+
+// VerifyViewAccess returns the meeting if the user owns it or it has been shared with them
+func (s *MeetingAccessService) VerifyViewAccess(ctx context.Context, meetingID, userID string) (*repositories.Meeting, error) {
+	return s.verifyAccess(ctx, meetingID, userID, false)
+}
+
+// VerifyEditAccess returns the meeting if the user owns it or holds an edit share
+func (s *MeetingAccessService) VerifyEditAccess(ctx context.Context, meetingID, userID string) (*repositories.Meeting, error) {
+	return s.verifyAccess(ctx, meetingID, userID, true)
+}
+
+// VerifyOwnership returns the meeting if the user owns it
+func (s *MeetingAccessService) VerifyOwnership(ctx context.Context, meetingID, userID string) (*repositories.Meeting, error) {
+	meeting, err := s.meetingRepo.FindMeetingByID(ctx, meetingID)
+	if err != nil {
+		return nil, domain.NewDomainError("MEETING_NOT_FOUND", "Meeting not found", err)
+	}
+	if meeting.UserID != userID {
+		return nil, domain.NewDomainError("UNAUTHORIZED", "Meeting not found or access denied", nil)
+	}
+	return meeting, nil
+}
+
+func (s *MeetingAccessService) verifyAccess(ctx context.Context, meetingID, userID string, requireEdit bool) (*repositories.Meeting, error) {
+	meeting, err := s.meetingRepo.FindMeetingByID(ctx, meetingID)
+	if err != nil {
+		return nil, domain.NewDomainError("MEETING_NOT_FOUND", "Meeting not found", err)
+	}
+	if userID == "" {
+		return nil, domain.NewDomainError("UNAUTHORIZED", "Meeting not found or access denied", nil)
+	}
+	if meeting.UserID == userID {
+		return meeting, nil
+	}
+
+	share, err := s.meetingRepo.FindMeetingShare(ctx, meetingID, userID)
+	if err != nil || share == nil {
+		return nil, domain.NewDomainError("UNAUTHORIZED", "Meeting not found or access denied", nil)
+	}
+	if requireEdit && share.Permission != repositories.MeetingSharePermissionEdit {
+		return nil, domain.NewDomainError("UNAUTHORIZED", "Meeting not found or access denied", nil)
+	}
+	return meeting, nil
+}
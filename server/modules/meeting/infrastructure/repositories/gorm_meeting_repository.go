@@ -147,6 +147,51 @@ func (r *GormMeetingRepository) FindBotSessionBySessionID(ctx context.Context, s
 	return &session, nil
 }
 
+// SaveMeetingShare stores a meeting share
+func (r *GormMeetingRepository) SaveMeetingShare(ctx context.Context, share *repositories.MeetingShare) error {
+	return r.db.WithContext(ctx).Save(share).Error
+}
+
+// FindMeetingSharesByMeetingID retrieves all shares of a meeting
+func (r *GormMeetingRepository) FindMeetingSharesByMeetingID(ctx context.Context, meetingID string) ([]*repositories.MeetingShare, error) {
+	var shares []*repositories.MeetingShare
+	err := r.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Order("created_at ASC").Find(&shares).Error
+	return shares, err
+}
+
+// FindMeetingShare retrieves the share of a meeting with a specific user
+func (r *GormMeetingRepository) FindMeetingShare(ctx context.Context, meetingID, userID string) (*repositories.MeetingShare, error) {
+	var share repositories.MeetingShare
+	err := r.db.WithContext(ctx).First(&share, "meeting_id = ? AND shared_with_user_id = ?", meetingID, userID).Error
+	if err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
+
+// FindMeetingsSharedWithUser retrieves meetings other users have shared with a user
+func (r *GormMeetingRepository) FindMeetingsSharedWithUser(ctx context.Context, userID string) ([]*repositories.Meeting, error) {
+	var meetings []*repositories.Meeting
+	err := r.db.WithContext(ctx).
+		Joins("JOIN meeting_shares ON meeting_shares.meeting_id = meetings.id AND meeting_shares.deleted_at IS NULL").
+		Where("meeting_shares.shared_with_user_id = ?", userID).
+		Order("meetings.created_at DESC").
+		Find(&meetings).Error
+	return meetings, err
+}
+
+// DeleteMeetingShare removes a meeting share
+func (r *GormMeetingRepository) DeleteMeetingShare(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Delete(&repositories.MeetingShare{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("meeting share not found: %s", id)
+	}
+	return nil
+}
+
 // FindMeetingsByStatus retrieves meetings by status
 func (r *GormMeetingRepository) FindMeetingsByStatus(ctx context.Context, status string) ([]*repositories.Meeting, error) {
 	var meetings []*repositories.Meeting
@@ -508,6 +508,147 @@ func (r *PostgresMeetingRepository) UpdateBotSession(ctx context.Context, sessio
 	return nil
 }
 
+// SaveMeetingShare stores a meeting share in the database
+func (r *PostgresMeetingRepository) SaveMeetingShare(ctx context.Context, share *repositories.MeetingShare) error {
+	query := `
+		INSERT INTO meeting_shares (id, meeting_id, shared_with_user_id, shared_by_user_id, permission, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (id) DO UPDATE SET
+			permission = EXCLUDED.permission,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	if share.ID == "" {
+		share.ID = uuid.New().String()
+	}
+
+	now := time.Now()
+	if share.CreatedAt.IsZero() {
+		share.CreatedAt = now
+	}
+	share.UpdatedAt = now
+
+	_, err := r.db.ExecContext(ctx, query,
+		share.ID,
+		share.MeetingID,
+		share.SharedWithUserID,
+		share.SharedByUserID,
+		share.Permission,
+		share.CreatedAt,
+		share.UpdatedAt,
+	)
+
+	return err
+}
+
+// FindMeetingSharesByMeetingID retrieves all shares of a meeting
+func (r *PostgresMeetingRepository) FindMeetingSharesByMeetingID(ctx context.Context, meetingID string) ([]*repositories.MeetingShare, error) {
+	query := `
+		SELECT id, meeting_id, shared_with_user_id, shared_by_user_id, permission, created_at, updated_at
+		FROM meeting_shares
+		WHERE meeting_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []*repositories.MeetingShare
+
+	for rows.Next() {
+		var share repositories.MeetingShare
+
+		err := rows.Scan(
+			&share.ID,
+			&share.MeetingID,
+			&share.SharedWithUserID,
+			&share.SharedByUserID,
+			&share.Permission,
+			&share.CreatedAt,
+			&share.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		shares = append(shares, &share)
+	}
+
+	return shares, rows.Err()
+}
+
+// FindMeetingShare retrieves the share of a meeting with a specific user
+func (r *PostgresMeetingRepository) FindMeetingShare(ctx context.Context, meetingID, userID string) (*repositories.MeetingShare, error) {
+	query := `
+		SELECT id, meeting_id, shared_with_user_id, shared_by_user_id, permission, created_at, updated_at
+		FROM meeting_shares
+		WHERE meeting_id = $1 AND shared_with_user_id = $2 AND deleted_at IS NULL
+	`
+
+	var share repositories.MeetingShare
+
+	err := r.db.QueryRowContext(ctx, query, meetingID, userID).Scan(
+		&share.ID,
+		&share.MeetingID,
+		&share.SharedWithUserID,
+		&share.SharedByUserID,
+		&share.Permission,
+		&share.CreatedAt,
+		&share.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("meeting share not found for meeting %s and user %s", meetingID, userID)
+		}
+		return nil, err
+	}
+
+	return &share, nil
+}
+
+// FindMeetingsSharedWithUser retrieves meetings other users have shared with a user
+func (r *PostgresMeetingRepository) FindMeetingsSharedWithUser(ctx context.Context, userID string) ([]*repositories.Meeting, error) {
+	query := `
+		SELECT m.id, m.user_id, m.title, m.type, m.status, m.start_time, m.end_time, m.meeting_url, m.bot_join_url, m.recording_path, m.created_at, m.updated_at
+		FROM meetings m
+		JOIN meeting_shares s ON s.meeting_id = m.id AND s.deleted_at IS NULL
+		WHERE s.shared_with_user_id = $1 AND m.deleted_at IS NULL
+		ORDER BY m.created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanMeetings(rows)
+}
+
+// DeleteMeetingShare removes a meeting share
+func (r *PostgresMeetingRepository) DeleteMeetingShare(ctx context.Context, id string) error {
+	query := `UPDATE meeting_shares SET deleted_at = CURRENT_TIMESTAMP WHERE id = $1 AND deleted_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("meeting share not found: %s", id)
+	}
+
+	return nil
+}
+
 // FindMeetingsByStatus retrieves meetings by status
 func (r *PostgresMeetingRepository) FindMeetingsByStatus(ctx context.Context, status string) ([]*repositories.Meeting, error) {
 	query := `
@@ -66,3 +66,52 @@ func ToMeetingsListResponse(meetings []*entities.Meeting, total int64) MeetingsL
 		Total:    total,
 	}
 }
+
+// ShareMeetingRequest represents the request to share a meeting with another user
+type ShareMeetingRequest struct {
+	UserID     string                   `json:"user_id" binding:"required"`
+	Permission entities.SharePermission `json:"permission" binding:"required,oneof=view edit"`
+}
+
+// MeetingShareResponse represents a meeting share
+type MeetingShareResponse struct {
+	ID               string                   `json:"id"`
+	MeetingID        string                   `json:"meeting_id"`
+	SharedWithUserID string                   `json:"shared_with_user_id"`
+	SharedByUserID   string                   `json:"shared_by_user_id"`
+	Permission       entities.SharePermission `json:"permission"`
+	CreatedAt        time.Time                `json:"created_at"`
+	UpdatedAt        time.Time                `json:"updated_at"`
+}
+
+// MeetingSharesListResponse represents the response containing the shares of a meeting
+type MeetingSharesListResponse struct {
+	Shares []MeetingShareResponse `json:"shares"`
+	Total  int                    `json:"total"`
+}
+
+// ToMeetingShareResponse converts a MeetingShare entity to MeetingShareResponse DTO
+func ToMeetingShareResponse(share *entities.MeetingShare) MeetingShareResponse {
+	return MeetingShareResponse{
+		ID:               share.GetID(),
+		MeetingID:        share.MeetingID,
+		SharedWithUserID: share.SharedWithUserID,
+		SharedByUserID:   share.SharedByUserID,
+		Permission:       share.Permission,
+		CreatedAt:        share.GetCreatedAt(),
+		UpdatedAt:        share.GetUpdatedAt(),
+	}
+}
+
+// ToMeetingSharesListResponse converts a slice of MeetingShare entities to MeetingSharesListResponse DTO
+func ToMeetingSharesListResponse(shares []*entities.MeetingShare) MeetingSharesListResponse {
+	shareResponses := make([]MeetingShareResponse, len(shares))
+	for i, share := range shares {
+		shareResponses[i] = ToMeetingShareResponse(share)
+	}
+
+	return MeetingSharesListResponse{
+		Shares: shareResponses,
+		Total:  len(shares),
+	}
+}
@@ -198,7 +198,7 @@ func (h *MeetingHandlers) GetMeetingByID(c *gin.Context) {
 		}
 		meeting, err := h.meetingService.GetMeetingByID(c.Request.Context(), query)
 		if err != nil {
-			if domainErr, ok := err.(*domain.DomainError); ok && domainErr.Code == "UNAUTHORIZED" {
+			if domainErr, ok := err.(*domain.DomainError); ok && (domainErr.Code == "UNAUTHORIZED" || domainErr.Code == "MEETING_NOT_FOUND") {
 				c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
 				return
 			}
@@ -218,7 +218,7 @@ func (h *MeetingHandlers) GetMeetingByID(c *gin.Context) {
 
 	meeting, err := h.meetingService.GetMeetingByID(c.Request.Context(), query)
 	if err != nil {
-		if domainErr, ok := err.(*domain.DomainError); ok && domainErr.Code == "UNAUTHORIZED" {
+		if domainErr, ok := err.(*domain.DomainError); ok && (domainErr.Code == "UNAUTHORIZED" || domainErr.Code == "MEETING_NOT_FOUND") {
 			c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
 			return
 		}
@@ -229,3 +229,153 @@ func (h *MeetingHandlers) GetMeetingByID(c *gin.Context) {
 	response := dtos.ToMeetingResponse(meeting)
 	c.JSON(http.StatusOK, response)
 }
+
+// ShareMeeting shares a meeting with another user
+// @Summary Share a meeting
+// @Description Grant another user view or edit access to a meeting owned by the authenticated user
+// @Tags meetings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Param share body dtos.ShareMeetingRequest true "Share information"
+// @Success 201 {object} dtos.MeetingShareResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/shares [post]
+func (h *MeetingHandlers) ShareMeeting(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.ShareMeetingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := commands.ShareMeetingCommand{
+		MeetingID:        c.Param("id"),
+		UserID:           userID,
+		SharedWithUserID: req.UserID,
+		Permission:       req.Permission,
+	}
+
+	share, err := h.meetingService.ShareMeeting(c.Request.Context(), cmd)
+	if err != nil {
+		if domainErr, ok := err.(*domain.DomainError); ok {
+			switch domainErr.Code {
+			case "UNAUTHORIZED", "MEETING_NOT_FOUND":
+				c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
+				return
+			case "INVALID_MEETING_SHARE":
+				c.JSON(http.StatusBadRequest, gin.H{"error": domainErr.Message})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to share meeting"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToMeetingShareResponse(share))
+}
+
+// GetMeetingShares lists who a meeting has been shared with
+// @Summary List meeting shares
+// @Description List the users a meeting owned by the authenticated user has been shared with
+// @Tags meetings
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Success 200 {object} dtos.MeetingSharesListResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/shares [get]
+func (h *MeetingHandlers) GetMeetingShares(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	query := queries.GetMeetingSharesQuery{
+		MeetingID: c.Param("id"),
+		UserID:    userID,
+	}
+
+	shares, err := h.meetingService.GetMeetingShares(c.Request.Context(), query)
+	if err != nil {
+		if domainErr, ok := err.(*domain.DomainError); ok && (domainErr.Code == "UNAUTHORIZED" || domainErr.Code == "MEETING_NOT_FOUND") {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get meeting shares"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToMeetingSharesListResponse(shares))
+}
+
+// RevokeMeetingShare stops sharing a meeting with a user
+// @Summary Revoke a meeting share
+// @Description Remove another user's access to a meeting owned by the authenticated user
+// @Tags meetings
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Param shareId path string true "Share ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/shares/{shareId} [delete]
+func (h *MeetingHandlers) RevokeMeetingShare(c *gin.Context) {
+	userID, ok := getUserID(c)
+	if !ok {
+		return
+	}
+
+	cmd := commands.RevokeMeetingShareCommand{
+		MeetingID: c.Param("id"),
+		UserID:    userID,
+		ShareID:   c.Param("shareId"),
+	}
+
+	if err := h.meetingService.RevokeMeetingShare(c.Request.Context(), cmd); err != nil {
+		if domainErr, ok := err.(*domain.DomainError); ok {
+			switch domainErr.Code {
+			case "UNAUTHORIZED", "MEETING_NOT_FOUND":
+				c.JSON(http.StatusNotFound, gin.H{"error": "Meeting not found"})
+				return
+			case "MEETING_SHARE_NOT_FOUND":
+				c.JSON(http.StatusNotFound, gin.H{"error": domainErr.Message})
+				return
+			}
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to revoke meeting share"})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// getUserID returns the authenticated user's ID, writing a 401 response when it is not available
+func getUserID(c *gin.Context) (string, bool) {
+	if userInterface, exists := c.Get("user"); exists {
+		if user, ok := userInterface.(*entities.User); ok {
+			return user.GetID(), true
+		}
+	}
+
+	// Try to get user ID directly if user object is not available
+	userIDInterface, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return "", false
+	}
+	userID, ok := userIDInterface.(string)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID"})
+		return "", false
+	}
+	return userID, true
+}
@@ -32,5 +32,10 @@ func (mr *MeetingRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
 		meetings.POST("", mr.meetingHandlers.CreateMeeting)     // Create new meeting
 		meetings.GET("", mr.meetingHandlers.GetMeetings)        // Get user's meetings
 		meetings.GET("/:id", mr.meetingHandlers.GetMeetingByID) // Get specific meeting
+
+		// Sharing endpoints
+		meetings.POST("/:id/shares", mr.meetingHandlers.ShareMeeting)                  // Share meeting with a user
+		meetings.GET("/:id/shares", mr.meetingHandlers.GetMeetingShares)               // List meeting shares
+		meetings.DELETE("/:id/shares/:shareId", mr.meetingHandlers.RevokeMeetingShare) // Revoke a share
 	}
 }
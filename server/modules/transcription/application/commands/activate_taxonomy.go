@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// ActivateTaxonomyCommand represents a command to make a taxonomy the one used for the user's analytics,
+// or for the analytics of a team they own when it is a team taxonomy. The built-in taxonomy's ID switches
+// the user, or the team given by TeamID, back to it.
+type ActivateTaxonomyCommand struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	TeamID string `json:"team_id,omitempty"`
+}
+
+// ActivateTaxonomyHandler handles the activate taxonomy command
+type ActivateTaxonomyHandler struct {
+	taxonomyRepo repositories.TaxonomyRepository
+	teamRepo     userRepos.TeamRepository
+}
+
+// NewActivateTaxonomyHandler creates a new activate taxonomy handler
+func NewActivateTaxonomyHandler(taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository) *ActivateTaxonomyHandler {
+	return &ActivateTaxonomyHandler{
+		taxonomyRepo: taxonomyRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// Handle executes the activate taxonomy command
+func (h *ActivateTaxonomyHandler) Handle(ctx context.Context, cmd ActivateTaxonomyCommand) error {
+	if cmd.ID == services.DefaultTaxonomyID {
+		return h.activateDefault(ctx, cmd)
+	}
+
+	taxonomy, err := loadManagedTaxonomy(ctx, h.taxonomyRepo, h.teamRepo, cmd.ID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	if cmd.TeamID != "" && (!taxonomy.IsTeamTaxonomy() || *taxonomy.TeamID != cmd.TeamID) {
+		return domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", domain.ErrNotFound)
+	}
+
+	return setActiveTaxonomy(ctx, h.taxonomyRepo, taxonomy)
+}
+
+// activateDefault deactivates the taxonomies of the user, or of the team they own
+func (h *ActivateTaxonomyHandler) activateDefault(ctx context.Context, cmd ActivateTaxonomyCommand) error {
+	var err error
+	if cmd.TeamID != "" {
+		if err := verifyTeamOwner(ctx, h.teamRepo, cmd.TeamID, cmd.UserID); err != nil {
+			return err
+		}
+		err = h.taxonomyRepo.SetTeamActive(ctx, cmd.TeamID, "")
+	} else {
+		err = h.taxonomyRepo.SetActive(ctx, cmd.UserID, "")
+	}
+	if err != nil {
+		return domain.NewDomainError("ACTIVATE_TAXONOMY_FAILED", "Failed to activate taxonomy", err)
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"log"
 	"strings"
 	"time"
 
@@ -20,6 +21,14 @@ type CompleteTranscriptionCommand struct {
 	MeetingID       string                  `json:"meeting_id"`
 	BotSessionID    *string                 `json:"bot_session_id,omitempty"`
 	Processor       services.AudioProcessor `json:"-"` // Not serialized
+
+	// SpellingRules are applied to the segments unless the processor applies them natively
+	SpellingRules []entities.SpellingRule `json:"spelling_rules,omitempty"`
+
+	// PII redaction requested for the session; applied locally unless the processor redacts natively
+	RedactPII      bool                 `json:"redact_pii,omitempty"`
+	RedactPIIAudio bool                 `json:"redact_pii_audio,omitempty"`
+	PIIPolicies    []entities.PIIPolicy `json:"pii_policies,omitempty"`
 }
 
 // CompleteTranscriptionResult represents the result of completing a transcription
@@ -79,11 +88,32 @@ func (h *CompleteTranscriptionHandler) Handle(ctx context.Context, cmd CompleteT
 		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
 	}
 
+	// Apply custom spelling for providers without native support
+	if len(cmd.SpellingRules) > 0 && !supportsCustomVocabulary(cmd.Processor) {
+		result.Segments = services.ApplySpellingRules(result.Segments, cmd.SpellingRules)
+	}
+
+	// Redact PII and record the redacted spans
+	if cmd.RedactPII {
+		if supportsPIIRedaction(cmd.Processor) {
+			result.Segments = services.MarkRedactedSegments(result.Segments)
+		} else {
+			result.Segments = services.RedactSegments(result.Segments, cmd.PIIPolicies)
+		}
+	}
+
+	// Never keep a reference to audio that still contains PII
+	if cmd.RedactPIIAudio && !result.AudioRedacted && result.FirebaseURL != "" {
+		log.Printf("Warning: audio for transcription %s could not be redacted and will not be linked", cmd.TranscriptionID)
+		result.FirebaseURL = ""
+	}
+
 	// Apply business rules through aggregate methods
 	content := h.segmentsToText(result.Segments)
 	confidence := h.calculateAverageConfidence(result.Segments)
 	transcription.CompleteTranscription(content, confidence, result.Segments)
 	transcription.AudioFilePath = result.FirebaseURL
+	transcription.ProviderTranscriptID = result.TranscriptionID
 
 	// Persist transcription changes
 	err = h.transcriptionRepo.Update(ctx, transcription)
@@ -152,6 +182,16 @@ func (h *CompleteTranscriptionHandler) updateBotSessionStatus(ctx context.Contex
 	return h.meetingRepo.UpdateBotSession(ctx, session)
 }
 
+func supportsPIIRedaction(processor services.AudioProcessor) bool {
+	redactionAware, ok := processor.(services.RedactionAwareProcessor)
+	return ok && redactionAware.SupportsPIIRedaction()
+}
+
+func supportsCustomVocabulary(processor services.AudioProcessor) bool {
+	vocabularyAware, ok := processor.(services.VocabularyAwareProcessor)
+	return ok && vocabularyAware.SupportsCustomVocabulary()
+}
+
 func (h *CompleteTranscriptionHandler) segmentsToText(segments []entities.TranscriptSegment) string {
 	var text string
 	for _, segment := range segments {
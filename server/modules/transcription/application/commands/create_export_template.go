@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+// CreateExportTemplateCommand represents a command to upload an export template
+type CreateExportTemplateCommand struct {
+	UserID      string                        `json:"user_id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	Format      entities.ExportTemplateFormat `json:"format"`
+	Body        string                        `json:"body"`
+}
+
+// CreateExportTemplateHandler handles the create export template command
+type CreateExportTemplateHandler struct {
+	templateRepo repositories.ExportTemplateRepository
+}
+
+// NewCreateExportTemplateHandler creates a new create export template handler
+func NewCreateExportTemplateHandler(templateRepo repositories.ExportTemplateRepository) *CreateExportTemplateHandler {
+	return &CreateExportTemplateHandler{
+		templateRepo: templateRepo,
+	}
+}
+
+// Handle executes the create export template command
+func (h *CreateExportTemplateHandler) Handle(ctx context.Context, cmd CreateExportTemplateCommand) (*entities.ExportTemplate, error) {
+	template, err := entities.NewExportTemplate(cmd.UserID, cmd.Name, cmd.Description, cmd.Format, cmd.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkExportTemplate(ctx, h.templateRepo, &template); err != nil {
+		return nil, err
+	}
+
+	if err := h.templateRepo.Save(ctx, &template); err != nil {
+		return nil, domain.NewDomainError("SAVE_EXPORT_TEMPLATE_FAILED", "Failed to save export template", err)
+	}
+
+	return &template, nil
+}
+
+// checkExportTemplate verifies that a template parses and that its name is free.
+// Built-in names are reserved so exports by name always resolve the same way.
+func checkExportTemplate(ctx context.Context, templateRepo repositories.ExportTemplateRepository, template *entities.ExportTemplate) error {
+	if _, err := services.ParseExportTemplate(template.Name, template.Format, template.Body); err != nil {
+		return err
+	}
+
+	if _, ok := services.FindBuiltinExportTemplate(template.Name); ok {
+		return domain.NewDomainError("DUPLICATE_EXPORT_TEMPLATE", "A built-in template named '"+template.Name+"' already exists", domain.ErrAlreadyExists)
+	}
+	existing, err := templateRepo.FindByUserIDAndName(ctx, template.UserID, template.Name)
+	if err == nil && existing.GetID() != template.GetID() {
+		return domain.NewDomainError("DUPLICATE_EXPORT_TEMPLATE", "A template named '"+template.Name+"' already exists", domain.ErrAlreadyExists)
+	}
+	return nil
+}
+
+// loadOwnedExportTemplate loads an export template, reporting templates of other users as not found
+func loadOwnedExportTemplate(ctx context.Context, templateRepo repositories.ExportTemplateRepository, id, userID string) (*entities.ExportTemplate, error) {
+	template, err := templateRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.NewDomainError("EXPORT_TEMPLATE_NOT_FOUND", "Export template not found", err)
+	}
+	if template.UserID != userID {
+		return nil, domain.NewDomainError("EXPORT_TEMPLATE_NOT_FOUND", "Export template not found", domain.ErrNotFound)
+	}
+	return template, nil
+}
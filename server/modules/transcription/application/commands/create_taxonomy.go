@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"context"
+	"errors"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// CreateTaxonomyCommand represents a command to create an analytics taxonomy, shared by a team the
+// user owns when TeamID is set. With FromDefault, missing topics and sentiment words are copied from
+// the built-in taxonomy.
+type CreateTaxonomyCommand struct {
+	UserID      string                    `json:"user_id"`
+	TeamID      string                    `json:"team_id,omitempty"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Topics      []entities.TaxonomyTopic  `json:"topics"`
+	Sentiment   entities.SentimentLexicon `json:"sentiment"`
+	Stemming    bool                      `json:"stemming"`
+	FromDefault bool                      `json:"from_default"`
+	Activate    bool                      `json:"activate"`
+}
+
+// CreateTaxonomyHandler handles the create taxonomy command
+type CreateTaxonomyHandler struct {
+	taxonomyRepo repositories.TaxonomyRepository
+	teamRepo     userRepos.TeamRepository
+}
+
+// NewCreateTaxonomyHandler creates a new create taxonomy handler
+func NewCreateTaxonomyHandler(taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository) *CreateTaxonomyHandler {
+	return &CreateTaxonomyHandler{
+		taxonomyRepo: taxonomyRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// Handle executes the create taxonomy command
+func (h *CreateTaxonomyHandler) Handle(ctx context.Context, cmd CreateTaxonomyCommand) (*entities.Taxonomy, error) {
+	if cmd.TeamID != "" {
+		if err := verifyTeamOwner(ctx, h.teamRepo, cmd.TeamID, cmd.UserID); err != nil {
+			return nil, err
+		}
+	}
+	if cmd.FromDefault {
+		defaults := services.DefaultTaxonomy()
+		if len(cmd.Topics) == 0 {
+			cmd.Topics = defaults.Topics
+		}
+		if cmd.Sentiment.IsEmpty() {
+			cmd.Sentiment = defaults.Sentiment
+		}
+	}
+
+	taxonomy, err := entities.NewTaxonomy(cmd.UserID, cmd.Name, cmd.Description, cmd.Topics, cmd.Sentiment, cmd.Stemming)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.TeamID != "" {
+		taxonomy.TeamID = &cmd.TeamID
+	}
+
+	if err := h.taxonomyRepo.Save(ctx, &taxonomy); err != nil {
+		return nil, domain.NewDomainError("SAVE_TAXONOMY_FAILED", "Failed to save taxonomy", err)
+	}
+
+	if cmd.Activate {
+		if err := setActiveTaxonomy(ctx, h.taxonomyRepo, &taxonomy); err != nil {
+			return nil, err
+		}
+		taxonomy.Active = true
+	}
+
+	return &taxonomy, nil
+}
+
+// loadManagedTaxonomy loads a taxonomy the user may change: one of their own or one of a team they
+// own. Taxonomies of other users and teams are reported as not found.
+func loadManagedTaxonomy(ctx context.Context, taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository, id, userID string) (*entities.Taxonomy, error) {
+	taxonomy, err := taxonomyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", err)
+	}
+	if !taxonomy.IsTeamTaxonomy() {
+		if taxonomy.UserID != userID {
+			return nil, domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", domain.ErrNotFound)
+		}
+		return taxonomy, nil
+	}
+
+	if err := verifyTeamOwner(ctx, teamRepo, *taxonomy.TeamID, userID); err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) && domainErr.Code == "TEAM_NOT_FOUND" {
+			return nil, domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", domain.ErrNotFound)
+		}
+		return nil, err
+	}
+	return taxonomy, nil
+}
+
+// verifyTeamOwner checks that the user owns the team, reporting teams they are not in as not found
+func verifyTeamOwner(ctx context.Context, teamRepo userRepos.TeamRepository, teamID, userID string) error {
+	if teamRepo == nil {
+		return domain.NewDomainError("TEAM_NOT_FOUND", "Team not found", domain.ErrNotFound)
+	}
+	member, err := teamRepo.FindMember(ctx, teamID, userID)
+	if err != nil {
+		return domain.NewDomainError("GET_TEAM_FAILED", "Failed to get team", err)
+	}
+	if member == nil {
+		return domain.NewDomainError("TEAM_NOT_FOUND", "Team not found", domain.ErrNotFound)
+	}
+	if !member.IsOwner() {
+		return domain.NewDomainError("NOT_TEAM_OWNER", "Only team owners can manage team taxonomies", domain.ErrForbidden)
+	}
+	return nil
+}
+
+// setActiveTaxonomy makes a taxonomy the active one of its user or team
+func setActiveTaxonomy(ctx context.Context, taxonomyRepo repositories.TaxonomyRepository, taxonomy *entities.Taxonomy) error {
+	var err error
+	if taxonomy.IsTeamTaxonomy() {
+		err = taxonomyRepo.SetTeamActive(ctx, *taxonomy.TeamID, taxonomy.ID)
+	} else {
+		err = taxonomyRepo.SetActive(ctx, taxonomy.UserID, taxonomy.ID)
+	}
+	if err != nil {
+		return domain.NewDomainError("ACTIVATE_TAXONOMY_FAILED", "Failed to activate taxonomy", err)
+	}
+	return nil
+}
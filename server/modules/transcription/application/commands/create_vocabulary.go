@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// CreateVocabularyCommand represents a command to create a custom vocabulary
+type CreateVocabularyCommand struct {
+	UserID      string                  `json:"user_id"`
+	Name        string                  `json:"name"`
+	MeetingType string                  `json:"meeting_type,omitempty"`
+	Terms       []string                `json:"terms"`
+	BoostParam  entities.BoostLevel     `json:"boost_param,omitempty"`
+	Spellings   []entities.SpellingRule `json:"spellings"`
+}
+
+// CreateVocabularyHandler handles the create vocabulary command
+type CreateVocabularyHandler struct {
+	vocabularyRepo repositories.VocabularyRepository
+}
+
+// NewCreateVocabularyHandler creates a new create vocabulary handler
+func NewCreateVocabularyHandler(vocabularyRepo repositories.VocabularyRepository) *CreateVocabularyHandler {
+	return &CreateVocabularyHandler{
+		vocabularyRepo: vocabularyRepo,
+	}
+}
+
+// Handle executes the create vocabulary command
+func (h *CreateVocabularyHandler) Handle(ctx context.Context, cmd CreateVocabularyCommand) (*entities.Vocabulary, error) {
+	vocabulary, err := entities.NewVocabulary(cmd.UserID, cmd.Name, cmd.MeetingType, cmd.Terms, cmd.BoostParam, cmd.Spellings)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.vocabularyRepo.Save(ctx, &vocabulary); err != nil {
+		return nil, domain.NewDomainError("SAVE_VOCABULARY_FAILED", "Failed to save vocabulary", err)
+	}
+
+	return &vocabulary, nil
+}
+
+// loadOwnedVocabulary loads a vocabulary, reporting vocabularies of other users as not found
+func loadOwnedVocabulary(ctx context.Context, vocabularyRepo repositories.VocabularyRepository, id, userID string) (*entities.Vocabulary, error) {
+	vocabulary, err := vocabularyRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.NewDomainError("VOCABULARY_NOT_FOUND", "Vocabulary not found", err)
+	}
+	if vocabulary.UserID != userID {
+		return nil, domain.NewDomainError("VOCABULARY_NOT_FOUND", "Vocabulary not found", domain.ErrNotFound)
+	}
+	return vocabulary, nil
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// DeleteExportTemplateCommand represents a command to delete an export template
+type DeleteExportTemplateCommand struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// DeleteExportTemplateHandler handles the delete export template command
+type DeleteExportTemplateHandler struct {
+	templateRepo repositories.ExportTemplateRepository
+}
+
+// NewDeleteExportTemplateHandler creates a new delete export template handler
+func NewDeleteExportTemplateHandler(templateRepo repositories.ExportTemplateRepository) *DeleteExportTemplateHandler {
+	return &DeleteExportTemplateHandler{
+		templateRepo: templateRepo,
+	}
+}
+
+// Handle executes the delete export template command
+func (h *DeleteExportTemplateHandler) Handle(ctx context.Context, cmd DeleteExportTemplateCommand) error {
+	if _, err := loadOwnedExportTemplate(ctx, h.templateRepo, cmd.ID, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := h.templateRepo.Delete(ctx, cmd.ID); err != nil {
+		return domain.NewDomainError("DELETE_EXPORT_TEMPLATE_FAILED", "Failed to delete export template", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// DeleteTaxonomyCommand represents a command to delete an analytics taxonomy of the user or of a team
+// they own. Deleting the active taxonomy switches the user or team back to the built-in one.
+type DeleteTaxonomyCommand struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// DeleteTaxonomyHandler handles the delete taxonomy command
+type DeleteTaxonomyHandler struct {
+	taxonomyRepo repositories.TaxonomyRepository
+	teamRepo     userRepos.TeamRepository
+}
+
+// NewDeleteTaxonomyHandler creates a new delete taxonomy handler
+func NewDeleteTaxonomyHandler(taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository) *DeleteTaxonomyHandler {
+	return &DeleteTaxonomyHandler{
+		taxonomyRepo: taxonomyRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// Handle executes the delete taxonomy command
+func (h *DeleteTaxonomyHandler) Handle(ctx context.Context, cmd DeleteTaxonomyCommand) error {
+	if _, err := loadManagedTaxonomy(ctx, h.taxonomyRepo, h.teamRepo, cmd.ID, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := h.taxonomyRepo.Delete(ctx, cmd.ID); err != nil {
+		return domain.NewDomainError("DELETE_TAXONOMY_FAILED", "Failed to delete taxonomy", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// DeleteVocabularyCommand represents a command to delete a custom vocabulary
+type DeleteVocabularyCommand struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// DeleteVocabularyHandler handles the delete vocabulary command
+type DeleteVocabularyHandler struct {
+	vocabularyRepo repositories.VocabularyRepository
+}
+
+// NewDeleteVocabularyHandler creates a new delete vocabulary handler
+func NewDeleteVocabularyHandler(vocabularyRepo repositories.VocabularyRepository) *DeleteVocabularyHandler {
+	return &DeleteVocabularyHandler{
+		vocabularyRepo: vocabularyRepo,
+	}
+}
+
+// Handle executes the delete vocabulary command
+func (h *DeleteVocabularyHandler) Handle(ctx context.Context, cmd DeleteVocabularyCommand) error {
+	if _, err := loadOwnedVocabulary(ctx, h.vocabularyRepo, cmd.ID, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := h.vocabularyRepo.Delete(ctx, cmd.ID); err != nil {
+		return domain.NewDomainError("DELETE_VOCABULARY_FAILED", "Failed to delete vocabulary", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	seedworkRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// EditSegmentCommand represents a command to change the text and/or speaker of a segment
+type EditSegmentCommand struct {
+	TranscriptionID string  `json:"transcription_id"`
+	SegmentID       string  `json:"segment_id"`
+	Text            *string `json:"text,omitempty"`
+	Speaker         *string `json:"speaker,omitempty"`
+	EditedBy        string  `json:"edited_by"`
+}
+
+// EditSegmentHandler handles the edit segment command
+type EditSegmentHandler struct {
+	editor transcriptEditor
+}
+
+// NewEditSegmentHandler creates a new edit segment handler
+func NewEditSegmentHandler(
+	transcriptionRepo repositories.TranscriptionRepository,
+	revisionRepo repositories.TranscriptRevisionRepository,
+	transactor seedworkRepos.Transactor,
+	eventBus events.EventBus,
+) *EditSegmentHandler {
+	return &EditSegmentHandler{
+		editor: transcriptEditor{
+			transcriptionRepo: transcriptionRepo,
+			revisionRepo:      revisionRepo,
+			transactor:        transactor,
+			eventBus:          eventBus,
+		},
+	}
+}
+
+// Handle executes the edit segment command
+func (h *EditSegmentHandler) Handle(ctx context.Context, cmd EditSegmentCommand) (*TranscriptEditResult, error) {
+	return h.editor.edit(ctx, cmd.TranscriptionID, func(transcription *entities.Transcription, revisionNumber int) (*entities.TranscriptRevision, error) {
+		before, after, err := transcription.EditSegment(cmd.SegmentID, cmd.Text, cmd.Speaker)
+		if err != nil {
+			return nil, err
+		}
+
+		revision := entities.NewTranscriptRevision(
+			transcription.GetID(),
+			revisionNumber,
+			editTypeFor(cmd),
+			cmd.EditedBy,
+			[]entities.TranscriptSegment{before},
+			[]entities.TranscriptSegment{after},
+			transcription.Segments,
+		)
+		return &revision, nil
+	})
+}
+
+func editTypeFor(cmd EditSegmentCommand) entities.RevisionEditType {
+	switch {
+	case cmd.Text != nil && cmd.Speaker != nil:
+		return entities.EditSegmentRevision
+	case cmd.Speaker != nil:
+		return entities.EditSpeakerRevision
+	default:
+		return entities.EditTextRevision
+	}
+}
@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// ImportedTranscriptionProvider is the provider recorded on imported transcriptions
+const ImportedTranscriptionProvider = "import"
+
+// ImportTranscriptCommand represents a command to import a transcript exported by another tool.
+// Without a MeetingID a completed meeting is created for the transcript.
+type ImportTranscriptCommand struct {
+	UserID          string     `json:"user_id"`
+	MeetingID       string     `json:"meeting_id,omitempty"`
+	Title           string     `json:"title,omitempty"`      // Title of a created meeting, defaults to the transcript's title or file name
+	StartTime       *time.Time `json:"start_time,omitempty"` // Start of a created meeting, defaults to the transcript's start or now
+	FileName        string     `json:"file_name,omitempty"`
+	Format          string     `json:"format,omitempty"`           // srt, vtt or json; detected from the file when empty
+	SpeakerPrefixes bool       `json:"speaker_prefixes,omitempty"` // Read "Speaker: text" prefixes of subtitle cues as speakers
+	Data            []byte     `json:"-"`
+}
+
+// ImportTranscriptResult represents the result of importing a transcript
+type ImportTranscriptResult struct {
+	TranscriptionID string   `json:"transcription_id"`
+	MeetingID       string   `json:"meeting_id"`
+	MeetingCreated  bool     `json:"meeting_created"`
+	Format          string   `json:"format"`
+	SegmentCount    int      `json:"segment_count"`
+	Speakers        []string `json:"speakers"`
+	Duration        float64  `json:"duration"`
+}
+
+// ImportTranscriptHandler handles the import transcript command
+type ImportTranscriptHandler struct {
+	transcriptionRepo repositories.TranscriptionRepository
+	meetingRepo       meetingRepos.MeetingRepository
+	eventBus          events.EventBus
+}
+
+// NewImportTranscriptHandler creates a new import transcript handler
+func NewImportTranscriptHandler(
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	eventBus events.EventBus,
+) *ImportTranscriptHandler {
+	return &ImportTranscriptHandler{
+		transcriptionRepo: transcriptionRepo,
+		meetingRepo:       meetingRepo,
+		eventBus:          eventBus,
+	}
+}
+
+// Handle executes the import transcript command. Access to an existing meeting must be
+// verified by the caller.
+func (h *ImportTranscriptHandler) Handle(ctx context.Context, cmd ImportTranscriptCommand) (*ImportTranscriptResult, error) {
+	format := strings.ToLower(cmd.Format)
+	if format == "" {
+		format = services.DetectImportFormat(cmd.FileName, cmd.Data)
+	}
+	imported, err := services.ParseTranscript(format, cmd.Data, services.ImportOptions{SpeakerPrefixes: cmd.SpeakerPrefixes})
+	if err != nil {
+		return nil, err
+	}
+
+	meeting, created, err := h.resolveMeeting(ctx, cmd, imported)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.addParticipants(ctx, meeting.ID, imported.Speakers()); err != nil {
+		return nil, err
+	}
+
+	// Imported transcripts go through the same lifecycle as streamed ones
+	transcription := entities.NewTranscription(meeting.ID, "", ImportedTranscriptionProvider)
+	if err := h.transcriptionRepo.Save(ctx, &transcription); err != nil {
+		return nil, domain.NewDomainError("SAVE_TRANSCRIPTION_FAILED", "Failed to save transcription", err)
+	}
+
+	// Content and confidence are derived from the imported segments
+	transcription.CompleteTranscription("", 0, imported.Segments)
+	transcription.RegenerateContent()
+	if err := h.transcriptionRepo.Update(ctx, &transcription); err != nil {
+		return nil, domain.NewDomainError("UPDATE_TRANSCRIPTION_FAILED", "Failed to update transcription", err)
+	}
+	if err := h.transcriptionRepo.SaveSegments(ctx, transcription.GetID(), imported.Segments); err != nil {
+		return nil, domain.NewDomainError("SAVE_SEGMENTS_FAILED", "Failed to save transcript segments", err)
+	}
+
+	// Index, analyse and notify as for transcriptions completed from audio
+	h.eventBus.Publish("transcription.completed", &TranscriptionCompletedEvent{
+		TranscriptionID: transcription.GetID(),
+		MeetingID:       meeting.ID,
+		Status:          entities.Completed,
+		SegmentCount:    len(imported.Segments),
+		ProcessingMode:  services.BatchMode,
+		CompletedAt:     time.Now(),
+	})
+
+	return &ImportTranscriptResult{
+		TranscriptionID: transcription.GetID(),
+		MeetingID:       meeting.ID,
+		MeetingCreated:  created,
+		Format:          format,
+		SegmentCount:    len(imported.Segments),
+		Speakers:        imported.Speakers(),
+		Duration:        imported.Duration(),
+	}, nil
+}
+
+// resolveMeeting loads the meeting to attach the transcript to, or creates a completed one
+func (h *ImportTranscriptHandler) resolveMeeting(ctx context.Context, cmd ImportTranscriptCommand, imported *services.ImportedTranscript) (*meetingRepos.Meeting, bool, error) {
+	if cmd.MeetingID != "" {
+		meeting, err := h.meetingRepo.FindMeetingByID(ctx, cmd.MeetingID)
+		if err != nil {
+			return nil, false, domain.NewDomainError("MEETING_NOT_FOUND", "Meeting not found", err)
+		}
+		return meeting, false, nil
+	}
+
+	title := firstNonEmpty(cmd.Title, imported.Title, strings.TrimSuffix(cmd.FileName, filepath.Ext(cmd.FileName)), "Imported transcript")
+	startTime := time.Now()
+	if cmd.StartTime != nil {
+		startTime = *cmd.StartTime
+	} else if imported.StartedAt != nil {
+		startTime = *imported.StartedAt
+	}
+	endTime := startTime.Add(time.Duration(imported.Duration() * float64(time.Second)))
+
+	meeting := &meetingRepos.Meeting{
+		UserID:    cmd.UserID,
+		Title:     title,
+		Type:      meetingRepos.MeetingTypeGeneric,
+		Status:    meetingRepos.MeetingStatusCompleted,
+		StartTime: startTime,
+		EndTime:   &endTime,
+	}
+	meeting.SetID(domain.GenerateID())
+	if err := h.meetingRepo.SaveMeeting(ctx, meeting); err != nil {
+		return nil, false, domain.NewDomainError("SAVE_MEETING_FAILED", "Failed to create meeting", err)
+	}
+	return meeting, true, nil
+}
+
+// addParticipants adds the transcript's speakers that are not yet participants of the meeting
+func (h *ImportTranscriptHandler) addParticipants(ctx context.Context, meetingID string, speakers []string) error {
+	participants, err := h.meetingRepo.FindParticipantsByMeetingID(ctx, meetingID)
+	if err != nil {
+		return domain.NewDomainError("GET_PARTICIPANTS_FAILED", "Failed to get participants", err)
+	}
+	known := make(map[string]bool, len(participants))
+	for _, participant := range participants {
+		known[strings.ToLower(participant.Name)] = true
+	}
+
+	for _, speaker := range speakers {
+		if known[strings.ToLower(speaker)] {
+			continue
+		}
+		participant := &meetingRepos.Participant{MeetingID: meetingID, Name: speaker}
+		participant.SetID(domain.GenerateID())
+		if err := h.meetingRepo.SaveParticipant(ctx, participant); err != nil {
+			return domain.NewDomainError("SAVE_PARTICIPANT_FAILED", "Failed to save participant", err)
+		}
+	}
+	return nil
+}
+
+// firstNonEmpty returns the first value that is not blank
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	seedworkRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// MergeSegmentsCommand represents a command to merge adjacent segments into one
+type MergeSegmentsCommand struct {
+	TranscriptionID string   `json:"transcription_id"`
+	SegmentIDs      []string `json:"segment_ids"`
+	Speaker         *string  `json:"speaker,omitempty"`
+	EditedBy        string   `json:"edited_by"`
+}
+
+// MergeSegmentsHandler handles the merge segments command
+type MergeSegmentsHandler struct {
+	editor transcriptEditor
+}
+
+// NewMergeSegmentsHandler creates a new merge segments handler
+func NewMergeSegmentsHandler(
+	transcriptionRepo repositories.TranscriptionRepository,
+	revisionRepo repositories.TranscriptRevisionRepository,
+	transactor seedworkRepos.Transactor,
+	eventBus events.EventBus,
+) *MergeSegmentsHandler {
+	return &MergeSegmentsHandler{
+		editor: transcriptEditor{
+			transcriptionRepo: transcriptionRepo,
+			revisionRepo:      revisionRepo,
+			transactor:        transactor,
+			eventBus:          eventBus,
+		},
+	}
+}
+
+// Handle executes the merge segments command
+func (h *MergeSegmentsHandler) Handle(ctx context.Context, cmd MergeSegmentsCommand) (*TranscriptEditResult, error) {
+	return h.editor.edit(ctx, cmd.TranscriptionID, func(transcription *entities.Transcription, revisionNumber int) (*entities.TranscriptRevision, error) {
+		before, after, err := transcription.MergeSegments(cmd.SegmentIDs, cmd.Speaker)
+		if err != nil {
+			return nil, err
+		}
+
+		revision := entities.NewTranscriptRevision(
+			transcription.GetID(),
+			revisionNumber,
+			entities.MergeRevision,
+			cmd.EditedBy,
+			before,
+			[]entities.TranscriptSegment{after},
+			transcription.Segments,
+		)
+		return &revision, nil
+	})
+}
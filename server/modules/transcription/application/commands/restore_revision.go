@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+	seedworkRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// RestoreRevisionCommand represents a command to restore the segments of an earlier revision.
+// Restoring never rewrites history; it records a new revision whose snapshot matches the restored one.
+type RestoreRevisionCommand struct {
+	TranscriptionID string `json:"transcription_id"`
+	RevisionNumber  int    `json:"revision_number"`
+	EditedBy        string `json:"edited_by"`
+}
+
+// RestoreRevisionHandler handles the restore revision command
+type RestoreRevisionHandler struct {
+	editor transcriptEditor
+}
+
+// NewRestoreRevisionHandler creates a new restore revision handler
+func NewRestoreRevisionHandler(
+	transcriptionRepo repositories.TranscriptionRepository,
+	revisionRepo repositories.TranscriptRevisionRepository,
+	transactor seedworkRepos.Transactor,
+	eventBus events.EventBus,
+) *RestoreRevisionHandler {
+	return &RestoreRevisionHandler{
+		editor: transcriptEditor{
+			transcriptionRepo: transcriptionRepo,
+			revisionRepo:      revisionRepo,
+			transactor:        transactor,
+			eventBus:          eventBus,
+		},
+	}
+}
+
+// Handle executes the restore revision command
+func (h *RestoreRevisionHandler) Handle(ctx context.Context, cmd RestoreRevisionCommand) (*TranscriptEditResult, error) {
+	target, err := h.editor.revisionRepo.FindByNumber(ctx, cmd.TranscriptionID, cmd.RevisionNumber)
+	if err != nil {
+		return nil, domain.NewDomainError("REVISION_NOT_FOUND", "Transcript revision not found", err)
+	}
+
+	return h.editor.edit(ctx, cmd.TranscriptionID, func(transcription *entities.Transcription, revisionNumber int) (*entities.TranscriptRevision, error) {
+		before := transcription.Segments
+		transcription.ReplaceSegments(target.SnapshotSegments())
+
+		revision := entities.NewTranscriptRevision(
+			transcription.GetID(),
+			revisionNumber,
+			entities.RestoreRevision,
+			cmd.EditedBy,
+			before,
+			transcription.Segments,
+			transcription.Segments,
+		)
+		revision.MarkRestoredFrom(target.RevisionNumber)
+		return &revision, nil
+	})
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	seedworkRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// SplitSegmentCommand represents a command to split a segment in two at a character offset
+type SplitSegmentCommand struct {
+	TranscriptionID string   `json:"transcription_id"`
+	SegmentID       string   `json:"segment_id"`
+	Offset          int      `json:"offset"`
+	SplitTime       *float64 `json:"split_time,omitempty"`
+	EditedBy        string   `json:"edited_by"`
+}
+
+// SplitSegmentHandler handles the split segment command
+type SplitSegmentHandler struct {
+	editor transcriptEditor
+}
+
+// NewSplitSegmentHandler creates a new split segment handler
+func NewSplitSegmentHandler(
+	transcriptionRepo repositories.TranscriptionRepository,
+	revisionRepo repositories.TranscriptRevisionRepository,
+	transactor seedworkRepos.Transactor,
+	eventBus events.EventBus,
+) *SplitSegmentHandler {
+	return &SplitSegmentHandler{
+		editor: transcriptEditor{
+			transcriptionRepo: transcriptionRepo,
+			revisionRepo:      revisionRepo,
+			transactor:        transactor,
+			eventBus:          eventBus,
+		},
+	}
+}
+
+// Handle executes the split segment command
+func (h *SplitSegmentHandler) Handle(ctx context.Context, cmd SplitSegmentCommand) (*TranscriptEditResult, error) {
+	return h.editor.edit(ctx, cmd.TranscriptionID, func(transcription *entities.Transcription, revisionNumber int) (*entities.TranscriptRevision, error) {
+		before, after, err := transcription.SplitSegment(cmd.SegmentID, cmd.Offset, cmd.SplitTime)
+		if err != nil {
+			return nil, err
+		}
+
+		revision := entities.NewTranscriptRevision(
+			transcription.GetID(),
+			revisionNumber,
+			entities.SplitRevision,
+			cmd.EditedBy,
+			[]entities.TranscriptSegment{before},
+			after,
+			transcription.Segments,
+		)
+		return &revision, nil
+	})
+}
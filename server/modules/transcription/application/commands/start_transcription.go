@@ -19,6 +19,9 @@ type StartTranscriptionCommand struct {
 	AudioStreamMetadata services.AudioStreamMetadata    `json:"metadata"`
 	ProcessingOptions   services.AudioProcessingOptions `json:"options"`
 	CreateBotSession    bool                            `json:"create_bot_session"`
+
+	// TranscriptionID starts an existing pending transcription instead of creating one
+	TranscriptionID string `json:"transcription_id,omitempty"`
 }
 
 // StartTranscriptionResult represents the result of starting a transcription
@@ -28,12 +31,20 @@ type StartTranscriptionResult struct {
 	MeetingID       string    `json:"meeting_id"`
 	BotSessionID    *string   `json:"bot_session_id,omitempty"`
 	StartedAt       time.Time `json:"started_at"`
+
+	// ProcessingOptions are the options the session was started with, including custom vocabulary
+	ProcessingOptions services.AudioProcessingOptions `json:"options"`
+
+	// Processor is the audio processor the session was started on
+	Processor services.AudioProcessor `json:"-"`
 }
 
 // StartTranscriptionHandler handles the start transcription command
 type StartTranscriptionHandler struct {
 	transcriptionRepo repositories.TranscriptionRepository
 	meetingRepo       meetingRepos.MeetingRepository
+	vocabularyRepo    repositories.VocabularyRepository
+	redactionRepo     repositories.RedactionSettingsRepository
 	audioFactory      services.AudioProcessorFactory
 	eventBus          events.EventBus
 }
@@ -42,12 +53,16 @@ type StartTranscriptionHandler struct {
 func NewStartTranscriptionHandler(
 	transcriptionRepo repositories.TranscriptionRepository,
 	meetingRepo meetingRepos.MeetingRepository,
+	vocabularyRepo repositories.VocabularyRepository,
+	redactionRepo repositories.RedactionSettingsRepository,
 	audioFactory services.AudioProcessorFactory,
 	eventBus events.EventBus,
 ) *StartTranscriptionHandler {
 	return &StartTranscriptionHandler{
 		transcriptionRepo: transcriptionRepo,
 		meetingRepo:       meetingRepo,
+		vocabularyRepo:    vocabularyRepo,
+		redactionRepo:     redactionRepo,
 		audioFactory:      audioFactory,
 		eventBus:          eventBus,
 	}
@@ -61,16 +76,24 @@ func (h *StartTranscriptionHandler) Handle(ctx context.Context, cmd StartTranscr
 		return nil, domain.NewDomainError("MEETING_NOT_FOUND", "Meeting not found", err)
 	}
 
-	// Create transcription aggregate using domain factory method
-	transcription := entities.NewTranscription(cmd.MeetingID, "", cmd.ProcessingOptions.Provider)
+	// Add the meeting owner's custom vocabulary to the processing options
+	vocabularies, err := h.vocabularyRepo.FindForMeetingType(ctx, meeting.UserID, meeting.Type)
+	if err != nil {
+		// Log warning but transcribe without custom vocabulary
+		fmt.Printf("Warning: failed to load custom vocabulary: %v\n", err)
+	}
+	cmd.ProcessingOptions = services.ApplyVocabularies(cmd.ProcessingOptions, vocabularies, meeting.Type)
 
-	// Apply business rules through aggregate methods
-	transcription.StartProcessing()
+	// Apply the meeting owner's default PII redaction; a failure here must not silently skip redaction
+	redactionSettings, err := h.redactionRepo.FindByUserID(ctx, meeting.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("LOAD_REDACTION_SETTINGS_FAILED", "Failed to load redaction settings", err)
+	}
+	cmd.ProcessingOptions = services.ApplyRedactionSettings(cmd.ProcessingOptions, redactionSettings)
 
-	// Save transcription to database
-	err = h.transcriptionRepo.Save(ctx, &transcription)
+	transcription, err := h.loadOrCreateTranscription(ctx, cmd)
 	if err != nil {
-		return nil, domain.NewDomainError("SAVE_TRANSCRIPTION_FAILED", "Failed to save transcription", err)
+		return nil, err
 	}
 
 	// Create audio processor
@@ -78,7 +101,7 @@ func (h *StartTranscriptionHandler) Handle(ctx context.Context, cmd StartTranscr
 	if err != nil {
 		// Mark transcription as failed
 		transcription.FailTranscription()
-		h.transcriptionRepo.Update(ctx, &transcription)
+		h.transcriptionRepo.Update(ctx, transcription)
 		return nil, domain.NewDomainError("CREATE_PROCESSOR_FAILED", "Failed to create audio processor", err)
 	}
 
@@ -87,7 +110,7 @@ func (h *StartTranscriptionHandler) Handle(ctx context.Context, cmd StartTranscr
 	if err != nil {
 		// Mark transcription as failed
 		transcription.FailTranscription()
-		h.transcriptionRepo.Update(ctx, &transcription)
+		h.transcriptionRepo.Update(ctx, transcription)
 		return nil, domain.NewDomainError("START_SESSION_FAILED", "Failed to start audio session", err)
 	}
 
@@ -134,14 +157,47 @@ func (h *StartTranscriptionHandler) Handle(ctx context.Context, cmd StartTranscr
 	h.eventBus.Publish("transcription.started", event)
 
 	return &StartTranscriptionResult{
-		TranscriptionID: transcription.GetID(),
-		SessionID:       sessionID,
-		MeetingID:       cmd.MeetingID,
-		BotSessionID:    botSessionID,
-		StartedAt:       time.Now(),
+		TranscriptionID:   transcription.GetID(),
+		SessionID:         sessionID,
+		MeetingID:         cmd.MeetingID,
+		BotSessionID:      botSessionID,
+		StartedAt:         time.Now(),
+		ProcessingOptions: cmd.ProcessingOptions,
+		Processor:         processor,
 	}, nil
 }
 
+// loadOrCreateTranscription moves the command's pending transcription, or a new one, to processing
+func (h *StartTranscriptionHandler) loadOrCreateTranscription(ctx context.Context, cmd StartTranscriptionCommand) (*entities.Transcription, error) {
+	if cmd.TranscriptionID == "" {
+		// Create transcription aggregate using domain factory method
+		transcription := entities.NewTranscription(cmd.MeetingID, "", cmd.ProcessingOptions.Provider)
+		transcription.StartProcessing()
+
+		if err := h.transcriptionRepo.Save(ctx, &transcription); err != nil {
+			return nil, domain.NewDomainError("SAVE_TRANSCRIPTION_FAILED", "Failed to save transcription", err)
+		}
+		return &transcription, nil
+	}
+
+	transcription, err := h.transcriptionRepo.FindByID(ctx, cmd.TranscriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if transcription.MeetingID != cmd.MeetingID {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", domain.ErrNotFound)
+	}
+	if transcription.IsCompleted() {
+		return nil, domain.NewDomainError("TRANSCRIPTION_ALREADY_COMPLETED", "Transcription is already completed", domain.ErrInvalidInput)
+	}
+
+	transcription.StartProcessing()
+	if err := h.transcriptionRepo.Update(ctx, transcription); err != nil {
+		return nil, domain.NewDomainError("UPDATE_TRANSCRIPTION_FAILED", "Failed to update transcription", err)
+	}
+	return transcription, nil
+}
+
 // TranscriptionStartedEvent represents a domain event for transcription started
 type TranscriptionStartedEvent struct {
 	TranscriptionID string    `json:"transcription_id"`
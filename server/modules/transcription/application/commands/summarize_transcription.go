@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"context"
+	"log"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+// SummarizeTranscriptionCommand represents a command to (re)generate the summary of a transcription
+type SummarizeTranscriptionCommand struct {
+	TranscriptionID string `json:"transcription_id"`
+	AnswerFormat    string `json:"answer_format,omitempty"`
+}
+
+// SummarizeTranscriptionHandler handles the summarize transcription command
+type SummarizeTranscriptionHandler struct {
+	transcriptionRepo repositories.TranscriptionRepository
+	summaryRepo       repositories.TranscriptSummaryRepository
+	summarizers       []services.Summarizer
+}
+
+// NewSummarizeTranscriptionHandler creates a new summarize transcription handler. Summarizers are
+// tried in order; the first that supports the transcription and succeeds produces the summary.
+func NewSummarizeTranscriptionHandler(
+	transcriptionRepo repositories.TranscriptionRepository,
+	summaryRepo repositories.TranscriptSummaryRepository,
+	summarizers ...services.Summarizer,
+) *SummarizeTranscriptionHandler {
+	return &SummarizeTranscriptionHandler{
+		transcriptionRepo: transcriptionRepo,
+		summaryRepo:       summaryRepo,
+		summarizers:       summarizers,
+	}
+}
+
+// Handle executes the summarize transcription command
+func (h *SummarizeTranscriptionHandler) Handle(ctx context.Context, cmd SummarizeTranscriptionCommand) (*entities.TranscriptSummary, error) {
+	transcription, err := h.transcriptionRepo.FindByID(ctx, cmd.TranscriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if !transcription.IsCompleted() {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_COMPLETED", "Only completed transcriptions can be summarized", domain.ErrInvalidInput)
+	}
+
+	segments, err := h.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, cmd.TranscriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+	}
+	if len(segments) == 0 {
+		return nil, domain.NewDomainError("NO_TRANSCRIPT_SEGMENTS", "Transcription has no segments to summarize", domain.ErrNotFound)
+	}
+
+	request := services.SummaryRequest{
+		Transcription: transcription,
+		Segments:      segments,
+		AnswerFormat:  cmd.AnswerFormat,
+	}
+
+	var content *services.SummaryContent
+	var lastErr error
+	for _, summarizer := range h.summarizers {
+		if !summarizer.Supports(transcription) {
+			continue
+		}
+		content, lastErr = summarizer.Summarize(ctx, request)
+		if lastErr == nil {
+			break
+		}
+		log.Printf("Summarizer failed for transcription %s, trying the next one: %v", cmd.TranscriptionID, lastErr)
+	}
+	if content == nil {
+		if lastErr == nil {
+			lastErr = domain.ErrInvalidInput
+		}
+		return nil, domain.NewDomainError("SUMMARIZE_FAILED", "Failed to summarize transcription", lastErr)
+	}
+
+	summary := entities.NewTranscriptSummary(transcription.ID, transcription.MeetingID, content.Method)
+	summary.Overview = content.Overview
+	summary.KeyPoints = content.KeyPoints
+	summary.Decisions = content.Decisions
+	summary.Model = content.Model
+	if content.Method == entities.LemurSummaryMethod {
+		summary.AnswerFormat = cmd.AnswerFormat
+	}
+
+	if err := h.summaryRepo.ReplaceSummary(ctx, &summary); err != nil {
+		return nil, domain.NewDomainError("SAVE_SUMMARY_FAILED", "Failed to save summary", err)
+	}
+
+	return &summary, nil
+}
@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+	seedworkRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// TranscriptSegmentsUpdatedEvent is published whenever a manual edit changes a transcription's segments
+type TranscriptSegmentsUpdatedEvent struct {
+	TranscriptionID string                    `json:"transcription_id"`
+	MeetingID       string                    `json:"meeting_id"`
+	RevisionNumber  int                       `json:"revision_number"`
+	EditType        entities.RevisionEditType `json:"edit_type"`
+	EditedBy        string                    `json:"edited_by"`
+	SegmentIDs      []string                  `json:"segment_ids"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+}
+
+// TranscriptEditResult represents the outcome of any transcript edit command
+type TranscriptEditResult struct {
+	Transcription *entities.Transcription      `json:"transcription"`
+	Revision      *entities.TranscriptRevision `json:"revision"`
+}
+
+// maxEditAttempts bounds how often an edit is applied again after a concurrent edit took its
+// revision number
+const maxEditAttempts = 3
+
+// transcriptChange applies an edit to a loaded transcription and returns the revision recording it
+type transcriptChange func(transcription *entities.Transcription, revisionNumber int) (*entities.TranscriptRevision, error)
+
+// transcriptEditor holds the load/persist steps shared by every transcript edit command
+type transcriptEditor struct {
+	transcriptionRepo repositories.TranscriptionRepository
+	revisionRepo      repositories.TranscriptRevisionRepository
+	transactor        seedworkRepos.Transactor
+	eventBus          events.EventBus
+}
+
+// edit loads a transcription, applies change to it and persists the result. Revision numbers are
+// unique per transcription, so when a concurrent edit saved the same number first the edit is
+// applied again to the transcription it left behind, and reported as a conflict after
+// maxEditAttempts.
+func (e *transcriptEditor) edit(ctx context.Context, transcriptionID string, change transcriptChange) (*TranscriptEditResult, error) {
+	var err error
+	for attempt := 0; attempt < maxEditAttempts; attempt++ {
+		var transcription *entities.Transcription
+		if transcription, err = e.load(ctx, transcriptionID); err != nil {
+			return nil, err
+		}
+		var revisionNumber int
+		if revisionNumber, err = e.nextRevisionNumber(ctx, transcription); err != nil {
+			if errors.Is(err, domain.ErrAlreadyExists) {
+				continue
+			}
+			return nil, err
+		}
+		var revision *entities.TranscriptRevision
+		if revision, err = change(transcription, revisionNumber); err != nil {
+			return nil, err
+		}
+
+		var result *TranscriptEditResult
+		if result, err = e.persist(ctx, transcription, revision); !errors.Is(err, domain.ErrAlreadyExists) {
+			return result, err
+		}
+	}
+	return nil, domain.NewDomainError("REVISION_CONFLICT", "The transcript was changed by another edit, please try again", err)
+}
+
+// load retrieves an editable transcription together with its segments
+func (e *transcriptEditor) load(ctx context.Context, transcriptionID string) (*entities.Transcription, error) {
+	transcription, err := e.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if !transcription.IsCompleted() {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_EDITABLE", "Only completed transcriptions can be edited", domain.ErrInvalidInput)
+	}
+
+	segments, err := e.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+	}
+	transcription.Segments = segments
+	return transcription, nil
+}
+
+// nextRevisionNumber returns the number for the next revision, recording the original
+// provider output as revision 0 the first time a transcription is edited
+func (e *transcriptEditor) nextRevisionNumber(ctx context.Context, transcription *entities.Transcription) (int, error) {
+	latest, err := e.revisionRepo.FindLatest(ctx, transcription.GetID())
+	if err != nil {
+		return 0, domain.NewDomainError("GET_REVISIONS_FAILED", "Failed to get transcript revisions", err)
+	}
+	if latest != nil {
+		return latest.RevisionNumber + 1, nil
+	}
+
+	original := entities.NewOriginalRevision(transcription.GetID(), transcription.Segments)
+	if err := e.revisionRepo.Save(ctx, &original); err != nil {
+		return 0, domain.NewDomainError("SAVE_REVISION_FAILED", "Failed to save original transcript revision", err)
+	}
+	return 1, nil
+}
+
+// persist stores the revision, the edited segments and the regenerated transcription in one
+// transaction, then publishes the update. The revision is saved first so that an edit losing the
+// race for its revision number stops before writing anything else.
+func (e *transcriptEditor) persist(ctx context.Context, transcription *entities.Transcription, revision *entities.TranscriptRevision) (*TranscriptEditResult, error) {
+	err := e.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := e.revisionRepo.Save(ctx, revision); err != nil {
+			return domain.NewDomainError("SAVE_REVISION_FAILED", "Failed to save transcript revision", err)
+		}
+
+		if err := e.transcriptionRepo.UpdateSegments(ctx, transcription.GetID(), transcription.Segments); err != nil {
+			return domain.NewDomainError("SAVE_SEGMENTS_FAILED", "Failed to save transcript segments", err)
+		}
+
+		// Segments are persisted separately; avoid GORM upserting the association again
+		segments := transcription.Segments
+		transcription.Segments = nil
+		err := e.transcriptionRepo.Update(ctx, transcription)
+		transcription.Segments = segments
+		if err != nil {
+			return domain.NewDomainError("UPDATE_TRANSCRIPTION_FAILED", "Failed to update transcription", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e.eventBus.Publish("transcription.segments_updated", &TranscriptSegmentsUpdatedEvent{
+		TranscriptionID: transcription.GetID(),
+		MeetingID:       transcription.MeetingID,
+		RevisionNumber:  revision.RevisionNumber,
+		EditType:        revision.EditType,
+		EditedBy:        revision.EditedBy,
+		SegmentIDs:      revision.SegmentIDs,
+		UpdatedAt:       time.Now(),
+	})
+
+	return &TranscriptEditResult{
+		Transcription: transcription,
+		Revision:      revision,
+	}, nil
+}
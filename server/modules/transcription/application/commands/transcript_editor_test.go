@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+	"teammate/server/seedwork/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTranscriptionRepository serves a single transcription and records segment updates
+type memoryTranscriptionRepository struct {
+	repositories.TranscriptionRepository
+	mu            sync.Mutex
+	transcription entities.Transcription
+	segments      []entities.TranscriptSegment
+	updates       int
+	updateErr     error // Returned by UpdateSegments when set
+}
+
+func (r *memoryTranscriptionRepository) FindByID(ctx context.Context, id string) (*entities.Transcription, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	transcription := r.transcription
+	return &transcription, nil
+}
+
+func (r *memoryTranscriptionRepository) FindSegmentsByTranscriptionID(ctx context.Context, transcriptionID string) ([]entities.TranscriptSegment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]entities.TranscriptSegment(nil), r.segments...), nil
+}
+
+func (r *memoryTranscriptionRepository) UpdateSegments(ctx context.Context, transcriptionID string, segments []entities.TranscriptSegment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.updateErr != nil {
+		return r.updateErr
+	}
+	r.segments = append([]entities.TranscriptSegment(nil), segments...)
+	r.updates++
+	return nil
+}
+
+func (r *memoryTranscriptionRepository) Update(ctx context.Context, transcription *entities.Transcription) error {
+	return nil
+}
+
+// memoryRevisionRepository enforces unique revision numbers like the database. Before each save
+// it lets racing edits take the next revision numbers first.
+type memoryRevisionRepository struct {
+	mu        sync.Mutex
+	revisions []entities.TranscriptRevision
+	racing    int
+}
+
+func (r *memoryRevisionRepository) Save(ctx context.Context, revision *entities.TranscriptRevision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.racing > 0 && revision.RevisionNumber > 0 {
+		r.racing--
+		r.revisions = append(r.revisions, entities.NewTranscriptRevision(revision.TranscriptionID, len(r.revisions), entities.EditTextRevision, "racer", nil, nil, nil))
+	}
+	for _, saved := range r.revisions {
+		if saved.RevisionNumber == revision.RevisionNumber {
+			return fmt.Errorf("revision %d: %w", revision.RevisionNumber, domain.ErrAlreadyExists)
+		}
+	}
+	r.revisions = append(r.revisions, *revision)
+	return nil
+}
+
+func (r *memoryRevisionRepository) FindByTranscriptionID(ctx context.Context, transcriptionID string) ([]*entities.TranscriptRevision, error) {
+	return nil, nil
+}
+
+func (r *memoryRevisionRepository) FindByNumber(ctx context.Context, transcriptionID string, revisionNumber int) (*entities.TranscriptRevision, error) {
+	return nil, fmt.Errorf("record not found")
+}
+
+func (r *memoryRevisionRepository) FindLatest(ctx context.Context, transcriptionID string) (*entities.TranscriptRevision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.revisions) == 0 {
+		return nil, nil
+	}
+	latest := r.revisions[len(r.revisions)-1]
+	return &latest, nil
+}
+
+// memoryTransactor puts back the segments and revisions of the memory repositories when a
+// transaction fails. Revisions of racing edits were committed by their own transactions and stay.
+type memoryTransactor struct {
+	transcriptionRepo *memoryTranscriptionRepository
+	revisionRepo      *memoryRevisionRepository
+}
+
+func (t *memoryTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.transcriptionRepo.mu.Lock()
+	segments, updates := t.transcriptionRepo.segments, t.transcriptionRepo.updates
+	t.transcriptionRepo.mu.Unlock()
+	t.revisionRepo.mu.Lock()
+	saved := len(t.revisionRepo.revisions)
+	t.revisionRepo.mu.Unlock()
+
+	err := fn(ctx)
+	if err != nil {
+		t.transcriptionRepo.mu.Lock()
+		t.transcriptionRepo.segments, t.transcriptionRepo.updates = segments, updates
+		t.transcriptionRepo.mu.Unlock()
+		t.revisionRepo.mu.Lock()
+		kept := t.revisionRepo.revisions[:saved]
+		for _, revision := range t.revisionRepo.revisions[saved:] {
+			if revision.EditedBy == "racer" {
+				kept = append(kept, revision)
+			}
+		}
+		t.revisionRepo.revisions = kept
+		t.revisionRepo.mu.Unlock()
+	}
+	return err
+}
+
+func newTestEditSegmentHandler(racing int) (*EditSegmentHandler, *memoryTranscriptionRepository, *memoryRevisionRepository) {
+	transcription := entities.NewTranscription("meeting-1", "", "assemblyai")
+	segments := []entities.TranscriptSegment{entities.NewTranscriptSegment(transcription.GetID(), "Anna", "Helo everyone", 0, 2, 0.9, 1)}
+	transcription.CompleteTranscription("", 0.9, nil)
+
+	transcriptionRepo := &memoryTranscriptionRepository{transcription: transcription, segments: segments}
+	revisionRepo := &memoryRevisionRepository{racing: racing}
+	transactor := &memoryTransactor{transcriptionRepo: transcriptionRepo, revisionRepo: revisionRepo}
+	return NewEditSegmentHandler(transcriptionRepo, revisionRepo, transactor, events.NewMemoryEventBus()), transcriptionRepo, revisionRepo
+}
+
+func TestEditSegmentHandler_RetriesTakenRevisionNumbers(t *testing.T) {
+	handler, transcriptionRepo, revisionRepo := newTestEditSegmentHandler(1)
+	text := "Hello everyone"
+
+	result, err := handler.Handle(context.Background(), EditSegmentCommand{
+		TranscriptionID: transcriptionRepo.transcription.GetID(),
+		SegmentID:       transcriptionRepo.segments[0].GetID(),
+		Text:            &text,
+		EditedBy:        "owner",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Revision.RevisionNumber)
+	assert.Equal(t, "Hello everyone", transcriptionRepo.segments[0].Text)
+	assert.Equal(t, 1, transcriptionRepo.updates)
+	assert.Len(t, revisionRepo.revisions, 3)
+}
+
+func TestEditSegmentHandler_ReportsConflicts(t *testing.T) {
+	handler, transcriptionRepo, _ := newTestEditSegmentHandler(maxEditAttempts)
+	text := "Hello everyone"
+
+	_, err := handler.Handle(context.Background(), EditSegmentCommand{
+		TranscriptionID: transcriptionRepo.transcription.GetID(),
+		SegmentID:       transcriptionRepo.segments[0].GetID(),
+		Text:            &text,
+		EditedBy:        "owner",
+	})
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "REVISION_CONFLICT", domainErr.Code)
+
+	// Edits that lost the race change nothing
+	assert.Equal(t, 0, transcriptionRepo.updates)
+	assert.Equal(t, "Helo everyone", transcriptionRepo.segments[0].Text)
+}
+
+func TestEditSegmentHandler_RollsBackFailedEdits(t *testing.T) {
+	handler, transcriptionRepo, revisionRepo := newTestEditSegmentHandler(0)
+	transcriptionRepo.updateErr = errors.New("connection reset")
+	text := "Hello everyone"
+
+	_, err := handler.Handle(context.Background(), EditSegmentCommand{
+		TranscriptionID: transcriptionRepo.transcription.GetID(),
+		SegmentID:       transcriptionRepo.segments[0].GetID(),
+		Text:            &text,
+		EditedBy:        "owner",
+	})
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "SAVE_SEGMENTS_FAILED", domainErr.Code)
+
+	// Only the original provider output is recorded; the edit's revision is rolled back with its segments
+	require.Len(t, revisionRepo.revisions, 1)
+	assert.Equal(t, 0, revisionRepo.revisions[0].RevisionNumber)
+	assert.Equal(t, "Helo everyone", transcriptionRepo.segments[0].Text)
+}
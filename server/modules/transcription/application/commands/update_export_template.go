@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// UpdateExportTemplateCommand represents a command to replace the contents of an export template
+type UpdateExportTemplateCommand struct {
+	ID          string                        `json:"id"`
+	UserID      string                        `json:"user_id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	Format      entities.ExportTemplateFormat `json:"format"`
+	Body        string                        `json:"body"`
+}
+
+// UpdateExportTemplateHandler handles the update export template command
+type UpdateExportTemplateHandler struct {
+	templateRepo repositories.ExportTemplateRepository
+}
+
+// NewUpdateExportTemplateHandler creates a new update export template handler
+func NewUpdateExportTemplateHandler(templateRepo repositories.ExportTemplateRepository) *UpdateExportTemplateHandler {
+	return &UpdateExportTemplateHandler{
+		templateRepo: templateRepo,
+	}
+}
+
+// Handle executes the update export template command
+func (h *UpdateExportTemplateHandler) Handle(ctx context.Context, cmd UpdateExportTemplateCommand) (*entities.ExportTemplate, error) {
+	template, err := loadOwnedExportTemplate(ctx, h.templateRepo, cmd.ID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := template.Update(cmd.Name, cmd.Description, cmd.Format, cmd.Body); err != nil {
+		return nil, err
+	}
+
+	if err := checkExportTemplate(ctx, h.templateRepo, template); err != nil {
+		return nil, err
+	}
+
+	if err := h.templateRepo.Update(ctx, template); err != nil {
+		return nil, domain.NewDomainError("UPDATE_EXPORT_TEMPLATE_FAILED", "Failed to update export template", err)
+	}
+
+	return template, nil
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// UpdateRedactionSettingsCommand represents a command to change a user's default PII redaction
+type UpdateRedactionSettingsCommand struct {
+	UserID         string               `json:"user_id"`
+	RedactPII      bool                 `json:"redact_pii"`
+	RedactPIIAudio bool                 `json:"redact_pii_audio"`
+	PIIPolicies    []entities.PIIPolicy `json:"pii_policies"`
+}
+
+// UpdateRedactionSettingsHandler handles the update redaction settings command
+type UpdateRedactionSettingsHandler struct {
+	redactionRepo repositories.RedactionSettingsRepository
+}
+
+// NewUpdateRedactionSettingsHandler creates a new update redaction settings handler
+func NewUpdateRedactionSettingsHandler(redactionRepo repositories.RedactionSettingsRepository) *UpdateRedactionSettingsHandler {
+	return &UpdateRedactionSettingsHandler{
+		redactionRepo: redactionRepo,
+	}
+}
+
+// Handle executes the update redaction settings command
+func (h *UpdateRedactionSettingsHandler) Handle(ctx context.Context, cmd UpdateRedactionSettingsCommand) (*entities.RedactionSettings, error) {
+	settings, err := h.redactionRepo.FindByUserID(ctx, cmd.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("LOAD_REDACTION_SETTINGS_FAILED", "Failed to load redaction settings", err)
+	}
+	if settings == nil {
+		newSettings := entities.NewRedactionSettings(cmd.UserID)
+		settings = &newSettings
+	}
+
+	if err := settings.Update(cmd.RedactPII, cmd.RedactPIIAudio, cmd.PIIPolicies); err != nil {
+		return nil, err
+	}
+
+	if err := h.redactionRepo.Save(ctx, settings); err != nil {
+		return nil, domain.NewDomainError("SAVE_REDACTION_SETTINGS_FAILED", "Failed to save redaction settings", err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// UpdateTaxonomyCommand represents a command to replace the contents of an analytics taxonomy of the
+// user or of a team they own
+type UpdateTaxonomyCommand struct {
+	ID          string                    `json:"id"`
+	UserID      string                    `json:"user_id"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Topics      []entities.TaxonomyTopic  `json:"topics"`
+	Sentiment   entities.SentimentLexicon `json:"sentiment"`
+	Stemming    bool                      `json:"stemming"`
+}
+
+// UpdateTaxonomyHandler handles the update taxonomy command
+type UpdateTaxonomyHandler struct {
+	taxonomyRepo repositories.TaxonomyRepository
+	teamRepo     userRepos.TeamRepository
+}
+
+// NewUpdateTaxonomyHandler creates a new update taxonomy handler
+func NewUpdateTaxonomyHandler(taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository) *UpdateTaxonomyHandler {
+	return &UpdateTaxonomyHandler{
+		taxonomyRepo: taxonomyRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// Handle executes the update taxonomy command
+func (h *UpdateTaxonomyHandler) Handle(ctx context.Context, cmd UpdateTaxonomyCommand) (*entities.Taxonomy, error) {
+	taxonomy, err := loadManagedTaxonomy(ctx, h.taxonomyRepo, h.teamRepo, cmd.ID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := taxonomy.Update(cmd.Name, cmd.Description, cmd.Topics, cmd.Sentiment, cmd.Stemming); err != nil {
+		return nil, err
+	}
+
+	if err := h.taxonomyRepo.Update(ctx, taxonomy); err != nil {
+		return nil, domain.NewDomainError("UPDATE_TAXONOMY_FAILED", "Failed to update taxonomy", err)
+	}
+
+	return taxonomy, nil
+}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// UpdateVocabularyCommand represents a command to replace the contents of a custom vocabulary
+type UpdateVocabularyCommand struct {
+	ID          string                  `json:"id"`
+	UserID      string                  `json:"user_id"`
+	Name        string                  `json:"name"`
+	MeetingType string                  `json:"meeting_type,omitempty"`
+	Terms       []string                `json:"terms"`
+	BoostParam  entities.BoostLevel     `json:"boost_param,omitempty"`
+	Spellings   []entities.SpellingRule `json:"spellings"`
+}
+
+// UpdateVocabularyHandler handles the update vocabulary command
+type UpdateVocabularyHandler struct {
+	vocabularyRepo repositories.VocabularyRepository
+}
+
+// NewUpdateVocabularyHandler creates a new update vocabulary handler
+func NewUpdateVocabularyHandler(vocabularyRepo repositories.VocabularyRepository) *UpdateVocabularyHandler {
+	return &UpdateVocabularyHandler{
+		vocabularyRepo: vocabularyRepo,
+	}
+}
+
+// Handle executes the update vocabulary command
+func (h *UpdateVocabularyHandler) Handle(ctx context.Context, cmd UpdateVocabularyCommand) (*entities.Vocabulary, error) {
+	vocabulary, err := loadOwnedVocabulary(ctx, h.vocabularyRepo, cmd.ID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := vocabulary.Update(cmd.Name, cmd.MeetingType, cmd.Terms, cmd.BoostParam, cmd.Spellings); err != nil {
+		return nil, err
+	}
+
+	if err := h.vocabularyRepo.Update(ctx, vocabulary); err != nil {
+		return nil, domain.NewDomainError("UPDATE_VOCABULARY_FAILED", "Failed to update vocabulary", err)
+	}
+
+	return vocabulary, nil
+}
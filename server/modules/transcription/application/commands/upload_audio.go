@@ -0,0 +1,146 @@
+package commands
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+// DefaultUploadProvider transcribes uploaded recordings when no provider is requested
+const DefaultUploadProvider = "assemblyai"
+
+// UploadAudioCommand represents a command to transcribe a pre-recorded audio file.
+// Without a MeetingID a meeting is created for the recording.
+type UploadAudioCommand struct {
+	UserID             string     `json:"user_id"`
+	MeetingID          string     `json:"meeting_id,omitempty"`
+	Title              string     `json:"title,omitempty"`      // Title of a created meeting, defaults to the file name
+	StartTime          *time.Time `json:"start_time,omitempty"` // Start of a created meeting, defaults to now
+	FileName           string     `json:"file_name,omitempty"`
+	ContentType        string     `json:"content_type,omitempty"`
+	Format             string     `json:"format,omitempty"` // Detected from the file name or content type when empty
+	Provider           string     `json:"provider,omitempty"`
+	Language           string     `json:"language,omitempty"`
+	SpeakerDiarization bool       `json:"speaker_diarization"`
+}
+
+// UploadAudioResult represents the meeting and pending transcription created for a recording
+type UploadAudioResult struct {
+	TranscriptionID string                          `json:"transcription_id"`
+	MeetingID       string                          `json:"meeting_id"`
+	MeetingCreated  bool                            `json:"meeting_created"`
+	Format          string                          `json:"format"`
+	Options         services.AudioProcessingOptions `json:"options"`
+}
+
+// UploadAudioHandler handles the upload audio command
+type UploadAudioHandler struct {
+	transcriptionRepo repositories.TranscriptionRepository
+	meetingRepo       meetingRepos.MeetingRepository
+	audioFactory      services.AudioProcessorFactory
+}
+
+// NewUploadAudioHandler creates a new upload audio handler
+func NewUploadAudioHandler(
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	audioFactory services.AudioProcessorFactory,
+) *UploadAudioHandler {
+	return &UploadAudioHandler{
+		transcriptionRepo: transcriptionRepo,
+		meetingRepo:       meetingRepo,
+		audioFactory:      audioFactory,
+	}
+}
+
+// Validate resolves the command's provider and audio format, checking the provider can
+// transcribe recordings in that format
+func (h *UploadAudioHandler) Validate(cmd UploadAudioCommand) (UploadAudioCommand, error) {
+	if cmd.Provider == "" {
+		cmd.Provider = DefaultUploadProvider
+	}
+	capabilities, err := h.audioFactory.GetProviderCapabilities(cmd.Provider)
+	if err != nil {
+		return cmd, domain.NewDomainError("UNSUPPORTED_PROVIDER", err.Error(), domain.ErrInvalidInput)
+	}
+	if !capabilities.SupportsBatch {
+		return cmd, domain.NewDomainError("UNSUPPORTED_PROVIDER", "Provider "+cmd.Provider+" cannot transcribe recordings", domain.ErrInvalidInput)
+	}
+
+	cmd.Format = strings.ToLower(cmd.Format)
+	if cmd.Format == "" {
+		cmd.Format = services.DetectAudioFormat(cmd.FileName, cmd.ContentType)
+	}
+	if err := services.ValidateAudioFormat(cmd.Format, capabilities); err != nil {
+		return cmd, err
+	}
+	return cmd, nil
+}
+
+// Handle executes the upload audio command, creating the meeting and a pending transcription
+// that the batch pipeline completes. Access to an existing meeting must be verified by the caller.
+func (h *UploadAudioHandler) Handle(ctx context.Context, cmd UploadAudioCommand) (*UploadAudioResult, error) {
+	cmd, err := h.Validate(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	meeting, created, err := h.resolveMeeting(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	transcription := entities.NewTranscription(meeting.ID, "", cmd.Provider)
+	if err := h.transcriptionRepo.Save(ctx, &transcription); err != nil {
+		return nil, domain.NewDomainError("SAVE_TRANSCRIPTION_FAILED", "Failed to save transcription", err)
+	}
+
+	return &UploadAudioResult{
+		TranscriptionID: transcription.GetID(),
+		MeetingID:       meeting.ID,
+		MeetingCreated:  created,
+		Format:          cmd.Format,
+		Options: services.AudioProcessingOptions{
+			Provider:           cmd.Provider,
+			Language:           cmd.Language,
+			SpeakerDiarization: cmd.SpeakerDiarization,
+			Mode:               services.BatchMode,
+		},
+	}, nil
+}
+
+// resolveMeeting loads the meeting to transcribe the recording into, or creates one
+func (h *UploadAudioHandler) resolveMeeting(ctx context.Context, cmd UploadAudioCommand) (*meetingRepos.Meeting, bool, error) {
+	if cmd.MeetingID != "" {
+		meeting, err := h.meetingRepo.FindMeetingByID(ctx, cmd.MeetingID)
+		if err != nil {
+			return nil, false, domain.NewDomainError("MEETING_NOT_FOUND", "Meeting not found", err)
+		}
+		return meeting, false, nil
+	}
+
+	startTime := time.Now()
+	if cmd.StartTime != nil {
+		startTime = *cmd.StartTime
+	}
+
+	meeting := &meetingRepos.Meeting{
+		UserID:    cmd.UserID,
+		Title:     firstNonEmpty(cmd.Title, strings.TrimSuffix(cmd.FileName, filepath.Ext(cmd.FileName)), "Uploaded recording"),
+		Type:      meetingRepos.MeetingTypeGeneric,
+		Status:    meetingRepos.MeetingStatusScheduled,
+		StartTime: startTime,
+	}
+	meeting.SetID(domain.GenerateID())
+	if err := h.meetingRepo.SaveMeeting(ctx, meeting); err != nil {
+		return nil, false, domain.NewDomainError("SAVE_MEETING_FAILED", "Failed to create meeting", err)
+	}
+	return meeting, true, nil
+}
@@ -0,0 +1,370 @@
+package queries
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	defaultTrendsRange = 90 * 24 * time.Hour
+	maxTrendPeriods    = 366
+	maxTrendSpeakers   = 10
+	maxRecurringTopics = 10
+)
+
+// Trend intervals, the length of the periods a time series is bucketed into
+const (
+	TrendIntervalDay   = "day"
+	TrendIntervalWeek  = "week"
+	TrendIntervalMonth = "month"
+)
+
+// Trend scopes; "all" covers the user's meetings and meetings shared with them, "team" the meetings
+// owned by the members of one of the user's teams
+const (
+	TrendScopeAll   = "all"
+	TrendScopeOwned = "owned"
+	TrendScopeTeam  = "team"
+)
+
+// GetAnalyticsTrendsQuery represents a request for analytics aggregated across meetings
+type GetAnalyticsTrendsQuery struct {
+	UserID      string     `json:"user_id"`
+	From        *time.Time `json:"from,omitempty"`
+	To          *time.Time `json:"to,omitempty"`
+	Interval    string     `json:"interval,omitempty"`
+	Scope       string     `json:"scope,omitempty"`
+	TeamID      string     `json:"team_id,omitempty"`
+	MeetingType string     `json:"meeting_type,omitempty"`
+}
+
+// TrendPoint is the value of a time series for the period starting at PeriodStart
+type TrendPoint struct {
+	PeriodStart time.Time `json:"period_start"`
+	Value       float64   `json:"value"`
+}
+
+// MeetingTypeTrend is the number of meetings of a type per period
+type MeetingTypeTrend struct {
+	MeetingType string       `json:"meeting_type"`
+	Total       int          `json:"total"`
+	Points      []TrendPoint `json:"points"`
+}
+
+// SpeakerTrend is a speaker's share of the speaking time per period, in percent
+type SpeakerTrend struct {
+	Speaker      string       `json:"speaker"`
+	SpeakingTime float64      `json:"speaking_time_seconds"`
+	TalkShare    float64      `json:"talk_share_percent"`
+	Points       []TrendPoint `json:"points"`
+}
+
+// TopicTrend is the number of meetings per period that discussed a recurring topic
+type TopicTrend struct {
+	Topic        string       `json:"topic"`
+	MeetingCount int          `json:"meeting_count"`
+	FirstSeen    time.Time    `json:"first_seen"`
+	LastSeen     time.Time    `json:"last_seen"`
+	Points       []TrendPoint `json:"points"`
+}
+
+// AnalyticsTrendsResult holds time series of analytics across meetings, one point per period.
+// Sentiment points are only present for periods with meetings.
+type AnalyticsTrendsResult struct {
+	From             time.Time          `json:"from"`
+	To               time.Time          `json:"to"`
+	Interval         string             `json:"interval"`
+	Scope            string             `json:"scope"`
+	TeamID           string             `json:"team_id,omitempty"`
+	Periods          []time.Time        `json:"periods"`
+	MeetingCount     int                `json:"meeting_count"`
+	MeetingHours     float64            `json:"meeting_hours"`
+	AverageSentiment float64            `json:"average_sentiment"`
+	HoursTrend       []TrendPoint       `json:"hours_trend"`
+	MeetingsByType   []MeetingTypeTrend `json:"meetings_by_type"`
+	SpeakerShare     []SpeakerTrend     `json:"speaker_share"`
+	RecurringTopics  []TopicTrend       `json:"recurring_topics"`
+	SentimentTrend   []TrendPoint       `json:"sentiment_trend"`
+}
+
+// GetAnalyticsTrendsHandler aggregates stored analytics snapshots into trends
+type GetAnalyticsTrendsHandler struct {
+	snapshotRepo repositories.AnalyticsSnapshotRepository
+	teamRepo     userRepos.TeamRepository
+}
+
+// NewGetAnalyticsTrendsHandler creates a new get analytics trends handler
+func NewGetAnalyticsTrendsHandler(snapshotRepo repositories.AnalyticsSnapshotRepository, teamRepo userRepos.TeamRepository) *GetAnalyticsTrendsHandler {
+	return &GetAnalyticsTrendsHandler{
+		snapshotRepo: snapshotRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// Handle executes the get analytics trends query
+func (h *GetAnalyticsTrendsHandler) Handle(ctx context.Context, query GetAnalyticsTrendsQuery) (*AnalyticsTrendsResult, error) {
+	if query.Interval == "" {
+		query.Interval = TrendIntervalWeek
+	}
+	if query.Interval != TrendIntervalDay && query.Interval != TrendIntervalWeek && query.Interval != TrendIntervalMonth {
+		return nil, domain.NewDomainError("INVALID_TRENDS_QUERY", "Interval must be day, week or month", domain.ErrInvalidInput)
+	}
+	if query.Scope == "" {
+		query.Scope = TrendScopeAll
+	}
+	if query.Scope != TrendScopeAll && query.Scope != TrendScopeOwned && query.Scope != TrendScopeTeam {
+		return nil, domain.NewDomainError("INVALID_TRENDS_QUERY", "Scope must be all, owned or team", domain.ErrInvalidInput)
+	}
+	if (query.Scope == TrendScopeTeam) != (query.TeamID != "") {
+		return nil, domain.NewDomainError("INVALID_TRENDS_QUERY", "A team ID is required for the team scope and only allowed with it", domain.ErrInvalidInput)
+	}
+
+	to := time.Now().UTC()
+	if query.To != nil {
+		to = query.To.UTC()
+	}
+	from := to.Add(-defaultTrendsRange)
+	if query.From != nil {
+		from = query.From.UTC()
+	}
+	if !to.After(from) {
+		return nil, domain.NewDomainError("INVALID_TRENDS_QUERY", "Trends date range is invalid", domain.ErrInvalidInput)
+	}
+
+	periods := trendPeriods(from, to, query.Interval)
+	if len(periods) > maxTrendPeriods {
+		return nil, domain.NewDomainError("INVALID_TRENDS_QUERY", "Trends date range has too many periods for the interval", domain.ErrInvalidInput)
+	}
+
+	if query.Scope == TrendScopeTeam {
+		if err := h.verifyTeamMember(ctx, query.TeamID, query.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	snapshots, err := h.snapshotRepo.FindAccessibleSnapshots(ctx, repositories.AnalyticsSnapshotCriteria{
+		UserID:      query.UserID,
+		OwnedOnly:   query.Scope == TrendScopeOwned,
+		TeamID:      query.TeamID,
+		MeetingType: query.MeetingType,
+		From:        from,
+		To:          to,
+	})
+	if err != nil {
+		return nil, domain.NewDomainError("GET_ANALYTICS_SNAPSHOTS_FAILED", "Failed to get analytics snapshots", err)
+	}
+
+	result := AggregateAnalyticsTrends(snapshots, periods, query.Interval)
+	result.From = from
+	result.To = to
+	result.Scope = query.Scope
+	result.TeamID = query.TeamID
+	return result, nil
+}
+
+// verifyTeamMember checks that the user is a member of the team, reporting other teams as not found
+func (h *GetAnalyticsTrendsHandler) verifyTeamMember(ctx context.Context, teamID, userID string) error {
+	if h.teamRepo == nil {
+		return domain.NewDomainError("TEAM_NOT_FOUND", "Team not found", domain.ErrNotFound)
+	}
+	member, err := h.teamRepo.FindMember(ctx, teamID, userID)
+	if err != nil {
+		return domain.NewDomainError("GET_TEAM_FAILED", "Failed to get team", err)
+	}
+	if member == nil {
+		return domain.NewDomainError("TEAM_NOT_FOUND", "Team not found", domain.ErrNotFound)
+	}
+	return nil
+}
+
+// AggregateAnalyticsTrends builds time series over periods from meeting snapshots
+func AggregateAnalyticsTrends(snapshots []*entities.AnalyticsSnapshot, periods []time.Time, interval string) *AnalyticsTrendsResult {
+	result := &AnalyticsTrendsResult{
+		Interval:        interval,
+		Periods:         periods,
+		HoursTrend:      newTrendPoints(periods),
+		MeetingsByType:  []MeetingTypeTrend{},
+		SpeakerShare:    []SpeakerTrend{},
+		RecurringTopics: []TopicTrend{},
+		SentimentTrend:  []TrendPoint{},
+	}
+
+	periodIndex := make(map[time.Time]int, len(periods))
+	for i, period := range periods {
+		periodIndex[period] = i
+	}
+
+	speakingPerPeriod := make([]float64, len(periods))
+	sentimentSums := make([]float64, len(periods))
+	sentimentCounts := make([]int, len(periods))
+	types := make(map[string]*MeetingTypeTrend)
+	speakers := make(map[string]*SpeakerTrend)
+	topics := make(map[string]*TopicTrend)
+	var totalSpeaking, totalSentiment float64
+
+	for _, snapshot := range snapshots {
+		i, ok := periodIndex[periodStart(snapshot.MeetingStartTime.UTC(), interval)]
+		if !ok {
+			continue
+		}
+
+		result.MeetingCount++
+		result.MeetingHours += snapshot.DurationSeconds / 3600
+		result.HoursTrend[i].Value += snapshot.DurationSeconds / 3600
+		sentimentSums[i] += snapshot.SentimentScore
+		sentimentCounts[i]++
+		totalSentiment += snapshot.SentimentScore
+
+		meetingType, ok := types[snapshot.MeetingType]
+		if !ok {
+			meetingType = &MeetingTypeTrend{MeetingType: snapshot.MeetingType, Points: newTrendPoints(periods)}
+			types[snapshot.MeetingType] = meetingType
+		}
+		meetingType.Total++
+		meetingType.Points[i].Value++
+
+		for _, share := range snapshot.Speakers {
+			speaker, ok := speakers[share.Speaker]
+			if !ok {
+				speaker = &SpeakerTrend{Speaker: share.Speaker, Points: newTrendPoints(periods)}
+				speakers[share.Speaker] = speaker
+			}
+			speaker.SpeakingTime += share.SpeakingTime
+			speaker.Points[i].Value += share.SpeakingTime
+			speakingPerPeriod[i] += share.SpeakingTime
+			totalSpeaking += share.SpeakingTime
+		}
+
+		seen := make(map[string]bool, len(snapshot.Topics))
+		for _, name := range snapshot.Topics {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			topic, ok := topics[name]
+			if !ok {
+				topic = &TopicTrend{Topic: name, FirstSeen: snapshot.MeetingStartTime, Points: newTrendPoints(periods)}
+				topics[name] = topic
+			}
+			topic.MeetingCount++
+			topic.Points[i].Value++
+			if snapshot.MeetingStartTime.Before(topic.FirstSeen) {
+				topic.FirstSeen = snapshot.MeetingStartTime
+			}
+			if snapshot.MeetingStartTime.After(topic.LastSeen) {
+				topic.LastSeen = snapshot.MeetingStartTime
+			}
+		}
+	}
+
+	if result.MeetingCount > 0 {
+		result.AverageSentiment = totalSentiment / float64(result.MeetingCount)
+	}
+	for i, period := range periods {
+		if sentimentCounts[i] > 0 {
+			result.SentimentTrend = append(result.SentimentTrend, TrendPoint{
+				PeriodStart: period,
+				Value:       sentimentSums[i] / float64(sentimentCounts[i]),
+			})
+		}
+	}
+
+	for _, meetingType := range types {
+		result.MeetingsByType = append(result.MeetingsByType, *meetingType)
+	}
+	sort.Slice(result.MeetingsByType, func(a, b int) bool {
+		return result.MeetingsByType[a].MeetingType < result.MeetingsByType[b].MeetingType
+	})
+
+	// Turn speaking time into a share of each period's speaking time
+	for _, speaker := range speakers {
+		if totalSpeaking > 0 {
+			speaker.TalkShare = speaker.SpeakingTime / totalSpeaking * 100
+		}
+		for i := range speaker.Points {
+			if speakingPerPeriod[i] > 0 {
+				speaker.Points[i].Value = speaker.Points[i].Value / speakingPerPeriod[i] * 100
+			}
+		}
+		result.SpeakerShare = append(result.SpeakerShare, *speaker)
+	}
+	sort.Slice(result.SpeakerShare, func(a, b int) bool {
+		if result.SpeakerShare[a].SpeakingTime != result.SpeakerShare[b].SpeakingTime {
+			return result.SpeakerShare[a].SpeakingTime > result.SpeakerShare[b].SpeakingTime
+		}
+		return result.SpeakerShare[a].Speaker < result.SpeakerShare[b].Speaker
+	})
+	if len(result.SpeakerShare) > maxTrendSpeakers {
+		result.SpeakerShare = result.SpeakerShare[:maxTrendSpeakers]
+	}
+
+	// Only topics discussed in more than one meeting recur
+	for _, topic := range topics {
+		if topic.MeetingCount > 1 {
+			result.RecurringTopics = append(result.RecurringTopics, *topic)
+		}
+	}
+	sort.Slice(result.RecurringTopics, func(a, b int) bool {
+		if result.RecurringTopics[a].MeetingCount != result.RecurringTopics[b].MeetingCount {
+			return result.RecurringTopics[a].MeetingCount > result.RecurringTopics[b].MeetingCount
+		}
+		return result.RecurringTopics[a].Topic < result.RecurringTopics[b].Topic
+	})
+	if len(result.RecurringTopics) > maxRecurringTopics {
+		result.RecurringTopics = result.RecurringTopics[:maxRecurringTopics]
+	}
+
+	return result
+}
+
+// trendPeriods returns the start of every period overlapping [from, to)
+func trendPeriods(from, to time.Time, interval string) []time.Time {
+	var periods []time.Time
+	for period := periodStart(from, interval); period.Before(to); period = nextPeriod(period, interval) {
+		periods = append(periods, period)
+		if len(periods) > maxTrendPeriods {
+			break
+		}
+	}
+	return periods
+}
+
+// periodStart returns the start of the UTC day, ISO week or month containing t
+func periodStart(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case TrendIntervalWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case TrendIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// nextPeriod returns the start of the period after the one starting at period
+func nextPeriod(period time.Time, interval string) time.Time {
+	switch interval {
+	case TrendIntervalWeek:
+		return period.AddDate(0, 0, 7)
+	case TrendIntervalMonth:
+		return period.AddDate(0, 1, 0)
+	default:
+		return period.AddDate(0, 0, 1)
+	}
+}
+
+func newTrendPoints(periods []time.Time) []TrendPoint {
+	points := make([]TrendPoint, len(periods))
+	for i, period := range periods {
+		points[i].PeriodStart = period
+	}
+	return points
+}
@@ -0,0 +1,164 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	userEntities "teammate/server/modules/user/domain/entities"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockAnalyticsSnapshotRepository struct {
+	repositories.AnalyticsSnapshotRepository
+	mock.Mock
+}
+
+func (m *MockAnalyticsSnapshotRepository) FindAccessibleSnapshots(ctx context.Context, criteria repositories.AnalyticsSnapshotCriteria) ([]*entities.AnalyticsSnapshot, error) {
+	args := m.Called(ctx, criteria)
+	return args.Get(0).([]*entities.AnalyticsSnapshot), args.Error(1)
+}
+
+// stubTeamRepository knows the members of teams by team ID
+type stubTeamRepository struct {
+	userRepos.TeamRepository
+	members map[string][]string
+}
+
+func (r *stubTeamRepository) FindMember(ctx context.Context, teamID, userID string) (*userEntities.TeamMember, error) {
+	for _, member := range r.members[teamID] {
+		if member == userID {
+			return &userEntities.TeamMember{TeamID: teamID, UserID: userID, Role: userEntities.TeamMemberRole}, nil
+		}
+	}
+	return nil, nil
+}
+
+func newTestSnapshot(meetingType string, start time.Time, duration, sentiment float64, speakers []entities.SpeakerShare, topics ...string) *entities.AnalyticsSnapshot {
+	snapshot := entities.NewAnalyticsSnapshot("meeting-"+start.Format("0102"), "tr-"+start.Format("0102"))
+	snapshot.MeetingType = meetingType
+	snapshot.MeetingStartTime = start
+	snapshot.DurationSeconds = duration
+	snapshot.SentimentScore = sentiment
+	snapshot.Speakers = speakers
+	snapshot.Topics = topics
+	return &snapshot
+}
+
+func TestGetAnalyticsTrendsHandler_Handle(t *testing.T) {
+	repo := new(MockAnalyticsSnapshotRepository)
+	handler := NewGetAnalyticsTrendsHandler(repo, nil)
+
+	// Monday 2026-03-02 to Monday 2026-03-16: two weekly periods
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)
+
+	snapshots := []*entities.AnalyticsSnapshot{
+		newTestSnapshot("zoom", time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC), 3600, 0.5,
+			[]entities.SpeakerShare{{Speaker: "Alice", SpeakingTime: 300}, {Speaker: "Bob", SpeakingTime: 100}},
+			"budget", "hiring"),
+		newTestSnapshot("generic", time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC), 1800, -0.1,
+			[]entities.SpeakerShare{{Speaker: "Alice", SpeakingTime: 100}},
+			"budget"),
+		newTestSnapshot("zoom", time.Date(2026, 3, 12, 10, 0, 0, 0, time.UTC), 5400, 0.2,
+			[]entities.SpeakerShare{{Speaker: "Bob", SpeakingTime: 200}},
+			"budget", "launch"),
+	}
+	repo.On("FindAccessibleSnapshots", mock.Anything, mock.MatchedBy(func(c repositories.AnalyticsSnapshotCriteria) bool {
+		return c.UserID == "user-1" && !c.OwnedOnly && c.From.Equal(from) && c.To.Equal(to)
+	})).Return(snapshots, nil)
+
+	result, err := handler.Handle(context.Background(), GetAnalyticsTrendsQuery{UserID: "user-1", From: &from, To: &to})
+	require.NoError(t, err)
+
+	assert.Equal(t, TrendIntervalWeek, result.Interval)
+	assert.Equal(t, TrendScopeAll, result.Scope)
+	assert.Equal(t, []time.Time{from, from.AddDate(0, 0, 7)}, result.Periods)
+	assert.Equal(t, 3, result.MeetingCount)
+	assert.InDelta(t, 3.0, result.MeetingHours, 0.001)
+	assert.InDelta(t, 1.5, result.HoursTrend[0].Value, 0.001)
+	assert.InDelta(t, 1.5, result.HoursTrend[1].Value, 0.001)
+
+	require.Len(t, result.MeetingsByType, 2)
+	assert.Equal(t, "generic", result.MeetingsByType[0].MeetingType)
+	assert.Equal(t, "zoom", result.MeetingsByType[1].MeetingType)
+	assert.Equal(t, []float64{1, 1}, []float64{result.MeetingsByType[1].Points[0].Value, result.MeetingsByType[1].Points[1].Value})
+
+	require.Len(t, result.SpeakerShare, 2)
+	assert.Equal(t, "Alice", result.SpeakerShare[0].Speaker)
+	assert.InDelta(t, 400.0/700*100, result.SpeakerShare[0].TalkShare, 0.001)
+	assert.InDelta(t, 80.0, result.SpeakerShare[0].Points[0].Value, 0.001)
+	assert.InDelta(t, 0.0, result.SpeakerShare[0].Points[1].Value, 0.001)
+
+	require.Len(t, result.RecurringTopics, 1)
+	assert.Equal(t, "budget", result.RecurringTopics[0].Topic)
+	assert.Equal(t, 3, result.RecurringTopics[0].MeetingCount)
+
+	require.Len(t, result.SentimentTrend, 2)
+	assert.InDelta(t, 0.2, result.SentimentTrend[0].Value, 0.001)
+	assert.InDelta(t, 0.2, result.SentimentTrend[1].Value, 0.001)
+	repo.AssertExpectations(t)
+}
+
+func TestGetAnalyticsTrendsHandler_TeamScope(t *testing.T) {
+	repo := new(MockAnalyticsSnapshotRepository)
+	handler := NewGetAnalyticsTrendsHandler(repo, &stubTeamRepository{members: map[string][]string{"team-1": {"user-1", "user-2"}}})
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	repo.On("FindAccessibleSnapshots", mock.Anything, mock.MatchedBy(func(c repositories.AnalyticsSnapshotCriteria) bool {
+		return c.UserID == "user-1" && c.TeamID == "team-1" && !c.OwnedOnly
+	})).Return([]*entities.AnalyticsSnapshot{
+		newTestSnapshot("zoom", time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC), 3600, 0.5, nil, "budget"),
+	}, nil)
+
+	result, err := handler.Handle(context.Background(), GetAnalyticsTrendsQuery{UserID: "user-1", From: &from, To: &to, Scope: TrendScopeTeam, TeamID: "team-1"})
+	require.NoError(t, err)
+	assert.Equal(t, TrendScopeTeam, result.Scope)
+	assert.Equal(t, "team-1", result.TeamID)
+	assert.Equal(t, 1, result.MeetingCount)
+
+	// Trends of teams the user is not in are not revealed
+	_, err = handler.Handle(context.Background(), GetAnalyticsTrendsQuery{UserID: "user-3", From: &from, To: &to, Scope: TrendScopeTeam, TeamID: "team-1"})
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "TEAM_NOT_FOUND", domainErr.Code)
+	repo.AssertNumberOfCalls(t, "FindAccessibleSnapshots", 1)
+}
+
+func TestGetAnalyticsTrendsHandler_RejectsInvalidQueries(t *testing.T) {
+	handler := NewGetAnalyticsTrendsHandler(new(MockAnalyticsSnapshotRepository), nil)
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]GetAnalyticsTrendsQuery{
+		"interval":        {UserID: "user-1", Interval: "year"},
+		"scope":           {UserID: "user-1", Scope: "everyone"},
+		"team without id": {UserID: "user-1", Scope: TrendScopeTeam},
+		"id without team": {UserID: "user-1", TeamID: "team-1"},
+		"reversed range":  {UserID: "user-1", From: &to, To: &from},
+		"too many days":   {UserID: "user-1", From: &from, To: &to, Interval: TrendIntervalDay},
+	}
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := handler.Handle(context.Background(), query)
+			var domainErr *domain.DomainError
+			require.ErrorAs(t, err, &domainErr)
+			assert.Equal(t, "INVALID_TRENDS_QUERY", domainErr.Code)
+		})
+	}
+}
+
+func TestPeriodStart(t *testing.T) {
+	sunday := time.Date(2026, 3, 8, 23, 30, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), periodStart(sunday, TrendIntervalWeek))
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), periodStart(sunday, TrendIntervalMonth))
+	assert.Equal(t, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), periodStart(sunday, TrendIntervalDay))
+}
@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// GetExportTemplatesQuery represents a query for all export templates uploaded by a user
+type GetExportTemplatesQuery struct {
+	UserID string `json:"user_id"`
+}
+
+// GetExportTemplatesHandler handles the get export templates query
+type GetExportTemplatesHandler struct {
+	templateRepo repositories.ExportTemplateRepository
+}
+
+// NewGetExportTemplatesHandler creates a new get export templates handler
+func NewGetExportTemplatesHandler(templateRepo repositories.ExportTemplateRepository) *GetExportTemplatesHandler {
+	return &GetExportTemplatesHandler{
+		templateRepo: templateRepo,
+	}
+}
+
+// Handle executes the get export templates query
+func (h *GetExportTemplatesHandler) Handle(ctx context.Context, query GetExportTemplatesQuery) ([]*entities.ExportTemplate, error) {
+	templates, err := h.templateRepo.FindByUserID(ctx, query.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_EXPORT_TEMPLATES_FAILED", "Failed to get export templates", err)
+	}
+	return templates, nil
+}
+
+// GetExportTemplateQuery represents a query for a single export template of a user
+type GetExportTemplateQuery struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// GetExportTemplateHandler handles the get export template query
+type GetExportTemplateHandler struct {
+	templateRepo repositories.ExportTemplateRepository
+}
+
+// NewGetExportTemplateHandler creates a new get export template handler
+func NewGetExportTemplateHandler(templateRepo repositories.ExportTemplateRepository) *GetExportTemplateHandler {
+	return &GetExportTemplateHandler{
+		templateRepo: templateRepo,
+	}
+}
+
+// Handle executes the get export template query
+func (h *GetExportTemplateHandler) Handle(ctx context.Context, query GetExportTemplateQuery) (*entities.ExportTemplate, error) {
+	template, err := h.templateRepo.FindByID(ctx, query.ID)
+	if err != nil || template.UserID != query.UserID {
+		return nil, domain.NewDomainError("EXPORT_TEMPLATE_NOT_FOUND", "Export template not found", err)
+	}
+	return template, nil
+}
@@ -0,0 +1,39 @@
+package queries
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// GetRedactionSettingsQuery represents a query for a user's default PII redaction
+type GetRedactionSettingsQuery struct {
+	UserID string `json:"user_id"`
+}
+
+// GetRedactionSettingsHandler handles the get redaction settings query
+type GetRedactionSettingsHandler struct {
+	redactionRepo repositories.RedactionSettingsRepository
+}
+
+// NewGetRedactionSettingsHandler creates a new get redaction settings handler
+func NewGetRedactionSettingsHandler(redactionRepo repositories.RedactionSettingsRepository) *GetRedactionSettingsHandler {
+	return &GetRedactionSettingsHandler{
+		redactionRepo: redactionRepo,
+	}
+}
+
+// Handle executes the get redaction settings query. Users without settings get redaction disabled.
+func (h *GetRedactionSettingsHandler) Handle(ctx context.Context, query GetRedactionSettingsQuery) (*entities.RedactionSettings, error) {
+	settings, err := h.redactionRepo.FindByUserID(ctx, query.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("LOAD_REDACTION_SETTINGS_FAILED", "Failed to load redaction settings", err)
+	}
+	if settings == nil {
+		defaults := entities.NewRedactionSettings(query.UserID)
+		return &defaults, nil
+	}
+	return settings, nil
+}
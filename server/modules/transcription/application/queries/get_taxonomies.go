@@ -0,0 +1,128 @@
+package queries
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// GetTaxonomiesQuery represents a query for all analytics taxonomies of a user and of their teams
+type GetTaxonomiesQuery struct {
+	UserID string `json:"user_id"`
+}
+
+// TaxonomiesResult holds the taxonomies available to a user and the ID of the one used for the
+// meetings they own
+type TaxonomiesResult struct {
+	Taxonomies []*entities.Taxonomy `json:"taxonomies"`
+	ActiveID   string               `json:"active_id"`
+}
+
+// GetTaxonomiesHandler handles the get taxonomies query
+type GetTaxonomiesHandler struct {
+	taxonomyRepo repositories.TaxonomyRepository
+	teamRepo     userRepos.TeamRepository
+}
+
+// NewGetTaxonomiesHandler creates a new get taxonomies handler
+func NewGetTaxonomiesHandler(taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository) *GetTaxonomiesHandler {
+	return &GetTaxonomiesHandler{
+		taxonomyRepo: taxonomyRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// Handle executes the get taxonomies query
+func (h *GetTaxonomiesHandler) Handle(ctx context.Context, query GetTaxonomiesQuery) (*TaxonomiesResult, error) {
+	taxonomies, err := h.taxonomyRepo.FindByUserID(ctx, query.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_TAXONOMIES_FAILED", "Failed to get taxonomies", err)
+	}
+
+	if h.teamRepo != nil {
+		teams, err := h.teamRepo.FindByUserID(ctx, query.UserID)
+		if err != nil {
+			return nil, domain.NewDomainError("GET_TAXONOMIES_FAILED", "Failed to get teams", err)
+		}
+		teamIDs := make([]string, len(teams))
+		for i, team := range teams {
+			teamIDs[i] = team.ID
+		}
+		teamTaxonomies, err := h.taxonomyRepo.FindByTeamIDs(ctx, teamIDs)
+		if err != nil {
+			return nil, domain.NewDomainError("GET_TAXONOMIES_FAILED", "Failed to get team taxonomies", err)
+		}
+		taxonomies = append(taxonomies, teamTaxonomies...)
+	}
+
+	active, err := h.taxonomyRepo.FindActiveForUser(ctx, query.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_TAXONOMIES_FAILED", "Failed to get active taxonomy", err)
+	}
+	result := &TaxonomiesResult{Taxonomies: taxonomies, ActiveID: services.DefaultTaxonomyID}
+	if active != nil {
+		result.ActiveID = active.ID
+	}
+	return result, nil
+}
+
+// GetTaxonomyQuery represents a query for a single taxonomy of a user or of one of their teams, or the
+// built-in one. The built-in taxonomy is active when neither the user nor their teams activated another.
+type GetTaxonomyQuery struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// GetTaxonomyHandler handles the get taxonomy query
+type GetTaxonomyHandler struct {
+	taxonomyRepo repositories.TaxonomyRepository
+	teamRepo     userRepos.TeamRepository
+}
+
+// NewGetTaxonomyHandler creates a new get taxonomy handler
+func NewGetTaxonomyHandler(taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository) *GetTaxonomyHandler {
+	return &GetTaxonomyHandler{
+		taxonomyRepo: taxonomyRepo,
+		teamRepo:     teamRepo,
+	}
+}
+
+// Handle executes the get taxonomy query
+func (h *GetTaxonomyHandler) Handle(ctx context.Context, query GetTaxonomyQuery) (*entities.Taxonomy, error) {
+	if query.ID == services.DefaultTaxonomyID {
+		active, err := h.taxonomyRepo.FindActiveForUser(ctx, query.UserID)
+		if err != nil {
+			return nil, domain.NewDomainError("GET_TAXONOMY_FAILED", "Failed to get active taxonomy", err)
+		}
+		taxonomy := services.DefaultTaxonomy()
+		taxonomy.Active = active == nil
+		return &taxonomy, nil
+	}
+
+	taxonomy, err := h.taxonomyRepo.FindByID(ctx, query.ID)
+	if err != nil {
+		return nil, domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", err)
+	}
+	if !taxonomy.IsTeamTaxonomy() {
+		if taxonomy.UserID != query.UserID {
+			return nil, domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", domain.ErrNotFound)
+		}
+		return taxonomy, nil
+	}
+
+	if h.teamRepo == nil {
+		return nil, domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", domain.ErrNotFound)
+	}
+	member, err := h.teamRepo.FindMember(ctx, *taxonomy.TeamID, query.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_TAXONOMY_FAILED", "Failed to get team", err)
+	}
+	if member == nil {
+		return nil, domain.NewDomainError("TAXONOMY_NOT_FOUND", "Taxonomy not found", domain.ErrNotFound)
+	}
+	return taxonomy, nil
+}
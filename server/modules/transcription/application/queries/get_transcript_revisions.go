@@ -0,0 +1,76 @@
+package queries
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// GetTranscriptRevisionsQuery represents a query to list the edit history of a transcription
+type GetTranscriptRevisionsQuery struct {
+	TranscriptionID string `json:"transcription_id"`
+}
+
+// GetTranscriptRevisionsResult represents the result of the query
+type GetTranscriptRevisionsResult struct {
+	Revisions []*entities.TranscriptRevision `json:"revisions"`
+	Total     int                            `json:"total"`
+}
+
+// GetTranscriptRevisionsHandler handles the get transcript revisions query
+type GetTranscriptRevisionsHandler struct {
+	revisionRepo repositories.TranscriptRevisionRepository
+}
+
+// NewGetTranscriptRevisionsHandler creates a new get transcript revisions handler
+func NewGetTranscriptRevisionsHandler(
+	revisionRepo repositories.TranscriptRevisionRepository,
+) *GetTranscriptRevisionsHandler {
+	return &GetTranscriptRevisionsHandler{
+		revisionRepo: revisionRepo,
+	}
+}
+
+// Handle executes the get transcript revisions query
+func (h *GetTranscriptRevisionsHandler) Handle(ctx context.Context, query GetTranscriptRevisionsQuery) (*GetTranscriptRevisionsResult, error) {
+	revisions, err := h.revisionRepo.FindByTranscriptionID(ctx, query.TranscriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_REVISIONS_FAILED", "Failed to get transcript revisions", err)
+	}
+
+	return &GetTranscriptRevisionsResult{
+		Revisions: revisions,
+		Total:     len(revisions),
+	}, nil
+}
+
+// GetTranscriptRevisionQuery represents a query to get a single revision of a transcription
+type GetTranscriptRevisionQuery struct {
+	TranscriptionID string `json:"transcription_id"`
+	RevisionNumber  int    `json:"revision_number"`
+}
+
+// GetTranscriptRevisionHandler handles the get transcript revision query
+type GetTranscriptRevisionHandler struct {
+	revisionRepo repositories.TranscriptRevisionRepository
+}
+
+// NewGetTranscriptRevisionHandler creates a new get transcript revision handler
+func NewGetTranscriptRevisionHandler(
+	revisionRepo repositories.TranscriptRevisionRepository,
+) *GetTranscriptRevisionHandler {
+	return &GetTranscriptRevisionHandler{
+		revisionRepo: revisionRepo,
+	}
+}
+
+// Handle executes the get transcript revision query
+func (h *GetTranscriptRevisionHandler) Handle(ctx context.Context, query GetTranscriptRevisionQuery) (*entities.TranscriptRevision, error) {
+	revision, err := h.revisionRepo.FindByNumber(ctx, query.TranscriptionID, query.RevisionNumber)
+	if err != nil {
+		return nil, domain.NewDomainError("REVISION_NOT_FOUND", "Transcript revision not found", err)
+	}
+	return revision, nil
+}
@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// GetVocabulariesQuery represents a query for all vocabularies of a user
+type GetVocabulariesQuery struct {
+	UserID string `json:"user_id"`
+}
+
+// GetVocabulariesHandler handles the get vocabularies query
+type GetVocabulariesHandler struct {
+	vocabularyRepo repositories.VocabularyRepository
+}
+
+// NewGetVocabulariesHandler creates a new get vocabularies handler
+func NewGetVocabulariesHandler(vocabularyRepo repositories.VocabularyRepository) *GetVocabulariesHandler {
+	return &GetVocabulariesHandler{
+		vocabularyRepo: vocabularyRepo,
+	}
+}
+
+// Handle executes the get vocabularies query
+func (h *GetVocabulariesHandler) Handle(ctx context.Context, query GetVocabulariesQuery) ([]*entities.Vocabulary, error) {
+	vocabularies, err := h.vocabularyRepo.FindByUserID(ctx, query.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_VOCABULARIES_FAILED", "Failed to get vocabularies", err)
+	}
+	return vocabularies, nil
+}
+
+// GetVocabularyQuery represents a query for a single vocabulary of a user
+type GetVocabularyQuery struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// GetVocabularyHandler handles the get vocabulary query
+type GetVocabularyHandler struct {
+	vocabularyRepo repositories.VocabularyRepository
+}
+
+// NewGetVocabularyHandler creates a new get vocabulary handler
+func NewGetVocabularyHandler(vocabularyRepo repositories.VocabularyRepository) *GetVocabularyHandler {
+	return &GetVocabularyHandler{
+		vocabularyRepo: vocabularyRepo,
+	}
+}
+
+// Handle executes the get vocabulary query
+func (h *GetVocabularyHandler) Handle(ctx context.Context, query GetVocabularyQuery) (*entities.Vocabulary, error) {
+	vocabulary, err := h.vocabularyRepo.FindByID(ctx, query.ID)
+	if err != nil || vocabulary.UserID != query.UserID {
+		return nil, domain.NewDomainError("VOCABULARY_NOT_FOUND", "Vocabulary not found", err)
+	}
+	return vocabulary, nil
+}
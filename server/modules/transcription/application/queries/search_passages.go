@@ -0,0 +1,170 @@
+package queries
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	defaultPassageCount = 5
+	maxPassageCount     = 50
+
+	// maxPassageCandidates bounds how many full-text matches are loaded and ranked by a lexical embedder
+	maxPassageCandidates = 500
+)
+
+// SearchPassagesQuery represents a retrieval query for the most relevant transcript passages
+type SearchPassagesQuery struct {
+	UserID    string `json:"user_id"`
+	Query     string `json:"query"`
+	MeetingID string `json:"meeting_id,omitempty"`
+	TopK      int    `json:"top_k,omitempty"`
+}
+
+// PassageResult is a ranked passage with references back to the meeting and the time in the recording
+type PassageResult struct {
+	ChunkID         string   `json:"chunk_id"`
+	TranscriptionID string   `json:"transcription_id"`
+	MeetingID       string   `json:"meeting_id"`
+	Text            string   `json:"text"`
+	Speakers        []string `json:"speakers"`
+	SegmentIDs      []string `json:"segment_ids"`
+	StartTime       float64  `json:"start_time"`
+	EndTime         float64  `json:"end_time"`
+	Score           float64  `json:"score"`
+	DeepLink        string   `json:"deep_link"`
+}
+
+// SearchPassagesResult represents the result of the passage query
+type SearchPassagesResult struct {
+	Passages []PassageResult `json:"passages"`
+	Embedder string          `json:"embedder"`
+}
+
+// SearchPassagesHandler handles the search passages query
+type SearchPassagesHandler struct {
+	chunkRepo repositories.TranscriptChunkRepository
+	embedder  services.Embedder
+}
+
+// NewSearchPassagesHandler creates a new search passages handler
+func NewSearchPassagesHandler(
+	chunkRepo repositories.TranscriptChunkRepository,
+	embedder services.Embedder,
+) *SearchPassagesHandler {
+	return &SearchPassagesHandler{
+		chunkRepo: chunkRepo,
+		embedder:  embedder,
+	}
+}
+
+// Handle executes the search passages query
+func (h *SearchPassagesHandler) Handle(ctx context.Context, query SearchPassagesQuery) (*SearchPassagesResult, error) {
+	query.Query = strings.TrimSpace(query.Query)
+	if query.Query == "" {
+		return nil, domain.NewDomainError("INVALID_SEARCH_QUERY", "Search query is required", domain.ErrInvalidInput)
+	}
+
+	return h.rank(ctx, query.Query, repositories.ChunkSearchCriteria{
+		UserID:    query.UserID,
+		MeetingID: query.MeetingID,
+	}, query.TopK, "")
+}
+
+// rank loads the passages matching the criteria and returns the topK passages the embedder scores
+// highest against the text, leaving out the passage with excludeChunkID. Lexical embedders only see
+// the best full-text matches for the text, scored against statistics of all matching passages.
+func (h *SearchPassagesHandler) rank(ctx context.Context, text string, criteria repositories.ChunkSearchCriteria, topK int, excludeChunkID string) (*SearchPassagesResult, error) {
+	if topK <= 0 {
+		topK = defaultPassageCount
+	}
+	if topK > maxPassageCount {
+		topK = maxPassageCount
+	}
+
+	queryEmbedding, err := h.embedder.EmbedQuery(ctx, text)
+	if err != nil {
+		return nil, domain.NewDomainError("SEARCH_FAILED", "Failed to embed search query", err)
+	}
+
+	criteria.Embedder = h.embedder.Name()
+	lexical, isLexical := h.embedder.(services.LexicalEmbedder)
+	if isLexical {
+		criteria.Query = text
+		criteria.Limit = maxPassageCandidates
+	}
+	chunks, err := h.chunkRepo.FindAccessibleChunks(ctx, criteria)
+	if err != nil {
+		return nil, domain.NewDomainError("SEARCH_FAILED", "Failed to load indexed passages", err)
+	}
+
+	embeddings := make([]entities.Embedding, len(chunks))
+	for i, chunk := range chunks {
+		embeddings[i] = chunk.Embedding
+	}
+
+	var scores []float64
+	if isLexical {
+		terms := make([]string, 0, len(queryEmbedding.Terms))
+		for term := range queryEmbedding.Terms {
+			terms = append(terms, term)
+		}
+		sort.Strings(terms)
+		corpus, err := h.chunkRepo.FindCorpusStats(ctx, criteria, terms)
+		if err != nil {
+			return nil, domain.NewDomainError("SEARCH_FAILED", "Failed to load passage statistics", err)
+		}
+		scores = lexical.ScoreInCorpus(queryEmbedding, embeddings, *corpus)
+	} else {
+		scores = h.embedder.Score(queryEmbedding, embeddings)
+	}
+
+	ranked := make([]int, 0, len(chunks))
+	for i, chunk := range chunks {
+		if scores[i] > 0 && chunk.GetID() != excludeChunkID {
+			ranked = append(ranked, i)
+		}
+	}
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return scores[ranked[a]] > scores[ranked[b]]
+	})
+	if len(ranked) > topK {
+		ranked = ranked[:topK]
+	}
+
+	passages := make([]PassageResult, len(ranked))
+	for i, index := range ranked {
+		passages[i] = toPassageResult(chunks[index], scores[index])
+	}
+
+	return &SearchPassagesResult{
+		Passages: passages,
+		Embedder: h.embedder.Name(),
+	}, nil
+}
+
+// toPassageResult references a passage back to its meeting and the time in the recording
+func toPassageResult(chunk *entities.TranscriptChunk, score float64) PassageResult {
+	firstSegmentID := ""
+	if len(chunk.SegmentIDs) > 0 {
+		firstSegmentID = chunk.SegmentIDs[0]
+	}
+	return PassageResult{
+		ChunkID:         chunk.GetID(),
+		TranscriptionID: chunk.TranscriptionID,
+		MeetingID:       chunk.MeetingID,
+		Text:            chunk.Text,
+		Speakers:        chunk.Speakers,
+		SegmentIDs:      chunk.SegmentIDs,
+		StartTime:       chunk.StartTime,
+		EndTime:         chunk.EndTime,
+		Score:           score,
+		DeepLink:        SegmentDeepLink(chunk.MeetingID, chunk.TranscriptionID, firstSegmentID, chunk.StartTime),
+	}
+}
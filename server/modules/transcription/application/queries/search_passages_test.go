@@ -0,0 +1,204 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/modules/transcription/infrastructure/embedders"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTranscriptChunkRepository struct {
+	mock.Mock
+	repositories.TranscriptChunkRepository
+}
+
+func (m *MockTranscriptChunkRepository) FindAccessibleChunks(ctx context.Context, criteria repositories.ChunkSearchCriteria) ([]*entities.TranscriptChunk, error) {
+	args := m.Called(ctx, criteria)
+	return args.Get(0).([]*entities.TranscriptChunk), args.Error(1)
+}
+
+func (m *MockTranscriptChunkRepository) FindAccessibleChunk(ctx context.Context, criteria repositories.ChunkLookupCriteria) (*entities.TranscriptChunk, error) {
+	args := m.Called(ctx, criteria)
+	chunk, _ := args.Get(0).(*entities.TranscriptChunk)
+	return chunk, args.Error(1)
+}
+
+func (m *MockTranscriptChunkRepository) FindCorpusStats(ctx context.Context, criteria repositories.ChunkSearchCriteria, terms []string) (*entities.CorpusStats, error) {
+	args := m.Called(ctx, criteria, terms)
+	stats, _ := args.Get(0).(*entities.CorpusStats)
+	return stats, args.Error(1)
+}
+
+// stubVectorEmbedder embeds texts as fixed vectors and scores them by cosine similarity
+type stubVectorEmbedder struct {
+	vectors map[string][]float64
+}
+
+func (e *stubVectorEmbedder) Name() string {
+	return "stub-vector"
+}
+
+func (e *stubVectorEmbedder) EmbedDocuments(ctx context.Context, texts []string) ([]entities.Embedding, error) {
+	embeddings := make([]entities.Embedding, len(texts))
+	for i, text := range texts {
+		embeddings[i] = entities.Embedding{Vector: e.vectors[text]}
+	}
+	return embeddings, nil
+}
+
+func (e *stubVectorEmbedder) EmbedQuery(ctx context.Context, text string) (entities.Embedding, error) {
+	return entities.Embedding{Vector: e.vectors[text]}, nil
+}
+
+func (e *stubVectorEmbedder) Score(query entities.Embedding, documents []entities.Embedding) []float64 {
+	scores := make([]float64, len(documents))
+	for i, document := range documents {
+		scores[i] = services.CosineSimilarity(query.Vector, document.Vector)
+	}
+	return scores
+}
+
+func newIndexedChunk(t *testing.T, meetingID string, chunkIndex int, text string) *entities.TranscriptChunk {
+	t.Helper()
+	embedder := embedders.NewBM25Embedder()
+	segment := entities.NewTranscriptSegment("tr-"+meetingID, "", text, float64(chunkIndex*10), float64(chunkIndex*10+5), 0.9, chunkIndex)
+	chunk := entities.NewTranscriptChunk("tr-"+meetingID, meetingID, chunkIndex, []entities.TranscriptSegment{segment})
+	embeddings, err := embedder.EmbedDocuments(context.Background(), []string{chunk.Text})
+	require.NoError(t, err)
+	chunk.SetEmbedding(embedder.Name(), embeddings[0])
+	return &chunk
+}
+
+func TestSearchPassagesHandler_PrefiltersCandidates(t *testing.T) {
+	repo := new(MockTranscriptChunkRepository)
+	handler := NewSearchPassagesHandler(repo, embedders.NewBM25Embedder())
+
+	budget := newIndexedChunk(t, "meeting-1", 0, "The marketing budget for next quarter")
+	hiring := newIndexedChunk(t, "meeting-1", 1, "Hiring plans and the budget for engineering hiring")
+	repo.On("FindAccessibleChunks", mock.Anything, mock.MatchedBy(func(c repositories.ChunkSearchCriteria) bool {
+		return c.UserID == "user-1" && c.Query == "hiring budget" && c.Limit == maxPassageCandidates && c.Embedder == "bm25-local"
+	})).Return([]*entities.TranscriptChunk{budget, hiring}, nil)
+	repo.On("FindCorpusStats", mock.Anything, mock.Anything, []string{"budget", "hir"}).
+		Return(&entities.CorpusStats{Documents: 1000, AverageLength: 6, DocumentFrequency: map[string]int{"budget": 900, "hir": 3}}, nil)
+
+	result, err := handler.Handle(context.Background(), SearchPassagesQuery{UserID: "user-1", Query: " hiring budget ", TopK: 500})
+
+	require.NoError(t, err)
+	require.Len(t, result.Passages, 2)
+	assert.Equal(t, hiring.GetID(), result.Passages[0].ChunkID)
+	assert.Equal(t, "/meetings/meeting-1/transcriptions/tr-meeting-1?segment="+hiring.SegmentIDs[0]+"&t=10.000", result.Passages[0].DeepLink)
+	repo.AssertExpectations(t)
+}
+
+func TestSearchPassagesHandler_CorpusWeighsTerms(t *testing.T) {
+	repo := new(MockTranscriptChunkRepository)
+	handler := NewSearchPassagesHandler(repo, embedders.NewBM25Embedder())
+
+	// Ranked against the candidates alone the budget passage would come first, but across the
+	// corpus "roadmap" is rare and "budget" is in most passages
+	budget := newIndexedChunk(t, "meeting-1", 0, "Budget budget budget budget")
+	roadmap := newIndexedChunk(t, "meeting-1", 1, "Roadmap")
+	candidateScores := embedders.NewBM25Embedder().Score(entities.Embedding{Terms: map[string]float64{"budget": 1, "roadmap": 1}},
+		[]entities.Embedding{budget.Embedding, roadmap.Embedding})
+	require.Greater(t, candidateScores[0], candidateScores[1])
+
+	repo.On("FindAccessibleChunks", mock.Anything, mock.Anything).Return([]*entities.TranscriptChunk{budget, roadmap}, nil)
+	repo.On("FindCorpusStats", mock.Anything, mock.MatchedBy(func(c repositories.ChunkSearchCriteria) bool {
+		return c.UserID == "user-1" && c.MeetingID == "meeting-1"
+	}), []string{"budget", "roadmap"}).
+		Return(&entities.CorpusStats{Documents: 1000, AverageLength: 2.5, DocumentFrequency: map[string]int{"budget": 800, "roadmap": 2}}, nil)
+
+	result, err := handler.Handle(context.Background(), SearchPassagesQuery{UserID: "user-1", MeetingID: "meeting-1", Query: "budget roadmap"})
+
+	require.NoError(t, err)
+	require.Len(t, result.Passages, 2)
+	assert.Equal(t, roadmap.GetID(), result.Passages[0].ChunkID)
+	repo.AssertExpectations(t)
+}
+
+func TestSearchPassagesHandler_VectorEmbedderSkipsPrefilter(t *testing.T) {
+	repo := new(MockTranscriptChunkRepository)
+	embedder := &stubVectorEmbedder{vectors: map[string][]float64{
+		"spending plans":                {1, 0},
+		"The marketing budget":          {0.9, 0.1},
+		"Lunch options near the office": {0, 1},
+	}}
+	handler := NewSearchPassagesHandler(repo, embedder)
+
+	budget := entities.NewTranscriptChunk("tr-1", "meeting-1", 0, []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "", "The marketing budget", 0, 5, 0.9, 0),
+	})
+	budget.SetEmbedding(embedder.Name(), entities.Embedding{Vector: embedder.vectors["The marketing budget"]})
+	lunch := entities.NewTranscriptChunk("tr-1", "meeting-1", 1, []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "", "Lunch options near the office", 5, 10, 0.9, 1),
+	})
+	lunch.SetEmbedding(embedder.Name(), entities.Embedding{Vector: embedder.vectors["Lunch options near the office"]})
+
+	// A passage about spending shares no words with the query, so it must not be filtered out by full-text search
+	repo.On("FindAccessibleChunks", mock.Anything, repositories.ChunkSearchCriteria{UserID: "user-1", Embedder: "stub-vector"}).
+		Return([]*entities.TranscriptChunk{&budget, &lunch}, nil)
+
+	result, err := handler.Handle(context.Background(), SearchPassagesQuery{UserID: "user-1", Query: "spending plans", TopK: 1})
+
+	require.NoError(t, err)
+	require.Len(t, result.Passages, 1)
+	assert.Equal(t, budget.GetID(), result.Passages[0].ChunkID)
+	repo.AssertNotCalled(t, "FindCorpusStats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSimilarPassagesHandler_Handle(t *testing.T) {
+	repo := new(MockTranscriptChunkRepository)
+	handler := NewSimilarPassagesHandler(repo, embedders.NewBM25Embedder())
+
+	source := newIndexedChunk(t, "meeting-1", 0, "We agreed to move the database migration to next sprint")
+	similar := newIndexedChunk(t, "meeting-2", 0, "The database migration slipped a sprint again")
+	unrelated := newIndexedChunk(t, "meeting-2", 1, "Lunch options near the office")
+	repo.On("FindAccessibleChunk", mock.Anything, repositories.ChunkLookupCriteria{
+		UserID:    "user-1",
+		Embedder:  "bm25-local",
+		SegmentID: source.SegmentIDs[0],
+	}).Return(source, nil)
+	repo.On("FindAccessibleChunks", mock.Anything, mock.MatchedBy(func(c repositories.ChunkSearchCriteria) bool {
+		return c.UserID == "user-1" && c.Query == source.Text && c.Limit == maxPassageCandidates
+	})).Return([]*entities.TranscriptChunk{source, similar, unrelated}, nil)
+	repo.On("FindCorpusStats", mock.Anything, mock.Anything, mock.Anything).
+		Return(&entities.CorpusStats{Documents: 3, AverageLength: 5, DocumentFrequency: map[string]int{"databas": 2, "migration": 2, "sprint": 2}}, nil)
+
+	result, err := handler.Handle(context.Background(), SimilarPassagesQuery{UserID: "user-1", SegmentID: source.SegmentIDs[0]})
+
+	require.NoError(t, err)
+	assert.Equal(t, source.GetID(), result.Source.ChunkID)
+	require.Len(t, result.Passages, 1)
+	assert.Equal(t, similar.GetID(), result.Passages[0].ChunkID)
+	repo.AssertExpectations(t)
+}
+
+func TestSimilarPassagesHandler_RequiresOneReference(t *testing.T) {
+	repo := new(MockTranscriptChunkRepository)
+	handler := NewSimilarPassagesHandler(repo, embedders.NewBM25Embedder())
+
+	_, err := handler.Handle(context.Background(), SimilarPassagesQuery{UserID: "user-1"})
+	assert.Error(t, err)
+	_, err = handler.Handle(context.Background(), SimilarPassagesQuery{UserID: "user-1", ChunkID: "chunk-1", SegmentID: "seg-1"})
+	assert.Error(t, err)
+	repo.AssertNotCalled(t, "FindAccessibleChunk", mock.Anything, mock.Anything)
+}
+
+func TestSimilarPassagesHandler_UnknownPassage(t *testing.T) {
+	repo := new(MockTranscriptChunkRepository)
+	handler := NewSimilarPassagesHandler(repo, embedders.NewBM25Embedder())
+	repo.On("FindAccessibleChunk", mock.Anything, mock.Anything).Return(nil, nil)
+
+	_, err := handler.Handle(context.Background(), SimilarPassagesQuery{UserID: "user-1", ChunkID: "someone-elses"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Passage not found")
+	repo.AssertNotCalled(t, "FindAccessibleChunks", mock.Anything, mock.Anything)
+}
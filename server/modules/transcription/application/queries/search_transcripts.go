@@ -0,0 +1,118 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// SearchTranscriptsQuery represents a full-text search over the user's transcripts
+type SearchTranscriptsQuery struct {
+	UserID      string     `json:"user_id"`
+	Query       string     `json:"query"`
+	MeetingID   string     `json:"meeting_id,omitempty"`
+	Speaker     string     `json:"speaker,omitempty"`
+	MeetingType string     `json:"meeting_type,omitempty"`
+	From        *time.Time `json:"from,omitempty"`
+	To          *time.Time `json:"to,omitempty"`
+	Limit       int        `json:"limit,omitempty"`
+	Offset      int        `json:"offset,omitempty"`
+}
+
+// TranscriptSearchResult is a ranked hit with a link that opens the transcript at the matching segment
+type TranscriptSearchResult struct {
+	repositories.TranscriptSearchHit
+	DeepLink string `json:"deep_link"`
+}
+
+// SearchTranscriptsResult represents the result of the search query
+type SearchTranscriptsResult struct {
+	Results []TranscriptSearchResult `json:"results"`
+	Total   int64                    `json:"total"`
+	Limit   int                      `json:"limit"`
+	Offset  int                      `json:"offset"`
+}
+
+// SearchTranscriptsHandler handles the search transcripts query
+type SearchTranscriptsHandler struct {
+	searchRepo repositories.TranscriptSearchRepository
+}
+
+// NewSearchTranscriptsHandler creates a new search transcripts handler
+func NewSearchTranscriptsHandler(
+	searchRepo repositories.TranscriptSearchRepository,
+) *SearchTranscriptsHandler {
+	return &SearchTranscriptsHandler{
+		searchRepo: searchRepo,
+	}
+}
+
+// Handle executes the search transcripts query
+func (h *SearchTranscriptsHandler) Handle(ctx context.Context, query SearchTranscriptsQuery) (*SearchTranscriptsResult, error) {
+	query.Query = strings.TrimSpace(query.Query)
+	if query.Query == "" {
+		return nil, domain.NewDomainError("INVALID_SEARCH_QUERY", "Search query is required", domain.ErrInvalidInput)
+	}
+	if query.From != nil && query.To != nil && query.To.Before(*query.From) {
+		return nil, domain.NewDomainError("INVALID_SEARCH_QUERY", "Search date range is invalid", domain.ErrInvalidInput)
+	}
+
+	if query.Limit <= 0 {
+		query.Limit = defaultSearchLimit
+	}
+	if query.Limit > maxSearchLimit {
+		query.Limit = maxSearchLimit
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+
+	hits, total, err := h.searchRepo.SearchSegments(ctx, repositories.TranscriptSearchCriteria{
+		UserID:      query.UserID,
+		Query:       query.Query,
+		MeetingID:   query.MeetingID,
+		Speaker:     query.Speaker,
+		MeetingType: query.MeetingType,
+		From:        query.From,
+		To:          query.To,
+		Limit:       query.Limit,
+		Offset:      query.Offset,
+	})
+	if err != nil {
+		return nil, domain.NewDomainError("SEARCH_FAILED", "Failed to search transcripts", err)
+	}
+
+	results := make([]TranscriptSearchResult, len(hits))
+	for i, hit := range hits {
+		results[i] = TranscriptSearchResult{
+			TranscriptSearchHit: hit,
+			DeepLink:            SegmentDeepLink(hit.MeetingID, hit.TranscriptionID, hit.SegmentID, hit.StartTime),
+		}
+	}
+
+	return &SearchTranscriptsResult{
+		Results: results,
+		Total:   total,
+		Limit:   query.Limit,
+		Offset:  query.Offset,
+	}, nil
+}
+
+// SegmentDeepLink builds the app link that opens a transcript at a segment, seeking playback to its start
+func SegmentDeepLink(meetingID, transcriptionID, segmentID string, startTime float64) string {
+	params := url.Values{}
+	params.Set("segment", segmentID)
+	params.Set("t", fmt.Sprintf("%.3f", startTime))
+	return fmt.Sprintf("/meetings/%s/transcriptions/%s?%s",
+		url.PathEscape(meetingID), url.PathEscape(transcriptionID), params.Encode())
+}
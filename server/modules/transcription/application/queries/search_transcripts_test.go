@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"teammate/server/modules/transcription/domain/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockTranscriptSearchRepository struct {
+	mock.Mock
+}
+
+func (m *MockTranscriptSearchRepository) SearchSegments(ctx context.Context, criteria repositories.TranscriptSearchCriteria) ([]repositories.TranscriptSearchHit, int64, error) {
+	args := m.Called(ctx, criteria)
+	return args.Get(0).([]repositories.TranscriptSearchHit), args.Get(1).(int64), args.Error(2)
+}
+
+func TestSearchTranscriptsHandler_Handle(t *testing.T) {
+	repo := new(MockTranscriptSearchRepository)
+	handler := NewSearchTranscriptsHandler(repo)
+
+	hit := repositories.TranscriptSearchHit{
+		SegmentID:       "seg-1",
+		TranscriptionID: "tr-1",
+		MeetingID:       "meeting-1",
+		Snippet:         "the <mark>roadmap</mark> review",
+		StartTime:       12.5,
+	}
+	repo.On("SearchSegments", mock.Anything, mock.MatchedBy(func(c repositories.TranscriptSearchCriteria) bool {
+		return c.UserID == "user-1" && c.Query == "roadmap" && c.Limit == maxSearchLimit && c.Offset == 0
+	})).Return([]repositories.TranscriptSearchHit{hit}, int64(1), nil)
+
+	result, err := handler.Handle(context.Background(), SearchTranscriptsQuery{
+		UserID: "user-1",
+		Query:  "  roadmap ",
+		Limit:  500,
+		Offset: -3,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, "/meetings/meeting-1/transcriptions/tr-1?segment=seg-1&t=12.500", result.Results[0].DeepLink)
+	repo.AssertExpectations(t)
+}
+
+func TestSearchTranscriptsHandler_RequiresQuery(t *testing.T) {
+	repo := new(MockTranscriptSearchRepository)
+	handler := NewSearchTranscriptsHandler(repo)
+
+	_, err := handler.Handle(context.Background(), SearchTranscriptsQuery{UserID: "user-1", Query: "   "})
+
+	assert.Error(t, err)
+	repo.AssertNotCalled(t, "SearchSegments", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,79 @@
+package queries
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+// SimilarPassagesQuery asks for passages that discuss the same things as an indexed passage,
+// identified by its chunk ID or by a segment it contains
+type SimilarPassagesQuery struct {
+	UserID    string `json:"user_id"`
+	ChunkID   string `json:"chunk_id,omitempty"`
+	SegmentID string `json:"segment_id,omitempty"`
+	MeetingID string `json:"meeting_id,omitempty"`
+	TopK      int    `json:"top_k,omitempty"`
+}
+
+// SimilarPassagesResult represents the result of the similar passages query
+type SimilarPassagesResult struct {
+	Source *PassageResult `json:"source"`
+	SearchPassagesResult
+}
+
+// SimilarPassagesHandler handles the similar passages query
+type SimilarPassagesHandler struct {
+	chunkRepo repositories.TranscriptChunkRepository
+	embedder  services.Embedder
+	search    *SearchPassagesHandler
+}
+
+// NewSimilarPassagesHandler creates a new similar passages handler
+func NewSimilarPassagesHandler(
+	chunkRepo repositories.TranscriptChunkRepository,
+	embedder services.Embedder,
+) *SimilarPassagesHandler {
+	return &SimilarPassagesHandler{
+		chunkRepo: chunkRepo,
+		embedder:  embedder,
+		search:    NewSearchPassagesHandler(chunkRepo, embedder),
+	}
+}
+
+// Handle executes the similar passages query. The source passage is ranked against the others
+// as if its text were the search query, and is itself left out of the results.
+func (h *SimilarPassagesHandler) Handle(ctx context.Context, query SimilarPassagesQuery) (*SimilarPassagesResult, error) {
+	if (query.ChunkID == "") == (query.SegmentID == "") {
+		return nil, domain.NewDomainError("INVALID_PASSAGE_REFERENCE", "Either a chunk ID or a segment ID is required", domain.ErrInvalidInput)
+	}
+
+	source, err := h.chunkRepo.FindAccessibleChunk(ctx, repositories.ChunkLookupCriteria{
+		UserID:    query.UserID,
+		Embedder:  h.embedder.Name(),
+		ChunkID:   query.ChunkID,
+		SegmentID: query.SegmentID,
+	})
+	if err != nil {
+		return nil, domain.NewDomainError("SEARCH_FAILED", "Failed to load the passage", err)
+	}
+	if source == nil {
+		return nil, domain.NewDomainError("PASSAGE_NOT_FOUND", "Passage not found", domain.ErrNotFound)
+	}
+
+	result, err := h.search.rank(ctx, source.Text, repositories.ChunkSearchCriteria{
+		UserID:    query.UserID,
+		MeetingID: query.MeetingID,
+	}, query.TopK, source.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	sourcePassage := toPassageResult(source, 0)
+	return &SimilarPassagesResult{
+		Source:               &sourcePassage,
+		SearchPassagesResult: *result,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"sync"
+	"time"
+)
+
+// analyticsCacheSize bounds how many transcriptions' analytics are kept in memory
+const analyticsCacheSize = 256
+
+// analyticsCacheEntry is the analytics of a transcription as of one of its versions
+type analyticsCacheEntry struct {
+	analytics *AnalyticsData
+	version   string
+	usedAt    time.Time
+}
+
+// analyticsCache keeps computed analytics until the transcription's segments or taxonomy change.
+// Entries are tied to a version built from the transcription's UpdatedAt and the taxonomy used,
+// and invalidating a transcription bumps
+// its generation so analytics computed from segments read before the change are not stored.
+type analyticsCache struct {
+	mu          sync.Mutex
+	entries     map[string]*analyticsCacheEntry
+	generations map[string]uint64
+	size        int
+}
+
+// newAnalyticsCache creates a cache holding the analytics of up to size transcriptions
+func newAnalyticsCache(size int) *analyticsCache {
+	return &analyticsCache{
+		entries:     make(map[string]*analyticsCacheEntry),
+		generations: make(map[string]uint64),
+		size:        size,
+	}
+}
+
+// get returns the cached analytics of a transcription version, or nil
+func (c *analyticsCache) get(transcriptionID, version string) *AnalyticsData {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[transcriptionID]
+	if !ok || entry.version != version {
+		return nil
+	}
+	entry.usedAt = time.Now()
+	return entry.analytics
+}
+
+// generation returns the current generation of a transcription, to be passed to put
+func (c *analyticsCache) generation(transcriptionID string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generations[transcriptionID]
+}
+
+// put stores analytics unless the transcription was invalidated since generation was read,
+// evicting the least recently used entry when the cache is full
+func (c *analyticsCache) put(transcriptionID string, generation uint64, version string, analytics *AnalyticsData) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[transcriptionID] != generation {
+		return
+	}
+	if _, ok := c.entries[transcriptionID]; !ok && len(c.entries) >= c.size {
+		c.evictOldest()
+	}
+	c.entries[transcriptionID] = &analyticsCacheEntry{analytics: analytics, version: version, usedAt: time.Now()}
+}
+
+// invalidate drops the analytics of a transcription
+func (c *analyticsCache) invalidate(transcriptionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, transcriptionID)
+	c.generations[transcriptionID]++
+}
+
+// evictOldest removes the least recently used entry. Must be called with mu held.
+func (c *analyticsCache) evictOldest() {
+	var oldestID string
+	var oldest time.Time
+	for id, entry := range c.entries {
+		if oldestID == "" || entry.usedAt.Before(oldest) {
+			oldestID, oldest = id, entry.usedAt
+		}
+	}
+	delete(c.entries, oldestID)
+}
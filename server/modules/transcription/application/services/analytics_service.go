@@ -9,14 +9,20 @@ import (
 	"time"
 
 	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/application/commands"
 	"teammate/server/modules/transcription/domain/entities"
 	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+	"teammate/server/seedwork/infrastructure/events"
 )
 
 // AnalyticsService provides advanced analytics and insights for transcriptions
 type AnalyticsService struct {
 	transcriptionRepo repositories.TranscriptionRepository
 	meetingRepo       meetingRepos.MeetingRepository
+	taxonomyRepo      repositories.TaxonomyRepository
+	cache             *analyticsCache
 }
 
 // NewAnalyticsService creates a new analytics service
@@ -27,22 +33,32 @@ func NewAnalyticsService(
 	return &AnalyticsService{
 		transcriptionRepo: transcriptionRepo,
 		meetingRepo:       meetingRepo,
+		cache:             newAnalyticsCache(analyticsCacheSize),
 	}
 }
 
+// WithTaxonomies analyzes topics and sentiment with the meeting owner's active taxonomy
+// instead of the built-in one
+func (s *AnalyticsService) WithTaxonomies(taxonomyRepo repositories.TaxonomyRepository) *AnalyticsService {
+	s.taxonomyRepo = taxonomyRepo
+	return s
+}
+
 // AnalyticsData represents comprehensive analytics for a transcription
 type AnalyticsData struct {
-	TranscriptionID   string             `json:"transcription_id"`
-	MeetingID         string             `json:"meeting_id"`
-	SpeakerAnalytics  []SpeakerAnalytics `json:"speaker_analytics"`
-	TopicAnalysis     []TopicAnalysis    `json:"topic_analysis"`
-	SentimentAnalysis *SentimentAnalysis `json:"sentiment_analysis"`
-	MeetingMetrics    *MeetingMetrics    `json:"meeting_metrics"`
-	KeywordFrequency  []KeywordFrequency `json:"keyword_frequency"`
-	TimeDistribution  *TimeDistribution  `json:"time_distribution"`
-	QualityMetrics    *QualityMetrics    `json:"quality_metrics"`
-	Insights          []Insight          `json:"insights"`
-	GeneratedAt       time.Time          `json:"generated_at"`
+	TranscriptionID   string                `json:"transcription_id"`
+	MeetingID         string                `json:"meeting_id"`
+	SpeakerAnalytics  []SpeakerAnalytics    `json:"speaker_analytics"`
+	TopicAnalysis     []TopicAnalysis       `json:"topic_analysis"`
+	SentimentAnalysis *SentimentAnalysis    `json:"sentiment_analysis"`
+	MeetingMetrics    *MeetingMetrics       `json:"meeting_metrics"`
+	KeywordFrequency  []KeywordFrequency    `json:"keyword_frequency"`
+	TimeDistribution  *TimeDistribution     `json:"time_distribution"`
+	QualityMetrics    *QualityMetrics       `json:"quality_metrics"`
+	Interruptions     *InterruptionAnalysis `json:"interruptions"`
+	TurnTaking        *TurnTakingAnalysis   `json:"turn_taking"`
+	Insights          []Insight             `json:"insights"`
+	GeneratedAt       time.Time             `json:"generated_at"`
 }
 
 // SpeakerAnalytics provides detailed analysis for each speaker
@@ -177,21 +193,30 @@ type EmotionalHighlight struct {
 	Context   string  `json:"context"`
 }
 
-// GetTranscriptionAnalytics generates comprehensive analytics for a transcription
+// GetTranscriptionAnalytics generates comprehensive analytics for a transcription. Analytics are
+// cached until the transcription's segments change; the result is shared and must not be modified.
 func (s *AnalyticsService) GetTranscriptionAnalytics(ctx context.Context, transcriptionID string) (*AnalyticsData, error) {
-	// Get transcription and segments
 	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find transcription: %w", err)
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	taxonomy, err := s.resolveTaxonomy(ctx, transcription.MeetingID)
+	if err != nil {
+		return nil, err
 	}
+	version := transcription.UpdatedAt.UTC().Format(time.RFC3339Nano) + "|" + taxonomy.ID + "@" + taxonomy.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	if analytics := s.cache.get(transcriptionID, version); analytics != nil {
+		return analytics, nil
+	}
+	generation := s.cache.generation(transcriptionID)
 
 	segments, err := s.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, transcriptionID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find segments: %w", err)
+		return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
 	}
 
 	if len(segments) == 0 {
-		return nil, fmt.Errorf("no segments found for transcription %s", transcriptionID)
+		return nil, domain.NewDomainError("NO_TRANSCRIPT_SEGMENTS", fmt.Sprintf("No segments found for transcription %s", transcriptionID), domain.ErrNotFound)
 	}
 
 	analytics := &AnalyticsData{
@@ -202,17 +227,62 @@ func (s *AnalyticsService) GetTranscriptionAnalytics(ctx context.Context, transc
 
 	// Generate all analytics components
 	analytics.SpeakerAnalytics = s.analyzeSpeakers(segments)
-	analytics.TopicAnalysis = s.analyzeTopics(segments)
-	analytics.SentimentAnalysis = s.analyzeSentiment(segments)
+	matcher := services.NewTaxonomyMatcher(taxonomy)
+	analytics.TopicAnalysis = s.analyzeTopics(segments, matcher)
+	analytics.SentimentAnalysis = s.analyzeSentiment(segments, matcher)
 	analytics.MeetingMetrics = s.calculateMeetingMetrics(segments)
+	analytics.Interruptions, analytics.TurnTaking = s.analyzeTurnTaking(segments)
+	analytics.MeetingMetrics.InterruptionCount = analytics.Interruptions.TotalInterruptions
+	analytics.MeetingMetrics.LongestMonologue = analytics.TurnTaking.LongestTurn
 	analytics.KeywordFrequency = s.analyzeKeywords(segments)
 	analytics.TimeDistribution = s.analyzeTimeDistribution(segments)
 	analytics.QualityMetrics = s.assessQuality(segments)
 	analytics.Insights = s.generateInsights(analytics)
 
+	s.cache.put(transcriptionID, generation, version, analytics)
 	return analytics, nil
 }
 
+// resolveTaxonomy returns the taxonomy the meeting's owner uses, personal or of their team, or the built-in one
+func (s *AnalyticsService) resolveTaxonomy(ctx context.Context, meetingID string) (*entities.Taxonomy, error) {
+	if s.taxonomyRepo != nil {
+		meeting, err := s.meetingRepo.FindMeetingByID(ctx, meetingID)
+		if err != nil {
+			return nil, domain.NewDomainError("MEETING_NOT_FOUND", "Meeting not found", err)
+		}
+		taxonomy, err := s.taxonomyRepo.FindActiveForUser(ctx, meeting.UserID)
+		if err != nil {
+			return nil, domain.NewDomainError("GET_TAXONOMY_FAILED", "Failed to get analytics taxonomy", err)
+		}
+		if taxonomy != nil {
+			return taxonomy, nil
+		}
+	}
+
+	taxonomy := services.DefaultTaxonomy()
+	return &taxonomy, nil
+}
+
+// InvalidateTranscription drops the cached analytics of a transcription
+func (s *AnalyticsService) InvalidateTranscription(transcriptionID string) {
+	s.cache.invalidate(transcriptionID)
+}
+
+// SubscribeToEvents drops cached analytics when transcriptions complete or their segments are edited
+func (s *AnalyticsService) SubscribeToEvents(eventBus events.EventBus) {
+	eventBus.Subscribe("transcription.completed", func(event interface{}) {
+		if completedEvent, ok := event.(*commands.TranscriptionCompletedEvent); ok {
+			s.InvalidateTranscription(completedEvent.TranscriptionID)
+		}
+	})
+
+	eventBus.Subscribe("transcription.segments_updated", func(event interface{}) {
+		if updatedEvent, ok := event.(*commands.TranscriptSegmentsUpdatedEvent); ok {
+			s.InvalidateTranscription(updatedEvent.TranscriptionID)
+		}
+	})
+}
+
 // analyzeSpeakers provides detailed speaker analytics
 func (s *AnalyticsService) analyzeSpeakers(segments []entities.TranscriptSegment) []SpeakerAnalytics {
 	speakerData := make(map[string]*SpeakerAnalytics)
@@ -294,60 +364,40 @@ func (s *AnalyticsService) analyzeSpeakers(segments []entities.TranscriptSegment
 	return result
 }
 
-// analyzeTopics identifies key topics (simplified implementation)
-func (s *AnalyticsService) analyzeTopics(segments []entities.TranscriptSegment) []TopicAnalysis {
-	// This is a simplified topic analysis
-	// In a real implementation, you'd use NLP libraries or services
-
-	topicKeywords := map[string][]string{
-		"Meeting Management":   {"meeting", "agenda", "schedule", "plan", "organize"},
-		"Technical Discussion": {"technical", "development", "code", "system", "architecture", "bug", "feature"},
-		"Business Strategy":    {"business", "strategy", "market", "customer", "revenue", "growth"},
-		"Project Planning":     {"project", "timeline", "deadline", "milestone", "deliverable", "task"},
-		"Team Coordination":    {"team", "collaboration", "communication", "assign", "responsibility"},
-		"Decision Making":      {"decision", "approve", "choose", "select", "vote", "agree"},
-		"Problem Solving":      {"problem", "issue", "solution", "fix", "resolve", "troubleshoot"},
-		"Review & Feedback":    {"review", "feedback", "comment", "suggestion", "improvement"},
-	}
-
+// analyzeTopics identifies key topics using the taxonomy's weighted terms
+func (s *AnalyticsService) analyzeTopics(segments []entities.TranscriptSegment, matcher *services.TaxonomyMatcher) []TopicAnalysis {
 	topicScores := make(map[string]*TopicAnalysis)
 
-	for topic, keywords := range topicKeywords {
+	topicSpeakers := make(map[string]map[string]bool)
+	for _, topic := range matcher.TopicNames() {
 		topicScores[topic] = &TopicAnalysis{
 			Topic:    topic,
-			Keywords: keywords,
+			Keywords: matcher.TopicTerms(topic),
 			Speakers: []string{},
 		}
+		topicSpeakers[topic] = make(map[string]bool)
 	}
 
 	// Analyze segments for topic relevance
 	for _, segment := range segments {
-		text := strings.ToLower(segment.Text)
-		speakerMap := make(map[string]bool)
-
-		for _, analysis := range topicScores {
-			matches := 0
-			for _, keyword := range analysis.Keywords {
-				if strings.Contains(text, keyword) {
-					matches++
-				}
-			}
+		tokens := matcher.Tokenize(segment.Text)
 
-			if matches > 0 {
-				analysis.Mentions++
-				analysis.Relevance += float64(matches)
+		for _, match := range matcher.MatchTopics(tokens) {
+			analysis := topicScores[match.Topic]
+			speakerMap := topicSpeakers[match.Topic]
+			analysis.Mentions++
+			analysis.Relevance += match.Score
 
-				if analysis.FirstMention == 0 || segment.StartTime < analysis.FirstMention {
-					analysis.FirstMention = segment.StartTime
-				}
-				if segment.EndTime > analysis.LastMention {
-					analysis.LastMention = segment.EndTime
-				}
+			if analysis.FirstMention == 0 || segment.StartTime < analysis.FirstMention {
+				analysis.FirstMention = segment.StartTime
+			}
+			if segment.EndTime > analysis.LastMention {
+				analysis.LastMention = segment.EndTime
+			}
 
-				if !speakerMap[segment.Speaker] && segment.Speaker != "" {
-					analysis.Speakers = append(analysis.Speakers, segment.Speaker)
-					speakerMap[segment.Speaker] = true
-				}
+			if !speakerMap[segment.Speaker] && segment.Speaker != "" {
+				analysis.Speakers = append(analysis.Speakers, segment.Speaker)
+				speakerMap[segment.Speaker] = true
 			}
 		}
 	}
@@ -375,14 +425,8 @@ func (s *AnalyticsService) analyzeTopics(segments []entities.TranscriptSegment)
 	return result
 }
 
-// analyzeSentiment provides sentiment analysis (simplified)
-func (s *AnalyticsService) analyzeSentiment(segments []entities.TranscriptSegment) *SentimentAnalysis {
-	// Simplified sentiment analysis using keyword-based approach
-	// In production, you'd use ML models or services like Google Cloud Natural Language
-
-	positiveWords := []string{"good", "great", "excellent", "positive", "agree", "success", "happy", "wonderful", "perfect", "amazing"}
-	negativeWords := []string{"bad", "terrible", "awful", "negative", "disagree", "failure", "sad", "problem", "issue", "wrong"}
-
+// analyzeSentiment scores segments against the taxonomy's sentiment lexicon
+func (s *AnalyticsService) analyzeSentiment(segments []entities.TranscriptSegment, matcher *services.TaxonomyMatcher) *SentimentAnalysis {
 	sentiment := &SentimentAnalysis{
 		SentimentTimeline:   []SentimentTimePoint{},
 		SpeakerSentiments:   []SpeakerSentiment{},
@@ -393,26 +437,8 @@ func (s *AnalyticsService) analyzeSentiment(segments []entities.TranscriptSegmen
 	speakerSentiments := make(map[string]*SpeakerSentiment)
 
 	for _, segment := range segments {
-		text := strings.ToLower(segment.Text)
-		segmentScore := 0.0
-
-		// Calculate sentiment score for segment
-		for _, word := range positiveWords {
-			if strings.Contains(text, word) {
-				segmentScore += 1.0
-			}
-		}
-		for _, word := range negativeWords {
-			if strings.Contains(text, word) {
-				segmentScore -= 1.0
-			}
-		}
-
-		// Normalize score
-		words := strings.Fields(text)
-		if len(words) > 0 {
-			segmentScore = segmentScore / float64(len(words))
-		}
+		// Weighted lexicon matches, normalized by the segment's word count
+		segmentScore := matcher.SentimentScore(matcher.Tokenize(segment.Text))
 
 		totalScore += segmentScore
 
@@ -546,11 +572,6 @@ func (s *AnalyticsService) calculateMeetingMetrics(segments []entities.Transcrip
 		if segment.EndTime > lastEndTime {
 			lastEndTime = segment.EndTime
 		}
-
-		// Track longest monologue
-		if segment.Speaker != "" && duration > metrics.LongestMonologue {
-			metrics.LongestMonologue = duration
-		}
 	}
 
 	metrics.TotalDuration = lastEndTime
@@ -577,22 +598,6 @@ func (s *AnalyticsService) calculateMeetingMetrics(segments []entities.Transcrip
 		}
 	}
 
-	// Estimate interruptions (simplified - when segments overlap or are very short)
-	for i := 1; i < len(segments); i++ {
-		prevSegment := segments[i-1]
-		currSegment := segments[i]
-
-		// If current segment starts before previous ends, it might be an interruption
-		if currSegment.StartTime < prevSegment.EndTime {
-			metrics.InterruptionCount++
-		}
-
-		// Very short segments might indicate interruptions
-		if currSegment.EndTime-currSegment.StartTime < 1.0 && len(strings.Fields(currSegment.Text)) < 3 {
-			metrics.InterruptionCount++
-		}
-	}
-
 	return metrics
 }
 
@@ -922,6 +927,22 @@ func (s *AnalyticsService) generateInsights(analytics *AnalyticsData) []Insight
 		})
 	}
 
+	// Speakers who get talked over
+	if interruptions := analytics.Interruptions; interruptions != nil && len(interruptions.Speakers) > 1 {
+		mostInterrupted := interruptions.Speakers[0]
+		if mostInterrupted.TimesInterrupted >= 3 && float64(mostInterrupted.TimesInterrupted) >= 0.4*float64(interruptions.TotalInterruptions) {
+			insights = append(insights, Insight{
+				Type:        "meeting_dynamics",
+				Title:       "Speaker Frequently Interrupted",
+				Description: fmt.Sprintf("%s was interrupted %d times out of %d interruptions in the meeting", mostInterrupted.Speaker, mostInterrupted.TimesInterrupted, interruptions.TotalInterruptions),
+				Confidence:  0.7,
+				Severity:    "medium",
+				Speaker:     mostInterrupted.Speaker,
+				ActionItems: []string{"Make sure this speaker can finish their points", "Ask a facilitator to manage turn-taking"},
+			})
+		}
+	}
+
 	return insights
 }
 
@@ -942,25 +963,24 @@ func (s *AnalyticsService) calculateTotalDuration(segments []entities.Transcript
 	return maxEndTime
 }
 
-// GetMeetingAnalyticsSummary provides a high-level analytics summary for a meeting
+// GetMeetingAnalyticsSummary provides analytics for a meeting from its latest completed transcription
 func (s *AnalyticsService) GetMeetingAnalyticsSummary(ctx context.Context, meetingID string) (*AnalyticsData, error) {
 	// Get all transcriptions for the meeting
 	transcriptions, err := s.transcriptionRepo.FindByMeetingID(ctx, meetingID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find transcriptions for meeting: %w", err)
+		return nil, domain.NewDomainError("GET_TRANSCRIPTIONS_FAILED", "Failed to get transcriptions for meeting", err)
 	}
 
-	if len(transcriptions) == 0 {
-		return nil, fmt.Errorf("no transcriptions found for meeting %s", meetingID)
-	}
-
-	// Use the latest transcription for analytics
-	latestTranscription := transcriptions[0]
+	// Use the latest completed transcription for analytics
+	var latestTranscription *entities.Transcription
 	for _, t := range transcriptions {
-		if t.CreatedAt.After(latestTranscription.CreatedAt) {
+		if t.IsCompleted() && (latestTranscription == nil || t.CreatedAt.After(latestTranscription.CreatedAt)) {
 			latestTranscription = t
 		}
 	}
+	if latestTranscription == nil {
+		return nil, domain.NewDomainError("NO_TRANSCRIPT_SEGMENTS", fmt.Sprintf("No completed transcriptions found for meeting %s", meetingID), domain.ErrNotFound)
+	}
 
 	return s.GetTranscriptionAnalytics(ctx, latestTranscription.ID)
 }
@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// snapshotTopicCount is the number of topics kept in a meeting's analytics snapshot
+const snapshotTopicCount = 10
+
+// AnalyticsSnapshotService keeps a snapshot of each meeting's analytics up to date so trends
+// across meetings can be aggregated without reprocessing transcripts
+type AnalyticsSnapshotService struct {
+	analyticsService *AnalyticsService
+	snapshotRepo     repositories.AnalyticsSnapshotRepository
+	meetingRepo      meetingRepos.MeetingRepository
+
+	// Serializes snapshots per meeting; events are delivered concurrently
+	locks keyLocks
+}
+
+// NewAnalyticsSnapshotService creates a new analytics snapshot service
+func NewAnalyticsSnapshotService(
+	analyticsService *AnalyticsService,
+	snapshotRepo repositories.AnalyticsSnapshotRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+) *AnalyticsSnapshotService {
+	return &AnalyticsSnapshotService{
+		analyticsService: analyticsService,
+		snapshotRepo:     snapshotRepo,
+		meetingRepo:      meetingRepo,
+	}
+}
+
+// SubscribeToEvents refreshes a meeting's snapshot when a transcription completes or its segments are edited
+func (s *AnalyticsSnapshotService) SubscribeToEvents(eventBus events.EventBus) {
+	eventBus.Subscribe("transcription.completed", func(event interface{}) {
+		if completedEvent, ok := event.(*commands.TranscriptionCompletedEvent); ok {
+			if err := s.SnapshotMeeting(context.Background(), completedEvent.MeetingID); err != nil {
+				log.Printf("Failed to snapshot analytics of meeting %s: %v", completedEvent.MeetingID, err)
+			}
+		}
+	})
+
+	eventBus.Subscribe("transcription.segments_updated", func(event interface{}) {
+		if updatedEvent, ok := event.(*commands.TranscriptSegmentsUpdatedEvent); ok {
+			if err := s.SnapshotMeeting(context.Background(), updatedEvent.MeetingID); err != nil {
+				log.Printf("Failed to snapshot analytics of meeting %s: %v", updatedEvent.MeetingID, err)
+			}
+		}
+	})
+}
+
+// SnapshotMeeting stores the analytics of a meeting's latest completed transcription,
+// removing the snapshot when the meeting has no transcript left
+func (s *AnalyticsSnapshotService) SnapshotMeeting(ctx context.Context, meetingID string) error {
+	unlock := s.locks.lock(meetingID)
+	defer unlock()
+
+	analytics, err := s.analyticsService.GetMeetingAnalyticsSummary(ctx, meetingID)
+	if errors.Is(err, domain.ErrNotFound) {
+		if err := s.snapshotRepo.DeleteByMeetingID(ctx, meetingID); err != nil {
+			return domain.NewDomainError("SAVE_ANALYTICS_SNAPSHOT_FAILED", "Failed to remove analytics snapshot", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	snapshot := NewAnalyticsSnapshot(analytics)
+	if err := s.snapshotRepo.ReplaceSnapshot(ctx, &snapshot); err != nil {
+		return domain.NewDomainError("SAVE_ANALYTICS_SNAPSHOT_FAILED", "Failed to save analytics snapshot", err)
+	}
+	return nil
+}
+
+// SnapshotMissing snapshots meetings with a completed transcription but no snapshot, e.g. after upgrading
+func (s *AnalyticsSnapshotService) SnapshotMissing(ctx context.Context) error {
+	ids, err := s.snapshotRepo.FindUnsnapshottedMeetingIDs(ctx)
+	if err != nil {
+		return domain.NewDomainError("FIND_UNSNAPSHOTTED_FAILED", "Failed to find meetings without analytics snapshots", err)
+	}
+
+	for _, id := range ids {
+		if err := s.SnapshotMeeting(ctx, id); err != nil {
+			log.Printf("Failed to snapshot analytics of meeting %s: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// SnapshotOwnedMeetings refreshes the snapshots of a user's meetings, e.g. after their taxonomy changed
+func (s *AnalyticsSnapshotService) SnapshotOwnedMeetings(ctx context.Context, userID string) error {
+	meetings, err := s.meetingRepo.FindMeetingsByUserID(ctx, userID)
+	if err != nil {
+		return domain.NewDomainError("GET_MEETINGS_FAILED", "Failed to get meetings", err)
+	}
+
+	for _, meeting := range meetings {
+		if err := s.SnapshotMeeting(ctx, meeting.ID); err != nil {
+			log.Printf("Failed to snapshot analytics of meeting %s: %v", meeting.ID, err)
+		}
+	}
+	return nil
+}
+
+// NewAnalyticsSnapshot condenses a transcription's analytics into the figures trends are built from
+func NewAnalyticsSnapshot(analytics *AnalyticsData) entities.AnalyticsSnapshot {
+	snapshot := entities.NewAnalyticsSnapshot(analytics.MeetingID, analytics.TranscriptionID)
+
+	if metrics := analytics.MeetingMetrics; metrics != nil {
+		snapshot.DurationSeconds = metrics.TotalDuration
+		snapshot.WordCount = metrics.WordCount
+	}
+	if sentiment := analytics.SentimentAnalysis; sentiment != nil {
+		snapshot.Sentiment = sentiment.OverallSentiment
+		snapshot.SentimentScore = sentiment.SentimentScore
+	}
+	for _, speaker := range analytics.SpeakerAnalytics {
+		snapshot.Speakers = append(snapshot.Speakers, entities.SpeakerShare{
+			Speaker:      speaker.Speaker,
+			SpeakingTime: speaker.SpeakingTime,
+			WordCount:    speaker.WordCount,
+		})
+	}
+	for i, topic := range analytics.TopicAnalysis {
+		if i == snapshotTopicCount {
+			break
+		}
+		snapshot.Topics = append(snapshot.Topics, topic.Topic)
+	}
+	return snapshot
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsSnapshotService_SnapshotMeeting(t *testing.T) {
+	_, analyticsService, transcription := newTestAnalyticsService()
+	repo := &memoryAnalyticsSnapshotRepository{snapshots: make(map[string]entities.AnalyticsSnapshot)}
+	service := NewAnalyticsSnapshotService(analyticsService, repo, analyticsService.meetingRepo)
+
+	require.NoError(t, service.SnapshotMeeting(context.Background(), "meeting-1"))
+
+	snapshot, ok := repo.snapshots["meeting-1"]
+	require.True(t, ok)
+	assert.Equal(t, "tr-1", snapshot.TranscriptionID)
+	assert.InDelta(t, 9.0, snapshot.DurationSeconds, 0.001)
+	assert.Len(t, snapshot.Speakers, 2)
+	assert.LessOrEqual(t, len(snapshot.Topics), snapshotTopicCount)
+
+	// A meeting whose transcription is no longer completed has nothing to aggregate
+	transcription.FailTranscription()
+	require.NoError(t, service.SnapshotMeeting(context.Background(), "meeting-1"))
+	assert.Empty(t, repo.snapshots)
+}
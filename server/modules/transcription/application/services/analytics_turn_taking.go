@@ -0,0 +1,427 @@
+package services
+
+import (
+	"sort"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+const (
+	// minInterruptionOverlap is how long a speaker must keep talking after someone else starts for it to
+	// count as an interruption, which ignores the small overlaps of transcription timestamps
+	minInterruptionOverlap = 0.5
+	// backchannelMaxDuration and backchannelMaxWords describe short acknowledgements such as "yeah" or
+	// "right", which overlap the speaker without interrupting them
+	backchannelMaxDuration = 1.5
+	backchannelMaxWords    = 3
+	// monologueMinDuration is the shortest turn reported as a monologue
+	monologueMinDuration = 60.0
+	// maxReportedMonologues is the number of longest monologues listed
+	maxReportedMonologues = 10
+	// maxResponseLatency is the longest gap between speakers counted as a response rather than a pause
+	maxResponseLatency = 30.0
+)
+
+// InterruptionAnalysis reports who interrupted whom
+type InterruptionAnalysis struct {
+	TotalInterruptions int                       `json:"total_interruptions"`
+	Matrix             map[string]map[string]int `json:"matrix"` // interrupter -> interrupted speaker -> count
+	Pairs              []InterruptionPair        `json:"pairs"`
+	Speakers           []SpeakerInterruptions    `json:"speakers"`
+}
+
+// InterruptionPair counts the interruptions of one speaker by another
+type InterruptionPair struct {
+	Interrupter string    `json:"interrupter"`
+	Interrupted string    `json:"interrupted"`
+	Count       int       `json:"count"`
+	Timestamps  []float64 `json:"timestamps"`
+}
+
+// SpeakerInterruptions counts the interruptions made and received by a speaker
+type SpeakerInterruptions struct {
+	Speaker           string `json:"speaker"`
+	InterruptionsMade int    `json:"interruptions_made"`
+	TimesInterrupted  int    `json:"times_interrupted"`
+}
+
+// TurnTakingAnalysis describes how speakers took turns: turn lengths, overlapping speech,
+// monologues and how quickly speakers responded to each other
+type TurnTakingAnalysis struct {
+	TurnCount              int                 `json:"turn_count"`
+	AverageTurnLength      float64             `json:"average_turn_length_seconds"`
+	LongestTurn            float64             `json:"longest_turn_seconds"`
+	OverlapDuration        float64             `json:"overlap_duration_seconds"`
+	OverlapPercent         float64             `json:"overlap_percent"`
+	AverageResponseLatency float64             `json:"average_response_latency_seconds"`
+	MedianResponseLatency  float64             `json:"median_response_latency_seconds"`
+	Monologues             []Monologue         `json:"monologues"`
+	ResponseLatencies      []ResponseLatency   `json:"response_latencies"`
+	Speakers               []SpeakerTurnTaking `json:"speakers"`
+}
+
+// Monologue is a turn of one speaker lasting at least monologueMinDuration
+type Monologue struct {
+	Speaker   string  `json:"speaker"`
+	StartTime float64 `json:"start_time"`
+	EndTime   float64 `json:"end_time"`
+	Duration  float64 `json:"duration"`
+}
+
+// ResponseLatency is the average gap before a speaker responded to another
+type ResponseLatency struct {
+	From           string  `json:"from"`
+	To             string  `json:"to"`
+	Count          int     `json:"count"`
+	AverageLatency float64 `json:"average_latency_seconds"`
+}
+
+// SpeakerTurnTaking describes the turns of a speaker
+type SpeakerTurnTaking struct {
+	Speaker                string  `json:"speaker"`
+	TurnCount              int     `json:"turn_count"`
+	AverageTurnLength      float64 `json:"average_turn_length_seconds"`
+	LongestTurn            float64 `json:"longest_turn_seconds"`
+	MonologueCount         int     `json:"monologue_count"`
+	OverlapDuration        float64 `json:"overlap_duration_seconds"`
+	AverageResponseLatency float64 `json:"average_response_latency_seconds"`
+}
+
+// speakerTurn is a run of consecutive segments by one speaker
+type speakerTurn struct {
+	speaker   string
+	startTime float64
+	endTime   float64
+}
+
+// analyzeTurnTaking finds interruptions and turn-taking patterns from the timing of the segments of
+// different speakers. Segments without a speaker are ignored.
+func (s *AnalyticsService) analyzeTurnTaking(segments []entities.TranscriptSegment) (*InterruptionAnalysis, *TurnTakingAnalysis) {
+	spoken := make([]entities.TranscriptSegment, 0, len(segments))
+	for _, segment := range segments {
+		if segment.Speaker != "" && segment.EndTime > segment.StartTime {
+			spoken = append(spoken, segment)
+		}
+	}
+	sort.SliceStable(spoken, func(i, j int) bool {
+		return spoken[i].StartTime < spoken[j].StartTime
+	})
+
+	interruptions := findInterruptions(spoken)
+	turnTaking := &TurnTakingAnalysis{
+		Monologues:        []Monologue{},
+		ResponseLatencies: []ResponseLatency{},
+		Speakers:          []SpeakerTurnTaking{},
+	}
+	if len(spoken) == 0 {
+		return interruptions, turnTaking
+	}
+
+	speakerStats := make(map[string]*SpeakerTurnTaking)
+	statsFor := func(speaker string) *SpeakerTurnTaking {
+		stats, ok := speakerStats[speaker]
+		if !ok {
+			stats = &SpeakerTurnTaking{Speaker: speaker}
+			speakerStats[speaker] = stats
+		}
+		return stats
+	}
+
+	// Turn lengths and monologues
+	turns := groupTurns(spoken)
+	totalTurnLength := 0.0
+	for _, turn := range turns {
+		length := turn.endTime - turn.startTime
+		totalTurnLength += length
+		if length > turnTaking.LongestTurn {
+			turnTaking.LongestTurn = length
+		}
+
+		stats := statsFor(turn.speaker)
+		stats.TurnCount++
+		stats.AverageTurnLength += length
+		if length > stats.LongestTurn {
+			stats.LongestTurn = length
+		}
+		if length >= monologueMinDuration {
+			stats.MonologueCount++
+			turnTaking.Monologues = append(turnTaking.Monologues, Monologue{
+				Speaker:   turn.speaker,
+				StartTime: turn.startTime,
+				EndTime:   turn.endTime,
+				Duration:  length,
+			})
+		}
+	}
+	turnTaking.TurnCount = len(turns)
+	turnTaking.AverageTurnLength = totalTurnLength / float64(len(turns))
+	sort.SliceStable(turnTaking.Monologues, func(i, j int) bool {
+		return turnTaking.Monologues[i].Duration > turnTaking.Monologues[j].Duration
+	})
+	if len(turnTaking.Monologues) > maxReportedMonologues {
+		turnTaking.Monologues = turnTaking.Monologues[:maxReportedMonologues]
+	}
+
+	// Overlapping speech
+	overlapBySpeaker, totalOverlap := measureOverlap(spoken)
+	turnTaking.OverlapDuration = totalOverlap
+	if duration := s.calculateTotalDuration(spoken); duration > 0 {
+		turnTaking.OverlapPercent = totalOverlap / duration * 100
+	}
+	for speaker, overlap := range overlapBySpeaker {
+		statsFor(speaker).OverlapDuration = overlap
+	}
+
+	// Response latency between speakers
+	latencies := findResponseLatencies(spoken)
+	var allLatencies []float64
+	type latencyKey struct{ from, to string }
+	byPair := make(map[latencyKey]*ResponseLatency)
+	responderTotals := make(map[string][]float64)
+	for _, latency := range latencies {
+		allLatencies = append(allLatencies, latency.gap)
+		responderTotals[latency.to] = append(responderTotals[latency.to], latency.gap)
+
+		key := latencyKey{latency.from, latency.to}
+		pair, ok := byPair[key]
+		if !ok {
+			pair = &ResponseLatency{From: latency.from, To: latency.to}
+			byPair[key] = pair
+		}
+		pair.Count++
+		pair.AverageLatency += latency.gap
+	}
+	for _, pair := range byPair {
+		pair.AverageLatency /= float64(pair.Count)
+		turnTaking.ResponseLatencies = append(turnTaking.ResponseLatencies, *pair)
+	}
+	sort.Slice(turnTaking.ResponseLatencies, func(i, j int) bool {
+		a, b := turnTaking.ResponseLatencies[i], turnTaking.ResponseLatencies[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To < b.To
+	})
+	turnTaking.AverageResponseLatency = average(allLatencies)
+	turnTaking.MedianResponseLatency = median(allLatencies)
+	for speaker, gaps := range responderTotals {
+		statsFor(speaker).AverageResponseLatency = average(gaps)
+	}
+
+	for _, stats := range speakerStats {
+		if stats.TurnCount > 0 {
+			stats.AverageTurnLength /= float64(stats.TurnCount)
+		}
+		turnTaking.Speakers = append(turnTaking.Speakers, *stats)
+	}
+	sort.Slice(turnTaking.Speakers, func(i, j int) bool {
+		return turnTaking.Speakers[i].Speaker < turnTaking.Speakers[j].Speaker
+	})
+
+	return interruptions, turnTaking
+}
+
+// findInterruptions counts a speaker starting a turn while another speaker still has at least
+// minInterruptionOverlap left to say. Short acknowledgements are not interruptions.
+func findInterruptions(spoken []entities.TranscriptSegment) *InterruptionAnalysis {
+	analysis := &InterruptionAnalysis{
+		Matrix:   make(map[string]map[string]int),
+		Pairs:    []InterruptionPair{},
+		Speakers: []SpeakerInterruptions{},
+	}
+
+	type pairKey struct{ interrupter, interrupted string }
+	pairs := make(map[pairKey]*InterruptionPair)
+	made := make(map[string]int)
+	received := make(map[string]int)
+
+	for i := 1; i < len(spoken); i++ {
+		segment := spoken[i]
+		// Only the start of a turn can interrupt
+		if spoken[i-1].Speaker == segment.Speaker || isBackchannel(segment) {
+			continue
+		}
+
+		// The interrupted speaker is the one who most recently started a segment still in progress
+		interrupted := ""
+		for j := i - 1; j >= 0; j-- {
+			previous := spoken[j]
+			if previous.Speaker != segment.Speaker && previous.EndTime-segment.StartTime >= minInterruptionOverlap {
+				interrupted = previous.Speaker
+				break
+			}
+		}
+		if interrupted == "" {
+			continue
+		}
+
+		key := pairKey{segment.Speaker, interrupted}
+		pair, ok := pairs[key]
+		if !ok {
+			pair = &InterruptionPair{Interrupter: segment.Speaker, Interrupted: interrupted}
+			pairs[key] = pair
+		}
+		pair.Count++
+		pair.Timestamps = append(pair.Timestamps, segment.StartTime)
+
+		if analysis.Matrix[segment.Speaker] == nil {
+			analysis.Matrix[segment.Speaker] = make(map[string]int)
+		}
+		analysis.Matrix[segment.Speaker][interrupted]++
+		made[segment.Speaker]++
+		received[interrupted]++
+		analysis.TotalInterruptions++
+	}
+
+	for _, pair := range pairs {
+		analysis.Pairs = append(analysis.Pairs, *pair)
+	}
+	sort.Slice(analysis.Pairs, func(i, j int) bool {
+		a, b := analysis.Pairs[i], analysis.Pairs[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Interrupter != b.Interrupter {
+			return a.Interrupter < b.Interrupter
+		}
+		return a.Interrupted < b.Interrupted
+	})
+
+	speakers := make(map[string]bool)
+	for _, segment := range spoken {
+		speakers[segment.Speaker] = true
+	}
+	for speaker := range speakers {
+		analysis.Speakers = append(analysis.Speakers, SpeakerInterruptions{
+			Speaker:           speaker,
+			InterruptionsMade: made[speaker],
+			TimesInterrupted:  received[speaker],
+		})
+	}
+	sort.Slice(analysis.Speakers, func(i, j int) bool {
+		a, b := analysis.Speakers[i], analysis.Speakers[j]
+		if a.TimesInterrupted != b.TimesInterrupted {
+			return a.TimesInterrupted > b.TimesInterrupted
+		}
+		return a.Speaker < b.Speaker
+	})
+
+	return analysis
+}
+
+// isBackchannel returns true for short acknowledgements such as "yeah" or "mm-hmm"
+func isBackchannel(segment entities.TranscriptSegment) bool {
+	return segment.EndTime-segment.StartTime <= backchannelMaxDuration && len(strings.Fields(segment.Text)) <= backchannelMaxWords
+}
+
+// groupTurns merges consecutive segments of the same speaker into turns
+func groupTurns(spoken []entities.TranscriptSegment) []speakerTurn {
+	var turns []speakerTurn
+	for _, segment := range spoken {
+		if n := len(turns); n > 0 && turns[n-1].speaker == segment.Speaker {
+			if segment.EndTime > turns[n-1].endTime {
+				turns[n-1].endTime = segment.EndTime
+			}
+			continue
+		}
+		turns = append(turns, speakerTurn{speaker: segment.Speaker, startTime: segment.StartTime, endTime: segment.EndTime})
+	}
+	return turns
+}
+
+// measureOverlap returns how long each speaker talked over someone else, and the total time when more
+// than one speaker was talking
+func measureOverlap(spoken []entities.TranscriptSegment) (map[string]float64, float64) {
+	type event struct {
+		time    float64
+		speaker string
+		delta   int
+	}
+	events := make([]event, 0, 2*len(spoken))
+	for _, segment := range spoken {
+		events = append(events, event{segment.StartTime, segment.Speaker, 1}, event{segment.EndTime, segment.Speaker, -1})
+	}
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].time != events[j].time {
+			return events[i].time < events[j].time
+		}
+		// Segments ending at the same time another starts do not overlap
+		return events[i].delta < events[j].delta
+	})
+
+	bySpeaker := make(map[string]float64)
+	total := 0.0
+	// Counts of open segments per speaker, so a speaker's own overlapping segments count once
+	active := make(map[string]int)
+	previous := 0.0
+	for _, e := range events {
+		if len(active) > 1 {
+			elapsed := e.time - previous
+			total += elapsed
+			for speaker := range active {
+				bySpeaker[speaker] += elapsed
+			}
+		}
+		active[e.speaker] += e.delta
+		if active[e.speaker] == 0 {
+			delete(active, e.speaker)
+		}
+		previous = e.time
+	}
+	return bySpeaker, total
+}
+
+// responseGap is the silence before a speaker responded to another
+type responseGap struct {
+	from string
+	to   string
+	gap  float64
+}
+
+// findResponseLatencies measures the silence between the last speaker to stop talking and the next
+// speaker to start. Speakers resuming after their own pause, overlapping starts and long silences are not responses.
+func findResponseLatencies(spoken []entities.TranscriptSegment) []responseGap {
+	var gaps []responseGap
+	lastEnd := spoken[0].EndTime
+	lastSpeaker := spoken[0].Speaker
+	for _, segment := range spoken[1:] {
+		if segment.StartTime >= lastEnd && segment.Speaker != lastSpeaker {
+			if gap := segment.StartTime - lastEnd; gap <= maxResponseLatency {
+				gaps = append(gaps, responseGap{from: lastSpeaker, to: segment.Speaker, gap: gap})
+			}
+		}
+		if segment.EndTime >= lastEnd {
+			lastEnd = segment.EndTime
+			lastSpeaker = segment.Speaker
+		}
+	}
+	return gaps
+}
+
+func average(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, value := range values {
+		total += value
+	}
+	return total / float64(len(values))
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsService_AnalyzeTurnTaking(t *testing.T) {
+	segments := []entities.TranscriptSegment{
+		{Speaker: "Alice", Text: "Here is the plan for the quarter and the budget we need", StartTime: 0, EndTime: 10},
+		// Bob talks over Alice for two seconds
+		{Speaker: "Bob", Text: "Sorry but that budget is far too high", StartTime: 8, EndTime: 14},
+		// A short acknowledgement is overlap, not an interruption
+		{Speaker: "Alice", Text: "Right", StartTime: 13.5, EndTime: 13.9},
+		{Speaker: "Carol", Text: "Can we look at the numbers together", StartTime: 15, EndTime: 20},
+		{Speaker: "Bob", Text: "Yes I will share them", StartTime: 17, EndTime: 22},
+		{Speaker: "Alice", Text: "Then let me walk through every line item in detail so nobody is surprised later on", StartTime: 23, EndTime: 90},
+		{Speaker: "Alice", Text: "And that is the end of it", StartTime: 90, EndTime: 95},
+	}
+
+	service := &AnalyticsService{}
+	interruptions, turnTaking := service.analyzeTurnTaking(segments)
+
+	assert.Equal(t, 2, interruptions.TotalInterruptions)
+	assert.Equal(t, 1, interruptions.Matrix["Bob"]["Alice"])
+	assert.Equal(t, 1, interruptions.Matrix["Bob"]["Carol"])
+	assert.Zero(t, interruptions.Matrix["Alice"]["Bob"])
+	require.Len(t, interruptions.Pairs, 2)
+	assert.Equal(t, []float64{8}, interruptions.Pairs[0].Timestamps)
+	for _, speaker := range interruptions.Speakers {
+		if speaker.Speaker == "Bob" {
+			assert.Equal(t, 2, speaker.InterruptionsMade)
+			assert.Zero(t, speaker.TimesInterrupted)
+		}
+	}
+
+	// Alice, Bob, Alice, Carol, Bob, Alice
+	assert.Equal(t, 6, turnTaking.TurnCount)
+	assert.InDelta(t, 72.0, turnTaking.LongestTurn, 0.001)
+	require.Len(t, turnTaking.Monologues, 1)
+	assert.Equal(t, "Alice", turnTaking.Monologues[0].Speaker)
+	assert.InDelta(t, 23.0, turnTaking.Monologues[0].StartTime, 0.001)
+
+	// Alice and Bob overlap for 2.4s in total, Carol and Bob for 3s
+	assert.InDelta(t, 5.4, turnTaking.OverlapDuration, 0.001)
+
+	// Bob stops at 14 and Carol responds after 1s; Bob stops at 22 and Alice responds after 1s
+	require.Len(t, turnTaking.ResponseLatencies, 2)
+	assert.InDelta(t, 1.0, turnTaking.AverageResponseLatency, 0.001)
+	assert.InDelta(t, 1.0, turnTaking.MedianResponseLatency, 0.001)
+
+	require.Len(t, turnTaking.Speakers, 3)
+	assert.Equal(t, "Alice", turnTaking.Speakers[0].Speaker)
+	assert.Equal(t, 3, turnTaking.Speakers[0].TurnCount)
+	assert.Equal(t, 1, turnTaking.Speakers[0].MonologueCount)
+}
+
+func TestAnalyticsService_AnalyzeTurnTakingWithoutSpeakers(t *testing.T) {
+	service := &AnalyticsService{}
+	interruptions, turnTaking := service.analyzeTurnTaking([]entities.TranscriptSegment{
+		{Text: "No diarization here", StartTime: 0, EndTime: 5},
+	})
+
+	assert.Zero(t, interruptions.TotalInterruptions)
+	assert.Empty(t, interruptions.Pairs)
+	assert.Zero(t, turnTaking.TurnCount)
+	assert.Empty(t, turnTaking.Monologues)
+}
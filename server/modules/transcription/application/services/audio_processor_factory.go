@@ -41,6 +41,14 @@ func NewAudioProcessorFactory() *AudioProcessorFactory {
 	}
 }
 
+// AudioSource returns the storage recordings are uploaded to, or nil when it is unavailable
+func (f *AudioProcessorFactory) AudioSource() AudioSource {
+	if f.firebaseUploader == nil {
+		return nil
+	}
+	return f.firebaseUploader
+}
+
 // CreateProcessor creates an audio processor based on the specified mode and options
 func (f *AudioProcessorFactory) CreateProcessor(mode services.ProcessingMode, options services.AudioProcessingOptions) (services.AudioProcessor, error) {
 	switch options.Provider {
@@ -94,14 +102,17 @@ func (f *AudioProcessorFactory) GetProviderCapabilities(provider string) (*servi
 	switch provider {
 	case "assemblyai":
 		return &services.ProviderCapabilities{
-			Provider:            "assemblyai",
-			SupportedModes:      []services.ProcessingMode{services.RealTimeMode, services.BatchMode},
-			SupportedLanguages:  []string{"en", "es", "fr", "de", "it", "pt", "hi", "ja", "ko", "zh"},
-			SupportsDiarization: true,
-			SupportsRealTime:    true,
-			SupportsBatch:       true,
-			MaxAudioDuration:    7200, // 2 hours
-			SupportedFormats:    []string{"wav", "mp3", "m4a", "flac", "opus"},
+			Provider:               "assemblyai",
+			SupportedModes:         []services.ProcessingMode{services.RealTimeMode, services.BatchMode},
+			SupportedLanguages:     []string{"en", "es", "fr", "de", "it", "pt", "hi", "ja", "ko", "zh"},
+			SupportsDiarization:    true,
+			SupportsRealTime:       true,
+			SupportsBatch:          true,
+			SupportsWordBoost:      true,
+			SupportsCustomSpelling: true,
+			SupportsPIIRedaction:   true,
+			MaxAudioDuration:       7200, // 2 hours
+			SupportedFormats:       []string{"wav", "mp3", "m4a", "flac", "opus"},
 			PricingPerMinute: map[string]float64{
 				"realtime": 0.0025,  // $0.0025/minute for real-time
 				"batch":    0.00065, // $0.00065/minute for batch
@@ -33,6 +33,9 @@ func TestAudioProcessorFactory_GetProviderCapabilities(t *testing.T) {
 	assert.True(t, assemblyAICapabilities.SupportsDiarization)
 	assert.True(t, assemblyAICapabilities.SupportsRealTime)
 	assert.True(t, assemblyAICapabilities.SupportsBatch)
+	assert.True(t, assemblyAICapabilities.SupportsWordBoost)
+	assert.True(t, assemblyAICapabilities.SupportsCustomSpelling)
+	assert.True(t, assemblyAICapabilities.SupportsPIIRedaction)
 	assert.Contains(t, assemblyAICapabilities.SupportedModes, services.RealTimeMode)
 	assert.Contains(t, assemblyAICapabilities.SupportedModes, services.BatchMode)
 
@@ -41,6 +44,8 @@ func TestAudioProcessorFactory_GetProviderCapabilities(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "mock", mockCapabilities.Provider)
 	assert.True(t, mockCapabilities.SupportsDiarization)
+	assert.False(t, mockCapabilities.SupportsCustomSpelling)
+	assert.False(t, mockCapabilities.SupportsPIIRedaction)
 
 	// Test unknown provider
 	_, err = factory.GetProviderCapabilities("unknown")
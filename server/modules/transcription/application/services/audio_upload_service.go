@@ -0,0 +1,438 @@
+package services
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/url"
+	"path"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/application/jobs"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+	jobRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+const (
+	// MaxAudioUploadSize is the largest recording that can be uploaded or fetched from a URL
+	MaxAudioUploadSize = 500 << 20
+
+	// audioUploadChunkSize is the size of the chunks a recording is fed to the processor in
+	audioUploadChunkSize = 4 << 20
+	// transcribeJobWorkers bounds how many recordings are transcribed at the same time
+	transcribeJobWorkers = 2
+	// transcribeJobTimeout bounds downloading, uploading and transcribing a single recording
+	transcribeJobTimeout = 90 * time.Minute
+)
+
+// Sources of uploaded recordings
+const (
+	AudioSourceUpload = "upload"
+	AudioSourceURL    = "url"
+)
+
+// AudioFileStore keeps uploaded recordings until they have been transcribed
+type AudioFileStore interface {
+	SaveAudio(ctx context.Context, name string, reader io.Reader) (int64, error)
+	OpenAudio(ctx context.Context, name string) (io.ReadCloser, error)
+	DeleteAudio(ctx context.Context, name string) error
+}
+
+// AudioFetcher downloads recordings from URLs supplied by users
+type AudioFetcher interface {
+	// ValidateURL rejects URLs the fetcher will not download from
+	ValidateURL(ctx context.Context, rawURL string) error
+	// Fetch starts downloading a recording and returns its body and content type
+	Fetch(ctx context.Context, rawURL string) (io.ReadCloser, string, error)
+}
+
+// AudioUpload is a recording queued for transcription
+type AudioUpload struct {
+	Job    *jobEntities.ProcessingJob
+	Result *commands.UploadAudioResult
+}
+
+// AudioTranscriptionResult is the outcome of a completed transcribe job
+type AudioTranscriptionResult struct {
+	TranscriptionID string `json:"transcription_id"`
+	MeetingID       string `json:"meeting_id"`
+	SegmentCount    int    `json:"segment_count"`
+	AudioFilePath   string `json:"audio_file_path,omitempty"`
+}
+
+// AudioUploadService transcribes pre-recorded audio files. Recordings are stored, then sent
+// through the batch pipeline in background processing jobs.
+type AudioUploadService struct {
+	uploadHandler     *commands.UploadAudioHandler
+	startHandler      *commands.StartTranscriptionHandler
+	processHandler    *commands.ProcessAudioChunkHandler
+	completeHandler   *commands.CompleteTranscriptionHandler
+	transcriptionRepo repositories.TranscriptionRepository
+	meetingRepo       meetingRepos.MeetingRepository
+	jobRepo           jobRepos.ProcessingJobRepository
+	accessService     *meetingServices.MeetingAccessService
+	store             AudioFileStore
+	fetcher           AudioFetcher // Optional, allows recordings to be fetched from URLs
+	runner            *jobs.Runner
+}
+
+// NewAudioUploadService creates a new audio upload service
+func NewAudioUploadService(
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	vocabularyRepo repositories.VocabularyRepository,
+	redactionRepo repositories.RedactionSettingsRepository,
+	audioFactory services.AudioProcessorFactory,
+	eventBus events.EventBus,
+	jobRepo jobRepos.ProcessingJobRepository,
+	store AudioFileStore,
+) *AudioUploadService {
+	s := &AudioUploadService{
+		uploadHandler:     commands.NewUploadAudioHandler(transcriptionRepo, meetingRepo, audioFactory),
+		startHandler:      commands.NewStartTranscriptionHandler(transcriptionRepo, meetingRepo, vocabularyRepo, redactionRepo, audioFactory, eventBus),
+		processHandler:    commands.NewProcessAudioChunkHandler(transcriptionRepo, eventBus),
+		completeHandler:   commands.NewCompleteTranscriptionHandler(transcriptionRepo, meetingRepo, eventBus),
+		transcriptionRepo: transcriptionRepo,
+		meetingRepo:       meetingRepo,
+		jobRepo:           jobRepo,
+		accessService:     meetingServices.NewMeetingAccessService(meetingRepo),
+		store:             store,
+	}
+	s.runner = jobs.NewRunner(jobRepo, "transcribe", transcribeJobWorkers, transcribeJobTimeout, s.transcribe).OnFinish(s.finish)
+	return s
+}
+
+// WithFetcher allows recordings to be fetched from URLs
+func (s *AudioUploadService) WithFetcher(fetcher AudioFetcher) *AudioUploadService {
+	s.fetcher = fetcher
+	return s
+}
+
+// Upload stores an uploaded recording, creates its meeting and transcription, and queues it
+// for transcription. Recordings can be added to existing meetings the user can edit.
+func (s *AudioUploadService) Upload(ctx context.Context, cmd commands.UploadAudioCommand, reader io.Reader) (*AudioUpload, error) {
+	cmd, err := s.validate(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	name := domain.GenerateID() + "." + cmd.Format
+	size, err := s.store.SaveAudio(ctx, name, io.LimitReader(reader, MaxAudioUploadSize+1))
+	if err == nil && (size == 0 || size > MaxAudioUploadSize) {
+		s.deleteAudio(ctx, name)
+		if size == 0 {
+			return nil, domain.NewDomainError("EMPTY_AUDIO_FILE", "Audio file is empty", domain.ErrInvalidInput)
+		}
+		return nil, domain.NewDomainError("AUDIO_FILE_TOO_LARGE", "Audio file is too large", domain.ErrInvalidInput)
+	}
+	if err != nil {
+		return nil, domain.NewDomainError("STORE_AUDIO_FAILED", "Failed to store audio file", err)
+	}
+
+	result, err := s.uploadHandler.Handle(ctx, cmd)
+	if err != nil {
+		s.deleteAudio(ctx, name)
+		return nil, err
+	}
+
+	job, err := s.enqueue(ctx, cmd, result, map[string]interface{}{
+		"source":     AudioSourceUpload,
+		"audio_file": name,
+		"file_size":  size,
+	})
+	if err != nil {
+		s.deleteAudio(ctx, name)
+		return nil, err
+	}
+	return &AudioUpload{Job: job, Result: result}, nil
+}
+
+// UploadFromURL creates the meeting and transcription for a recording at a URL and queues it
+// to be downloaded and transcribed. URLs that do not point to public hosts are rejected.
+func (s *AudioUploadService) UploadFromURL(ctx context.Context, cmd commands.UploadAudioCommand, rawURL string) (*AudioUpload, error) {
+	if s.fetcher == nil {
+		return nil, domain.NewDomainError("AUDIO_URL_UNAVAILABLE", "Fetching audio from URLs is not enabled", domain.ErrInvalidInput)
+	}
+	if err := s.fetcher.ValidateURL(ctx, rawURL); err != nil {
+		return nil, err
+	}
+	if cmd.FileName == "" {
+		if target, err := url.Parse(rawURL); err == nil && path.Base(target.Path) != "/" {
+			cmd.FileName = path.Base(target.Path)
+		}
+	}
+
+	cmd, err := s.validate(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.uploadHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.enqueue(ctx, cmd, result, map[string]interface{}{
+		"source":     AudioSourceURL,
+		"source_url": rawURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &AudioUpload{Job: job, Result: result}, nil
+}
+
+// GetJob returns a transcribe job started by the user
+func (s *AudioUploadService) GetJob(ctx context.Context, jobID, userID string) (*jobEntities.ProcessingJob, error) {
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIBE_JOB_NOT_FOUND", "Transcribe job not found", err)
+	}
+	if owner, _ := job.GetPayloadValue("user_id"); job.JobType != jobEntities.TranscribeJobType || owner != userID {
+		return nil, domain.NewDomainError("TRANSCRIBE_JOB_NOT_FOUND", "Transcribe job not found", domain.ErrNotFound)
+	}
+	return job, nil
+}
+
+// ResumePendingJobs restarts transcribe jobs that were queued or running when the server stopped
+func (s *AudioUploadService) ResumePendingJobs(ctx context.Context) error {
+	return s.runner.Resume(ctx, jobEntities.TranscribeJobType)
+}
+
+// validate checks that the user can add a recording to the command's meeting and that the
+// provider can transcribe it
+func (s *AudioUploadService) validate(ctx context.Context, cmd commands.UploadAudioCommand) (commands.UploadAudioCommand, error) {
+	if cmd.MeetingID != "" {
+		if _, err := s.accessService.VerifyEditAccess(ctx, cmd.MeetingID, cmd.UserID); err != nil {
+			return cmd, err
+		}
+	}
+	return s.uploadHandler.Validate(cmd)
+}
+
+// enqueue saves a transcribe job and starts it in the background
+func (s *AudioUploadService) enqueue(ctx context.Context, cmd commands.UploadAudioCommand, result *commands.UploadAudioResult, payload map[string]interface{}) (*jobEntities.ProcessingJob, error) {
+	payload["user_id"] = cmd.UserID
+	payload["meeting_id"] = result.MeetingID
+	payload["file_name"] = cmd.FileName
+	payload["meeting_created"] = result.MeetingCreated
+	payload["format"] = result.Format
+	payload["options"] = result.Options
+
+	job := jobEntities.NewProcessingJob("transcription", result.TranscriptionID, jobEntities.TranscribeJobType, payload)
+	if err := s.jobRepo.Save(ctx, &job); err != nil {
+		s.failTranscription(ctx, result.TranscriptionID, result.MeetingID, result.MeetingCreated)
+		return nil, domain.NewDomainError("SAVE_TRANSCRIBE_JOB_FAILED", "Failed to queue transcription", err)
+	}
+
+	s.runner.Enqueue(job.GetID())
+	return &job, nil
+}
+
+// finish cleans up after a job: a failed job fails its transcription, and the stored recording
+// is removed since the provider keeps it in permanent storage once it is transcribed
+func (s *AudioUploadService) finish(ctx context.Context, job *jobEntities.ProcessingJob, err error) {
+	if err != nil {
+		meetingCreated, _ := job.GetPayloadValue("meeting_created")
+		s.failTranscription(ctx, job.EntityID, payloadString(job, "meeting_id"), meetingCreated == true)
+	}
+	if name := payloadString(job, "audio_file"); name != "" {
+		s.deleteAudio(ctx, name)
+	}
+}
+
+// transcribe runs a transcribe job and stores its result on the job
+func (s *AudioUploadService) transcribe(ctx context.Context, job *jobEntities.ProcessingJob) error {
+	result, err := s.runJob(ctx, job)
+	if err != nil {
+		return err
+	}
+	job.SetPayloadValue("result", result)
+	return nil
+}
+
+// runJob downloads the recording of a job if needed and sends it through the batch pipeline
+func (s *AudioUploadService) runJob(ctx context.Context, job *jobEntities.ProcessingJob) (*AudioTranscriptionResult, error) {
+	var options services.AudioProcessingOptions
+	if err := decodePayloadValue(job, "options", &options); err != nil {
+		return nil, err
+	}
+	meetingID, userID, format := payloadString(job, "meeting_id"), payloadString(job, "user_id"), payloadString(job, "format")
+	if meetingID == "" || format == "" {
+		return nil, domain.NewDomainError("INVALID_JOB_PAYLOAD", "Job has no meeting or format", domain.ErrInvalidInput)
+	}
+
+	name, err := s.stageAudio(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	file, err := s.store.OpenAudio(ctx, name)
+	if err != nil {
+		return nil, domain.NewDomainError("OPEN_AUDIO_FAILED", "Failed to open audio file", err)
+	}
+	defer file.Close()
+
+	started, err := s.startHandler.Handle(ctx, commands.StartTranscriptionCommand{
+		MeetingID:       meetingID,
+		TranscriptionID: job.EntityID,
+		AudioStreamMetadata: services.AudioStreamMetadata{
+			MeetingID: meetingID,
+			UserID:    userID,
+			MimeType:  services.AudioContentType(format),
+			StartTime: time.Now().Unix(),
+			Mode:      services.BatchMode,
+		},
+		ProcessingOptions: options,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.processAudio(ctx, started, file); err != nil {
+		if abortErr := started.Processor.AbortSession(ctx, started.SessionID); abortErr != nil {
+			log.Printf("Failed to abort session %s: %v", started.SessionID, abortErr)
+		}
+		return nil, err
+	}
+
+	completed, err := s.completeHandler.Handle(ctx, commands.CompleteTranscriptionCommand{
+		TranscriptionID: started.TranscriptionID,
+		SessionID:       started.SessionID,
+		MeetingID:       meetingID,
+		Processor:       started.Processor,
+		SpellingRules:   started.ProcessingOptions.CustomSpelling,
+		RedactPII:       started.ProcessingOptions.RedactPII,
+		RedactPIIAudio:  started.ProcessingOptions.RedactPIIAudio,
+		PIIPolicies:     started.ProcessingOptions.PIIPolicies,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AudioTranscriptionResult{
+		TranscriptionID: completed.TranscriptionID,
+		MeetingID:       completed.MeetingID,
+		SegmentCount:    len(completed.Segments),
+		AudioFilePath:   completed.AudioFilePath,
+	}, nil
+}
+
+// stageAudio returns the stored recording of a job, first downloading it for URL jobs
+func (s *AudioUploadService) stageAudio(ctx context.Context, job *jobEntities.ProcessingJob) (string, error) {
+	if name := payloadString(job, "audio_file"); name != "" {
+		return name, nil
+	}
+
+	sourceURL := payloadString(job, "source_url")
+	if sourceURL == "" {
+		return "", domain.NewDomainError("INVALID_JOB_PAYLOAD", "Job has no audio file or source URL", domain.ErrInvalidInput)
+	}
+	if s.fetcher == nil {
+		return "", domain.NewDomainError("AUDIO_URL_UNAVAILABLE", "Fetching audio from URLs is not enabled", domain.ErrInvalidInput)
+	}
+
+	body, _, err := s.fetcher.Fetch(ctx, sourceURL)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	name := job.GetID() + "." + payloadString(job, "format")
+	size, err := s.store.SaveAudio(ctx, name, io.LimitReader(body, MaxAudioUploadSize+1))
+	if err != nil {
+		return "", domain.NewDomainError("STORE_AUDIO_FAILED", "Failed to store audio file", err)
+	}
+	// Record the file before checking it so it is removed with the job
+	job.SetPayloadValue("audio_file", name)
+	job.SetPayloadValue("file_size", size)
+	if size == 0 {
+		return "", domain.NewDomainError("EMPTY_AUDIO_FILE", "Audio file is empty", domain.ErrInvalidInput)
+	}
+	if size > MaxAudioUploadSize {
+		return "", domain.NewDomainError("AUDIO_FILE_TOO_LARGE", "Audio file is too large", domain.ErrInvalidInput)
+	}
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		log.Printf("Failed to update transcribe job %s: %v", job.GetID(), err)
+	}
+	return name, nil
+}
+
+// processAudio feeds a recording to the session's processor in chunks
+func (s *AudioUploadService) processAudio(ctx context.Context, started *commands.StartTranscriptionResult, reader io.Reader) error {
+	buffer := make([]byte, audioUploadChunkSize)
+	for sequence := 0; ; sequence++ {
+		n, err := io.ReadFull(reader, buffer)
+		if n > 0 {
+			chunk := services.AudioChunk{
+				Data:        append([]byte(nil), buffer[:n]...),
+				Timestamp:   time.Now().UnixMilli(),
+				SequenceNum: sequence,
+				Size:        n,
+			}
+			if _, processErr := s.processHandler.Handle(ctx, commands.ProcessAudioChunkCommand{
+				TranscriptionID: started.TranscriptionID,
+				SessionID:       started.SessionID,
+				AudioChunk:      chunk,
+				Processor:       started.Processor,
+			}); processErr != nil {
+				return processErr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return domain.NewDomainError("READ_AUDIO_FAILED", "Failed to read audio file", err)
+		}
+	}
+}
+
+// failTranscription marks a transcription that will not complete as failed, along with the
+// meeting created for it
+func (s *AudioUploadService) failTranscription(ctx context.Context, transcriptionID, meetingID string, meetingCreated bool) {
+	if transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID); err == nil && !transcription.IsCompleted() {
+		transcription.FailTranscription()
+		if err := s.transcriptionRepo.Update(ctx, transcription); err != nil {
+			log.Printf("Failed to update transcription %s: %v", transcriptionID, err)
+		}
+	}
+
+	if !meetingCreated {
+		return
+	}
+	if meeting, err := s.meetingRepo.FindMeetingByID(ctx, meetingID); err == nil && meeting.Status != meetingRepos.MeetingStatusCompleted {
+		meeting.Status = meetingRepos.MeetingStatusFailed
+		if err := s.meetingRepo.UpdateMeeting(ctx, meeting); err != nil {
+			log.Printf("Failed to update meeting %s: %v", meetingID, err)
+		}
+	}
+}
+
+// deleteAudio removes a stored recording, logging failures
+func (s *AudioUploadService) deleteAudio(ctx context.Context, name string) {
+	if err := s.store.DeleteAudio(ctx, name); err != nil {
+		log.Printf("Failed to delete uploaded audio %s: %v", name, err)
+	}
+}
+
+// payloadString returns a string payload value, or "" when it is missing
+func payloadString(job *jobEntities.ProcessingJob, key string) string {
+	value, _ := job.GetPayloadValue(key)
+	text, _ := value.(string)
+	return text
+}
+
+// AudioUploadJobResult returns the transcription produced by a completed transcribe job, or nil
+func AudioUploadJobResult(job *jobEntities.ProcessingJob) *AudioTranscriptionResult {
+	var result AudioTranscriptionResult
+	if !job.IsCompleted() || decodePayloadValue(job, "result", &result) != nil {
+		return nil
+	}
+	return &result
+}
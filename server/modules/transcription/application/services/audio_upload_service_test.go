@@ -0,0 +1,281 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/modules/transcription/infrastructure/providers"
+	"teammate/server/seedwork/application/jobs/jobstest"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+	"teammate/server/seedwork/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingAudioProcessor keeps the audio it is sent and returns a single segment
+type recordingAudioProcessor struct {
+	services.AudioProcessor
+	mu      sync.Mutex
+	audio   []byte
+	chunks  int
+	options services.AudioProcessingOptions
+	endErr  error
+}
+
+func (p *recordingAudioProcessor) StartSession(ctx context.Context, metadata services.AudioStreamMetadata, options services.AudioProcessingOptions) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.options = options
+	return "session-1", nil
+}
+
+func (p *recordingAudioProcessor) ProcessChunk(ctx context.Context, sessionID string, chunk services.AudioChunk) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.audio = append(p.audio, chunk.Data...)
+	p.chunks++
+	return nil
+}
+
+func (p *recordingAudioProcessor) EndSession(ctx context.Context, sessionID string) (*services.AudioProcessingResult, error) {
+	if p.endErr != nil {
+		return nil, p.endErr
+	}
+	return &services.AudioProcessingResult{
+		Status:      entities.Completed,
+		Segments:    []entities.TranscriptSegment{{Speaker: "Speaker A", Text: "Recorded earlier", StartTime: 0, EndTime: 2, Confidence: 0.9}},
+		FirebaseURL: "gs://bucket/meetings/m/audio/session-1.wav",
+	}, nil
+}
+
+func (p *recordingAudioProcessor) AbortSession(ctx context.Context, sessionID string) error {
+	return nil
+}
+
+// stubAudioProcessorFactory hands out the same processor for every provider but "unknown"
+type stubAudioProcessorFactory struct {
+	processor *recordingAudioProcessor
+}
+
+func (f *stubAudioProcessorFactory) CreateProcessor(mode services.ProcessingMode, options services.AudioProcessingOptions) (services.AudioProcessor, error) {
+	return f.processor, nil
+}
+
+func (f *stubAudioProcessorFactory) GetAvailableProviders() []string {
+	return []string{"assemblyai"}
+}
+
+func (f *stubAudioProcessorFactory) GetProviderCapabilities(provider string) (*services.ProviderCapabilities, error) {
+	if provider == "unknown" {
+		return nil, fmt.Errorf("unknown provider: %s", provider)
+	}
+	return &services.ProviderCapabilities{Provider: provider, SupportsBatch: true, SupportedFormats: []string{"wav", "mp3"}}, nil
+}
+
+// stubAudioFetcher serves the same recording for every public URL
+type stubAudioFetcher struct {
+	audio []byte
+}
+
+func (f *stubAudioFetcher) ValidateURL(ctx context.Context, rawURL string) error {
+	if strings.Contains(rawURL, "169.254.169.254") {
+		return domain.NewDomainError("INVALID_AUDIO_URL", "Audio URL must point to a public host", domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+func (f *stubAudioFetcher) Fetch(ctx context.Context, rawURL string) (io.ReadCloser, string, error) {
+	return io.NopCloser(bytes.NewReader(f.audio)), "audio/mpeg", nil
+}
+
+type audioUploadTest struct {
+	service           *AudioUploadService
+	processor         *recordingAudioProcessor
+	transcriptionRepo *memoryTranscriptionRepository
+	meetingRepo       *memoryMeetingRepository
+	dir               string
+}
+
+func newTestAudioUploadService(t *testing.T) *audioUploadTest {
+	dir := t.TempDir()
+	store, err := providers.NewLocalAudioStore(dir)
+	require.NoError(t, err)
+
+	test := &audioUploadTest{
+		processor: &recordingAudioProcessor{},
+		transcriptionRepo: &memoryTranscriptionRepository{
+			transcriptions: make(map[string]entities.Transcription),
+			segments:       make(map[string][]entities.TranscriptSegment),
+		},
+		meetingRepo: &memoryMeetingRepository{meetings: make(map[string]*meetingRepos.Meeting)},
+		dir:         dir,
+	}
+	test.service = NewAudioUploadService(
+		test.transcriptionRepo,
+		test.meetingRepo,
+		&emptyVocabularyRepository{},
+		&emptyRedactionSettingsRepository{},
+		&stubAudioProcessorFactory{processor: test.processor},
+		events.NewMemoryEventBus(),
+		jobstest.NewMemoryProcessingJobRepository(),
+		store,
+	)
+	return test
+}
+
+// waitForJob waits until a transcribe job has finished
+func (test *audioUploadTest) waitForJob(t *testing.T, jobID string) *jobEntities.ProcessingJob {
+	var job *jobEntities.ProcessingJob
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = test.service.GetJob(context.Background(), jobID, "owner")
+		return err == nil && (job.IsCompleted() || job.IsFailed())
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+// storedFiles lists the recordings left in the upload directory
+func (test *audioUploadTest) storedFiles(t *testing.T) []string {
+	entries, err := os.ReadDir(test.dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestAudioUploadService_Upload(t *testing.T) {
+	test := newTestAudioUploadService(t)
+	audio := bytes.Repeat([]byte("RIFF"), audioUploadChunkSize/4+10)
+
+	upload, err := test.service.Upload(context.Background(), commands.UploadAudioCommand{
+		UserID:             "owner",
+		FileName:           "Zoom Recording.WAV",
+		Language:           "en",
+		SpeakerDiarization: true,
+	}, bytes.NewReader(audio))
+	require.NoError(t, err)
+	assert.True(t, upload.Result.MeetingCreated)
+	assert.Equal(t, "wav", upload.Result.Format)
+	assert.Equal(t, jobEntities.TranscribeJobType, upload.Job.JobType)
+	assert.Equal(t, upload.Result.TranscriptionID, upload.Job.EntityID)
+
+	job := test.waitForJob(t, upload.Job.GetID())
+	require.True(t, job.IsCompleted(), job.ErrorMessage)
+
+	result := AudioUploadJobResult(job)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.SegmentCount)
+	assert.Equal(t, upload.Result.MeetingID, result.MeetingID)
+
+	// The whole recording is sent through the batch pipeline in chunks
+	assert.Equal(t, audio, test.processor.audio)
+	assert.Equal(t, 2, test.processor.chunks)
+	assert.Equal(t, commands.DefaultUploadProvider, test.processor.options.Provider)
+	assert.Equal(t, services.BatchMode, test.processor.options.Mode)
+	assert.True(t, test.processor.options.SpeakerDiarization)
+
+	transcription := test.transcriptionRepo.transcriptions[result.TranscriptionID]
+	assert.Equal(t, entities.Completed, transcription.Status)
+	assert.Equal(t, "gs://bucket/meetings/m/audio/session-1.wav", transcription.AudioFilePath)
+	assert.Len(t, test.transcriptionRepo.segments[result.TranscriptionID], 1)
+
+	meeting := test.meetingRepo.meetings[result.MeetingID]
+	assert.Equal(t, "Zoom Recording", meeting.Title)
+	assert.Equal(t, meetingRepos.MeetingStatusCompleted, meeting.Status)
+
+	// The stored recording is removed once transcribed
+	assert.Empty(t, test.storedFiles(t))
+
+	_, err = test.service.GetJob(context.Background(), job.GetID(), "someone-else")
+	assert.ErrorContains(t, err, "Transcribe job not found")
+}
+
+func TestAudioUploadService_UploadValidation(t *testing.T) {
+	test := newTestAudioUploadService(t)
+	meeting := &meetingRepos.Meeting{UserID: "owner", Title: "Planning"}
+	meeting.ID = "meeting-1"
+	test.meetingRepo.meetings[meeting.ID] = meeting
+	ctx := context.Background()
+
+	_, err := test.service.Upload(ctx, commands.UploadAudioCommand{UserID: "owner", FileName: "recording.ogg"}, strings.NewReader("OggS"))
+	assert.ErrorContains(t, err, "Provider assemblyai supports wav, mp3 audio")
+
+	_, err = test.service.Upload(ctx, commands.UploadAudioCommand{UserID: "owner", FileName: "recording", ContentType: "audio/mpeg", Provider: "unknown"}, strings.NewReader("ID3"))
+	assert.ErrorContains(t, err, "unknown provider")
+
+	_, err = test.service.Upload(ctx, commands.UploadAudioCommand{UserID: "someone-else", MeetingID: "meeting-1", FileName: "a.mp3"}, strings.NewReader("ID3"))
+	assert.ErrorContains(t, err, "access denied")
+
+	_, err = test.service.Upload(ctx, commands.UploadAudioCommand{UserID: "owner", FileName: "a.mp3"}, strings.NewReader(""))
+	assert.ErrorContains(t, err, "Audio file is empty")
+
+	// Rejected uploads leave no meetings, transcriptions or files behind
+	assert.Len(t, test.meetingRepo.meetings, 1)
+	assert.Empty(t, test.transcriptionRepo.transcriptions)
+	assert.Empty(t, test.storedFiles(t))
+
+	// Recordings can be added to an existing meeting
+	upload, err := test.service.Upload(ctx, commands.UploadAudioCommand{UserID: "owner", MeetingID: "meeting-1", FileName: "a.mp3"}, strings.NewReader("ID3"))
+	require.NoError(t, err)
+	assert.False(t, upload.Result.MeetingCreated)
+	assert.Equal(t, "meeting-1", upload.Result.MeetingID)
+	test.waitForJob(t, upload.Job.GetID())
+}
+
+func TestAudioUploadService_UploadFromURL(t *testing.T) {
+	test := newTestAudioUploadService(t)
+	ctx := context.Background()
+	cmd := commands.UploadAudioCommand{UserID: "owner"}
+
+	_, err := test.service.UploadFromURL(ctx, cmd, "https://example.com/recordings/standup.mp3")
+	assert.ErrorContains(t, err, "not enabled")
+
+	test.service.WithFetcher(&stubAudioFetcher{audio: []byte("ID3-recording")})
+
+	_, err = test.service.UploadFromURL(ctx, cmd, "http://169.254.169.254/latest/meta-data")
+	assert.ErrorContains(t, err, "must point to a public host")
+	_, err = test.service.UploadFromURL(ctx, cmd, "https://example.com/recordings/standup.ogg")
+	assert.ErrorContains(t, err, "supports wav, mp3")
+
+	upload, err := test.service.UploadFromURL(ctx, cmd, "https://example.com/recordings/standup.mp3?token=abc")
+	require.NoError(t, err)
+	assert.Equal(t, "mp3", upload.Result.Format)
+
+	job := test.waitForJob(t, upload.Job.GetID())
+	require.True(t, job.IsCompleted(), job.ErrorMessage)
+	assert.Equal(t, "ID3-recording", string(test.processor.audio))
+	assert.Equal(t, "standup", test.meetingRepo.meetings[upload.Result.MeetingID].Title)
+	assert.Empty(t, test.storedFiles(t))
+}
+
+func TestAudioUploadService_FailedTranscription(t *testing.T) {
+	test := newTestAudioUploadService(t)
+	test.processor.endErr = fmt.Errorf("provider unavailable")
+
+	upload, err := test.service.Upload(context.Background(), commands.UploadAudioCommand{UserID: "owner", FileName: "a.wav"}, strings.NewReader("RIFF"))
+	require.NoError(t, err)
+
+	job := test.waitForJob(t, upload.Job.GetID())
+	assert.True(t, job.IsFailed())
+	assert.Contains(t, job.ErrorMessage, "Failed to end audio session")
+	assert.Nil(t, AudioUploadJobResult(job))
+
+	assert.Equal(t, entities.Failed, test.transcriptionRepo.transcriptions[upload.Result.TranscriptionID].Status)
+	assert.Equal(t, meetingRepos.MeetingStatusFailed, test.meetingRepo.meetings[upload.Result.MeetingID].Status)
+	assert.Empty(t, test.storedFiles(t))
+}
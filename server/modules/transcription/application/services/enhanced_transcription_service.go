@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"strings"
 	"time"
 
 	meetingRepos "teammate/server/modules/meeting/domain/repositories"
@@ -40,11 +39,13 @@ type EnhancedTranscriptionService struct {
 func NewEnhancedTranscriptionService(
 	transcriptionRepo repositories.TranscriptionRepository,
 	meetingRepo meetingRepos.MeetingRepository,
+	vocabularyRepo repositories.VocabularyRepository,
+	redactionRepo repositories.RedactionSettingsRepository,
 	audioFactory *AudioProcessorFactory,
 	eventBus events.EventBus,
 ) *EnhancedTranscriptionService {
 	return &EnhancedTranscriptionService{
-		startHandler:      commands.NewStartTranscriptionHandler(transcriptionRepo, meetingRepo, audioFactory, eventBus),
+		startHandler:      commands.NewStartTranscriptionHandler(transcriptionRepo, meetingRepo, vocabularyRepo, redactionRepo, audioFactory, eventBus),
 		processHandler:    commands.NewProcessAudioChunkHandler(transcriptionRepo, eventBus),
 		completeHandler:   commands.NewCompleteTranscriptionHandler(transcriptionRepo, meetingRepo, eventBus),
 		historyHandler:    queries.NewGetTranscriptionHistoryHandler(transcriptionRepo),
@@ -73,7 +74,7 @@ func (s *EnhancedTranscriptionService) StartTranscriptionSession(ctx context.Con
 	}
 
 	// Create audio processor for session management using concrete factory
-	processor, err := s.concreteFactory.CreateProcessor(req.Options.Mode, req.Options)
+	processor, err := s.concreteFactory.CreateProcessor(result.ProcessingOptions.Mode, result.ProcessingOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +88,7 @@ func (s *EnhancedTranscriptionService) StartTranscriptionSession(ctx context.Con
 		Processor:    processor,
 		BotSessionID: result.BotSessionID,
 		StartedAt:    result.StartedAt,
-		Options:      req.Options,
+		Options:      result.ProcessingOptions,
 	}
 
 	// Store active session
@@ -126,6 +127,10 @@ func (s *EnhancedTranscriptionService) EndTranscriptionSession(ctx context.Conte
 		MeetingID:       session.MeetingID,
 		BotSessionID:    session.BotSessionID,
 		Processor:       session.Processor,
+		SpellingRules:   session.Options.CustomSpelling,
+		RedactPII:       session.Options.RedactPII,
+		RedactPIIAudio:  session.Options.RedactPIIAudio,
+		PIIPolicies:     session.Options.PIIPolicies,
 	}
 
 	result, err := s.completeHandler.Handle(ctx, cmd)
@@ -274,34 +279,11 @@ type TranscriptionCompletedEvent struct {
 // These should be methods on the Transcription aggregate root
 
 func (s *EnhancedTranscriptionService) segmentsToText(segments []entities.TranscriptSegment) string {
-	// TODO: This should be transcription.GenerateContentFromSegments()
-	var text string
-	for _, segment := range segments {
-		// Skip segments with empty text to avoid creating malformed content
-		if strings.TrimSpace(segment.Text) == "" {
-			continue
-		}
-
-		// Handle speaker identification properly - only normalize truly unknown speakers
-		if segment.Speaker != "" && segment.Speaker != "speaker_unknown" {
-			text += segment.Speaker + ": " + segment.Text + "\n"
-		} else {
-			text += segment.Text + "\n"
-		}
-	}
-	return text
+	transcription := entities.Transcription{Segments: segments}
+	return transcription.GenerateContentFromSegments()
 }
 
 func (s *EnhancedTranscriptionService) calculateAverageConfidence(segments []entities.TranscriptSegment) float64 {
-	// TODO: This should be transcription.CalculateConfidence()
-	if len(segments) == 0 {
-		return 0.0
-	}
-
-	var total float64
-	for _, segment := range segments {
-		total += segment.Confidence
-	}
-
-	return total / float64(len(segments))
+	transcription := entities.Transcription{Segments: segments}
+	return transcription.CalculateConfidence()
 }
@@ -0,0 +1,432 @@
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+)
+
+const (
+	// ArchiveFormatVersion is the version of the archive layout described by its manifest
+	ArchiveFormatVersion = 1
+	// DefaultArchiveFormat is the human-readable transcript format used when none is chosen
+	DefaultArchiveFormat = "md"
+)
+
+// ArchiveOptions selects what an archive of a user's meetings contains
+type ArchiveOptions struct {
+	Format       string `json:"format"`             // Human-readable transcript format, written alongside JSON
+	Template     string `json:"template,omitempty"` // Template name for md and html transcripts
+	IncludeAudio bool   `json:"include_audio"`      // Include the recorded audio of each transcription
+}
+
+// AudioSource opens the recorded audio a transcription refers to
+type AudioSource interface {
+	OpenAudio(ctx context.Context, filePath string) (io.ReadCloser, error)
+}
+
+// ArchiveManifest describes the contents of an archive, stored in it as manifest.json
+type ArchiveManifest struct {
+	FormatVersion int               `json:"format_version"`
+	GeneratedAt   time.Time         `json:"generated_at"`
+	UserID        string            `json:"user_id"`
+	Format        string            `json:"format"`
+	IncludeAudio  bool              `json:"include_audio"`
+	Meetings      []ArchivedMeeting `json:"meetings"`
+}
+
+// ArchivedMeeting lists the files written for a meeting and the parts that could not be exported
+type ArchivedMeeting struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	StartTime time.Time `json:"start_time"`
+	Folder    string    `json:"folder"`
+	Files     []string  `json:"files"`
+	Errors    []string  `json:"errors,omitempty"`
+}
+
+// ArchiveProgress reports how far an archive job has got, stored in its payload as "progress"
+type ArchiveProgress struct {
+	MeetingsTotal int `json:"meetings_total"`
+	MeetingsDone  int `json:"meetings_done"`
+	Percent       int `json:"percent"`
+}
+
+// archiveMeetingInfo is the meeting.json file of an archived meeting
+type archiveMeetingInfo struct {
+	ID         string     `json:"id"`
+	Title      string     `json:"title"`
+	Type       string     `json:"type"`
+	Status     string     `json:"status"`
+	StartTime  time.Time  `json:"start_time"`
+	EndTime    *time.Time `json:"end_time,omitempty"`
+	MeetingURL string     `json:"meeting_url,omitempty"`
+}
+
+// ExportArchive queues a background job building a zip archive of all of the user's meetings.
+// Progress and, once finished, the download link are available from GetJob.
+func (s *TranscriptExportService) ExportArchive(ctx context.Context, userID string, options ArchiveOptions) (*jobEntities.ProcessingJob, error) {
+	if options.Format == "" {
+		options.Format = DefaultArchiveFormat
+	}
+	options.Format = strings.ToLower(options.Format)
+	if options.Format == "markdown" {
+		options.Format = "md"
+	}
+	if options.Format == "json" {
+		return nil, domain.NewDomainError("INVALID_ARCHIVE_OPTIONS", "format must be a human-readable format, JSON is always included", domain.ErrInvalidInput)
+	}
+	if err := s.exportService.ValidateExportOptions(&ExportOptions{Format: options.Format}); err != nil {
+		return nil, domain.NewDomainError("INVALID_ARCHIVE_OPTIONS", err.Error(), domain.ErrInvalidInput)
+	}
+	if options.IncludeAudio && s.audioSource == nil {
+		return nil, domain.NewDomainError("AUDIO_EXPORT_UNAVAILABLE", "Audio storage is not configured", domain.ErrInvalidInput)
+	}
+
+	job := jobEntities.NewProcessingJob("user", userID, jobEntities.ArchiveExportJobType, map[string]interface{}{
+		"user_id":  userID,
+		"format":   "zip",
+		"options":  options,
+		"progress": ArchiveProgress{},
+	})
+	if err := s.jobRepo.Save(ctx, &job); err != nil {
+		return nil, domain.NewDomainError("SAVE_EXPORT_JOB_FAILED", "Failed to queue archive export", err)
+	}
+
+	s.archiveRunner.Enqueue(job.GetID())
+	return &job, nil
+}
+
+// ArchiveJobProgress returns the progress of an archive job, or nil for other jobs
+func ArchiveJobProgress(job *jobEntities.ProcessingJob) *ArchiveProgress {
+	var progress ArchiveProgress
+	if job.JobType != jobEntities.ArchiveExportJobType || decodePayloadValue(job, "progress", &progress) != nil {
+		return nil
+	}
+	return &progress
+}
+
+// runArchiveJob writes every meeting of the job's user into a zip archive and uploads it.
+// The archive is built in a temporary file so meetings with long recordings are never held
+// in memory. A meeting part that cannot be exported is listed in the manifest instead of
+// failing the whole archive.
+func (s *TranscriptExportService) runArchiveJob(ctx context.Context, job *jobEntities.ProcessingJob) (*ExportResult, error) {
+	var options ArchiveOptions
+	if err := decodePayloadValue(job, "options", &options); err != nil {
+		return nil, err
+	}
+	userID := job.EntityID
+
+	meetings, err := s.meetingRepo.FindMeetingsByUserID(ctx, userID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_MEETINGS_FAILED", "Failed to get meetings", err)
+	}
+	active := meetings[:0]
+	for _, meeting := range meetings {
+		if meeting.DeletedAt == nil {
+			active = append(active, meeting)
+		}
+	}
+	meetings = active
+
+	file, err := os.CreateTemp("", "meetings_archive_*.zip")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	generatedAt := time.Now()
+	manifest := ArchiveManifest{
+		FormatVersion: ArchiveFormatVersion,
+		GeneratedAt:   generatedAt,
+		UserID:        userID,
+		Format:        options.Format,
+		IncludeAudio:  options.IncludeAudio,
+		Meetings:      make([]ArchivedMeeting, 0, len(meetings)),
+	}
+
+	archive := zip.NewWriter(file)
+	s.updateArchiveProgress(ctx, job, 0, len(meetings))
+	for i, meeting := range meetings {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		archived, err := s.archiveMeeting(ctx, archive, meeting, userID, options)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Meetings = append(manifest.Meetings, *archived)
+		s.updateArchiveProgress(ctx, job, i+1, len(meetings))
+	}
+
+	if err := writeArchiveJSON(archive, "manifest.json", manifest); err != nil {
+		return nil, err
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write archive: %w", err)
+	}
+
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("failed to read archive: %w", err)
+	}
+
+	return s.exportService.StoreDocumentStream(ctx, file, size, &ExportDocument{
+		FileName:    fmt.Sprintf("meetings_archive_%s.zip", generatedAt.Format("20060102_150405")),
+		ObjectName:  fmt.Sprintf("meetings_archive_%s.zip", job.GetID()),
+		Format:      "zip",
+		ContentType: "application/zip",
+		GeneratedAt: generatedAt,
+		Metadata: map[string]interface{}{
+			"meetings_count": len(meetings),
+		},
+	})
+}
+
+// archiveMeeting writes the folder of a meeting. Only errors writing the archive itself are
+// returned; parts of the meeting that cannot be loaded are recorded in the manifest entry.
+func (s *TranscriptExportService) archiveMeeting(ctx context.Context, archive *zip.Writer, meeting *meetingRepos.Meeting, userID string, options ArchiveOptions) (*ArchivedMeeting, error) {
+	archived := &ArchivedMeeting{
+		ID:        meeting.ID,
+		Title:     meeting.Title,
+		StartTime: meeting.StartTime,
+		Folder:    archiveFolder(meeting),
+		Files:     []string{},
+	}
+	write := func(name string, value interface{}) error {
+		filePath := archived.Folder + name
+		if err := writeArchiveJSON(archive, filePath, value); err != nil {
+			return err
+		}
+		archived.Files = append(archived.Files, filePath)
+		return nil
+	}
+	fail := func(part string, err error) {
+		log.Printf("Archive of meeting %s: failed to export %s: %v", meeting.ID, part, err)
+		archived.Errors = append(archived.Errors, fmt.Sprintf("%s: %v", part, err))
+	}
+
+	if err := write("meeting.json", archiveMeetingInfo{
+		ID:         meeting.ID,
+		Title:      meeting.Title,
+		Type:       meeting.Type,
+		Status:     meeting.Status,
+		StartTime:  meeting.StartTime,
+		EndTime:    meeting.EndTime,
+		MeetingURL: meeting.MeetingURL,
+	}); err != nil {
+		return nil, err
+	}
+
+	exportContext, err := s.loadArchiveContext(ctx, meeting.ID)
+	if err != nil {
+		fail("participants", err)
+	} else {
+		if err := write("participants.json", exportContext.Participants); err != nil {
+			return nil, err
+		}
+		if exportContext.ActionItems != nil {
+			if err := write("action_items.json", exportContext.ActionItems); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	transcriptions, err := s.transcriptionRepo.FindByMeetingID(ctx, meeting.ID)
+	if err != nil {
+		fail("transcripts", err)
+		return archived, nil
+	}
+	for _, transcription := range transcriptions {
+		if err := s.archiveTranscription(ctx, archive, archived, transcription, userID, options, fail); err != nil {
+			return nil, err
+		}
+	}
+	return archived, nil
+}
+
+// archiveTranscription writes the transcripts, analytics and audio of a transcription
+func (s *TranscriptExportService) archiveTranscription(ctx context.Context, archive *zip.Writer, archived *ArchivedMeeting, transcription *entities.Transcription, userID string, options ArchiveOptions, fail func(string, error)) error {
+	id := transcription.GetID()
+	prefix := archived.Folder + "transcripts/" + id
+
+	item, err := s.historyItem(ctx, transcription)
+	if err != nil {
+		fail("transcript "+id, err)
+		return nil
+	}
+
+	for _, format := range []string{"json", options.Format} {
+		document, err := s.exportService.RenderTranscription(ctx, item, &ExportOptions{
+			Format:            format,
+			IncludeMetadata:   true,
+			IncludeSpeakers:   true,
+			IncludeTimestamps: true,
+			IncludeStats:      true,
+			Template:          options.Template,
+			UserID:            userID,
+		})
+		if err != nil {
+			fail(fmt.Sprintf("%s transcript %s", format, id), err)
+			continue
+		}
+		if err := writeArchiveFile(archive, prefix+"."+document.Format, document.Data); err != nil {
+			return err
+		}
+		archived.Files = append(archived.Files, prefix+"."+document.Format)
+	}
+
+	if s.analyticsService != nil {
+		analytics, err := s.analyticsService.GetTranscriptionAnalytics(ctx, id)
+		if err != nil {
+			fail("analytics "+id, err)
+		} else {
+			filePath := archived.Folder + "analytics/" + id + ".json"
+			if err := writeArchiveJSON(archive, filePath, analytics); err != nil {
+				return err
+			}
+			archived.Files = append(archived.Files, filePath)
+		}
+	}
+
+	if options.IncludeAudio && transcription.AudioFilePath != "" {
+		filePath := archived.Folder + "audio/" + id + audioExtension(transcription.AudioFilePath)
+		if err := s.archiveAudio(ctx, archive, filePath, transcription.AudioFilePath); err != nil {
+			fail("audio "+id, err)
+		} else {
+			archived.Files = append(archived.Files, filePath)
+		}
+	}
+	return nil
+}
+
+// archiveAudio copies a recording into the archive. Audio is already compressed, so it is stored as is.
+func (s *TranscriptExportService) archiveAudio(ctx context.Context, archive *zip.Writer, filePath, audioFilePath string) error {
+	reader, err := s.audioSource.OpenAudio(ctx, audioFilePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	writer, err := archive.CreateHeader(&zip.FileHeader{Name: filePath, Method: zip.Store, Modified: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	_, err = io.Copy(writer, reader)
+	return err
+}
+
+// loadArchiveContext loads the participants and action items of a meeting. Without an export
+// context repository, participants come from the meeting repository and action items are left out.
+func (s *TranscriptExportService) loadArchiveContext(ctx context.Context, meetingID string) (*repositories.ExportContext, error) {
+	if s.exportService.exportContext != nil {
+		return s.exportService.exportContext.FindByMeetingID(ctx, meetingID)
+	}
+
+	participants, err := s.meetingRepo.FindParticipantsByMeetingID(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	exportContext := &repositories.ExportContext{Participants: make([]repositories.ExportParticipant, 0, len(participants))}
+	for _, participant := range participants {
+		exported := repositories.ExportParticipant{Name: participant.Name}
+		if participant.Email != nil {
+			exported.Email = *participant.Email
+		}
+		if participant.Role != nil {
+			exported.Role = *participant.Role
+		}
+		exportContext.Participants = append(exportContext.Participants, exported)
+	}
+	return exportContext, nil
+}
+
+// updateArchiveProgress records how many meetings have been archived on the job
+func (s *TranscriptExportService) updateArchiveProgress(ctx context.Context, job *jobEntities.ProcessingJob, done, total int) {
+	percent := 100
+	if total > 0 {
+		percent = done * 100 / total
+	}
+	job.SetPayloadValue("progress", ArchiveProgress{MeetingsTotal: total, MeetingsDone: done, Percent: percent})
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		log.Printf("Failed to update archive job %s: %v", job.GetID(), err)
+	}
+}
+
+// writeArchiveJSON writes an indented JSON file into the archive
+func writeArchiveJSON(archive *zip.Writer, name string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	return writeArchiveFile(archive, name, data)
+}
+
+// writeArchiveFile writes a compressed file into the archive
+func writeArchiveFile(archive *zip.Writer, name string, data []byte) error {
+	writer, err := archive.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	if _, err := writer.Write(data); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	return nil
+}
+
+// archiveFolder names the folder of a meeting after its date and title, e.g.
+// "meetings/2024-03-01_weekly-sync_1a2b3c4d/". The ID suffix keeps folders unique.
+func archiveFolder(meeting *meetingRepos.Meeting) string {
+	var slug strings.Builder
+	for _, r := range strings.ToLower(meeting.Title) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			slug.WriteRune(r)
+		case slug.Len() > 0 && !strings.HasSuffix(slug.String(), "-"):
+			slug.WriteRune('-')
+		}
+		if slug.Len() >= 40 {
+			break
+		}
+	}
+	name := strings.Trim(slug.String(), "-")
+	if name == "" {
+		name = "meeting"
+	}
+
+	id := meeting.ID
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	return fmt.Sprintf("meetings/%s_%s_%s/", meeting.StartTime.Format("2006-01-02"), name, id)
+}
+
+// audioExtension returns the file extension of an audio path or URL, defaulting to .wav
+func audioExtension(audioFilePath string) string {
+	if parsed, err := url.Parse(audioFilePath); err == nil && parsed.Path != "" {
+		audioFilePath = parsed.Path
+	}
+	if ext := path.Ext(audioFilePath); ext != "" && len(ext) <= 5 {
+		return ext
+	}
+	return ".wav"
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	jobEntities "teammate/server/seedwork/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubAudioSource serves the same recording for every path
+type stubAudioSource struct {
+	audio []byte
+}
+
+func (s *stubAudioSource) OpenAudio(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(s.audio)), nil
+}
+
+func readArchive(t *testing.T, data []byte) map[string][]byte {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	files := make(map[string][]byte)
+	for _, file := range reader.File {
+		content, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(content)
+		require.NoError(t, err)
+		content.Close()
+	}
+	return files
+}
+
+func TestTranscriptExportService_ExportArchive(t *testing.T) {
+	service, uploader := newTestTranscriptExportService(3)
+	meetingRepo := service.meetingRepo.(*stubMeetingRepository)
+	meetingRepo.meeting.Title = "Weekly Sync: Q3 Roadmap"
+	meetingRepo.meeting.StartTime = time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	email := "anna@example.com"
+	meetingRepo.participants = []*meetingRepos.Participant{{Name: "Анна", Email: &email}}
+	service.transcriptionRepo.(*stubTranscriptionRepository).transcription.AudioFilePath = "gs://bucket/meetings/meeting-1/audio/session_1.wav"
+	service.WithAnalytics(NewAnalyticsService(service.transcriptionRepo, service.meetingRepo)).
+		WithAudioSource(&stubAudioSource{audio: []byte("RIFF-audio")})
+
+	job, err := service.ExportArchive(context.Background(), "owner", ArchiveOptions{Format: "Markdown", IncludeAudio: true})
+	require.NoError(t, err)
+	assert.Equal(t, jobEntities.ArchiveExportJobType, job.JobType)
+
+	require.Eventually(t, func() bool {
+		job, err = service.GetJob(context.Background(), job.GetID(), "owner")
+		return err == nil && job.IsCompleted()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	result := ExportJobResult(job)
+	require.NotNil(t, result)
+	assert.Equal(t, "zip", result.Format)
+	assert.Equal(t, int64(len(uploader.data)), result.FileSize)
+	assert.Equal(t, &ArchiveProgress{MeetingsTotal: 1, MeetingsDone: 1, Percent: 100}, ArchiveJobProgress(job))
+
+	files := readArchive(t, uploader.data)
+	folder := "meetings/2024-03-01_weekly-sync-q3-roadmap_meeting-/"
+
+	var manifest ArchiveManifest
+	require.NoError(t, json.Unmarshal(files["manifest.json"], &manifest))
+	assert.Equal(t, ArchiveFormatVersion, manifest.FormatVersion)
+	assert.Equal(t, "md", manifest.Format)
+	require.Len(t, manifest.Meetings, 1)
+	assert.Equal(t, folder, manifest.Meetings[0].Folder)
+	assert.Empty(t, manifest.Meetings[0].Errors)
+	for _, name := range manifest.Meetings[0].Files {
+		assert.Contains(t, files, name)
+	}
+
+	assert.Contains(t, string(files[folder+"meeting.json"]), "Weekly Sync: Q3 Roadmap")
+	assert.Contains(t, string(files[folder+"participants.json"]), "anna@example.com")
+	assert.Contains(t, string(files[folder+"transcripts/tr-1.json"]), "Segment 2")
+	assert.Contains(t, string(files[folder+"transcripts/tr-1.md"]), "Segment 2")
+	assert.Contains(t, string(files[folder+"analytics/tr-1.json"]), `"transcription_id": "tr-1"`)
+	assert.Equal(t, "RIFF-audio", string(files[folder+"audio/tr-1.wav"]))
+
+	_, err = service.GetJob(context.Background(), job.GetID(), "someone-else")
+	assert.ErrorContains(t, err, "Export job not found")
+}
+
+func TestTranscriptExportService_ConcurrentArchives(t *testing.T) {
+	service, uploader := newTestTranscriptExportService(3)
+
+	// Archives started in the same second by different users are stored apart
+	owner, err := service.ExportArchive(context.Background(), "owner", ArchiveOptions{})
+	require.NoError(t, err)
+	other, err := service.ExportArchive(context.Background(), "someone-else", ArchiveOptions{})
+	require.NoError(t, err)
+
+	results := make(map[string]*ExportResult)
+	require.Eventually(t, func() bool {
+		for userID, job := range map[string]*jobEntities.ProcessingJob{"owner": owner, "someone-else": other} {
+			job, err := service.GetJob(context.Background(), job.GetID(), userID)
+			if err != nil || !job.IsCompleted() {
+				return false
+			}
+			results[userID] = ExportJobResult(job)
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.Len(t, uploader.documents, 2)
+	assert.NotEqual(t, results["owner"].DownloadURL, results["someone-else"].DownloadURL)
+	for userID, result := range results {
+		assert.Regexp(t, `^meetings_archive_\d{8}_\d{6}\.zip$`, result.FileName)
+		objectName := strings.TrimPrefix(result.DownloadURL, "https://storage.example.com/")
+		require.Contains(t, uploader.documents, objectName)
+
+		var manifest ArchiveManifest
+		require.NoError(t, json.Unmarshal(readArchive(t, uploader.documents[objectName])["manifest.json"], &manifest))
+		assert.Equal(t, userID, manifest.UserID)
+	}
+}
+
+func TestTranscriptExportService_ExportArchiveValidation(t *testing.T) {
+	service, _ := newTestTranscriptExportService(1)
+
+	_, err := service.ExportArchive(context.Background(), "owner", ArchiveOptions{Format: "json"})
+	assert.ErrorContains(t, err, "human-readable")
+	_, err = service.ExportArchive(context.Background(), "owner", ArchiveOptions{Format: "xls"})
+	assert.ErrorContains(t, err, "unsupported format")
+	_, err = service.ExportArchive(context.Background(), "owner", ArchiveOptions{IncludeAudio: true})
+	assert.ErrorContains(t, err, "Audio storage is not configured")
+}
+
+func TestArchiveFolder(t *testing.T) {
+	meeting := &meetingRepos.Meeting{Title: "  Планёрка / Daily!! ", StartTime: time.Date(2024, 5, 2, 9, 0, 0, 0, time.UTC)}
+	meeting.ID = "1a2b3c4d-5e6f"
+	assert.Equal(t, "meetings/2024-05-02_планёрка-daily_1a2b3c4d/", archiveFolder(meeting))
+
+	meeting.Title = strings.Repeat("long title ", 10)
+	folder := archiveFolder(meeting)
+	assert.LessOrEqual(t, len(folder), len("meetings/2024-05-02__1a2b3c4d/")+40)
+
+	meeting.Title = "???"
+	assert.Equal(t, "meetings/2024-05-02_meeting_1a2b3c4d/", archiveFolder(meeting))
+}
+
+func TestAudioExtension(t *testing.T) {
+	assert.Equal(t, ".wav", audioExtension("gs://bucket/meetings/m/audio/s_1.wav"))
+	assert.Equal(t, ".mp3", audioExtension("https://storage.googleapis.com/bucket/a.mp3?X-Goog-Signature=abc"))
+	assert.Equal(t, ".wav", audioExtension("recording"))
+}
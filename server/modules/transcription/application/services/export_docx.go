@@ -0,0 +1,197 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const docxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>
+<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>
+<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>
+<Override PartName="/docProps/app.xml" ContentType="application/vnd.openxmlformats-officedocument.extended-properties+xml"/>
+</Types>`
+
+const docxPackageRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>
+<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>
+<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties" Target="docProps/app.xml"/>
+</Relationships>`
+
+const docxDocumentRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>
+</Relationships>`
+
+const docxApp = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Properties xmlns="http://schemas.openxmlformats.org/officeDocument/2006/extended-properties"><Application>GitScribe</Application></Properties>`
+
+const docxStyles = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+<w:docDefaults>
+<w:rPrDefault><w:rPr><w:rFonts w:ascii="Calibri" w:hAnsi="Calibri" w:eastAsia="Calibri" w:cs="Calibri"/><w:sz w:val="22"/><w:szCs w:val="22"/><w:lang w:val="en-US"/></w:rPr></w:rPrDefault>
+<w:pPrDefault><w:pPr><w:spacing w:after="120" w:line="264" w:lineRule="auto"/></w:pPr></w:pPrDefault>
+</w:docDefaults>
+<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>
+<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:spacing w:after="240"/></w:pPr><w:rPr><w:b/><w:sz w:val="40"/><w:szCs w:val="40"/></w:rPr></w:style>
+<w:style w:type="paragraph" w:styleId="Heading1"><w:name w:val="heading 1"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="240" w:after="120"/><w:outlineLvl w:val="0"/></w:pPr><w:rPr><w:b/><w:color w:val="1F3864"/><w:sz w:val="28"/><w:szCs w:val="28"/></w:rPr></w:style>
+<w:style w:type="character" w:styleId="Speaker"><w:name w:val="Speaker"/><w:rPr><w:b/><w:color w:val="0052A3"/></w:rPr></w:style>
+<w:style w:type="character" w:styleId="Timestamp"><w:name w:val="Timestamp"/><w:rPr><w:color w:val="6E6E6E"/><w:sz w:val="18"/><w:szCs w:val="18"/></w:rPr></w:style>
+</w:styles>`
+
+// docxRun is a run of text with an optional character style
+type docxRun struct {
+	style string
+	bold  bool
+	text  string
+}
+
+// docxWriter builds the body of word/document.xml
+type docxWriter struct {
+	body bytes.Buffer
+}
+
+// paragraph writes a paragraph with an optional paragraph style
+func (w *docxWriter) paragraph(style string, runs ...docxRun) {
+	w.body.WriteString("<w:p>")
+	if style != "" {
+		fmt.Fprintf(&w.body, `<w:pPr><w:pStyle w:val="%s"/></w:pPr>`, style)
+	}
+	for _, run := range runs {
+		w.body.WriteString("<w:r>")
+		if run.style != "" || run.bold {
+			w.body.WriteString("<w:rPr>")
+			if run.style != "" {
+				fmt.Fprintf(&w.body, `<w:rStyle w:val="%s"/>`, run.style)
+			}
+			if run.bold {
+				w.body.WriteString("<w:b/>")
+			}
+			w.body.WriteString("</w:rPr>")
+		}
+		w.body.WriteString(`<w:t xml:space="preserve">`)
+		_ = xml.EscapeText(&w.body, []byte(run.text))
+		w.body.WriteString("</w:t></w:r>")
+	}
+	w.body.WriteString("</w:p>")
+}
+
+// document wraps the body in the document part
+func (w *docxWriter) document() []byte {
+	var document bytes.Buffer
+	document.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	document.WriteString(`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>`)
+	document.Write(w.body.Bytes())
+	// A4 page with 2cm margins
+	document.WriteString(`<w:sectPr><w:pgSz w:w="11906" w:h="16838"/><w:pgMar w:top="1134" w:right="1134" w:bottom="1134" w:left="1134" w:header="709" w:footer="709" w:gutter="0"/></w:sectPr>`)
+	document.WriteString(`</w:body></w:document>`)
+	return document.Bytes()
+}
+
+// renderDOCX builds an Office Open XML word processing package for the transcription
+func (s *ExportService) renderDOCX(transcription *TranscriptionHistoryItem, options *ExportOptions, generatedAt time.Time) ([]byte, error) {
+	title := exportTitle(options)
+
+	w := &docxWriter{}
+	w.paragraph("Title", docxRun{text: title})
+
+	if options.IncludeMetadata {
+		w.paragraph("Heading1", docxRun{text: "Details"})
+		for _, field := range [][2]string{
+			{"Meeting ID", transcription.MeetingID},
+			{"Transcription ID", transcription.ID},
+			{"Provider", transcription.Provider},
+			{"Status", string(transcription.Status)},
+			{"Confidence", fmt.Sprintf("%.2f%%", transcription.Confidence*100)},
+			{"Created", transcription.CreatedAt.Format("2006-01-02 15:04:05")},
+		} {
+			w.paragraph("", docxRun{bold: true, text: field[0] + ": "}, docxRun{text: field[1]})
+		}
+	}
+
+	if len(transcription.Segments) > 0 {
+		w.paragraph("Heading1", docxRun{text: "Transcript"})
+		for _, segment := range transcription.Segments {
+			var runs []docxRun
+			if options.IncludeTimestamps {
+				runs = append(runs, docxRun{style: "Timestamp", text: fmt.Sprintf("[%s - %s] ", s.formatTime(segment.StartTime), s.formatTime(segment.EndTime))})
+			}
+			if options.IncludeSpeakers && segment.Speaker != "" {
+				runs = append(runs, docxRun{style: "Speaker", bold: true, text: segment.Speaker + ": "})
+			}
+			runs = append(runs, docxRun{text: segment.Text})
+			if options.IncludeMetadata {
+				runs = append(runs, docxRun{style: "Timestamp", text: fmt.Sprintf(" (%.2f%%)", segment.Confidence*100)})
+			}
+			w.paragraph("", runs...)
+		}
+	} else if transcription.Content != "" {
+		w.paragraph("Heading1", docxRun{text: "Transcript"})
+		for _, line := range strings.Split(transcription.Content, "\n") {
+			if strings.TrimSpace(line) != "" {
+				w.paragraph("", docxRun{text: line})
+			}
+		}
+	}
+
+	if options.IncludeStats && transcription.Stats != nil {
+		stats := transcription.Stats
+		w.paragraph("Heading1", docxRun{text: "Statistics"})
+		for _, field := range [][2]string{
+			{"Duration", s.formatTime(stats.TotalDuration)},
+			{"Segments", fmt.Sprintf("%d", stats.SegmentCount)},
+			{"Speakers", fmt.Sprintf("%d", stats.SpeakerCount)},
+			{"Words", fmt.Sprintf("%d", stats.WordCount)},
+			{"Average confidence", fmt.Sprintf("%.2f%%", stats.AverageConfidence*100)},
+		} {
+			w.paragraph("", docxRun{bold: true, text: field[0] + ": "}, docxRun{text: field[1]})
+		}
+	}
+
+	var core bytes.Buffer
+	core.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	core.WriteString(`<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:dcterms="http://purl.org/dc/terms/" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`)
+	core.WriteString("<dc:title>")
+	_ = xml.EscapeText(&core, []byte(title))
+	core.WriteString("</dc:title><dc:creator>GitScribe</dc:creator>")
+	created := generatedAt.UTC().Format(time.RFC3339)
+	fmt.Fprintf(&core, `<dcterms:created xsi:type="dcterms:W3CDTF">%s</dcterms:created><dcterms:modified xsi:type="dcterms:W3CDTF">%s</dcterms:modified>`, created, created)
+	core.WriteString("</cp:coreProperties>")
+
+	parts := []struct {
+		name string
+		data []byte
+	}{
+		{"[Content_Types].xml", []byte(docxContentTypes)},
+		{"_rels/.rels", []byte(docxPackageRels)},
+		{"docProps/core.xml", core.Bytes()},
+		{"docProps/app.xml", []byte(docxApp)},
+		{"word/_rels/document.xml.rels", []byte(docxDocumentRels)},
+		{"word/styles.xml", []byte(docxStyles)},
+		{"word/document.xml", w.document()},
+	}
+
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for _, part := range parts {
+		writer, err := archive.CreateHeader(&zip.FileHeader{Name: part.name, Method: zip.Deflate, Modified: generatedAt})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DOCX part %s: %w", part.name, err)
+		}
+		if _, err := writer.Write(part.data); err != nil {
+			return nil, fmt.Errorf("failed to write DOCX part %s: %w", part.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish DOCX package: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
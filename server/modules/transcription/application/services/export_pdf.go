@@ -0,0 +1,238 @@
+package services
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"time"
+	"unicode"
+
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+)
+
+const pdfFontFamily = "transcript"
+
+// shapedScripts are scripts whose letters change form with their neighbours or run right to left.
+// The PDF renderer places glyphs one by one from left to right, so they are never shown correctly.
+var shapedScripts = []*unicode.RangeTable{
+	unicode.Arabic, unicode.Hebrew, unicode.Syriac, unicode.Thaana, unicode.Nko,
+	unicode.Devanagari, unicode.Bengali, unicode.Gurmukhi, unicode.Gujarati, unicode.Oriya,
+	unicode.Tamil, unicode.Telugu, unicode.Kannada, unicode.Malayalam, unicode.Sinhala,
+	unicode.Thai, unicode.Lao, unicode.Tibetan, unicode.Myanmar, unicode.Khmer,
+}
+
+// PDFFont is the TrueType font family used to render PDF exports.
+// The default Go fonts cover Latin, Greek and Cyrillic transcripts; CJK needs a font with
+// those glyphs such as Noto Sans CJK. Scripts that need shaping or right-to-left layout
+// (e.g. Arabic, Hebrew, Devanagari) are not supported whatever the font.
+type PDFFont struct {
+	Regular []byte
+	Bold    []byte
+
+	parsed *sfnt.Font // Regular, parsed once to look up glyphs
+}
+
+// DefaultPDFFont returns the Go font family bundled with the server
+func DefaultPDFFont() PDFFont {
+	parsed, err := sfnt.Parse(goregular.TTF)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the bundled PDF font: %v", err))
+	}
+	return PDFFont{Regular: goregular.TTF, Bold: gobold.TTF, parsed: parsed}
+}
+
+// LoadPDFFont reads a TrueType font family from disk. The bold file is optional;
+// without it the regular font is also used for bold text.
+func LoadPDFFont(regularPath, boldPath string) (PDFFont, error) {
+	regular, parsed, err := readPDFFont(regularPath)
+	if err != nil {
+		return PDFFont{}, err
+	}
+
+	font := PDFFont{Regular: regular, Bold: regular, parsed: parsed}
+	if boldPath != "" {
+		if font.Bold, _, err = readPDFFont(boldPath); err != nil {
+			return PDFFont{}, err
+		}
+	}
+	return font, nil
+}
+
+// readPDFFont reads and parses a font file, rejecting files that are not TrueType fonts. OpenType
+// fonts with CFF outlines ("OTTO") cannot be embedded by the PDF renderer.
+func readPDFFont(path string) ([]byte, *sfnt.Font, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read PDF font %s: %w", path, err)
+	}
+	if bytes.HasPrefix(data, []byte("OTTO")) {
+		return nil, nil, fmt.Errorf("failed to read PDF font %s: only TrueType outlines are supported", path)
+	}
+	parsed, err := sfnt.Parse(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read PDF font %s: %w", path, err)
+	}
+	return data, parsed, nil
+}
+
+// MissingRunes returns the distinct characters of texts that the regular font has no glyph for,
+// or that belong to a script PDF exports cannot lay out, in order of appearance. They are left
+// blank or garbled in PDF exports.
+func (f PDFFont) MissingRunes(texts ...string) []rune {
+	font := f.parsed
+	if font == nil {
+		parsed, err := sfnt.Parse(f.Regular)
+		if err != nil {
+			return nil
+		}
+		font = parsed
+	}
+
+	var buffer sfnt.Buffer
+	seen := make(map[rune]bool)
+	var missing []rune
+	for _, text := range texts {
+		for _, r := range text {
+			if seen[r] || unicode.IsSpace(r) || unicode.IsControl(r) {
+				continue
+			}
+			seen[r] = true
+			if unicode.In(r, shapedScripts...) {
+				missing = append(missing, r)
+				continue
+			}
+			if index, err := font.GlyphIndex(&buffer, r); err != nil || index == 0 {
+				missing = append(missing, r)
+			}
+		}
+	}
+	return missing
+}
+
+// renderPDF lays out the transcription as an A4 PDF document with page headers and numbered footers
+func (s *ExportService) renderPDF(transcription *TranscriptionHistoryItem, options *ExportOptions, generatedAt time.Time) ([]byte, error) {
+	title := exportTitle(options)
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "", s.pdfFont.Regular)
+	pdf.AddUTF8FontFromBytes(pdfFontFamily, "B", s.pdfFont.Bold)
+	pdf.SetTitle(title, true)
+	pdf.SetCreator("GitScribe", true)
+	pdf.SetMargins(20, 20, 20)
+	pdf.SetAutoPageBreak(true, 20)
+	pdf.AliasNbPages("{nb}")
+
+	pdf.SetHeaderFunc(func() {
+		if pdf.PageNo() == 1 {
+			return
+		}
+		pdf.SetFont(pdfFontFamily, "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		pdf.CellFormat(85, 5, "Transcription "+transcription.ID, "", 0, "L", false, 0, "")
+		pdf.CellFormat(85, 5, generatedAt.Format("2006-01-02 15:04"), "", 1, "R", false, 0, "")
+		pdf.SetDrawColor(200, 200, 200)
+		pdf.Line(20, 26, 190, 26)
+		pdf.Ln(6)
+		pdf.SetTextColor(0, 0, 0)
+	})
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-15)
+		pdf.SetFont(pdfFontFamily, "", 8)
+		pdf.SetTextColor(120, 120, 120)
+		pdf.CellFormat(0, 10, fmt.Sprintf("Page %d of {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	pdf.AddPage()
+
+	// Title
+	pdf.SetFont(pdfFontFamily, "B", 18)
+	pdf.MultiCell(0, 10, title, "", "L", false)
+	pdf.SetDrawColor(50, 50, 50)
+	pdf.Line(20, pdf.GetY()+1, 190, pdf.GetY()+1)
+	pdf.Ln(5)
+
+	if options.IncludeMetadata {
+		s.writePDFFields(pdf, [][2]string{
+			{"Meeting ID", transcription.MeetingID},
+			{"Transcription ID", transcription.ID},
+			{"Provider", transcription.Provider},
+			{"Status", string(transcription.Status)},
+			{"Confidence", fmt.Sprintf("%.2f%%", transcription.Confidence*100)},
+			{"Created", transcription.CreatedAt.Format("2006-01-02 15:04:05")},
+		})
+		pdf.Ln(4)
+	}
+
+	if len(transcription.Segments) > 0 {
+		s.writePDFHeading(pdf, "Transcript")
+		for _, segment := range transcription.Segments {
+			if options.IncludeTimestamps {
+				pdf.SetFont(pdfFontFamily, "", 8)
+				pdf.SetTextColor(110, 110, 110)
+				pdf.Write(5, fmt.Sprintf("[%s - %s]  ", s.formatTime(segment.StartTime), s.formatTime(segment.EndTime)))
+			}
+			if options.IncludeSpeakers && segment.Speaker != "" {
+				pdf.SetFont(pdfFontFamily, "B", 10)
+				pdf.SetTextColor(0, 82, 163)
+				pdf.Write(5, segment.Speaker+": ")
+			}
+			pdf.SetFont(pdfFontFamily, "", 10)
+			pdf.SetTextColor(0, 0, 0)
+			pdf.Write(5, segment.Text)
+			if options.IncludeMetadata {
+				pdf.SetFont(pdfFontFamily, "", 8)
+				pdf.SetTextColor(150, 150, 150)
+				pdf.Write(5, fmt.Sprintf("  (%.2f%%)", segment.Confidence*100))
+			}
+			pdf.Ln(7)
+		}
+	} else if transcription.Content != "" {
+		s.writePDFHeading(pdf, "Transcript")
+		pdf.SetFont(pdfFontFamily, "", 10)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.MultiCell(0, 5, transcription.Content, "", "L", false)
+	}
+
+	if options.IncludeStats && transcription.Stats != nil {
+		pdf.Ln(4)
+		s.writePDFHeading(pdf, "Statistics")
+		stats := transcription.Stats
+		s.writePDFFields(pdf, [][2]string{
+			{"Duration", s.formatTime(stats.TotalDuration)},
+			{"Segments", fmt.Sprintf("%d", stats.SegmentCount)},
+			{"Speakers", fmt.Sprintf("%d", stats.SpeakerCount)},
+			{"Words", fmt.Sprintf("%d", stats.WordCount)},
+			{"Average confidence", fmt.Sprintf("%.2f%%", stats.AverageConfidence*100)},
+		})
+	}
+
+	var buffer bytes.Buffer
+	if err := pdf.Output(&buffer); err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// writePDFHeading writes a section heading
+func (s *ExportService) writePDFHeading(pdf *fpdf.Fpdf, heading string) {
+	pdf.SetFont(pdfFontFamily, "B", 13)
+	pdf.SetTextColor(0, 0, 0)
+	pdf.CellFormat(0, 8, heading, "", 1, "L", false, 0, "")
+	pdf.Ln(2)
+}
+
+// writePDFFields writes label/value pairs as a two column table
+func (s *ExportService) writePDFFields(pdf *fpdf.Fpdf, fields [][2]string) {
+	pdf.SetFillColor(245, 245, 245)
+	for _, field := range fields {
+		pdf.SetFont(pdfFontFamily, "B", 9)
+		pdf.SetTextColor(60, 60, 60)
+		pdf.CellFormat(45, 6, field[0], "", 0, "L", true, 0, "")
+		pdf.SetFont(pdfFontFamily, "", 9)
+		pdf.SetTextColor(0, 0, 0)
+		pdf.CellFormat(0, 6, field[1], "", 1, "L", true, 0, "")
+	}
+}
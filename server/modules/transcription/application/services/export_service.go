@@ -5,31 +5,55 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"log"
 	"strings"
 	"time"
 
-	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
 )
 
 // ExportService handles transcription exports in various formats
 type ExportService struct {
 	storageUploader StorageUploader
+	pdfFont         PDFFont
+	linkTTL         time.Duration
+	templates       repositories.ExportTemplateRepository // Optional, resolves user-uploaded templates
+	exportContext   repositories.ExportContextRepository  // Optional, provides meeting details to templates
 }
 
-// StorageUploader interface for uploading export files
+// DefaultExportLinkTTL is how long export download links stay valid by default
+const DefaultExportLinkTTL = 24 * time.Hour
+
+// StorageUploader interface for uploading export files.
+// Documents are stored under objectName, which is unique to the export, and downloaded as fileName.
+// The returned download URL must stop working after expiresAt.
 type StorageUploader interface {
-	UploadDocument(ctx context.Context, data []byte, fileName, contentType string) (string, error)
+	UploadDocument(ctx context.Context, data []byte, objectName, fileName, contentType string, expiresAt time.Time) (string, error)
+}
+
+// StreamingStorageUploader is implemented by storage that can upload large exports without
+// holding them in memory
+type StreamingStorageUploader interface {
+	UploadDocumentStream(ctx context.Context, reader io.Reader, objectName, fileName, contentType string, expiresAt time.Time) (string, error)
 }
 
 // ExportOptions defines options for transcription export
 type ExportOptions struct {
-	Format            string                 `json:"format"`                  // pdf, docx, json, txt
+	Format            string                 `json:"format"`                  // pdf, docx, json, txt, srt, vtt, md, html
+	Title             string                 `json:"title,omitempty"`         // Document title, defaults to "Transcription Export"
 	IncludeMetadata   bool                   `json:"include_metadata"`        // Include transcription metadata
 	IncludeSpeakers   bool                   `json:"include_speakers"`        // Include speaker names
 	IncludeTimestamps bool                   `json:"include_timestamps"`      // Include timestamps
 	IncludeStats      bool                   `json:"include_stats"`           // Include statistics
-	Template          string                 `json:"template,omitempty"`      // Export template
-	CustomFields      map[string]interface{} `json:"custom_fields,omitempty"` // Custom fields
+	Template          string                 `json:"template,omitempty"`      // Template name for md and html, defaults to DefaultExportTemplate
+	CustomFields      map[string]interface{} `json:"custom_fields,omitempty"` // Custom fields, available to templates as .Fields
+	UserID            string                 `json:"-"`                       // Owner of user-uploaded templates
+
+	// Subtitle (srt, vtt) cue limits; zero uses DefaultSubtitleLineLength and DefaultSubtitleLines
+	MaxLineLength int `json:"max_line_length,omitempty"` // Maximum characters per caption line
+	MaxLines      int `json:"max_lines,omitempty"`       // Maximum lines per caption cue
 }
 
 // ExportResult contains the result of an export operation
@@ -43,15 +67,51 @@ type ExportResult struct {
 	Metadata    map[string]interface{} `json:"metadata,omitempty"`
 }
 
+// ExportDocument is a rendered export that has not been stored yet
+type ExportDocument struct {
+	Data        []byte
+	FileName    string // Name the document is downloaded as
+	ObjectName  string // Name the document is stored under, generated from FileName when empty
+	Format      string
+	ContentType string
+	GeneratedAt time.Time
+	Metadata    map[string]interface{}
+}
+
 // NewExportService creates a new export service
 func NewExportService(storageUploader StorageUploader) *ExportService {
 	return &ExportService{
 		storageUploader: storageUploader,
+		pdfFont:         DefaultPDFFont(),
+		linkTTL:         DefaultExportLinkTTL,
 	}
 }
 
-// ExportTranscription exports a transcription in the specified format
+// WithLinkTTL sets how long download links of new exports stay valid
+func (s *ExportService) WithLinkTTL(ttl time.Duration) *ExportService {
+	if ttl > 0 {
+		s.linkTTL = ttl
+	}
+	return s
+}
+
+// WithPDFFont sets the font used for PDF exports, e.g. one covering non-Latin scripts
+func (s *ExportService) WithPDFFont(font PDFFont) *ExportService {
+	s.pdfFont = font
+	return s
+}
+
+// ExportTranscription exports a transcription in the specified format and stores it
 func (s *ExportService) ExportTranscription(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportResult, error) {
+	document, err := s.RenderTranscription(ctx, transcription, options)
+	if err != nil {
+		return nil, err
+	}
+	return s.StoreDocument(ctx, document)
+}
+
+// RenderTranscription renders a transcription in the specified format without storing it
+func (s *ExportService) RenderTranscription(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportDocument, error) {
 	switch strings.ToLower(options.Format) {
 	case "json":
 		return s.exportJSON(ctx, transcription, options)
@@ -61,13 +121,19 @@ func (s *ExportService) ExportTranscription(ctx context.Context, transcription *
 		return s.exportPDF(ctx, transcription, options)
 	case "docx":
 		return s.exportDOCX(ctx, transcription, options)
+	case "srt", "vtt":
+		return s.exportSubtitles(ctx, transcription, options, strings.ToLower(options.Format))
+	case "md", "markdown":
+		return s.exportTemplated(ctx, transcription, options, "md")
+	case "html":
+		return s.exportTemplated(ctx, transcription, options, "html")
 	default:
 		return nil, fmt.Errorf("unsupported export format: %s", options.Format)
 	}
 }
 
 // exportJSON exports transcription as JSON
-func (s *ExportService) exportJSON(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportResult, error) {
+func (s *ExportService) exportJSON(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportDocument, error) {
 	exportData := s.buildExportData(transcription, options)
 
 	jsonData, err := json.MarshalIndent(exportData, "", "  ")
@@ -75,29 +141,14 @@ func (s *ExportService) exportJSON(ctx context.Context, transcription *Transcrip
 		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	fileName := fmt.Sprintf("transcription_%s_%s.json", transcription.ID, time.Now().Format("20060102_150405"))
-
-	downloadURL, err := s.storageUploader.UploadDocument(ctx, jsonData, fileName, "application/json")
-	if err != nil {
-		return nil, fmt.Errorf("failed to upload JSON export: %w", err)
-	}
-
-	return &ExportResult{
-		DownloadURL: downloadURL,
-		FileName:    fileName,
-		FileSize:    int64(len(jsonData)),
-		Format:      "json",
-		ExpiresAt:   time.Now().Add(24 * time.Hour),
-		GeneratedAt: time.Now(),
-		Metadata: map[string]interface{}{
-			"transcription_id": transcription.ID,
-			"segments_count":   len(transcription.Segments),
-		},
-	}, nil
+	return newExportDocument(transcription, jsonData, "json", "application/json", time.Now(), map[string]interface{}{
+		"transcription_id": transcription.ID,
+		"segments_count":   len(transcription.Segments),
+	}), nil
 }
 
 // exportTXT exports transcription as plain text
-func (s *ExportService) exportTXT(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportResult, error) {
+func (s *ExportService) exportTXT(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportDocument, error) {
 	var buffer bytes.Buffer
 
 	// Add header if metadata is included
@@ -159,77 +210,122 @@ func (s *ExportService) exportTXT(ctx context.Context, transcription *Transcript
 		buffer.Write(statsJSON)
 	}
 
-	txtData := buffer.Bytes()
-	fileName := fmt.Sprintf("transcription_%s_%s.txt", transcription.ID, time.Now().Format("20060102_150405"))
+	return newExportDocument(transcription, buffer.Bytes(), "txt", "text/plain", time.Now(), nil), nil
+}
 
-	downloadURL, err := s.storageUploader.UploadDocument(ctx, txtData, fileName, "text/plain")
+// exportPDF exports transcription as PDF
+func (s *ExportService) exportPDF(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportDocument, error) {
+	generatedAt := time.Now()
+	pdfData, err := s.renderPDF(transcription, options, generatedAt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload TXT export: %w", err)
+		return nil, err
 	}
 
-	return &ExportResult{
-		DownloadURL: downloadURL,
-		FileName:    fileName,
-		FileSize:    int64(len(txtData)),
-		Format:      "txt",
-		ExpiresAt:   time.Now().Add(24 * time.Hour),
-		GeneratedAt: time.Now(),
-	}, nil
+	metadata := map[string]interface{}{
+		"transcription_id": transcription.ID,
+		"segments_count":   len(transcription.Segments),
+	}
+	texts := []string{exportTitle(options)}
+	for _, segment := range transcription.Segments {
+		texts = append(texts, segment.Speaker, segment.Text)
+	}
+	if len(transcription.Segments) == 0 {
+		texts = append(texts, transcription.Content)
+	}
+	if missing := s.pdfFont.MissingRunes(texts...); len(missing) > 0 {
+		log.Printf("PDF export of transcription %s has %d characters PDF exports cannot show; set PDF_FONT_PATH to a font covering them unless they are in a right-to-left or shaped script", transcription.ID, len(missing))
+		metadata["missing_glyphs"] = string(missing)
+	}
+
+	return newExportDocument(transcription, pdfData, "pdf", "application/pdf", generatedAt, metadata), nil
 }
 
-// exportPDF exports transcription as PDF (simplified HTML-to-PDF approach)
-func (s *ExportService) exportPDF(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportResult, error) {
-	// For now, we'll create an HTML representation and note that PDF generation would require additional libraries
-	htmlContent := s.generateHTML(transcription, options)
+// exportDOCX exports transcription as an Office Open XML Word document
+func (s *ExportService) exportDOCX(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportDocument, error) {
+	generatedAt := time.Now()
+	docxData, err := s.renderDOCX(transcription, options, generatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newExportDocument(transcription, docxData, "docx", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", generatedAt, map[string]interface{}{
+		"transcription_id": transcription.ID,
+		"segments_count":   len(transcription.Segments),
+	}), nil
+}
 
-	// In a full implementation, you'd use a library like wkhtmltopdf, chromedp, or similar
-	// For this example, we'll save as HTML with PDF content type
-	fileName := fmt.Sprintf("transcription_%s_%s.html", transcription.ID, time.Now().Format("20060102_150405"))
+// StoreDocument uploads a rendered document, with a download link valid for the service's link TTL
+func (s *ExportService) StoreDocument(ctx context.Context, document *ExportDocument) (*ExportResult, error) {
+	expiresAt := document.GeneratedAt.Add(s.linkTTL)
 
-	downloadURL, err := s.storageUploader.UploadDocument(ctx, []byte(htmlContent), fileName, "text/html")
+	downloadURL, err := s.storageUploader.UploadDocument(ctx, document.Data, document.objectName(), document.FileName, document.ContentType, expiresAt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload PDF export: %w", err)
+		return nil, fmt.Errorf("failed to upload %s export: %w", strings.ToUpper(document.Format), err)
 	}
 
 	return &ExportResult{
 		DownloadURL: downloadURL,
-		FileName:    fileName,
-		FileSize:    int64(len(htmlContent)),
-		Format:      "pdf",
-		ExpiresAt:   time.Now().Add(24 * time.Hour),
-		GeneratedAt: time.Now(),
-		Metadata: map[string]interface{}{
-			"note": "PDF generation requires additional libraries - this is an HTML representation",
-		},
+		FileName:    document.FileName,
+		FileSize:    int64(len(document.Data)),
+		Format:      document.Format,
+		ExpiresAt:   expiresAt,
+		GeneratedAt: document.GeneratedAt,
+		Metadata:    document.Metadata,
 	}, nil
 }
 
-// exportDOCX exports transcription as Word document (simplified)
-func (s *ExportService) exportDOCX(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions) (*ExportResult, error) {
-	// For DOCX generation, you'd typically use a library like github.com/unidoc/unioffice
-	// For this example, we'll create a structured text representation
-	content := s.generateWordContent(transcription, options)
-
-	fileName := fmt.Sprintf("transcription_%s_%s.docx", transcription.ID, time.Now().Format("20060102_150405"))
-
-	downloadURL, err := s.storageUploader.UploadDocument(ctx, []byte(content), fileName, "application/vnd.openxmlformats-officedocument.wordprocessingml.document")
+// StoreDocumentStream uploads a large document of the given size from a reader. Storage that
+// cannot stream uploads receives the document read into memory.
+func (s *ExportService) StoreDocumentStream(ctx context.Context, reader io.Reader, size int64, document *ExportDocument) (*ExportResult, error) {
+	expiresAt := document.GeneratedAt.Add(s.linkTTL)
+	objectName := document.objectName()
+
+	var downloadURL string
+	var err error
+	if streaming, ok := s.storageUploader.(StreamingStorageUploader); ok {
+		downloadURL, err = streaming.UploadDocumentStream(ctx, reader, objectName, document.FileName, document.ContentType, expiresAt)
+	} else {
+		var data []byte
+		if data, err = io.ReadAll(reader); err == nil {
+			downloadURL, err = s.storageUploader.UploadDocument(ctx, data, objectName, document.FileName, document.ContentType, expiresAt)
+		}
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload DOCX export: %w", err)
+		return nil, fmt.Errorf("failed to upload %s export: %w", strings.ToUpper(document.Format), err)
 	}
 
 	return &ExportResult{
 		DownloadURL: downloadURL,
-		FileName:    fileName,
-		FileSize:    int64(len(content)),
-		Format:      "docx",
-		ExpiresAt:   time.Now().Add(24 * time.Hour),
-		GeneratedAt: time.Now(),
-		Metadata: map[string]interface{}{
-			"note": "DOCX generation requires additional libraries - this is a text representation",
-		},
+		FileName:    document.FileName,
+		FileSize:    size,
+		Format:      document.Format,
+		ExpiresAt:   expiresAt,
+		GeneratedAt: document.GeneratedAt,
+		Metadata:    document.Metadata,
 	}, nil
 }
 
+// objectName returns the name a document is stored under. File names are only unique to the
+// second, so a generated ID keeps exports of different users and requests apart.
+func (d *ExportDocument) objectName() string {
+	if d.ObjectName != "" {
+		return d.ObjectName
+	}
+	return fmt.Sprintf("%s_%s", domain.GenerateID(), d.FileName)
+}
+
+// newExportDocument names a rendered export of a transcription
+func newExportDocument(transcription *TranscriptionHistoryItem, data []byte, format, contentType string, generatedAt time.Time, metadata map[string]interface{}) *ExportDocument {
+	return &ExportDocument{
+		Data:        data,
+		FileName:    fmt.Sprintf("transcription_%s_%s.%s", transcription.ID, generatedAt.Format("20060102_150405"), format),
+		Format:      format,
+		ContentType: contentType,
+		GeneratedAt: generatedAt,
+		Metadata:    metadata,
+	}
+}
+
 // buildExportData creates the data structure for export
 func (s *ExportService) buildExportData(transcription *TranscriptionHistoryItem, options *ExportOptions) map[string]interface{} {
 	data := map[string]interface{}{
@@ -285,121 +381,12 @@ func (s *ExportService) buildExportData(transcription *TranscriptionHistoryItem,
 	return data
 }
 
-// generateHTML creates HTML content for PDF export
-func (s *ExportService) generateHTML(transcription *TranscriptionHistoryItem, options *ExportOptions) string {
-	var html strings.Builder
-
-	html.WriteString(`<!DOCTYPE html>
-<html>
-<head>
-    <meta charset="UTF-8">
-    <title>Transcription Export</title>
-    <style>
-        body { font-family: Arial, sans-serif; margin: 40px; }
-        .header { border-bottom: 2px solid #333; margin-bottom: 20px; }
-        .metadata { background-color: #f5f5f5; padding: 15px; margin-bottom: 20px; }
-        .segment { margin-bottom: 15px; }
-        .timestamp { color: #666; font-size: 0.9em; }
-        .speaker { font-weight: bold; color: #0066cc; }
-        .confidence { color: #999; font-size: 0.8em; }
-    </style>
-</head>
-<body>`)
-
-	html.WriteString("<div class='header'><h1>Transcription Export</h1></div>")
-
-	if options.IncludeMetadata {
-		html.WriteString("<div class='metadata'>")
-		html.WriteString(fmt.Sprintf("<p><strong>Meeting ID:</strong> %s</p>", transcription.MeetingID))
-		html.WriteString(fmt.Sprintf("<p><strong>Transcription ID:</strong> %s</p>", transcription.ID))
-		html.WriteString(fmt.Sprintf("<p><strong>Provider:</strong> %s</p>", transcription.Provider))
-		html.WriteString(fmt.Sprintf("<p><strong>Confidence:</strong> %.2f%%</p>", transcription.Confidence*100))
-		html.WriteString(fmt.Sprintf("<p><strong>Created:</strong> %s</p>", transcription.CreatedAt.Format("2006-01-02 15:04:05")))
-		html.WriteString("</div>")
-	}
-
-	if transcription.Content != "" {
-		html.WriteString("<div class='content'>")
-		html.WriteString("<h2>Full Transcript</h2>")
-		html.WriteString(fmt.Sprintf("<p>%s</p>", transcription.Content))
-		html.WriteString("</div>")
-	}
-
-	if len(transcription.Segments) > 0 {
-		html.WriteString("<div class='segments'>")
-		html.WriteString("<h2>Detailed Segments</h2>")
-
-		for _, segment := range transcription.Segments {
-			html.WriteString("<div class='segment'>")
-
-			if options.IncludeTimestamps {
-				html.WriteString(fmt.Sprintf("<span class='timestamp'>[%s - %s]</span> ",
-					s.formatTime(segment.StartTime),
-					s.formatTime(segment.EndTime)))
-			}
-
-			if options.IncludeSpeakers && segment.Speaker != "" {
-				html.WriteString(fmt.Sprintf("<span class='speaker'>%s:</span> ", segment.Speaker))
-			}
-
-			html.WriteString(segment.Text)
-
-			if options.IncludeMetadata {
-				html.WriteString(fmt.Sprintf(" <span class='confidence'>(%.2f%%)</span>", segment.Confidence*100))
-			}
-
-			html.WriteString("</div>")
-		}
-		html.WriteString("</div>")
-	}
-
-	html.WriteString("</body></html>")
-	return html.String()
-}
-
-// generateWordContent creates content for DOCX export
-func (s *ExportService) generateWordContent(transcription *TranscriptionHistoryItem, options *ExportOptions) string {
-	// This would be replaced with actual DOCX generation in a full implementation
-	return fmt.Sprintf(`TRANSCRIPTION EXPORT
-
-Meeting ID: %s
-Transcription ID: %s
-Provider: %s
-Created: %s
-
-CONTENT:
-%s
-
-SEGMENTS:
-%s`,
-		transcription.MeetingID,
-		transcription.ID,
-		transcription.Provider,
-		transcription.CreatedAt.Format("2006-01-02 15:04:05"),
-		transcription.Content,
-		s.formatSegmentsForText(transcription.Segments, options))
-}
-
-// formatSegmentsForText formats segments as text
-func (s *ExportService) formatSegmentsForText(segments []entities.TranscriptSegment, options *ExportOptions) string {
-	var buffer strings.Builder
-
-	for _, segment := range segments {
-		if options.IncludeTimestamps {
-			buffer.WriteString(fmt.Sprintf("[%s - %s] ",
-				s.formatTime(segment.StartTime),
-				s.formatTime(segment.EndTime)))
-		}
-
-		if options.IncludeSpeakers && segment.Speaker != "" {
-			buffer.WriteString(fmt.Sprintf("%s: ", segment.Speaker))
-		}
-
-		buffer.WriteString(segment.Text)
-		buffer.WriteString("\n")
+// exportTitle returns the document title for an export
+func exportTitle(options *ExportOptions) string {
+	if title := strings.TrimSpace(options.Title); title != "" {
+		return title
 	}
-
-	return buffer.String()
+	return "Transcription Export"
 }
 
 // formatTime formats duration in seconds to MM:SS format
@@ -411,7 +398,7 @@ func (s *ExportService) formatTime(seconds float64) string {
 
 // GetSupportedFormats returns list of supported export formats
 func (s *ExportService) GetSupportedFormats() []string {
-	return []string{"json", "txt", "pdf", "docx"}
+	return []string{"json", "txt", "pdf", "docx", "srt", "vtt", "md", "html"}
 }
 
 // ValidateExportOptions validates export options
@@ -422,6 +409,9 @@ func (s *ExportService) ValidateExportOptions(options *ExportOptions) error {
 
 	supportedFormats := s.GetSupportedFormats()
 	format := strings.ToLower(options.Format)
+	if format == "markdown" {
+		format = "md"
+	}
 
 	for _, supported := range supportedFormats {
 		if format == supported {
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func newTestExportTranscription(segmentCount int) *TranscriptionHistoryItem {
+	speakers := []string{"Анна", "田中", "Speaker C"}
+	segments := make([]entities.TranscriptSegment, segmentCount)
+	for i := range segments {
+		start := float64(i * 5)
+		segments[i] = entities.NewTranscriptSegment("tr-1", speakers[i%len(speakers)],
+			fmt.Sprintf("Segment %d: Привет, καλημέρα, we discussed the roadmap and the release plan in detail.", i), start, start+5, 0.92, i+1)
+	}
+	return &TranscriptionHistoryItem{
+		ID:         "tr-1",
+		MeetingID:  "meeting-1",
+		Status:     entities.Completed,
+		Provider:   "assemblyai",
+		Confidence: 0.92,
+		Segments:   segments,
+		Stats:      &repositories.TranscriptionStats{TotalDuration: float64(segmentCount * 5), SegmentCount: segmentCount, SpeakerCount: 3},
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+}
+
+func TestExportService_ExportPDF(t *testing.T) {
+	uploader := &recordingUploader{}
+	service := NewExportService(uploader)
+
+	result, err := service.ExportTranscription(context.Background(), newTestExportTranscription(120), &ExportOptions{
+		Format:            "pdf",
+		IncludeMetadata:   true,
+		IncludeSpeakers:   true,
+		IncludeTimestamps: true,
+		IncludeStats:      true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "pdf", result.Format)
+	assert.Regexp(t, `^transcription_tr-1_\d{8}_\d{6}\.pdf$`, result.FileName)
+	assert.Equal(t, "application/pdf", uploader.contentType)
+	assert.Equal(t, int64(len(uploader.data)), result.FileSize)
+	assert.True(t, bytes.HasPrefix(uploader.data, []byte("%PDF-")))
+	assert.Nil(t, result.Metadata["note"])
+	assert.Contains(t, string(uploader.data), "/FontFile2")
+
+	// The bundled Go font covers the Cyrillic and Greek text but not the Japanese speaker
+	assert.Empty(t, DefaultPDFFont().MissingRunes("Привет, καλημέρα, Анна"))
+	assert.Equal(t, "田中", result.Metadata["missing_glyphs"])
+
+	// 120 segments do not fit on one page
+	pages := regexp.MustCompile(`/Type /Page\b`).FindAll(uploader.data, -1)
+	assert.Greater(t, len(pages), 1)
+}
+
+func TestExportService_ExportPDF_ContentOnly(t *testing.T) {
+	uploader := &recordingUploader{}
+	service := NewExportService(uploader)
+
+	transcription := newTestExportTranscription(0)
+	transcription.Content = "Speaker A: 你好，欢迎参加会议。"
+	transcription.Stats = nil
+
+	result, err := service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "PDF", IncludeStats: true})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(uploader.data, []byte("%PDF-")))
+	assert.Equal(t, "你好，欢迎参加会议。", result.Metadata["missing_glyphs"])
+}
+
+func TestExportService_ExportPDF_ConfiguredFont(t *testing.T) {
+	dir := t.TempDir()
+	regularPath := filepath.Join(dir, "regular.ttf")
+	require.NoError(t, os.WriteFile(regularPath, goregular.TTF, 0o600))
+	invalidPath := filepath.Join(dir, "invalid.ttf")
+	require.NoError(t, os.WriteFile(invalidPath, []byte("not a font"), 0o600))
+
+	_, err := LoadPDFFont(invalidPath, "")
+	assert.ErrorContains(t, err, "failed to read PDF font")
+	font, err := LoadPDFFont(regularPath, "")
+	require.NoError(t, err)
+	assert.Equal(t, font.Regular, font.Bold)
+
+	uploader := &recordingUploader{}
+	service := NewExportService(uploader).WithPDFFont(font)
+	transcription := newTestExportTranscription(3)
+	transcription.Segments[1].Speaker = "Ben"
+
+	// Every character of the transcript has a glyph in the configured font
+	result, err := service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "pdf", IncludeSpeakers: true})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(uploader.data, []byte("%PDF-")))
+	assert.NotContains(t, result.Metadata, "missing_glyphs")
+}
+
+// readDOCXParts unzips a DOCX package and checks that every part is well-formed XML
+func readDOCXParts(t *testing.T, data []byte) map[string]string {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		require.NoError(t, err)
+		parts[file.Name] = string(content)
+
+		decoder := xml.NewDecoder(bytes.NewReader(content))
+		for {
+			_, err := decoder.Token()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err, file.Name)
+		}
+	}
+	return parts
+}
+
+func TestExportService_ExportDOCX(t *testing.T) {
+	uploader := &recordingUploader{}
+	service := NewExportService(uploader)
+
+	transcription := newTestExportTranscription(3)
+	transcription.Segments[2].Text = "Use <b> & \"quotes\" safely"
+
+	result, err := service.ExportTranscription(context.Background(), transcription, &ExportOptions{
+		Format:            "docx",
+		Title:             "Weekly Sync & Planning",
+		IncludeMetadata:   true,
+		IncludeSpeakers:   true,
+		IncludeTimestamps: true,
+		IncludeStats:      true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "docx", result.Format)
+	assert.Equal(t, "application/vnd.openxmlformats-officedocument.wordprocessingml.document", uploader.contentType)
+
+	parts := readDOCXParts(t, uploader.data)
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "word/document.xml", "word/styles.xml", "word/_rels/document.xml.rels", "docProps/core.xml", "docProps/app.xml"} {
+		assert.Contains(t, parts, name)
+	}
+
+	document := parts["word/document.xml"]
+	assert.Contains(t, document, "Weekly Sync &amp; Planning")
+	assert.Contains(t, document, `<w:rStyle w:val="Speaker"/><w:b/></w:rPr><w:t xml:space="preserve">Анна: </w:t>`)
+	assert.Contains(t, document, "[00:05 - 00:10] ")
+	assert.Contains(t, document, "Use &lt;b&gt; &amp; &#34;quotes&#34; safely")
+	assert.Contains(t, parts["docProps/core.xml"], "<dc:title>Weekly Sync &amp; Planning</dc:title>")
+
+	// Timestamps and speakers are optional
+	_, err = service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "docx"})
+	require.NoError(t, err)
+	document = readDOCXParts(t, uploader.data)["word/document.xml"]
+	assert.NotContains(t, document, "[00:05 - 00:10]")
+	assert.NotContains(t, document, "Анна: ")
+	assert.Contains(t, document, "Transcription Export")
+}
+
+func TestExportService_ExportSubtitles(t *testing.T) {
+	uploader := &recordingUploader{}
+	service := NewExportService(uploader)
+
+	withWords := entities.NewTranscriptSegment("tr-1", "Speaker A", "Welcome everyone to the quarterly planning review for the platform team", 0, 6, 0.9, 1)
+	for i, word := range strings.Fields(withWords.Text) {
+		withWords.Words = append(withWords.Words, entities.WordTiming{Text: word, Start: float64(i) * 0.5, End: float64(i)*0.5 + 0.4})
+	}
+	transcription := &TranscriptionHistoryItem{
+		ID: "tr-1",
+		Segments: []entities.TranscriptSegment{
+			withWords,
+			entities.NewTranscriptSegment("tr-1", "Speaker <B>", "Thanks & hello", 3725, 3727, 0.9, 2),
+		},
+	}
+
+	result, err := service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "srt", IncludeSpeakers: true, MaxLineLength: 20, MaxLines: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "srt", result.Format)
+	assert.Equal(t, "application/x-subrip", uploader.contentType)
+	assert.Equal(t, 3, result.Metadata["cues_count"])
+	assert.Equal(t, "1\n00:00:00,000 --> 00:00:02,400\nSpeaker A:\nWelcome everyone to\nthe quarterly\n\n"+
+		"2\n00:00:02,500 --> 00:00:05,400\nplanning review for\nthe platform team\n\n"+
+		"3\n01:02:05,000 --> 01:02:07,000\nSpeaker <B>:\nThanks & hello\n\n", string(uploader.data))
+
+	result, err = service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "VTT", IncludeSpeakers: true, MaxLineLength: 20, MaxLines: 2})
+	require.NoError(t, err)
+	assert.Equal(t, "vtt", result.Format)
+	assert.Equal(t, "text/vtt", uploader.contentType)
+	assert.True(t, strings.HasPrefix(string(uploader.data), "WEBVTT\n\n00:00:00.000 --> 00:00:02.400\n<v Speaker A>Welcome everyone to\nthe quarterly\n\n"))
+	assert.Contains(t, string(uploader.data), "01:02:05.000 --> 01:02:07.000\n<v Speaker &lt;B&gt;>Thanks &amp; hello\n")
+
+	_, err = service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "vtt", MaxLineLength: 20, MaxLines: 2})
+	require.NoError(t, err)
+	assert.NotContains(t, string(uploader.data), "<v ")
+	assert.Contains(t, string(uploader.data), "01:02:05.000 --> 01:02:07.000\nThanks &amp; hello\n")
+}
+
+func TestExportService_BuildSubtitleCues_MinimumDuration(t *testing.T) {
+	service := NewExportService(&recordingUploader{})
+	short := entities.NewTranscriptSegment("tr-1", "Anna", "Hi", 0, 1, 0.9, 1)
+	short.Words = []entities.WordTiming{{Text: "Hi", Start: 0, End: 0.1}}
+	segments := []entities.TranscriptSegment{
+		short,
+		entities.NewTranscriptSegment("tr-1", "Ben", "Hello", 0.3, 2, 0.9, 2),
+		entities.NewTranscriptSegment("tr-1", "Anna", "Bye", 5, 5, 0.9, 3),
+	}
+
+	cues := service.buildSubtitleCues(segments, &ExportOptions{})
+
+	require.Len(t, cues, 3)
+	// Lengthening the first cue to the minimum duration stops at the next cue
+	assert.Equal(t, 0.3, cues[0].End)
+	assert.InDelta(t, 2.0, cues[1].End, 0.001)
+	assert.Equal(t, 5.5, cues[2].End)
+}
+
+func TestExportService_BuildSubtitleCues_Defaults(t *testing.T) {
+	service := NewExportService(&recordingUploader{})
+	text := strings.Repeat("word ", 40)
+	segments := []entities.TranscriptSegment{entities.NewTranscriptSegment("tr-1", "", text, 10, 30, 0.9, 1)}
+
+	cues := service.buildSubtitleCues(segments, &ExportOptions{})
+
+	require.NotEmpty(t, cues)
+	assert.Equal(t, 10.0, cues[0].Start)
+	assert.InDelta(t, 30.0, cues[len(cues)-1].End, 0.001)
+	for _, cue := range cues {
+		assert.LessOrEqual(t, len(cue.Lines), DefaultSubtitleLines)
+		for _, line := range cue.Lines {
+			assert.LessOrEqual(t, len(line), DefaultSubtitleLineLength)
+		}
+	}
+}
+
+func TestExportService_ExportTemplated(t *testing.T) {
+	uploader := &recordingUploader{}
+	service := NewExportService(uploader).WithExportContext(&stubExportContextRepository{context: &repositories.ExportContext{
+		Meeting:      &repositories.ExportMeeting{Title: "Roadmap <Review>", StartTime: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)},
+		Participants: []repositories.ExportParticipant{{Name: "Анна"}, {Name: "田中"}},
+		ActionItems:  []repositories.ExportActionItem{{Title: "Draft the release plan", Assignee: "Анна", Priority: "high"}},
+	}})
+	transcription := newTestExportTranscription(3)
+
+	result, err := service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "markdown", Template: "meeting_minutes"})
+	require.NoError(t, err)
+	assert.Equal(t, "md", result.Format)
+	assert.Regexp(t, `^transcription_tr-1_\d{8}_\d{6}\.md$`, result.FileName)
+	assert.Equal(t, "text/markdown; charset=utf-8", uploader.contentType)
+	assert.Equal(t, "meeting_minutes", result.Metadata["template"])
+	markdown := string(uploader.data)
+	assert.True(t, strings.HasPrefix(markdown, "# Meeting Minutes: Roadmap <Review>\n"))
+	assert.Contains(t, markdown, "**Attendees:** Анна, 田中")
+	assert.Contains(t, markdown, "| 1 | Draft the release plan | Анна | — | high |")
+
+	// HTML exports default to the transcript template and escape values
+	result, err = service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "html", Title: "A & B", IncludeStats: true})
+	require.NoError(t, err)
+	assert.Equal(t, "html", result.Format)
+	assert.Equal(t, "text/html; charset=utf-8", uploader.contentType)
+	assert.Equal(t, DefaultExportTemplate, result.Metadata["template"])
+	html := string(uploader.data)
+	assert.Contains(t, html, "<title>A &amp; B</title>")
+	assert.Contains(t, html, "Roadmap &lt;Review&gt;")
+	assert.Contains(t, html, "<li>Speakers: 3</li>")
+}
+
+func TestExportService_ExportTemplated_UserTemplate(t *testing.T) {
+	template, err := entities.NewExportTemplate("user-1", "summary", "", entities.MarkdownTemplate,
+		"{{.Fields.project}}: {{len .Segments}} segments by {{join .Speakers \", \"}}")
+	require.NoError(t, err)
+
+	uploader := &recordingUploader{}
+	service := NewExportService(uploader).WithTemplates(&stubExportTemplateRepository{templates: []*entities.ExportTemplate{&template}})
+	transcription := newTestExportTranscription(2)
+
+	_, err = service.ExportTranscription(context.Background(), transcription, &ExportOptions{
+		Format:       "md",
+		Template:     "summary",
+		UserID:       "user-1",
+		CustomFields: map[string]interface{}{"project": "Apollo"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Apollo: 2 segments by Анна, 田中", string(uploader.data))
+
+	// Templates of other users and templates of the wrong format are not used
+	_, err = service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "md", Template: "summary", UserID: "user-2"})
+	assert.ErrorContains(t, err, "not found")
+	_, err = service.ExportTranscription(context.Background(), transcription, &ExportOptions{Format: "html", Template: "summary", UserID: "user-1"})
+	assert.ErrorContains(t, err, "renders markdown, not html")
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+const (
+	// DefaultSubtitleLineLength is the default maximum number of characters per caption line
+	DefaultSubtitleLineLength = 42
+	// DefaultSubtitleLines is the default maximum number of lines per caption cue
+	DefaultSubtitleLines = 2
+
+	// minCueDuration keeps zero-length words from producing cues that never show
+	minCueDuration = 0.5
+)
+
+// subtitleCue is a caption shown on screen between Start and End seconds
+type subtitleCue struct {
+	Start   float64
+	End     float64
+	Speaker string
+	Lines   []string
+}
+
+// timedWord is a word of segment text with its start and end time
+type timedWord struct {
+	text  string
+	start float64
+	end   float64
+}
+
+// exportSubtitles exports transcription as SRT or WebVTT captions
+func (s *ExportService) exportSubtitles(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions, format string) (*ExportDocument, error) {
+	cues := s.buildSubtitleCues(transcription.Segments, options)
+
+	var data []byte
+	var contentType string
+	if format == "vtt" {
+		data = []byte(renderVTT(cues, options.IncludeSpeakers))
+		contentType = "text/vtt"
+	} else {
+		data = []byte(renderSRT(cues, options.IncludeSpeakers))
+		contentType = "application/x-subrip"
+	}
+
+	return newExportDocument(transcription, data, format, contentType, time.Now(), map[string]interface{}{
+		"transcription_id": transcription.ID,
+		"cues_count":       len(cues),
+	}), nil
+}
+
+// buildSubtitleCues splits segments into caption-sized cues of at most MaxLines lines
+// of MaxLineLength characters. Cue times come from word timings when the segment has them
+// and are otherwise interpolated over the segment by character count.
+func (s *ExportService) buildSubtitleCues(segments []entities.TranscriptSegment, options *ExportOptions) []subtitleCue {
+	maxLength := options.MaxLineLength
+	if maxLength <= 0 {
+		maxLength = DefaultSubtitleLineLength
+	}
+	maxLines := options.MaxLines
+	if maxLines <= 0 {
+		maxLines = DefaultSubtitleLines
+	}
+
+	var cues []subtitleCue
+	for _, segment := range segments {
+		words := segmentWordTimings(segment)
+		if len(words) == 0 {
+			continue
+		}
+
+		var cue *subtitleCue
+		line := ""
+		for _, word := range words {
+			switch {
+			case cue == nil:
+				cue = &subtitleCue{Start: word.start, Speaker: segment.Speaker}
+				line = word.text
+			case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word.text) <= maxLength:
+				line += " " + word.text
+			case len(cue.Lines)+1 < maxLines:
+				cue.Lines = append(cue.Lines, line)
+				line = word.text
+			default:
+				cue.Lines = append(cue.Lines, line)
+				cues = append(cues, finishCue(*cue, segment.EndTime))
+				cue = &subtitleCue{Start: word.start, Speaker: segment.Speaker}
+				line = word.text
+			}
+			cue.End = word.end
+		}
+		cue.Lines = append(cue.Lines, line)
+		cues = append(cues, finishCue(*cue, segment.EndTime))
+	}
+
+	// The minimum duration must not make a cue overlap the next one
+	for i := 0; i+1 < len(cues); i++ {
+		if next := cues[i+1].Start; cues[i].End > next && next > cues[i].Start {
+			cues[i].End = next
+		}
+	}
+	return cues
+}
+
+// finishCue makes sure a cue is visible for a minimum time without running past its segment
+func finishCue(cue subtitleCue, segmentEnd float64) subtitleCue {
+	if cue.End-cue.Start < minCueDuration {
+		cue.End = cue.Start + minCueDuration
+		if segmentEnd > cue.Start && cue.End > segmentEnd {
+			cue.End = segmentEnd
+		}
+	}
+	return cue
+}
+
+// segmentWordTimings pairs the words of a segment's text with start and end times.
+// Provider word timings are used when they still match the text, which edits, spelling
+// rules and redaction can change.
+func segmentWordTimings(segment entities.TranscriptSegment) []timedWord {
+	fields := strings.Fields(segment.Text)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	words := make([]timedWord, len(fields))
+	if len(segment.Words) == len(fields) {
+		for i, field := range fields {
+			words[i] = timedWord{text: field, start: segment.Words[i].Start, end: segment.Words[i].End}
+		}
+		return words
+	}
+
+	totalChars := 0
+	for _, field := range fields {
+		totalChars += utf8.RuneCountInString(field) + 1
+	}
+	duration := segment.GetDuration()
+	if duration < 0 {
+		duration = 0
+	}
+	elapsed := 0
+	for i, field := range fields {
+		start := segment.StartTime + duration*float64(elapsed)/float64(totalChars)
+		elapsed += utf8.RuneCountInString(field) + 1
+		words[i] = timedWord{text: field, start: start, end: segment.StartTime + duration*float64(elapsed)/float64(totalChars)}
+	}
+	return words
+}
+
+// renderSRT writes cues in SubRip format, optionally adding a speaker line whenever the speaker changes
+func renderSRT(cues []subtitleCue, includeSpeakers bool) string {
+	var builder strings.Builder
+	previousSpeaker := ""
+	for i, cue := range cues {
+		lines := cue.Lines
+		if includeSpeakers && cue.Speaker != "" && (i == 0 || cue.Speaker != previousSpeaker) {
+			lines = append([]string{cue.Speaker + ":"}, lines...)
+		}
+		previousSpeaker = cue.Speaker
+
+		fmt.Fprintf(&builder, "%d\n%s --> %s\n%s\n\n", i+1,
+			formatSubtitleTime(cue.Start, ","), formatSubtitleTime(cue.End, ","), strings.Join(lines, "\n"))
+	}
+	return builder.String()
+}
+
+// renderVTT writes cues in WebVTT format, optionally marking speakers with <v Speaker> voice tags
+func renderVTT(cues []subtitleCue, includeSpeakers bool) string {
+	escaper := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+	var builder strings.Builder
+	builder.WriteString("WEBVTT\n\n")
+	for _, cue := range cues {
+		lines := make([]string, len(cue.Lines))
+		for i, line := range cue.Lines {
+			lines[i] = escaper.Replace(line)
+		}
+		text := strings.Join(lines, "\n")
+		if includeSpeakers && cue.Speaker != "" {
+			text = fmt.Sprintf("<v %s>%s", escaper.Replace(cue.Speaker), text)
+		}
+
+		fmt.Fprintf(&builder, "%s --> %s\n%s\n\n",
+			formatSubtitleTime(cue.Start, "."), formatSubtitleTime(cue.End, "."), text)
+	}
+	return builder.String()
+}
+
+// formatSubtitleTime formats seconds as HH:MM:SS followed by the separator and milliseconds
+func formatSubtitleTime(seconds float64, separator string) string {
+	if seconds < 0 {
+		seconds = 0
+	}
+	milliseconds := int64(seconds*1000 + 0.5)
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d",
+		milliseconds/3600000, milliseconds/60000%60, milliseconds/1000%60, separator, milliseconds%1000)
+}
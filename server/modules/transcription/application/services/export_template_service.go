@@ -0,0 +1,61 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+)
+
+// ExportTemplateService manages the export templates uploaded by a user
+type ExportTemplateService struct {
+	createHandler *commands.CreateExportTemplateHandler
+	updateHandler *commands.UpdateExportTemplateHandler
+	deleteHandler *commands.DeleteExportTemplateHandler
+	listHandler   *queries.GetExportTemplatesHandler
+	getHandler    *queries.GetExportTemplateHandler
+}
+
+// NewExportTemplateService creates a new export template service
+func NewExportTemplateService(templateRepo repositories.ExportTemplateRepository) *ExportTemplateService {
+	return &ExportTemplateService{
+		createHandler: commands.NewCreateExportTemplateHandler(templateRepo),
+		updateHandler: commands.NewUpdateExportTemplateHandler(templateRepo),
+		deleteHandler: commands.NewDeleteExportTemplateHandler(templateRepo),
+		listHandler:   queries.NewGetExportTemplatesHandler(templateRepo),
+		getHandler:    queries.NewGetExportTemplateHandler(templateRepo),
+	}
+}
+
+// CreateExportTemplate uploads a template for the user
+func (s *ExportTemplateService) CreateExportTemplate(ctx context.Context, cmd commands.CreateExportTemplateCommand) (*entities.ExportTemplate, error) {
+	return s.createHandler.Handle(ctx, cmd)
+}
+
+// UpdateExportTemplate replaces the contents of one of the user's templates
+func (s *ExportTemplateService) UpdateExportTemplate(ctx context.Context, cmd commands.UpdateExportTemplateCommand) (*entities.ExportTemplate, error) {
+	return s.updateHandler.Handle(ctx, cmd)
+}
+
+// DeleteExportTemplate deletes one of the user's templates
+func (s *ExportTemplateService) DeleteExportTemplate(ctx context.Context, id, userID string) error {
+	return s.deleteHandler.Handle(ctx, commands.DeleteExportTemplateCommand{ID: id, UserID: userID})
+}
+
+// GetExportTemplates lists the user's templates
+func (s *ExportTemplateService) GetExportTemplates(ctx context.Context, userID string) ([]*entities.ExportTemplate, error) {
+	return s.listHandler.Handle(ctx, queries.GetExportTemplatesQuery{UserID: userID})
+}
+
+// GetExportTemplate returns one of the user's templates
+func (s *ExportTemplateService) GetExportTemplate(ctx context.Context, id, userID string) (*entities.ExportTemplate, error) {
+	return s.getHandler.Handle(ctx, queries.GetExportTemplateQuery{ID: id, UserID: userID})
+}
+
+// GetBuiltinExportTemplates lists the templates available to every user
+func (s *ExportTemplateService) GetBuiltinExportTemplates() []services.BuiltinExportTemplate {
+	return services.BuiltinExportTemplates
+}
@@ -0,0 +1,163 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	// DefaultExportTemplate is the template used when ExportOptions.Template is empty
+	DefaultExportTemplate = "transcript"
+
+	// MaxTemplatedExportSize caps the output of a template, which could otherwise grow without bound
+	MaxTemplatedExportSize = 10 * 1024 * 1024
+)
+
+// ExportTemplateData is the data passed to export templates
+type ExportTemplateData struct {
+	Title         string
+	GeneratedAt   time.Time
+	Transcription *TranscriptionHistoryItem
+	Segments      []entities.TranscriptSegment
+	Stats         *repositories.TranscriptionStats
+	Meeting       *repositories.ExportMeeting // nil when the meeting details are unavailable
+	Participants  []repositories.ExportParticipant
+	Speakers      []string // Distinct speaker names in order of appearance
+	ActionItems   []repositories.ExportActionItem
+	Options       *ExportOptions
+	Fields        map[string]interface{} // ExportOptions.CustomFields
+}
+
+// errTemplatedExportTooLarge is returned by limitedBuffer once the output exceeds its limit
+var errTemplatedExportTooLarge = errors.New("templated export too large")
+
+// limitedBuffer is a bytes.Buffer that refuses writes past a limit
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, errTemplatedExportTooLarge
+	}
+	return b.Buffer.Write(p)
+}
+
+// WithTemplates sets the repository of user-uploaded export templates
+func (s *ExportService) WithTemplates(templates repositories.ExportTemplateRepository) *ExportService {
+	s.templates = templates
+	return s
+}
+
+// WithExportContext sets the repository that provides meeting details, participants and action items
+func (s *ExportService) WithExportContext(exportContext repositories.ExportContextRepository) *ExportService {
+	s.exportContext = exportContext
+	return s
+}
+
+// exportTemplated renders transcription through a named Markdown or HTML export template
+func (s *ExportService) exportTemplated(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions, format string) (*ExportDocument, error) {
+	templateFormat := entities.MarkdownTemplate
+	contentType := "text/markdown; charset=utf-8"
+	if format == "html" {
+		templateFormat = entities.HTMLTemplate
+		contentType = "text/html; charset=utf-8"
+	}
+
+	name := options.Template
+	if name == "" {
+		name = DefaultExportTemplate
+	}
+	renderer, err := s.resolveExportTemplate(ctx, name, templateFormat, options.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	generatedAt := time.Now()
+	data, err := s.buildTemplateData(ctx, transcription, options, generatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &limitedBuffer{limit: MaxTemplatedExportSize}
+	if err := renderer.Execute(output, data); err != nil {
+		if errors.Is(err, errTemplatedExportTooLarge) {
+			return nil, domain.NewDomainError("EXPORT_TOO_LARGE", "Templated export exceeds 10 MB", domain.ErrInvalidInput)
+		}
+		return nil, domain.NewDomainError("INVALID_EXPORT_TEMPLATE", "Failed to render template '"+name+"': "+err.Error(), domain.ErrInvalidInput)
+	}
+
+	return newExportDocument(transcription, output.Bytes(), format, contentType, generatedAt, map[string]interface{}{
+		"transcription_id": transcription.ID,
+		"template":         name,
+	}), nil
+}
+
+// resolveExportTemplate finds a built-in template by name, falling back to the user's own templates
+func (s *ExportService) resolveExportTemplate(ctx context.Context, name string, format entities.ExportTemplateFormat, userID string) (services.ExportTemplateRenderer, error) {
+	if builtin, ok := services.FindBuiltinExportTemplate(name); ok {
+		return services.ParseExportTemplate(name, format, builtin.Body(format))
+	}
+
+	if s.templates == nil || userID == "" {
+		return nil, domain.NewDomainError("EXPORT_TEMPLATE_NOT_FOUND", "Export template '"+name+"' not found", domain.ErrNotFound)
+	}
+	template, err := s.templates.FindByUserIDAndName(ctx, userID, name)
+	if err != nil {
+		return nil, domain.NewDomainError("EXPORT_TEMPLATE_NOT_FOUND", "Export template '"+name+"' not found", err)
+	}
+	if template.Format != format {
+		return nil, domain.NewDomainError("INVALID_EXPORT_TEMPLATE",
+			fmt.Sprintf("Template '%s' renders %s, not %s", name, template.Format, format), domain.ErrInvalidInput)
+	}
+	return services.ParseExportTemplate(name, template.Format, template.Body)
+}
+
+// buildTemplateData collects the transcription and its meeting details for a template
+func (s *ExportService) buildTemplateData(ctx context.Context, transcription *TranscriptionHistoryItem, options *ExportOptions, generatedAt time.Time) (*ExportTemplateData, error) {
+	data := &ExportTemplateData{
+		GeneratedAt:   generatedAt,
+		Transcription: transcription,
+		Segments:      transcription.Segments,
+		Stats:         transcription.Stats,
+		Participants:  []repositories.ExportParticipant{},
+		ActionItems:   []repositories.ExportActionItem{},
+		Options:       options,
+		Fields:        options.CustomFields,
+	}
+
+	if s.exportContext != nil && transcription.MeetingID != "" {
+		exportContext, err := s.exportContext.FindByMeetingID(ctx, transcription.MeetingID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load meeting details: %w", err)
+		}
+		data.Meeting = exportContext.Meeting
+		data.Participants = exportContext.Participants
+		data.ActionItems = exportContext.ActionItems
+	}
+
+	data.Title = exportTitle(options)
+	if strings.TrimSpace(options.Title) == "" && data.Meeting != nil && data.Meeting.Title != "" {
+		data.Title = data.Meeting.Title
+	}
+
+	seen := make(map[string]bool)
+	for _, segment := range transcription.Segments {
+		if segment.Speaker != "" && !seen[segment.Speaker] {
+			seen[segment.Speaker] = true
+			data.Speakers = append(data.Speakers, segment.Speaker)
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+)
+
+// recordingUploader keeps uploaded documents in memory, the last one in its fields
+type recordingUploader struct {
+	mu          sync.Mutex
+	documents   map[string][]byte // By object name
+	data        []byte
+	fileName    string
+	contentType string
+	expiresAt   time.Time
+}
+
+func (u *recordingUploader) UploadDocument(ctx context.Context, data []byte, objectName, fileName, contentType string, expiresAt time.Time) (string, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.documents == nil {
+		u.documents = make(map[string][]byte)
+	}
+	u.documents[objectName] = data
+	u.data = data
+	u.fileName = fileName
+	u.contentType = contentType
+	u.expiresAt = expiresAt
+	return "https://storage.example.com/" + objectName, nil
+}
+
+// stubExportTemplateRepository serves templates from memory
+type stubExportTemplateRepository struct {
+	repositories.ExportTemplateRepository
+	templates []*entities.ExportTemplate
+}
+
+func (r *stubExportTemplateRepository) FindByUserIDAndName(ctx context.Context, userID, name string) (*entities.ExportTemplate, error) {
+	for _, template := range r.templates {
+		if template.UserID == userID && template.Name == name {
+			return template, nil
+		}
+	}
+	return nil, fmt.Errorf("record not found")
+}
+
+// stubExportContextRepository returns a fixed export context
+type stubExportContextRepository struct {
+	context *repositories.ExportContext
+}
+
+func (r *stubExportContextRepository) FindByMeetingID(ctx context.Context, meetingID string) (*repositories.ExportContext, error) {
+	return r.context, nil
+}
+
+// stubTranscriptionRepository serves a single transcription from memory
+type stubTranscriptionRepository struct {
+	repositories.TranscriptionRepository
+	transcription *entities.Transcription
+}
+
+func (r *stubTranscriptionRepository) FindByID(ctx context.Context, id string) (*entities.Transcription, error) {
+	if r.transcription.GetID() != id {
+		return nil, fmt.Errorf("record not found")
+	}
+	return r.transcription, nil
+}
+
+func (r *stubTranscriptionRepository) FindByMeetingID(ctx context.Context, meetingID string) ([]*entities.Transcription, error) {
+	if r.transcription.MeetingID != meetingID {
+		return nil, nil
+	}
+	return []*entities.Transcription{r.transcription}, nil
+}
+
+func (r *stubTranscriptionRepository) FindSegmentsByTranscriptionID(ctx context.Context, transcriptionID string) ([]entities.TranscriptSegment, error) {
+	return r.transcription.Segments, nil
+}
+
+func (r *stubTranscriptionRepository) GetSegmentCount(ctx context.Context, transcriptionID string) (int, error) {
+	return len(r.transcription.Segments), nil
+}
+
+// stubMeetingRepository serves a single meeting without shares
+type stubMeetingRepository struct {
+	meetingRepos.MeetingRepository
+	meeting      *meetingRepos.Meeting
+	participants []*meetingRepos.Participant
+}
+
+func (r *stubMeetingRepository) FindMeetingsByUserID(ctx context.Context, userID string) ([]*meetingRepos.Meeting, error) {
+	if r.meeting.UserID != userID {
+		return nil, nil
+	}
+	return []*meetingRepos.Meeting{r.meeting}, nil
+}
+
+func (r *stubMeetingRepository) FindParticipantsByMeetingID(ctx context.Context, meetingID string) ([]*meetingRepos.Participant, error) {
+	return r.participants, nil
+}
+
+func (r *stubMeetingRepository) FindMeetingByID(ctx context.Context, id string) (*meetingRepos.Meeting, error) {
+	return r.meeting, nil
+}
+
+func (r *stubMeetingRepository) FindMeetingShare(ctx context.Context, meetingID, userID string) (*meetingRepos.MeetingShare, error) {
+	return nil, fmt.Errorf("record not found")
+}
+
+// memoryTranscriptionRepository stores saved transcriptions and their segments
+type memoryTranscriptionRepository struct {
+	repositories.TranscriptionRepository
+	transcriptions map[string]entities.Transcription
+	segments       map[string][]entities.TranscriptSegment
+}
+
+func (r *memoryTranscriptionRepository) Save(ctx context.Context, transcription *entities.Transcription) error {
+	r.transcriptions[transcription.GetID()] = *transcription
+	return nil
+}
+
+func (r *memoryTranscriptionRepository) FindByID(ctx context.Context, id string) (*entities.Transcription, error) {
+	transcription, ok := r.transcriptions[id]
+	if !ok {
+		return nil, fmt.Errorf("record not found")
+	}
+	return &transcription, nil
+}
+
+func (r *memoryTranscriptionRepository) Update(ctx context.Context, transcription *entities.Transcription) error {
+	return r.Save(ctx, transcription)
+}
+
+func (r *memoryTranscriptionRepository) SaveSegments(ctx context.Context, transcriptionID string, segments []entities.TranscriptSegment) error {
+	r.segments[transcriptionID] = segments
+	return nil
+}
+
+// memoryMeetingRepository stores meetings and participants
+type memoryMeetingRepository struct {
+	meetingRepos.MeetingRepository
+	meetings     map[string]*meetingRepos.Meeting
+	participants []*meetingRepos.Participant
+}
+
+func (r *memoryMeetingRepository) SaveMeeting(ctx context.Context, meeting *meetingRepos.Meeting) error {
+	r.meetings[meeting.ID] = meeting
+	return nil
+}
+
+func (r *memoryMeetingRepository) UpdateMeeting(ctx context.Context, meeting *meetingRepos.Meeting) error {
+	return r.SaveMeeting(ctx, meeting)
+}
+
+func (r *memoryMeetingRepository) FindMeetingByID(ctx context.Context, id string) (*meetingRepos.Meeting, error) {
+	meeting, ok := r.meetings[id]
+	if !ok {
+		return nil, fmt.Errorf("record not found")
+	}
+	return meeting, nil
+}
+
+func (r *memoryMeetingRepository) FindMeetingShare(ctx context.Context, meetingID, userID string) (*meetingRepos.MeetingShare, error) {
+	return nil, fmt.Errorf("record not found")
+}
+
+func (r *memoryMeetingRepository) SaveParticipant(ctx context.Context, participant *meetingRepos.Participant) error {
+	r.participants = append(r.participants, participant)
+	return nil
+}
+
+func (r *memoryMeetingRepository) FindParticipantsByMeetingID(ctx context.Context, meetingID string) ([]*meetingRepos.Participant, error) {
+	var participants []*meetingRepos.Participant
+	for _, participant := range r.participants {
+		if participant.MeetingID == meetingID {
+			participants = append(participants, participant)
+		}
+	}
+	return participants, nil
+}
+
+type emptyVocabularyRepository struct {
+	repositories.VocabularyRepository
+}
+
+func (r *emptyVocabularyRepository) FindForMeetingType(ctx context.Context, userID, meetingType string) ([]*entities.Vocabulary, error) {
+	return nil, nil
+}
+
+type emptyRedactionSettingsRepository struct {
+	repositories.RedactionSettingsRepository
+}
+
+func (r *emptyRedactionSettingsRepository) FindByUserID(ctx context.Context, userID string) (*entities.RedactionSettings, error) {
+	return nil, nil
+}
+
+// memoryAnalyticsSnapshotRepository keeps one snapshot per meeting in memory
+type memoryAnalyticsSnapshotRepository struct {
+	repositories.AnalyticsSnapshotRepository
+	snapshots map[string]entities.AnalyticsSnapshot
+}
+
+func (r *memoryAnalyticsSnapshotRepository) ReplaceSnapshot(ctx context.Context, snapshot *entities.AnalyticsSnapshot) error {
+	r.snapshots[snapshot.MeetingID] = *snapshot
+	return nil
+}
+
+func (r *memoryAnalyticsSnapshotRepository) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	delete(r.snapshots, meetingID)
+	return nil
+}
+
+// activeTaxonomyRepository serves a single active taxonomy
+type activeTaxonomyRepository struct {
+	repositories.TaxonomyRepository
+	taxonomy *entities.Taxonomy
+}
+
+func (r *activeTaxonomyRepository) FindActiveForUser(ctx context.Context, userID string) (*entities.Taxonomy, error) {
+	if r.taxonomy == nil || r.taxonomy.UserID != userID {
+		return nil, nil
+	}
+	return r.taxonomy, nil
+}
+
+// memoryTranscriptSummaryRepository keeps the latest summary of each transcription
+type memoryTranscriptSummaryRepository struct {
+	repositories.TranscriptSummaryRepository
+	mu        sync.Mutex
+	summaries map[string]entities.TranscriptSummary
+}
+
+func (r *memoryTranscriptSummaryRepository) ReplaceSummary(ctx context.Context, summary *entities.TranscriptSummary) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.summaries[summary.TranscriptionID] = *summary
+	return nil
+}
+
+func (r *memoryTranscriptSummaryRepository) FindByTranscriptionID(ctx context.Context, transcriptionID string) (*entities.TranscriptSummary, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	summary, ok := r.summaries[transcriptionID]
+	if !ok {
+		return nil, nil
+	}
+	return &summary, nil
+}
+
+// memoryMeetingQuestionRepository keeps questions in memory
+type memoryMeetingQuestionRepository struct {
+	repositories.MeetingQuestionRepository
+	questions []*entities.MeetingQuestion
+}
+
+func (r *memoryMeetingQuestionRepository) Save(ctx context.Context, question *entities.MeetingQuestion) error {
+	r.questions = append(r.questions, question)
+	return nil
+}
+
+func (r *memoryMeetingQuestionRepository) FindByID(ctx context.Context, id string) (*entities.MeetingQuestion, error) {
+	for _, question := range r.questions {
+		if question.GetID() == id {
+			return question, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryMeetingQuestionRepository) FindByMeetingID(ctx context.Context, meetingID string, limit, offset int) ([]*entities.MeetingQuestion, int64, error) {
+	var questions []*entities.MeetingQuestion
+	for i := len(r.questions) - 1; i >= 0; i-- {
+		if r.questions[i].MeetingID == meetingID {
+			questions = append(questions, r.questions[i])
+		}
+	}
+	total := int64(len(questions))
+	if offset >= len(questions) {
+		return nil, total, nil
+	}
+	questions = questions[offset:]
+	if len(questions) > limit {
+		questions = questions[:limit]
+	}
+	return questions, total, nil
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"hash/fnv"
+	"sync"
+)
+
+// keyLockStripes is the number of mutexes shared by all keys of a keyLocks
+const keyLockStripes = 64
+
+// keyLocks serializes work per key on a fixed set of mutexes, so it does not grow with the
+// number of keys it has seen. Keys that hash to the same mutex wait for each other.
+type keyLocks [keyLockStripes]sync.Mutex
+
+// lock locks the mutex of a key and returns the function that unlocks it
+func (l *keyLocks) lock(key string) func() {
+	hash := fnv.New32a()
+	hash.Write([]byte(key))
+	mutex := &l[hash.Sum32()%keyLockStripes]
+	mutex.Lock()
+	return mutex.Unlock
+}
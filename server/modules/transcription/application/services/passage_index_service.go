@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// PassageIndexService keeps the passage index of completed transcriptions up to date and
+// answers top-k retrieval queries against it
+type PassageIndexService struct {
+	transcriptionRepo repositories.TranscriptionRepository
+	chunkRepo         repositories.TranscriptChunkRepository
+	embedder          services.Embedder
+	chunking          services.ChunkingOptions
+	searchHandler     *queries.SearchPassagesHandler
+	similarHandler    *queries.SimilarPassagesHandler
+
+	// Serializes indexing per transcription; events are delivered concurrently
+	locks keyLocks
+}
+
+// NewPassageIndexService creates a new passage index service
+func NewPassageIndexService(
+	transcriptionRepo repositories.TranscriptionRepository,
+	chunkRepo repositories.TranscriptChunkRepository,
+	embedder services.Embedder,
+) *PassageIndexService {
+	return &PassageIndexService{
+		transcriptionRepo: transcriptionRepo,
+		chunkRepo:         chunkRepo,
+		embedder:          embedder,
+		chunking:          services.DefaultChunkingOptions(),
+		searchHandler:     queries.NewSearchPassagesHandler(chunkRepo, embedder),
+		similarHandler:    queries.NewSimilarPassagesHandler(chunkRepo, embedder),
+	}
+}
+
+// SubscribeToEvents re-indexes transcriptions when they complete or their segments are edited
+func (s *PassageIndexService) SubscribeToEvents(eventBus events.EventBus) {
+	eventBus.Subscribe("transcription.completed", func(event interface{}) {
+		if completedEvent, ok := event.(*commands.TranscriptionCompletedEvent); ok {
+			if err := s.IndexTranscription(context.Background(), completedEvent.TranscriptionID); err != nil {
+				log.Printf("Failed to index transcription %s: %v", completedEvent.TranscriptionID, err)
+			}
+		}
+	})
+
+	eventBus.Subscribe("transcription.segments_updated", func(event interface{}) {
+		if updatedEvent, ok := event.(*commands.TranscriptSegmentsUpdatedEvent); ok {
+			if err := s.IndexTranscription(context.Background(), updatedEvent.TranscriptionID); err != nil {
+				log.Printf("Failed to re-index transcription %s: %v", updatedEvent.TranscriptionID, err)
+			}
+		}
+	})
+}
+
+// IndexTranscription chunks and embeds the segments of a completed transcription, replacing any previous passages
+func (s *PassageIndexService) IndexTranscription(ctx context.Context, transcriptionID string) error {
+	unlock := s.locks.lock(transcriptionID)
+	defer unlock()
+
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if !transcription.IsCompleted() {
+		return nil
+	}
+
+	segments, err := s.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, transcriptionID)
+	if err != nil {
+		return domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+	}
+
+	chunks := services.ChunkSegments(transcriptionID, transcription.MeetingID, segments, s.chunking)
+	if err := s.embedChunks(ctx, chunks); err != nil {
+		return err
+	}
+
+	if err := s.chunkRepo.ReplaceChunks(ctx, transcriptionID, s.embedder.Name(), chunks); err != nil {
+		return domain.NewDomainError("SAVE_CHUNKS_FAILED", "Failed to save indexed passages", err)
+	}
+
+	log.Printf("Indexed %d passages for transcription %s with %s", len(chunks), transcriptionID, s.embedder.Name())
+	return nil
+}
+
+// IndexMissing indexes completed transcriptions that have no passages yet, e.g. after switching embedder
+func (s *PassageIndexService) IndexMissing(ctx context.Context) error {
+	ids, err := s.chunkRepo.FindUnindexedTranscriptionIDs(ctx, s.embedder.Name())
+	if err != nil {
+		return domain.NewDomainError("FIND_UNINDEXED_FAILED", "Failed to find unindexed transcriptions", err)
+	}
+
+	for _, id := range ids {
+		if err := s.IndexTranscription(ctx, id); err != nil {
+			log.Printf("Failed to index transcription %s: %v", id, err)
+		}
+	}
+	return nil
+}
+
+// SearchPassages returns the top-k passages for a query across the meetings the user can access
+func (s *PassageIndexService) SearchPassages(ctx context.Context, query queries.SearchPassagesQuery) (*queries.SearchPassagesResult, error) {
+	return s.searchHandler.Handle(ctx, query)
+}
+
+// SimilarPassages returns the top-k passages that discuss the same things as a given passage
+func (s *PassageIndexService) SimilarPassages(ctx context.Context, query queries.SimilarPassagesQuery) (*queries.SimilarPassagesResult, error) {
+	return s.similarHandler.Handle(ctx, query)
+}
+
+func (s *PassageIndexService) embedChunks(ctx context.Context, chunks []entities.TranscriptChunk) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = chunk.Text
+	}
+
+	embeddings, err := s.embedder.EmbedDocuments(ctx, texts)
+	if err != nil {
+		return domain.NewDomainError("EMBED_FAILED", "Failed to embed passages", err)
+	}
+
+	for i := range chunks {
+		chunks[i].SetEmbedding(s.embedder.Name(), embeddings[i])
+	}
+	return nil
+}
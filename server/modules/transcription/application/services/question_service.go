@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	// MaxQuestionLength bounds the length of a meeting question
+	MaxQuestionLength = 1000
+
+	// NoAnswerText is the answer when no part of the transcript matches the question
+	NoAnswerText = "The meeting transcript does not seem to mention this."
+
+	// questionPassageCount is the number of passages retrieved as sources for an answer
+	questionPassageCount = 5
+	// answerTimeout bounds answering a single question
+	answerTimeout = 2 * time.Minute
+
+	defaultQuestionHistoryLimit = 20
+	maxQuestionHistoryLimit     = 100
+)
+
+// PassageSearcher retrieves the transcript passages most relevant to a query
+type PassageSearcher interface {
+	SearchPassages(ctx context.Context, query queries.SearchPassagesQuery) (*queries.SearchPassagesResult, error)
+}
+
+// QuestionHistory is a page of the questions asked about a meeting, newest first
+type QuestionHistory struct {
+	Questions []*entities.MeetingQuestion
+	Total     int64
+	Limit     int
+	Offset    int
+}
+
+// QuestionService answers questions about meetings from the segments retrieved from their
+// transcripts and keeps the question history of each meeting
+type QuestionService struct {
+	transcriptionRepo repositories.TranscriptionRepository
+	questionRepo      repositories.MeetingQuestionRepository
+	passages          PassageSearcher
+	answerers         []services.QuestionAnswerer
+	accessService     *meetingServices.MeetingAccessService
+}
+
+// NewQuestionService creates a new question service. Answerers are tried in order, so the
+// extractive answerer belongs last as the fallback for every meeting.
+func NewQuestionService(
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	questionRepo repositories.MeetingQuestionRepository,
+	passages PassageSearcher,
+	answerers ...services.QuestionAnswerer,
+) *QuestionService {
+	return &QuestionService{
+		transcriptionRepo: transcriptionRepo,
+		questionRepo:      questionRepo,
+		passages:          passages,
+		answerers:         answerers,
+		accessService:     meetingServices.NewMeetingAccessService(meetingRepo),
+	}
+}
+
+// Ask answers a question about a meeting the user can view and adds it to the meeting's history.
+// The answer always cites the transcript segments it is based on.
+func (s *QuestionService) Ask(ctx context.Context, meetingID, userID, question string) (*entities.MeetingQuestion, error) {
+	question = strings.TrimSpace(question)
+	if question == "" {
+		return nil, domain.NewDomainError("INVALID_QUESTION", "Question is required", domain.ErrInvalidInput)
+	}
+	if len(question) > MaxQuestionLength {
+		return nil, domain.NewDomainError("INVALID_QUESTION", "Question is too long", domain.ErrInvalidInput)
+	}
+
+	if _, err := s.accessService.VerifyViewAccess(ctx, meetingID, userID); err != nil {
+		return nil, err
+	}
+
+	transcriptions, err := s.completedTranscriptions(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	if len(transcriptions) == 0 {
+		return nil, domain.NewDomainError("NO_COMPLETED_TRANSCRIPTION", "Meeting has no completed transcription to ask about", domain.ErrInvalidInput)
+	}
+
+	sources, err := s.retrieveSources(ctx, meetingID, userID, question)
+	if err != nil {
+		return nil, err
+	}
+
+	meetingQuestion := entities.NewMeetingQuestion(meetingID, userID, question)
+	if len(sources) == 0 {
+		meetingQuestion.Answer = NoAnswerText
+		meetingQuestion.Method = entities.ExtractiveAnswerMethod
+	} else {
+		answer, err := s.answer(ctx, services.QuestionRequest{
+			Question:       question,
+			Transcriptions: transcriptions,
+			Sources:        sources,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		meetingQuestion.Answer = answer.Answer
+		meetingQuestion.Method = answer.Method
+		meetingQuestion.Model = answer.Model
+		if len(answer.Sources) == 0 {
+			for _, source := range sources {
+				meetingQuestion.CiteSegment(source)
+			}
+		} else {
+			for _, index := range answer.Sources {
+				meetingQuestion.CiteSegment(sources[index])
+			}
+		}
+	}
+
+	if err := s.questionRepo.Save(ctx, &meetingQuestion); err != nil {
+		return nil, domain.NewDomainError("SAVE_QUESTION_FAILED", "Failed to save question", err)
+	}
+	return &meetingQuestion, nil
+}
+
+// GetHistory returns a page of the questions asked about a meeting the user can view
+func (s *QuestionService) GetHistory(ctx context.Context, meetingID, userID string, limit, offset int) (*QuestionHistory, error) {
+	if _, err := s.accessService.VerifyViewAccess(ctx, meetingID, userID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultQuestionHistoryLimit
+	}
+	if limit > maxQuestionHistoryLimit {
+		limit = maxQuestionHistoryLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	questions, total, err := s.questionRepo.FindByMeetingID(ctx, meetingID, limit, offset)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_QUESTIONS_FAILED", "Failed to get question history", err)
+	}
+	return &QuestionHistory{Questions: questions, Total: total, Limit: limit, Offset: offset}, nil
+}
+
+// GetQuestion returns a question asked about a meeting the user can view
+func (s *QuestionService) GetQuestion(ctx context.Context, meetingID, questionID, userID string) (*entities.MeetingQuestion, error) {
+	if _, err := s.accessService.VerifyViewAccess(ctx, meetingID, userID); err != nil {
+		return nil, err
+	}
+
+	question, err := s.questionRepo.FindByID(ctx, questionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_QUESTIONS_FAILED", "Failed to get question", err)
+	}
+	if question == nil || question.MeetingID != meetingID {
+		return nil, domain.NewDomainError("QUESTION_NOT_FOUND", "Question not found", domain.ErrNotFound)
+	}
+	return question, nil
+}
+
+// completedTranscriptions returns the completed transcriptions of a meeting
+func (s *QuestionService) completedTranscriptions(ctx context.Context, meetingID string) ([]*entities.Transcription, error) {
+	transcriptions, err := s.transcriptionRepo.FindByMeetingID(ctx, meetingID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_TRANSCRIPTIONS_FAILED", "Failed to get meeting transcriptions", err)
+	}
+
+	completed := make([]*entities.Transcription, 0, len(transcriptions))
+	for _, transcription := range transcriptions {
+		if transcription.IsCompleted() {
+			completed = append(completed, transcription)
+		}
+	}
+	return completed, nil
+}
+
+// retrieveSources returns the segments of the passages most relevant to the question, most
+// relevant passage first. Segments deleted since the passages were indexed are skipped.
+func (s *QuestionService) retrieveSources(ctx context.Context, meetingID, userID, question string) ([]entities.TranscriptSegment, error) {
+	result, err := s.passages.SearchPassages(ctx, queries.SearchPassagesQuery{
+		UserID:    userID,
+		Query:     question,
+		MeetingID: meetingID,
+		TopK:      questionPassageCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	segmentsByTranscription := make(map[string]map[string]entities.TranscriptSegment)
+	var sources []entities.TranscriptSegment
+	seen := make(map[string]bool)
+	for _, passage := range result.Passages {
+		segments, ok := segmentsByTranscription[passage.TranscriptionID]
+		if !ok {
+			list, err := s.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, passage.TranscriptionID)
+			if err != nil {
+				return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+			}
+			segments = make(map[string]entities.TranscriptSegment, len(list))
+			for _, segment := range list {
+				segments[segment.GetID()] = segment
+			}
+			segmentsByTranscription[passage.TranscriptionID] = segments
+		}
+
+		for _, segmentID := range passage.SegmentIDs {
+			segment, ok := segments[segmentID]
+			if !ok || seen[segmentID] {
+				continue
+			}
+			seen[segmentID] = true
+			sources = append(sources, segment)
+		}
+	}
+	return sources, nil
+}
+
+// answer asks each answerer that supports the meeting in turn until one succeeds
+func (s *QuestionService) answer(ctx context.Context, request services.QuestionRequest) (*services.QuestionAnswer, error) {
+	ctx, cancel := context.WithTimeout(ctx, answerTimeout)
+	defer cancel()
+
+	var lastErr error
+	for _, answerer := range s.answerers {
+		if !answerer.Supports(request.Transcriptions) {
+			continue
+		}
+		answer, err := answerer.Answer(ctx, request)
+		if err != nil {
+			lastErr = err
+			log.Printf("Answerer failed for meeting question, trying the next one: %v", err)
+			continue
+		}
+		answer.Sources = validSources(answer.Sources, len(request.Sources))
+		return answer, nil
+	}
+	if lastErr == nil {
+		lastErr = domain.ErrInvalidInput
+	}
+	return nil, domain.NewDomainError("ANSWER_FAILED", "Failed to answer question", lastErr)
+}
+
+// validSources drops source indexes outside the retrieved sources
+func validSources(indexes []int, sourceCount int) []int {
+	valid := make([]int, 0, len(indexes))
+	for _, index := range indexes {
+		if index >= 0 && index < sourceCount {
+			valid = append(valid, index)
+		}
+	}
+	return valid
+}
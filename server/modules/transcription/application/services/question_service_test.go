@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPassageSearcher returns the same passages for every query
+type stubPassageSearcher struct {
+	passages []queries.PassageResult
+}
+
+func (s *stubPassageSearcher) SearchPassages(ctx context.Context, query queries.SearchPassagesQuery) (*queries.SearchPassagesResult, error) {
+	return &queries.SearchPassagesResult{Passages: s.passages}, nil
+}
+
+// stubQuestionAnswerer answers every question with a fixed answer, or an error
+type stubQuestionAnswerer struct {
+	method  entities.AnswerMethod
+	sources []int
+	err     error
+	request *services.QuestionRequest
+}
+
+func (a *stubQuestionAnswerer) Supports(transcriptions []*entities.Transcription) bool {
+	return true
+}
+
+func (a *stubQuestionAnswerer) Answer(ctx context.Context, request services.QuestionRequest) (*services.QuestionAnswer, error) {
+	a.request = &request
+	if a.err != nil {
+		return nil, a.err
+	}
+	return &services.QuestionAnswer{Answer: "On March third.", Sources: a.sources, Method: a.method}, nil
+}
+
+func newTestQuestionService(answerers ...services.QuestionAnswerer) (*QuestionService, *entities.Transcription) {
+	transcription := entities.NewTranscription("meeting-1", "", "assemblyai")
+	transcription.SetID("tr-1")
+	transcription.CompleteTranscription("", 0.9, []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "Anna", "Let's talk about the launch.", 0, 2, 0.9, 1),
+		entities.NewTranscriptSegment("tr-1", "Ben", "The launch date is March third.", 2, 5, 0.9, 2),
+		entities.NewTranscriptSegment("tr-1", "Anna", "Agreed.", 5, 6, 0.9, 3),
+	})
+
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+
+	service := NewQuestionService(
+		&stubTranscriptionRepository{transcription: &transcription},
+		&stubMeetingRepository{meeting: meeting},
+		&memoryMeetingQuestionRepository{},
+		&stubPassageSearcher{},
+		answerers...,
+	)
+	return service, &transcription
+}
+
+func testPassage(transcription *entities.Transcription, segments ...int) queries.PassageResult {
+	passage := queries.PassageResult{TranscriptionID: transcription.GetID(), MeetingID: transcription.MeetingID}
+	for _, index := range segments {
+		passage.SegmentIDs = append(passage.SegmentIDs, transcription.Segments[index].GetID())
+	}
+	return passage
+}
+
+func TestQuestionService_Ask(t *testing.T) {
+	answerer := &stubQuestionAnswerer{method: entities.LLMAnswerMethod, sources: []int{1}}
+	service, transcription := newTestQuestionService(answerer)
+	service.passages = &stubPassageSearcher{passages: []queries.PassageResult{
+		testPassage(transcription, 1, 2),
+		testPassage(transcription, 0, 1),
+	}}
+
+	question, err := service.Ask(context.Background(), "meeting-1", "owner", "  What did we decide about the launch date? ")
+	require.NoError(t, err)
+
+	assert.Equal(t, "What did we decide about the launch date?", question.Question)
+	assert.Equal(t, "On March third.", question.Answer)
+	assert.Equal(t, entities.LLMAnswerMethod, question.Method)
+	require.Len(t, answerer.request.Sources, 3)
+	assert.Equal(t, "The launch date is March third.", answerer.request.Sources[0].Text)
+	assert.Equal(t, "Let's talk about the launch.", answerer.request.Sources[2].Text)
+
+	require.Len(t, question.Citations, 1)
+	citation := question.Citations[0]
+	assert.Equal(t, transcription.Segments[2].GetID(), citation.SegmentID)
+	assert.Equal(t, "tr-1", citation.TranscriptionID)
+	assert.Equal(t, 5.0, citation.StartTime)
+	assert.Equal(t, 6.0, citation.EndTime)
+
+	history, err := service.GetHistory(context.Background(), "meeting-1", "owner", 0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), history.Total)
+	assert.Equal(t, defaultQuestionHistoryLimit, history.Limit)
+
+	stored, err := service.GetQuestion(context.Background(), "meeting-1", question.GetID(), "owner")
+	require.NoError(t, err)
+	assert.Equal(t, question.GetID(), stored.GetID())
+}
+
+func TestQuestionService_AskFallsBackAndCitesAllSources(t *testing.T) {
+	failing := &stubQuestionAnswerer{method: entities.LemurAnswerMethod, err: errors.New("LeMUR unavailable")}
+	fallback := &stubQuestionAnswerer{method: entities.ExtractiveAnswerMethod}
+	service, transcription := newTestQuestionService(failing, fallback)
+	service.passages = &stubPassageSearcher{passages: []queries.PassageResult{testPassage(transcription, 0, 1)}}
+
+	question, err := service.Ask(context.Background(), "meeting-1", "owner", "When is the launch?")
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExtractiveAnswerMethod, question.Method)
+
+	var cited []string
+	for _, citation := range question.Citations {
+		cited = append(cited, citation.SegmentID)
+	}
+	assert.Equal(t, []string{transcription.Segments[0].GetID(), transcription.Segments[1].GetID()}, cited)
+}
+
+func TestQuestionService_AskWithoutMatchingPassages(t *testing.T) {
+	answerer := &stubQuestionAnswerer{method: entities.LLMAnswerMethod}
+	service, _ := newTestQuestionService(answerer)
+
+	question, err := service.Ask(context.Background(), "meeting-1", "owner", "What about the budget?")
+	require.NoError(t, err)
+	assert.Equal(t, NoAnswerText, question.Answer)
+	assert.Empty(t, question.Citations)
+	assert.Nil(t, answerer.request)
+}
+
+func TestQuestionService_Validation(t *testing.T) {
+	service, _ := newTestQuestionService(&stubQuestionAnswerer{method: entities.ExtractiveAnswerMethod})
+
+	_, err := service.Ask(context.Background(), "meeting-1", "owner", "   ")
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "INVALID_QUESTION", domainErr.Code)
+
+	_, err = service.Ask(context.Background(), "meeting-1", "stranger", "When is the launch?")
+	assert.Error(t, err)
+
+	_, err = service.GetQuestion(context.Background(), "meeting-1", "missing", "owner")
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "QUESTION_NOT_FOUND", domainErr.Code)
+}
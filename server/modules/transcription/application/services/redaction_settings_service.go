@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+)
+
+// RedactionSettingsService manages a user's default PII redaction
+type RedactionSettingsService struct {
+	updateHandler *commands.UpdateRedactionSettingsHandler
+	getHandler    *queries.GetRedactionSettingsHandler
+}
+
+// NewRedactionSettingsService creates a new redaction settings service
+func NewRedactionSettingsService(redactionRepo repositories.RedactionSettingsRepository) *RedactionSettingsService {
+	return &RedactionSettingsService{
+		updateHandler: commands.NewUpdateRedactionSettingsHandler(redactionRepo),
+		getHandler:    queries.NewGetRedactionSettingsHandler(redactionRepo),
+	}
+}
+
+// GetSettings returns the user's redaction settings
+func (s *RedactionSettingsService) GetSettings(ctx context.Context, userID string) (*entities.RedactionSettings, error) {
+	return s.getHandler.Handle(ctx, queries.GetRedactionSettingsQuery{UserID: userID})
+}
+
+// UpdateSettings replaces the user's redaction settings
+func (s *RedactionSettingsService) UpdateSettings(ctx context.Context, cmd commands.UpdateRedactionSettingsCommand) (*entities.RedactionSettings, error) {
+	return s.updateHandler.Handle(ctx, cmd)
+}
+
+// LocalPolicies returns the policies that are also redacted for providers without native support
+func (s *RedactionSettingsService) LocalPolicies() []entities.PIIPolicy {
+	return services.LocalPIIPolicies()
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/application/jobs"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+	jobRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+const (
+	// MaxSummaryAnswerFormatLength bounds the answer format a summary can be regenerated with
+	MaxSummaryAnswerFormatLength = 500
+
+	// summarizeJobWorkers bounds how many transcriptions are summarized at the same time
+	summarizeJobWorkers = 2
+	// summarizeJobTimeout bounds summarizing a single transcription
+	summarizeJobTimeout = 10 * time.Minute
+)
+
+// GeneratedSummary is the outcome of a completed summarize job
+type GeneratedSummary struct {
+	SummaryID string                 `json:"summary_id"`
+	Method    entities.SummaryMethod `json:"method"`
+}
+
+// SummaryService summarizes completed transcriptions in background processing jobs and serves
+// the summaries of meetings the user can view
+type SummaryService struct {
+	summarizeHandler  *commands.SummarizeTranscriptionHandler
+	transcriptionRepo repositories.TranscriptionRepository
+	summaryRepo       repositories.TranscriptSummaryRepository
+	jobRepo           jobRepos.ProcessingJobRepository
+	accessService     *meetingServices.MeetingAccessService
+	runner            *jobs.Runner
+}
+
+// NewSummaryService creates a new summary service. Summarizers are tried in order, so the
+// extractive summarizer belongs last as the fallback for every provider.
+func NewSummaryService(
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	summaryRepo repositories.TranscriptSummaryRepository,
+	jobRepo jobRepos.ProcessingJobRepository,
+	summarizers ...services.Summarizer,
+) *SummaryService {
+	s := &SummaryService{
+		summarizeHandler:  commands.NewSummarizeTranscriptionHandler(transcriptionRepo, summaryRepo, summarizers...),
+		transcriptionRepo: transcriptionRepo,
+		summaryRepo:       summaryRepo,
+		jobRepo:           jobRepo,
+		accessService:     meetingServices.NewMeetingAccessService(meetingRepo),
+	}
+	s.runner = jobs.NewRunner(jobRepo, "summarize", summarizeJobWorkers, summarizeJobTimeout, s.summarize)
+	return s
+}
+
+// SubscribeToEvents summarizes transcriptions when they complete
+func (s *SummaryService) SubscribeToEvents(eventBus events.EventBus) {
+	eventBus.Subscribe("transcription.completed", func(event interface{}) {
+		if completedEvent, ok := event.(*commands.TranscriptionCompletedEvent); ok {
+			if _, err := s.enqueue(context.Background(), completedEvent.TranscriptionID, completedEvent.MeetingID, "", ""); err != nil {
+				log.Printf("Failed to queue summary of transcription %s: %v", completedEvent.TranscriptionID, err)
+			}
+		}
+	})
+}
+
+// GetSummary returns the summary of a transcription in a meeting the user can view
+func (s *SummaryService) GetSummary(ctx context.Context, transcriptionID, userID string) (*entities.TranscriptSummary, error) {
+	if _, err := s.loadViewableTranscription(ctx, transcriptionID, userID); err != nil {
+		return nil, err
+	}
+
+	summary, err := s.summaryRepo.FindByTranscriptionID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_SUMMARY_FAILED", "Failed to get summary", err)
+	}
+	if summary == nil {
+		return nil, domain.NewDomainError("SUMMARY_NOT_FOUND", "Transcription has not been summarized yet", domain.ErrNotFound)
+	}
+	return summary, nil
+}
+
+// Regenerate queues a new summary of a transcription in a meeting the user can edit. The answer
+// format describes how the overview should be written; it is only followed by LeMUR.
+func (s *SummaryService) Regenerate(ctx context.Context, transcriptionID, userID, answerFormat string) (*jobEntities.ProcessingJob, error) {
+	answerFormat = strings.TrimSpace(answerFormat)
+	if len(answerFormat) > MaxSummaryAnswerFormatLength {
+		return nil, domain.NewDomainError("INVALID_SUMMARY_REQUEST", "Answer format is too long", domain.ErrInvalidInput)
+	}
+
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if _, err := s.accessService.VerifyEditAccess(ctx, transcription.MeetingID, userID); err != nil {
+		return nil, err
+	}
+	if !transcription.IsCompleted() {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_COMPLETED", "Only completed transcriptions can be summarized", domain.ErrInvalidInput)
+	}
+
+	return s.enqueue(ctx, transcription.ID, transcription.MeetingID, answerFormat, userID)
+}
+
+// GetJob returns a summarize job of a transcription in a meeting the user can view
+func (s *SummaryService) GetJob(ctx context.Context, transcriptionID, jobID, userID string) (*jobEntities.ProcessingJob, error) {
+	if _, err := s.loadViewableTranscription(ctx, transcriptionID, userID); err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, domain.NewDomainError("SUMMARY_JOB_NOT_FOUND", "Summary job not found", err)
+	}
+	if job.JobType != jobEntities.SummarizeJobType || job.EntityID != transcriptionID {
+		return nil, domain.NewDomainError("SUMMARY_JOB_NOT_FOUND", "Summary job not found", domain.ErrNotFound)
+	}
+	return job, nil
+}
+
+// ResumePendingJobs restarts summarize jobs that were queued or running when the server stopped
+func (s *SummaryService) ResumePendingJobs(ctx context.Context) error {
+	return s.runner.Resume(ctx, jobEntities.SummarizeJobType)
+}
+
+// loadViewableTranscription loads a transcription in a meeting the user can view
+func (s *SummaryService) loadViewableTranscription(ctx context.Context, transcriptionID, userID string) (*entities.Transcription, error) {
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if _, err := s.accessService.VerifyViewAccess(ctx, transcription.MeetingID, userID); err != nil {
+		return nil, err
+	}
+	return transcription, nil
+}
+
+// enqueue saves a summarize job and starts it in the background. Jobs queued when a transcription
+// completes have no requesting user.
+func (s *SummaryService) enqueue(ctx context.Context, transcriptionID, meetingID, answerFormat, userID string) (*jobEntities.ProcessingJob, error) {
+	job := jobEntities.NewProcessingJob("transcription", transcriptionID, jobEntities.SummarizeJobType, map[string]interface{}{
+		"meeting_id":    meetingID,
+		"answer_format": answerFormat,
+		"requested_by":  userID,
+	})
+	if err := s.jobRepo.Save(ctx, &job); err != nil {
+		return nil, domain.NewDomainError("SAVE_SUMMARY_JOB_FAILED", "Failed to queue summary", err)
+	}
+
+	s.runner.Enqueue(job.GetID())
+	return &job, nil
+}
+
+// summarize summarizes the transcription of a job and stores the summary produced on the job
+func (s *SummaryService) summarize(ctx context.Context, job *jobEntities.ProcessingJob) error {
+	summary, err := s.summarizeHandler.Handle(ctx, commands.SummarizeTranscriptionCommand{
+		TranscriptionID: job.EntityID,
+		AnswerFormat:    payloadString(job, "answer_format"),
+	})
+	if err != nil {
+		return err
+	}
+	job.SetPayloadValue("result", GeneratedSummary{SummaryID: summary.ID, Method: summary.Method})
+	return nil
+}
+
+// SummaryJobResult returns the summary produced by a completed summarize job, or nil
+func SummaryJobResult(job *jobEntities.ProcessingJob) *GeneratedSummary {
+	var result GeneratedSummary
+	if !job.IsCompleted() || decodePayloadValue(job, "result", &result) != nil {
+		return nil
+	}
+	return &result
+}
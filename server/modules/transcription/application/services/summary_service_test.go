@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/seedwork/application/jobs/jobstest"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSummarizer returns a fixed summary, or an error, for the providers it supports
+type stubSummarizer struct {
+	provider string
+	method   entities.SummaryMethod
+	err      error
+}
+
+func (s *stubSummarizer) Supports(transcription *entities.Transcription) bool {
+	return s.provider == "" || transcription.Provider == s.provider
+}
+
+func (s *stubSummarizer) Summarize(ctx context.Context, request services.SummaryRequest) (*services.SummaryContent, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &services.SummaryContent{
+		Overview:  "Summary in " + request.AnswerFormat,
+		KeyPoints: []string{request.Segments[0].Text},
+		Decisions: []string{},
+		Method:    s.method,
+	}, nil
+}
+
+func newTestSummaryService(provider string, summarizers ...services.Summarizer) (*SummaryService, *memoryTranscriptSummaryRepository) {
+	transcription := entities.NewTranscription("meeting-1", "", provider)
+	transcription.SetID("tr-1")
+	transcription.CompleteTranscription("Ship it on Friday.", 0.9, []entities.TranscriptSegment{
+		{Speaker: "Anna", Text: "Ship it on Friday.", StartTime: 0, EndTime: 2, Confidence: 0.9},
+	})
+
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+
+	summaryRepo := &memoryTranscriptSummaryRepository{summaries: make(map[string]entities.TranscriptSummary)}
+	service := NewSummaryService(
+		&stubTranscriptionRepository{transcription: &transcription},
+		&stubMeetingRepository{meeting: meeting},
+		summaryRepo,
+		jobstest.NewMemoryProcessingJobRepository(),
+		summarizers...,
+	)
+	return service, summaryRepo
+}
+
+// waitForSummaryJob waits until a summarize job has finished
+func waitForSummaryJob(t *testing.T, service *SummaryService, jobID string) *jobEntities.ProcessingJob {
+	var job *jobEntities.ProcessingJob
+	require.Eventually(t, func() bool {
+		var err error
+		job, err = service.GetJob(context.Background(), "tr-1", jobID, "owner")
+		return err == nil && (job.IsCompleted() || job.IsFailed())
+	}, 5*time.Second, 10*time.Millisecond)
+	return job
+}
+
+func TestSummaryService_Regenerate(t *testing.T) {
+	service, _ := newTestSummaryService("assemblyai", &stubSummarizer{provider: "assemblyai", method: entities.LemurSummaryMethod})
+
+	job, err := service.Regenerate(context.Background(), "tr-1", "owner", " bullet points ")
+	require.NoError(t, err)
+	assert.Equal(t, jobEntities.SummarizeJobType, job.JobType)
+
+	job = waitForSummaryJob(t, service, job.GetID())
+	require.True(t, job.IsCompleted(), job.ErrorMessage)
+	result := SummaryJobResult(job)
+	require.NotNil(t, result)
+	assert.Equal(t, entities.LemurSummaryMethod, result.Method)
+
+	summary, err := service.GetSummary(context.Background(), "tr-1", "owner")
+	require.NoError(t, err)
+	assert.Equal(t, result.SummaryID, summary.GetID())
+	assert.Equal(t, "Summary in bullet points", summary.Overview)
+	assert.Equal(t, "bullet points", summary.AnswerFormat)
+	assert.Equal(t, []string{"Ship it on Friday."}, summary.KeyPoints)
+}
+
+func TestSummaryService_FallsBackWhenSummarizerFails(t *testing.T) {
+	service, summaryRepo := newTestSummaryService("assemblyai",
+		&stubSummarizer{provider: "assemblyai", method: entities.LemurSummaryMethod, err: errors.New("LeMUR unavailable")},
+		&stubSummarizer{method: entities.ExtractiveSummaryMethod},
+	)
+
+	job, err := service.Regenerate(context.Background(), "tr-1", "owner", "bullet points")
+	require.NoError(t, err)
+	job = waitForSummaryJob(t, service, job.GetID())
+	require.True(t, job.IsCompleted(), job.ErrorMessage)
+
+	summary, err := summaryRepo.FindByTranscriptionID(context.Background(), "tr-1")
+	require.NoError(t, err)
+	require.NotNil(t, summary)
+	assert.Equal(t, entities.ExtractiveSummaryMethod, summary.Method)
+	assert.Empty(t, summary.AnswerFormat)
+}
+
+func TestSummaryService_SkipsUnsupportedSummarizers(t *testing.T) {
+	service, summaryRepo := newTestSummaryService("deepgram",
+		&stubSummarizer{provider: "assemblyai", method: entities.LemurSummaryMethod},
+		&stubSummarizer{method: entities.ExtractiveSummaryMethod},
+	)
+
+	job, err := service.Regenerate(context.Background(), "tr-1", "owner", "")
+	require.NoError(t, err)
+	job = waitForSummaryJob(t, service, job.GetID())
+	require.True(t, job.IsCompleted(), job.ErrorMessage)
+
+	summary, err := summaryRepo.FindByTranscriptionID(context.Background(), "tr-1")
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExtractiveSummaryMethod, summary.Method)
+}
+
+func TestSummaryService_Validation(t *testing.T) {
+	service, _ := newTestSummaryService("assemblyai", &stubSummarizer{method: entities.ExtractiveSummaryMethod})
+
+	_, err := service.GetSummary(context.Background(), "tr-1", "owner")
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "SUMMARY_NOT_FOUND", domainErr.Code)
+
+	longFormat := make([]byte, MaxSummaryAnswerFormatLength+1)
+	for i := range longFormat {
+		longFormat[i] = 'a'
+	}
+	_, err = service.Regenerate(context.Background(), "tr-1", "owner", string(longFormat))
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "INVALID_SUMMARY_REQUEST", domainErr.Code)
+
+	_, err = service.Regenerate(context.Background(), "tr-1", "stranger", "")
+	assert.Error(t, err)
+
+	_, err = service.GetJob(context.Background(), "tr-1", "missing", "owner")
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "SUMMARY_JOB_NOT_FOUND", domainErr.Code)
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/modules/transcription/domain/services"
+	userRepos "teammate/server/modules/user/domain/repositories"
+)
+
+// TaxonomyService manages the topic taxonomies and sentiment lexicons used for the analytics of users
+// and their teams
+type TaxonomyService struct {
+	createHandler   *commands.CreateTaxonomyHandler
+	updateHandler   *commands.UpdateTaxonomyHandler
+	deleteHandler   *commands.DeleteTaxonomyHandler
+	activateHandler *commands.ActivateTaxonomyHandler
+	listHandler     *queries.GetTaxonomiesHandler
+	getHandler      *queries.GetTaxonomyHandler
+	teamRepo        userRepos.TeamRepository
+	snapshots       *AnalyticsSnapshotService
+}
+
+// NewTaxonomyService creates a new taxonomy service
+func NewTaxonomyService(taxonomyRepo repositories.TaxonomyRepository, teamRepo userRepos.TeamRepository) *TaxonomyService {
+	return &TaxonomyService{
+		createHandler:   commands.NewCreateTaxonomyHandler(taxonomyRepo, teamRepo),
+		updateHandler:   commands.NewUpdateTaxonomyHandler(taxonomyRepo, teamRepo),
+		deleteHandler:   commands.NewDeleteTaxonomyHandler(taxonomyRepo, teamRepo),
+		activateHandler: commands.NewActivateTaxonomyHandler(taxonomyRepo, teamRepo),
+		listHandler:     queries.NewGetTaxonomiesHandler(taxonomyRepo, teamRepo),
+		getHandler:      queries.NewGetTaxonomyHandler(taxonomyRepo, teamRepo),
+		teamRepo:        teamRepo,
+	}
+}
+
+// WithSnapshots refreshes the analytics snapshots of the meetings a taxonomy applies to when it changes
+func (s *TaxonomyService) WithSnapshots(snapshots *AnalyticsSnapshotService) *TaxonomyService {
+	s.snapshots = snapshots
+	return s
+}
+
+// CreateTaxonomy creates a taxonomy for the user or for a team they own
+func (s *TaxonomyService) CreateTaxonomy(ctx context.Context, cmd commands.CreateTaxonomyCommand) (*entities.Taxonomy, error) {
+	taxonomy, err := s.createHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if taxonomy.Active {
+		s.refreshSnapshots(taxonomy.UserID, cmd.TeamID)
+	}
+	return taxonomy, nil
+}
+
+// UpdateTaxonomy replaces the contents of one of the user's taxonomies or of a team they own
+func (s *TaxonomyService) UpdateTaxonomy(ctx context.Context, cmd commands.UpdateTaxonomyCommand) (*entities.Taxonomy, error) {
+	taxonomy, err := s.updateHandler.Handle(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	if taxonomy.Active {
+		s.refreshTaxonomySnapshots(taxonomy)
+	}
+	return taxonomy, nil
+}
+
+// DeleteTaxonomy deletes one of the user's taxonomies or of a team they own
+func (s *TaxonomyService) DeleteTaxonomy(ctx context.Context, id, userID string) error {
+	taxonomy, err := s.GetTaxonomy(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.deleteHandler.Handle(ctx, commands.DeleteTaxonomyCommand{ID: id, UserID: userID}); err != nil {
+		return err
+	}
+	if taxonomy.Active {
+		s.refreshTaxonomySnapshots(taxonomy)
+	}
+	return nil
+}
+
+// ActivateTaxonomy makes a taxonomy, or the built-in one, used for the analytics of the user or of a team they own
+func (s *TaxonomyService) ActivateTaxonomy(ctx context.Context, cmd commands.ActivateTaxonomyCommand) error {
+	if err := s.activateHandler.Handle(ctx, cmd); err != nil {
+		return err
+	}
+	if cmd.ID != services.DefaultTaxonomyID {
+		if taxonomy, err := s.GetTaxonomy(ctx, cmd.ID, cmd.UserID); err == nil {
+			s.refreshTaxonomySnapshots(taxonomy)
+			return nil
+		}
+	}
+	s.refreshSnapshots(cmd.UserID, cmd.TeamID)
+	return nil
+}
+
+// GetTaxonomies lists the taxonomies of the user and of their teams, and which one their analytics use
+func (s *TaxonomyService) GetTaxonomies(ctx context.Context, userID string) (*queries.TaxonomiesResult, error) {
+	return s.listHandler.Handle(ctx, queries.GetTaxonomiesQuery{UserID: userID})
+}
+
+// GetTaxonomy returns one of the user's taxonomies or of their teams, or the built-in one
+func (s *TaxonomyService) GetTaxonomy(ctx context.Context, id, userID string) (*entities.Taxonomy, error) {
+	return s.getHandler.Handle(ctx, queries.GetTaxonomyQuery{ID: id, UserID: userID})
+}
+
+// GetDefaultTaxonomy returns the built-in taxonomy that seeds new taxonomies
+func (s *TaxonomyService) GetDefaultTaxonomy() entities.Taxonomy {
+	return services.DefaultTaxonomy()
+}
+
+// refreshTaxonomySnapshots re-snapshots the meetings of the taxonomy's user or team
+func (s *TaxonomyService) refreshTaxonomySnapshots(taxonomy *entities.Taxonomy) {
+	teamID := ""
+	if taxonomy.IsTeamTaxonomy() {
+		teamID = *taxonomy.TeamID
+	}
+	s.refreshSnapshots(taxonomy.UserID, teamID)
+}
+
+// refreshSnapshots re-snapshots in the background the meetings of the team's members or, without a
+// team, of the user
+func (s *TaxonomyService) refreshSnapshots(userID, teamID string) {
+	if s.snapshots == nil {
+		return
+	}
+	go func() {
+		ctx := context.Background()
+		userIDs := []string{userID}
+		if teamID != "" && s.teamRepo != nil {
+			team, err := s.teamRepo.FindByID(ctx, teamID)
+			if err != nil || team == nil {
+				log.Printf("Failed to get members of team %s to refresh analytics snapshots: %v", teamID, err)
+				return
+			}
+			userIDs = userIDs[:0]
+			for _, member := range team.Members {
+				userIDs = append(userIDs, member.UserID)
+			}
+		}
+		for _, id := range userIDs {
+			if err := s.snapshots.SnapshotOwnedMeetings(ctx, id); err != nil {
+				log.Printf("Failed to refresh analytics snapshots of user %s: %v", id, err)
+			}
+		}
+	}()
+}
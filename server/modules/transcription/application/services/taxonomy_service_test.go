@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	domainServices "teammate/server/modules/transcription/domain/services"
+	userEntities "teammate/server/modules/user/domain/entities"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTaxonomyRepository keeps taxonomies in memory
+type memoryTaxonomyRepository struct {
+	repositories.TaxonomyRepository
+	taxonomies []*entities.Taxonomy
+	members    *staticTeamRepository
+}
+
+func (r *memoryTaxonomyRepository) Save(ctx context.Context, taxonomy *entities.Taxonomy) error {
+	saved := *taxonomy
+	r.taxonomies = append(r.taxonomies, &saved)
+	return nil
+}
+
+func (r *memoryTaxonomyRepository) Update(ctx context.Context, taxonomy *entities.Taxonomy) error {
+	for i, existing := range r.taxonomies {
+		if existing.ID == taxonomy.ID {
+			saved := *taxonomy
+			r.taxonomies[i] = &saved
+		}
+	}
+	return nil
+}
+
+func (r *memoryTaxonomyRepository) FindByID(ctx context.Context, id string) (*entities.Taxonomy, error) {
+	for _, taxonomy := range r.taxonomies {
+		if taxonomy.ID == id {
+			found := *taxonomy
+			return &found, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (r *memoryTaxonomyRepository) FindByUserID(ctx context.Context, userID string) ([]*entities.Taxonomy, error) {
+	var taxonomies []*entities.Taxonomy
+	for _, taxonomy := range r.taxonomies {
+		if taxonomy.UserID == userID && !taxonomy.IsTeamTaxonomy() {
+			taxonomies = append(taxonomies, taxonomy)
+		}
+	}
+	return taxonomies, nil
+}
+
+func (r *memoryTaxonomyRepository) FindByTeamIDs(ctx context.Context, teamIDs []string) ([]*entities.Taxonomy, error) {
+	var taxonomies []*entities.Taxonomy
+	for _, taxonomy := range r.taxonomies {
+		for _, teamID := range teamIDs {
+			if taxonomy.IsTeamTaxonomy() && *taxonomy.TeamID == teamID {
+				taxonomies = append(taxonomies, taxonomy)
+			}
+		}
+	}
+	return taxonomies, nil
+}
+
+func (r *memoryTaxonomyRepository) FindActiveForUser(ctx context.Context, userID string) (*entities.Taxonomy, error) {
+	personal, _ := r.FindByUserID(ctx, userID)
+	for _, taxonomy := range personal {
+		if taxonomy.Active {
+			return taxonomy, nil
+		}
+	}
+	teams, _ := r.members.FindByUserID(ctx, userID)
+	for _, team := range teams {
+		shared, _ := r.FindByTeamIDs(ctx, []string{team.ID})
+		for _, taxonomy := range shared {
+			if taxonomy.Active {
+				return taxonomy, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryTaxonomyRepository) SetTeamActive(ctx context.Context, teamID, id string) error {
+	for _, taxonomy := range r.taxonomies {
+		if taxonomy.IsTeamTaxonomy() && *taxonomy.TeamID == teamID {
+			taxonomy.Active = taxonomy.ID == id
+		}
+	}
+	return nil
+}
+
+// staticTeamRepository serves a single team
+type staticTeamRepository struct {
+	userRepos.TeamRepository
+	team userEntities.Team
+}
+
+func (r *staticTeamRepository) FindByID(ctx context.Context, id string) (*userEntities.Team, error) {
+	if id != r.team.ID {
+		return nil, nil
+	}
+	return &r.team, nil
+}
+
+func (r *staticTeamRepository) FindByUserID(ctx context.Context, userID string) ([]*userEntities.Team, error) {
+	if r.team.Member(userID) == nil {
+		return nil, nil
+	}
+	return []*userEntities.Team{&r.team}, nil
+}
+
+func (r *staticTeamRepository) FindMember(ctx context.Context, teamID, userID string) (*userEntities.TeamMember, error) {
+	if teamID != r.team.ID {
+		return nil, nil
+	}
+	return r.team.Member(userID), nil
+}
+
+func newTestTaxonomyService(t *testing.T) (*TaxonomyService, string) {
+	team, err := userEntities.NewTeam("Platform", "owner")
+	require.NoError(t, err)
+	member, err := team.NewMember("member", userEntities.TeamMemberRole)
+	require.NoError(t, err)
+	team.Members = append(team.Members, *member)
+
+	teamRepo := &staticTeamRepository{team: team}
+	taxonomyRepo := &memoryTaxonomyRepository{members: teamRepo}
+	return NewTaxonomyService(taxonomyRepo, teamRepo), team.ID
+}
+
+func assertDomainErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, code, domainErr.Code)
+}
+
+func TestTaxonomyService_SharesTeamTaxonomyWithMembers(t *testing.T) {
+	service, teamID := newTestTaxonomyService(t)
+	ctx := context.Background()
+
+	taxonomy, err := service.CreateTaxonomy(ctx, commands.CreateTaxonomyCommand{
+		UserID:      "owner",
+		TeamID:      teamID,
+		Name:        "Platform topics",
+		FromDefault: true,
+		Activate:    true,
+	})
+	require.NoError(t, err)
+	assert.True(t, taxonomy.Active)
+
+	result, err := service.GetTaxonomies(ctx, "member")
+	require.NoError(t, err)
+	require.Len(t, result.Taxonomies, 1)
+	assert.Equal(t, taxonomy.ID, result.ActiveID)
+
+	found, err := service.GetTaxonomy(ctx, taxonomy.ID, "member")
+	require.NoError(t, err)
+	assert.Equal(t, teamID, *found.TeamID)
+
+	result, err = service.GetTaxonomies(ctx, "outsider")
+	require.NoError(t, err)
+	assert.Empty(t, result.Taxonomies)
+	assert.Equal(t, domainServices.DefaultTaxonomyID, result.ActiveID)
+
+	require.NoError(t, service.ActivateTaxonomy(ctx, commands.ActivateTaxonomyCommand{
+		ID:     domainServices.DefaultTaxonomyID,
+		UserID: "owner",
+		TeamID: teamID,
+	}))
+	result, err = service.GetTaxonomies(ctx, "member")
+	require.NoError(t, err)
+	assert.Equal(t, domainServices.DefaultTaxonomyID, result.ActiveID)
+}
+
+func TestTaxonomyService_OnlyTeamOwnersManageTeamTaxonomies(t *testing.T) {
+	service, teamID := newTestTaxonomyService(t)
+	ctx := context.Background()
+
+	_, err := service.CreateTaxonomy(ctx, commands.CreateTaxonomyCommand{
+		UserID: "member", TeamID: teamID, Name: "Mine", FromDefault: true,
+	})
+	assertDomainErrorCode(t, err, "NOT_TEAM_OWNER")
+
+	taxonomy, err := service.CreateTaxonomy(ctx, commands.CreateTaxonomyCommand{
+		UserID: "owner", TeamID: teamID, Name: "Platform topics", FromDefault: true,
+	})
+	require.NoError(t, err)
+
+	_, err = service.UpdateTaxonomy(ctx, commands.UpdateTaxonomyCommand{
+		ID: taxonomy.ID, UserID: "member", Name: "Renamed", Topics: taxonomy.Topics, Sentiment: taxonomy.Sentiment,
+	})
+	assertDomainErrorCode(t, err, "NOT_TEAM_OWNER")
+
+	err = service.ActivateTaxonomy(ctx, commands.ActivateTaxonomyCommand{ID: taxonomy.ID, UserID: "member"})
+	assertDomainErrorCode(t, err, "NOT_TEAM_OWNER")
+
+	_, err = service.GetTaxonomy(ctx, taxonomy.ID, "outsider")
+	assertDomainErrorCode(t, err, "TAXONOMY_NOT_FOUND")
+
+	err = service.DeleteTaxonomy(ctx, taxonomy.ID, "outsider")
+	assertDomainErrorCode(t, err, "TAXONOMY_NOT_FOUND")
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/repositories"
+	userRepos "teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	// summaryTopicCount is the number of topics listed in a meeting summary
+	summaryTopicCount = 5
+	// summaryKeywordCount is the number of keywords listed in a meeting summary
+	summaryKeywordCount = 10
+)
+
+// MeetingAnalyticsSummary is a condensed view of the analytics of a meeting's latest transcription
+type MeetingAnalyticsSummary struct {
+	MeetingID       string                 `json:"meeting_id"`
+	TranscriptionID string                 `json:"transcription_id"`
+	Title           string                 `json:"title"`
+	Duration        float64                `json:"duration_seconds"`
+	SpeakerCount    int                    `json:"speaker_count"`
+	WordCount       int                    `json:"word_count"`
+	Pace            string                 `json:"pace"`
+	Participation   []SpeakerParticipation `json:"participation"`
+	Topics          []string               `json:"topics"`
+	Keywords        []string               `json:"keywords"`
+	Sentiment       string                 `json:"sentiment"`
+	SentimentScore  float64                `json:"sentiment_score"`
+	Quality         string                 `json:"quality"`
+	Insights        []Insight              `json:"insights"`
+	GeneratedAt     time.Time              `json:"generated_at"`
+}
+
+// SpeakerParticipation is a speaker's share of a meeting
+type SpeakerParticipation struct {
+	Speaker             string  `json:"speaker"`
+	SpeakingTime        float64 `json:"speaking_time_seconds"`
+	SpeakingTimePercent float64 `json:"speaking_time_percent"`
+	WordCount           int     `json:"word_count"`
+}
+
+// TranscriptAnalyticsService serves the analytics of transcriptions and meetings the user can view,
+// and trends across them
+type TranscriptAnalyticsService struct {
+	analyticsService  *AnalyticsService
+	transcriptionRepo repositories.TranscriptionRepository
+	accessService     *meetingServices.MeetingAccessService
+	trendsHandler     *queries.GetAnalyticsTrendsHandler
+}
+
+// NewTranscriptAnalyticsService creates a new transcript analytics service
+func NewTranscriptAnalyticsService(
+	analyticsService *AnalyticsService,
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	snapshotRepo repositories.AnalyticsSnapshotRepository,
+	teamRepo userRepos.TeamRepository,
+) *TranscriptAnalyticsService {
+	return &TranscriptAnalyticsService{
+		analyticsService:  analyticsService,
+		transcriptionRepo: transcriptionRepo,
+		accessService:     meetingServices.NewMeetingAccessService(meetingRepo),
+		trendsHandler:     queries.NewGetAnalyticsTrendsHandler(snapshotRepo, teamRepo),
+	}
+}
+
+// GetTranscriptionAnalytics returns the analytics of a transcription in a meeting the user can view
+func (s *TranscriptAnalyticsService) GetTranscriptionAnalytics(ctx context.Context, transcriptionID, userID string) (*AnalyticsData, error) {
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if _, err := s.accessService.VerifyViewAccess(ctx, transcription.MeetingID, userID); err != nil {
+		return nil, err
+	}
+	return s.analyticsService.GetTranscriptionAnalytics(ctx, transcriptionID)
+}
+
+// GetMeetingSummary summarises the analytics of a meeting the user can view
+func (s *TranscriptAnalyticsService) GetMeetingSummary(ctx context.Context, meetingID, userID string) (*MeetingAnalyticsSummary, error) {
+	meeting, err := s.accessService.VerifyViewAccess(ctx, meetingID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	analytics, err := s.analyticsService.GetMeetingAnalyticsSummary(ctx, meetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := SummarizeAnalytics(analytics)
+	summary.Title = meeting.Title
+	return summary, nil
+}
+
+// GetTrends aggregates the analytics snapshots of the user's meetings into time series
+func (s *TranscriptAnalyticsService) GetTrends(ctx context.Context, query queries.GetAnalyticsTrendsQuery) (*queries.AnalyticsTrendsResult, error) {
+	return s.trendsHandler.Handle(ctx, query)
+}
+
+// SummarizeAnalytics condenses analytics into the figures shown in a meeting summary
+func SummarizeAnalytics(analytics *AnalyticsData) *MeetingAnalyticsSummary {
+	summary := &MeetingAnalyticsSummary{
+		MeetingID:       analytics.MeetingID,
+		TranscriptionID: analytics.TranscriptionID,
+		Participation:   make([]SpeakerParticipation, 0, len(analytics.SpeakerAnalytics)),
+		Topics:          []string{},
+		Keywords:        []string{},
+		Insights:        analytics.Insights,
+		GeneratedAt:     analytics.GeneratedAt,
+	}
+	if summary.Insights == nil {
+		summary.Insights = []Insight{}
+	}
+
+	if metrics := analytics.MeetingMetrics; metrics != nil {
+		summary.Duration = metrics.TotalDuration
+		summary.SpeakerCount = metrics.SpeakerCount
+		summary.WordCount = metrics.WordCount
+		summary.Pace = metrics.OverallPace
+	}
+	if sentiment := analytics.SentimentAnalysis; sentiment != nil {
+		summary.Sentiment = sentiment.OverallSentiment
+		summary.SentimentScore = sentiment.SentimentScore
+	}
+	if quality := analytics.QualityMetrics; quality != nil {
+		summary.Quality = quality.OverallQuality
+	}
+
+	for _, speaker := range analytics.SpeakerAnalytics {
+		summary.Participation = append(summary.Participation, SpeakerParticipation{
+			Speaker:             speaker.Speaker,
+			SpeakingTime:        speaker.SpeakingTime,
+			SpeakingTimePercent: speaker.SpeakingTimePercent,
+			WordCount:           speaker.WordCount,
+		})
+	}
+	for i, topic := range analytics.TopicAnalysis {
+		if i == summaryTopicCount {
+			break
+		}
+		summary.Topics = append(summary.Topics, topic.Topic)
+	}
+	for i, keyword := range analytics.KeywordFrequency {
+		if i == summaryKeywordCount {
+			break
+		}
+		summary.Keywords = append(summary.Keywords, keyword.Keyword)
+	}
+	return summary
+}
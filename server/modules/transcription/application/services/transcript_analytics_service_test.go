@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/domain"
+	"teammate/server/seedwork/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAnalyticsService() (*TranscriptAnalyticsService, *AnalyticsService, *entities.Transcription) {
+	transcription := entities.NewTranscription("meeting-1", "", "assemblyai")
+	transcription.SetID("tr-1")
+	transcription.CompleteTranscription("", 0.9, []entities.TranscriptSegment{
+		{Speaker: "Alice", Text: "Let's review the budget for the launch", StartTime: 0, EndTime: 4, Confidence: 0.9},
+		{Speaker: "Bob", Text: "The budget looks great, the launch is on track", StartTime: 4, EndTime: 9, Confidence: 0.95},
+	})
+
+	meeting := &meetingRepos.Meeting{UserID: "owner", Title: "Launch sync"}
+	meeting.ID = "meeting-1"
+
+	transcriptionRepo := &stubTranscriptionRepository{transcription: &transcription}
+	meetingRepo := &stubMeetingRepository{meeting: meeting}
+	analyticsService := NewAnalyticsService(transcriptionRepo, meetingRepo)
+	return NewTranscriptAnalyticsService(analyticsService, transcriptionRepo, meetingRepo, nil, nil), analyticsService, &transcription
+}
+
+func TestTranscriptAnalyticsService_CachesUntilTranscriptChanges(t *testing.T) {
+	service, _, transcription := newTestAnalyticsService()
+	ctx := context.Background()
+
+	first, err := service.GetTranscriptionAnalytics(ctx, "tr-1", "owner")
+	require.NoError(t, err)
+	second, err := service.GetTranscriptionAnalytics(ctx, "tr-1", "owner")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	transcription.UpdatedAt = transcription.UpdatedAt.Add(time.Second)
+	third, err := service.GetTranscriptionAnalytics(ctx, "tr-1", "owner")
+	require.NoError(t, err)
+	assert.NotSame(t, first, third)
+}
+
+func TestTranscriptAnalyticsService_InvalidatesOnSegmentsUpdated(t *testing.T) {
+	service, analyticsService, _ := newTestAnalyticsService()
+	ctx := context.Background()
+
+	eventBus := events.NewMemoryEventBus()
+	analyticsService.SubscribeToEvents(eventBus)
+
+	first, err := service.GetTranscriptionAnalytics(ctx, "tr-1", "owner")
+	require.NoError(t, err)
+
+	require.NoError(t, eventBus.Publish("transcription.segments_updated", &commands.TranscriptSegmentsUpdatedEvent{TranscriptionID: "tr-1"}))
+	assert.Eventually(t, func() bool {
+		second, err := service.GetTranscriptionAnalytics(ctx, "tr-1", "owner")
+		return err == nil && second != first
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTranscriptAnalyticsService_DeniesOtherUsers(t *testing.T) {
+	service, _, _ := newTestAnalyticsService()
+	ctx := context.Background()
+
+	_, err := service.GetTranscriptionAnalytics(ctx, "tr-1", "someone-else")
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "UNAUTHORIZED", domainErr.Code)
+
+	_, err = service.GetMeetingSummary(ctx, "meeting-1", "someone-else")
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, "UNAUTHORIZED", domainErr.Code)
+}
+
+func TestTranscriptAnalyticsService_GetMeetingSummary(t *testing.T) {
+	service, _, _ := newTestAnalyticsService()
+
+	summary, err := service.GetMeetingSummary(context.Background(), "meeting-1", "owner")
+	require.NoError(t, err)
+
+	assert.Equal(t, "tr-1", summary.TranscriptionID)
+	assert.Equal(t, "Launch sync", summary.Title)
+	assert.Equal(t, 2, summary.SpeakerCount)
+	assert.Len(t, summary.Participation, 2)
+	assert.LessOrEqual(t, len(summary.Keywords), summaryKeywordCount)
+	assert.LessOrEqual(t, len(summary.Topics), summaryTopicCount)
+}
+
+func TestTranscriptAnalyticsService_UsesActiveTaxonomy(t *testing.T) {
+	service, analyticsService, _ := newTestAnalyticsService()
+	taxonomies := &activeTaxonomyRepository{}
+	analyticsService.WithTaxonomies(taxonomies)
+	ctx := context.Background()
+
+	analytics, err := service.GetTranscriptionAnalytics(ctx, "tr-1", "owner")
+	require.NoError(t, err)
+	assert.NotEmpty(t, analytics.TopicAnalysis)
+	for _, topic := range analytics.TopicAnalysis {
+		assert.NotEqual(t, "Finance", topic.Topic)
+	}
+
+	taxonomy, err := entities.NewTaxonomy("owner", "Finance team", "", []entities.TaxonomyTopic{
+		{Name: "Finance", Terms: []entities.TaxonomyTerm{{Term: "budget", Weight: 2, Synonyms: []string{"spend"}}}},
+	}, entities.SentimentLexicon{Positive: []entities.TaxonomyTerm{{Term: "on track"}}}, true)
+	require.NoError(t, err)
+	taxonomy.Active = true
+	taxonomies.taxonomy = &taxonomy
+
+	analytics, err = service.GetTranscriptionAnalytics(ctx, "tr-1", "owner")
+	require.NoError(t, err)
+	require.Len(t, analytics.TopicAnalysis, 1)
+	assert.Equal(t, "Finance", analytics.TopicAnalysis[0].Topic)
+	assert.Equal(t, 2, analytics.TopicAnalysis[0].Mentions)
+	assert.InDelta(t, 2.0, analytics.TopicAnalysis[0].Relevance, 0.001)
+	assert.Equal(t, 1, analytics.SentimentAnalysis.PositiveSegments)
+}
+
+func TestAnalyticsCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newAnalyticsCache(2)
+	version := "v1"
+
+	cache.put("a", cache.generation("a"), version, &AnalyticsData{TranscriptionID: "a"})
+	cache.put("b", cache.generation("b"), version, &AnalyticsData{TranscriptionID: "b"})
+	time.Sleep(time.Millisecond)
+	require.NotNil(t, cache.get("a", version))
+	cache.put("c", cache.generation("c"), version, &AnalyticsData{TranscriptionID: "c"})
+
+	assert.NotNil(t, cache.get("a", version))
+	assert.Nil(t, cache.get("b", version))
+	assert.NotNil(t, cache.get("c", version))
+}
+
+func TestAnalyticsCache_SkipsAnalyticsComputedBeforeInvalidation(t *testing.T) {
+	cache := newAnalyticsCache(2)
+	version := "v1"
+
+	generation := cache.generation("a")
+	cache.invalidate("a")
+	cache.put("a", generation, version, &AnalyticsData{TranscriptionID: "a"})
+
+	assert.Nil(t, cache.get("a", version))
+}
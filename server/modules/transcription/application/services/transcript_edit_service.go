@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/domain"
+	seedworkRepos "teammate/server/seedwork/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// TranscriptEditService orchestrates manual transcript edits and their revision history
+type TranscriptEditService struct {
+	transcriptionRepo repositories.TranscriptionRepository
+	accessService     *meetingServices.MeetingAccessService
+
+	editSegmentHandler     *commands.EditSegmentHandler
+	splitSegmentHandler    *commands.SplitSegmentHandler
+	mergeSegmentsHandler   *commands.MergeSegmentsHandler
+	restoreRevisionHandler *commands.RestoreRevisionHandler
+	getRevisionsHandler    *queries.GetTranscriptRevisionsHandler
+	getRevisionHandler     *queries.GetTranscriptRevisionHandler
+}
+
+// NewTranscriptEditService creates a new transcript edit service
+func NewTranscriptEditService(
+	transcriptionRepo repositories.TranscriptionRepository,
+	revisionRepo repositories.TranscriptRevisionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	transactor seedworkRepos.Transactor,
+	eventBus events.EventBus,
+) *TranscriptEditService {
+	return &TranscriptEditService{
+		transcriptionRepo:      transcriptionRepo,
+		accessService:          meetingServices.NewMeetingAccessService(meetingRepo),
+		editSegmentHandler:     commands.NewEditSegmentHandler(transcriptionRepo, revisionRepo, transactor, eventBus),
+		splitSegmentHandler:    commands.NewSplitSegmentHandler(transcriptionRepo, revisionRepo, transactor, eventBus),
+		mergeSegmentsHandler:   commands.NewMergeSegmentsHandler(transcriptionRepo, revisionRepo, transactor, eventBus),
+		restoreRevisionHandler: commands.NewRestoreRevisionHandler(transcriptionRepo, revisionRepo, transactor, eventBus),
+		getRevisionsHandler:    queries.NewGetTranscriptRevisionsHandler(revisionRepo),
+		getRevisionHandler:     queries.NewGetTranscriptRevisionHandler(revisionRepo),
+	}
+}
+
+// GetSegments returns the current segments of a transcription
+func (s *TranscriptEditService) GetSegments(ctx context.Context, transcriptionID, userID string) ([]entities.TranscriptSegment, error) {
+	if err := s.verifyAccess(ctx, transcriptionID, userID, false); err != nil {
+		return nil, err
+	}
+
+	segments, err := s.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+	}
+	return segments, nil
+}
+
+// EditSegment changes the text and/or speaker of a segment
+func (s *TranscriptEditService) EditSegment(ctx context.Context, cmd commands.EditSegmentCommand) (*commands.TranscriptEditResult, error) {
+	if err := s.verifyAccess(ctx, cmd.TranscriptionID, cmd.EditedBy, true); err != nil {
+		return nil, err
+	}
+	return s.editSegmentHandler.Handle(ctx, cmd)
+}
+
+// SplitSegment splits a segment in two
+func (s *TranscriptEditService) SplitSegment(ctx context.Context, cmd commands.SplitSegmentCommand) (*commands.TranscriptEditResult, error) {
+	if err := s.verifyAccess(ctx, cmd.TranscriptionID, cmd.EditedBy, true); err != nil {
+		return nil, err
+	}
+	return s.splitSegmentHandler.Handle(ctx, cmd)
+}
+
+// MergeSegments merges adjacent segments into one
+func (s *TranscriptEditService) MergeSegments(ctx context.Context, cmd commands.MergeSegmentsCommand) (*commands.TranscriptEditResult, error) {
+	if err := s.verifyAccess(ctx, cmd.TranscriptionID, cmd.EditedBy, true); err != nil {
+		return nil, err
+	}
+	return s.mergeSegmentsHandler.Handle(ctx, cmd)
+}
+
+// RestoreRevision restores the segments of an earlier revision
+func (s *TranscriptEditService) RestoreRevision(ctx context.Context, cmd commands.RestoreRevisionCommand) (*commands.TranscriptEditResult, error) {
+	if err := s.verifyAccess(ctx, cmd.TranscriptionID, cmd.EditedBy, true); err != nil {
+		return nil, err
+	}
+	return s.restoreRevisionHandler.Handle(ctx, cmd)
+}
+
+// GetRevisions lists the edit history of a transcription
+func (s *TranscriptEditService) GetRevisions(ctx context.Context, transcriptionID, userID string) (*queries.GetTranscriptRevisionsResult, error) {
+	if err := s.verifyAccess(ctx, transcriptionID, userID, false); err != nil {
+		return nil, err
+	}
+	return s.getRevisionsHandler.Handle(ctx, queries.GetTranscriptRevisionsQuery{TranscriptionID: transcriptionID})
+}
+
+// GetRevision returns a single revision of a transcription
+func (s *TranscriptEditService) GetRevision(ctx context.Context, transcriptionID, userID string, revisionNumber int) (*entities.TranscriptRevision, error) {
+	if err := s.verifyAccess(ctx, transcriptionID, userID, false); err != nil {
+		return nil, err
+	}
+	return s.getRevisionHandler.Handle(ctx, queries.GetTranscriptRevisionQuery{
+		TranscriptionID: transcriptionID,
+		RevisionNumber:  revisionNumber,
+	})
+}
+
+// verifyAccess checks that the user may view, or when requireEdit is set edit, the transcription's meeting
+func (s *TranscriptEditService) verifyAccess(ctx context.Context, transcriptionID, userID string, requireEdit bool) error {
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+
+	if requireEdit {
+		_, err = s.accessService.VerifyEditAccess(ctx, transcription.MeetingID, userID)
+	} else {
+		_, err = s.accessService.VerifyViewAccess(ctx, transcription.MeetingID, userID)
+	}
+	return err
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/application/jobs"
+	"teammate/server/seedwork/domain"
+	jobEntities "teammate/server/seedwork/domain/entities"
+	jobRepos "teammate/server/seedwork/domain/repositories"
+)
+
+const (
+	// AsyncExportSegmentThreshold is the number of segments from which exports run as background jobs
+	AsyncExportSegmentThreshold = 1000
+
+	// exportJobWorkers bounds how many background exports render at the same time
+	exportJobWorkers = 2
+	// exportJobTimeout bounds how long a single background export may take
+	exportJobTimeout = 10 * time.Minute
+	// archiveJobWorkers bounds how many archives are built at the same time, apart from exports
+	archiveJobWorkers = 1
+	// archiveJobTimeout bounds how long building an archive of all of a user's meetings may take
+	archiveJobTimeout = 2 * time.Hour
+)
+
+// TranscriptExport is the outcome of an export request: a finished export, or a background job producing it
+type TranscriptExport struct {
+	Result *ExportResult
+	Job    *jobEntities.ProcessingJob
+}
+
+// TranscriptExportService exports transcriptions the user can view, rendering large
+// exports in background processing jobs
+type TranscriptExportService struct {
+	exportService     *ExportService
+	transcriptionRepo repositories.TranscriptionRepository
+	meetingRepo       meetingRepos.MeetingRepository
+	jobRepo           jobRepos.ProcessingJobRepository
+	accessService     *meetingServices.MeetingAccessService
+	analyticsService  *AnalyticsService // Optional, adds analytics to archives
+	audioSource       AudioSource       // Optional, allows archives to include recorded audio
+	exportRunner      *jobs.Runner
+	archiveRunner     *jobs.Runner
+}
+
+// NewTranscriptExportService creates a new transcript export service
+func NewTranscriptExportService(
+	exportService *ExportService,
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	jobRepo jobRepos.ProcessingJobRepository,
+) *TranscriptExportService {
+	s := &TranscriptExportService{
+		exportService:     exportService,
+		transcriptionRepo: transcriptionRepo,
+		meetingRepo:       meetingRepo,
+		jobRepo:           jobRepo,
+		accessService:     meetingServices.NewMeetingAccessService(meetingRepo),
+	}
+	s.exportRunner = jobs.NewRunner(jobRepo, "export", exportJobWorkers, exportJobTimeout, exportJobHandler(s.runExportJob))
+	s.archiveRunner = jobs.NewRunner(jobRepo, "archive export", archiveJobWorkers, archiveJobTimeout, exportJobHandler(s.runArchiveJob))
+	return s
+}
+
+// WithAnalytics includes the analytics of each transcription in archives
+func (s *TranscriptExportService) WithAnalytics(analyticsService *AnalyticsService) *TranscriptExportService {
+	s.analyticsService = analyticsService
+	return s
+}
+
+// WithAudioSource allows archives to include the recorded audio of transcriptions
+func (s *TranscriptExportService) WithAudioSource(audioSource AudioSource) *TranscriptExportService {
+	s.audioSource = audioSource
+	return s
+}
+
+// Export exports a transcription. Exports of long transcriptions, or any export when async
+// is set, are queued as a background job whose progress is available from GetJob.
+func (s *TranscriptExportService) Export(ctx context.Context, transcriptionID, userID string, options ExportOptions, async bool) (*TranscriptExport, error) {
+	if err := s.exportService.ValidateExportOptions(&options); err != nil {
+		return nil, domain.NewDomainError("INVALID_EXPORT_OPTIONS", err.Error(), domain.ErrInvalidInput)
+	}
+	options.UserID = userID
+
+	transcription, err := s.transcriptionRepo.FindByID(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	if _, err := s.accessService.VerifyViewAccess(ctx, transcription.MeetingID, userID); err != nil {
+		return nil, err
+	}
+
+	segmentCount, err := s.transcriptionRepo.GetSegmentCount(ctx, transcriptionID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+	}
+
+	if async || segmentCount >= AsyncExportSegmentThreshold {
+		job, err := s.enqueue(ctx, transcriptionID, userID, options)
+		if err != nil {
+			return nil, err
+		}
+		return &TranscriptExport{Job: job}, nil
+	}
+
+	result, err := s.exportTranscription(ctx, transcription, options)
+	if err != nil {
+		return nil, err
+	}
+	return &TranscriptExport{Result: result}, nil
+}
+
+// GetJob returns an export or archive job started by the user
+func (s *TranscriptExportService) GetJob(ctx context.Context, jobID, userID string) (*jobEntities.ProcessingJob, error) {
+	job, err := s.jobRepo.FindByID(ctx, jobID)
+	if err != nil {
+		return nil, domain.NewDomainError("EXPORT_JOB_NOT_FOUND", "Export job not found", err)
+	}
+	if owner, _ := job.GetPayloadValue("user_id"); !isExportJobType(job.JobType) || owner != userID {
+		return nil, domain.NewDomainError("EXPORT_JOB_NOT_FOUND", "Export job not found", domain.ErrNotFound)
+	}
+	return job, nil
+}
+
+// GetSupportedFormats returns the export formats
+func (s *TranscriptExportService) GetSupportedFormats() []string {
+	return s.exportService.GetSupportedFormats()
+}
+
+// ResumePendingJobs restarts export and archive jobs that were queued or running when the server stopped
+func (s *TranscriptExportService) ResumePendingJobs(ctx context.Context) error {
+	if err := s.exportRunner.Resume(ctx, jobEntities.ExportJobType); err != nil {
+		return err
+	}
+	return s.archiveRunner.Resume(ctx, jobEntities.ArchiveExportJobType)
+}
+
+// enqueue saves an export job and starts it in the background
+func (s *TranscriptExportService) enqueue(ctx context.Context, transcriptionID, userID string, options ExportOptions) (*jobEntities.ProcessingJob, error) {
+	job := jobEntities.NewProcessingJob("transcription", transcriptionID, jobEntities.ExportJobType, map[string]interface{}{
+		"user_id": userID,
+		"format":  options.Format,
+		"options": options,
+	})
+	if err := s.jobRepo.Save(ctx, &job); err != nil {
+		return nil, domain.NewDomainError("SAVE_EXPORT_JOB_FAILED", "Failed to queue export", err)
+	}
+
+	s.exportRunner.Enqueue(job.GetID())
+	return &job, nil
+}
+
+// exportJobTypes are the job types run by the transcript export service
+var exportJobTypes = []string{jobEntities.ExportJobType, jobEntities.ArchiveExportJobType}
+
+// isExportJobType reports whether jobs of a type are run by the transcript export service
+func isExportJobType(jobType string) bool {
+	for _, exportJobType := range exportJobTypes {
+		if jobType == exportJobType {
+			return true
+		}
+	}
+	return false
+}
+
+// exportJobHandler adapts an export or archive function to a job handler that stores the export
+// result on the job
+func exportJobHandler(export func(ctx context.Context, job *jobEntities.ProcessingJob) (*ExportResult, error)) jobs.Handler {
+	return func(ctx context.Context, job *jobEntities.ProcessingJob) error {
+		result, err := export(ctx, job)
+		if err != nil {
+			return err
+		}
+		job.SetPayloadValue("result", result)
+		return nil
+	}
+}
+
+// runExportJob exports the transcription of a job with the options stored in its payload
+func (s *TranscriptExportService) runExportJob(ctx context.Context, job *jobEntities.ProcessingJob) (*ExportResult, error) {
+	var options ExportOptions
+	if err := decodePayloadValue(job, "options", &options); err != nil {
+		return nil, err
+	}
+	if userID, ok := job.GetPayloadValue("user_id"); ok {
+		options.UserID, _ = userID.(string)
+	}
+
+	transcription, err := s.transcriptionRepo.FindByID(ctx, job.EntityID)
+	if err != nil {
+		return nil, domain.NewDomainError("TRANSCRIPTION_NOT_FOUND", "Transcription not found", err)
+	}
+	return s.exportTranscription(ctx, transcription, options)
+}
+
+// exportTranscription loads the segments of a transcription and renders the export
+func (s *TranscriptExportService) exportTranscription(ctx context.Context, transcription *entities.Transcription, options ExportOptions) (*ExportResult, error) {
+	item, err := s.historyItem(ctx, transcription)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.exportService.ExportTranscription(ctx, item, &options)
+	if err != nil {
+		var domainErr *domain.DomainError
+		if errors.As(err, &domainErr) {
+			return nil, err
+		}
+		return nil, domain.NewDomainError("EXPORT_FAILED", "Failed to export transcription", err)
+	}
+	return result, nil
+}
+
+// historyItem loads the segments of a transcription into the form the export service renders
+func (s *TranscriptExportService) historyItem(ctx context.Context, transcription *entities.Transcription) (*TranscriptionHistoryItem, error) {
+	segments, err := s.transcriptionRepo.FindSegmentsByTranscriptionID(ctx, transcription.GetID())
+	if err != nil {
+		return nil, domain.NewDomainError("GET_SEGMENTS_FAILED", "Failed to get transcript segments", err)
+	}
+
+	return &TranscriptionHistoryItem{
+		ID:            transcription.GetID(),
+		MeetingID:     transcription.MeetingID,
+		Status:        transcription.Status,
+		Provider:      transcription.Provider,
+		Content:       transcription.Content,
+		Confidence:    transcription.Confidence,
+		AudioFilePath: transcription.AudioFilePath,
+		Segments:      segments,
+		Stats:         segmentStats(segments),
+		CreatedAt:     transcription.CreatedAt,
+		UpdatedAt:     transcription.UpdatedAt,
+	}, nil
+}
+
+// ExportJobResult returns the export produced by a completed job, or nil
+func ExportJobResult(job *jobEntities.ProcessingJob) *ExportResult {
+	var result ExportResult
+	if !job.IsCompleted() || decodePayloadValue(job, "result", &result) != nil {
+		return nil
+	}
+	return &result
+}
+
+// decodePayloadValue reads a payload value into target. Values come back from the
+// database as generic JSON, so they are converted through their JSON encoding.
+func decodePayloadValue(job *jobEntities.ProcessingJob, key string, target interface{}) error {
+	value, ok := job.GetPayloadValue(key)
+	if !ok {
+		return domain.NewDomainError("INVALID_JOB_PAYLOAD", "Job has no "+key, domain.ErrInvalidInput)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// segmentStats summarises the segments of a single transcription
+func segmentStats(segments []entities.TranscriptSegment) *repositories.TranscriptionStats {
+	stats := &repositories.TranscriptionStats{SegmentCount: len(segments)}
+	speakers := make(map[string]bool)
+	var confidence float64
+	for i := range segments {
+		segment := &segments[i]
+		if segment.EndTime > stats.TotalDuration {
+			stats.TotalDuration = segment.EndTime
+		}
+		if segment.Speaker != "" {
+			speakers[segment.Speaker] = true
+		}
+		stats.WordCount += segment.GetWordCount()
+		confidence += segment.Confidence
+	}
+	stats.SpeakerCount = len(speakers)
+	if len(segments) > 0 {
+		stats.AverageConfidence = confidence / float64(len(segments))
+	}
+	return stats
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/application/jobs/jobstest"
+	jobEntities "teammate/server/seedwork/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTranscriptExportService(segmentCount int) (*TranscriptExportService, *recordingUploader) {
+	transcription := entities.NewTranscription("meeting-1", "", "assemblyai")
+	transcription.SetID("tr-1")
+	transcription.Segments = newTestExportTranscription(segmentCount).Segments
+
+	meeting := &meetingRepos.Meeting{UserID: "owner"}
+	meeting.ID = "meeting-1"
+
+	uploader := &recordingUploader{}
+	service := NewTranscriptExportService(
+		NewExportService(uploader).WithLinkTTL(time.Hour),
+		&stubTranscriptionRepository{transcription: &transcription},
+		&stubMeetingRepository{meeting: meeting},
+		jobstest.NewMemoryProcessingJobRepository(),
+	)
+	return service, uploader
+}
+
+func TestTranscriptExportService_ExportSync(t *testing.T) {
+	service, uploader := newTestTranscriptExportService(3)
+
+	export, err := service.Export(context.Background(), "tr-1", "owner", ExportOptions{Format: "txt", IncludeSpeakers: true}, false)
+	require.NoError(t, err)
+	require.NotNil(t, export.Result)
+	assert.Nil(t, export.Job)
+	assert.Equal(t, "txt", export.Result.Format)
+	assert.Equal(t, uploader.expiresAt, export.Result.ExpiresAt)
+	assert.Equal(t, time.Hour, export.Result.ExpiresAt.Sub(export.Result.GeneratedAt))
+	assert.Contains(t, string(uploader.data), "Анна: Segment 0")
+
+	_, err = service.Export(context.Background(), "tr-1", "someone-else", ExportOptions{Format: "txt"}, false)
+	assert.ErrorContains(t, err, "access denied")
+	_, err = service.Export(context.Background(), "tr-1", "owner", ExportOptions{Format: "xls"}, false)
+	assert.ErrorContains(t, err, "unsupported format")
+}
+
+func TestTranscriptExportService_ExportAsync(t *testing.T) {
+	service, _ := newTestTranscriptExportService(3)
+
+	export, err := service.Export(context.Background(), "tr-1", "owner", ExportOptions{Format: "md"}, true)
+	require.NoError(t, err)
+	require.NotNil(t, export.Job)
+	assert.Nil(t, export.Result)
+	assert.Equal(t, jobEntities.ExportJobType, export.Job.JobType)
+
+	var job *jobEntities.ProcessingJob
+	require.Eventually(t, func() bool {
+		job, err = service.GetJob(context.Background(), export.Job.GetID(), "owner")
+		return err == nil && job.IsCompleted()
+	}, 5*time.Second, 10*time.Millisecond)
+
+	result := ExportJobResult(job)
+	require.NotNil(t, result)
+	assert.Equal(t, "md", result.Format)
+	assert.Equal(t, DefaultExportTemplate, result.Metadata["template"])
+
+	// Jobs are private to the user who started them
+	_, err = service.GetJob(context.Background(), export.Job.GetID(), "someone-else")
+	assert.ErrorContains(t, err, "Export job not found")
+}
+
+func TestTranscriptExportService_LargeExportsRunInBackground(t *testing.T) {
+	service, _ := newTestTranscriptExportService(AsyncExportSegmentThreshold)
+
+	export, err := service.Export(context.Background(), "tr-1", "owner", ExportOptions{Format: "json"}, false)
+	require.NoError(t, err)
+	require.NotNil(t, export.Job)
+
+	require.Eventually(t, func() bool {
+		job, err := service.GetJob(context.Background(), export.Job.GetID(), "owner")
+		return err == nil && job.IsCompleted()
+	}, 5*time.Second, 10*time.Millisecond)
+}
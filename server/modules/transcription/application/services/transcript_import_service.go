@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	meetingServices "teammate/server/modules/meeting/domain/services"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/infrastructure/events"
+)
+
+// TranscriptImportService imports transcripts exported by other tools as completed transcriptions
+type TranscriptImportService struct {
+	accessService *meetingServices.MeetingAccessService
+	importHandler *commands.ImportTranscriptHandler
+}
+
+// NewTranscriptImportService creates a new transcript import service
+func NewTranscriptImportService(
+	transcriptionRepo repositories.TranscriptionRepository,
+	meetingRepo meetingRepos.MeetingRepository,
+	eventBus events.EventBus,
+) *TranscriptImportService {
+	return &TranscriptImportService{
+		accessService: meetingServices.NewMeetingAccessService(meetingRepo),
+		importHandler: commands.NewImportTranscriptHandler(transcriptionRepo, meetingRepo, eventBus),
+	}
+}
+
+// Import imports a transcript into a new meeting, or into an existing meeting the user can edit
+func (s *TranscriptImportService) Import(ctx context.Context, cmd commands.ImportTranscriptCommand) (*commands.ImportTranscriptResult, error) {
+	if cmd.MeetingID != "" {
+		if _, err := s.accessService.VerifyEditAccess(ctx, cmd.MeetingID, cmd.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return s.importHandler.Handle(ctx, cmd)
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	meetingRepos "teammate/server/modules/meeting/domain/repositories"
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/infrastructure/events"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTranscriptImportService() (*TranscriptImportService, *memoryTranscriptionRepository, *memoryMeetingRepository, chan string) {
+	transcriptionRepo := &memoryTranscriptionRepository{
+		transcriptions: make(map[string]entities.Transcription),
+		segments:       make(map[string][]entities.TranscriptSegment),
+	}
+	meetingRepo := &memoryMeetingRepository{meetings: make(map[string]*meetingRepos.Meeting)}
+
+	completed := make(chan string, 1)
+	eventBus := events.NewMemoryEventBus()
+	eventBus.Subscribe("transcription.completed", func(event interface{}) {
+		completed <- event.(*commands.TranscriptionCompletedEvent).TranscriptionID
+	})
+
+	return NewTranscriptImportService(transcriptionRepo, meetingRepo, eventBus), transcriptionRepo, meetingRepo, completed
+}
+
+func TestTranscriptImportService_ImportCreatesMeeting(t *testing.T) {
+	service, transcriptionRepo, meetingRepo, completed := newTestTranscriptImportService()
+
+	// Transcripts exported as SRT can be imported again
+	exported, err := NewExportService(&recordingUploader{}).RenderTranscription(context.Background(), newTestExportTranscription(3), &ExportOptions{Format: "srt", IncludeSpeakers: true})
+	require.NoError(t, err)
+
+	result, err := service.Import(context.Background(), commands.ImportTranscriptCommand{
+		UserID:   "owner",
+		FileName: "weekly-sync.srt",
+		Data:     exported.Data,
+	})
+	require.NoError(t, err)
+	assert.True(t, result.MeetingCreated)
+	assert.Equal(t, "srt", result.Format)
+	assert.Equal(t, []string{"Анна", "田中", "Speaker C"}, result.Speakers)
+	assert.Equal(t, 15.0, result.Duration)
+
+	meeting := meetingRepo.meetings[result.MeetingID]
+	require.NotNil(t, meeting)
+	assert.Equal(t, "owner", meeting.UserID)
+	assert.Equal(t, "weekly-sync", meeting.Title)
+	assert.Equal(t, meetingRepos.MeetingStatusCompleted, meeting.Status)
+	assert.Equal(t, 15*time.Second, meeting.EndTime.Sub(meeting.StartTime))
+	assert.Len(t, meetingRepo.participants, 3)
+
+	transcription := transcriptionRepo.transcriptions[result.TranscriptionID]
+	assert.Equal(t, entities.Completed, transcription.Status)
+	assert.Equal(t, commands.ImportedTranscriptionProvider, transcription.Provider)
+	assert.Contains(t, transcription.Content, "田中: Segment 1")
+
+	segments := transcriptionRepo.segments[result.TranscriptionID]
+	require.Len(t, segments, result.SegmentCount)
+	assert.Equal(t, "Анна", segments[0].Speaker)
+	assert.Contains(t, segments[0].Text, "Segment 0: Привет")
+
+	select {
+	case transcriptionID := <-completed:
+		assert.Equal(t, result.TranscriptionID, transcriptionID)
+	case <-time.After(time.Second):
+		t.Fatal("transcription.completed was not published")
+	}
+}
+
+func TestTranscriptImportService_ImportIntoMeeting(t *testing.T) {
+	service, _, meetingRepo, _ := newTestTranscriptImportService()
+	meeting := &meetingRepos.Meeting{UserID: "owner", Title: "Planning"}
+	meeting.ID = "meeting-1"
+	meetingRepo.meetings[meeting.ID] = meeting
+	meetingRepo.participants = []*meetingRepos.Participant{{MeetingID: "meeting-1", Name: "anna"}}
+
+	data := []byte(`{"segments": [
+		{"speaker": "Anna", "text": "Hello", "start_time": 0, "end_time": 1},
+		{"speaker": "Bob", "text": "Hi", "start_time": 1, "end_time": 2}
+	]}`)
+
+	result, err := service.Import(context.Background(), commands.ImportTranscriptCommand{UserID: "owner", MeetingID: "meeting-1", FileName: "upload", Data: data})
+	require.NoError(t, err)
+	assert.False(t, result.MeetingCreated)
+	assert.Equal(t, "meeting-1", result.MeetingID)
+	assert.Equal(t, "json", result.Format)
+	require.Len(t, meetingRepo.participants, 2)
+	assert.Equal(t, "Bob", meetingRepo.participants[1].Name)
+
+	_, err = service.Import(context.Background(), commands.ImportTranscriptCommand{UserID: "someone-else", MeetingID: "meeting-1", Data: data})
+	assert.ErrorContains(t, err, "access denied")
+
+	_, err = service.Import(context.Background(), commands.ImportTranscriptCommand{UserID: "owner", Format: "vtt", Data: []byte("not a transcript")})
+	assert.ErrorContains(t, err, "must start with WEBVTT")
+	assert.Len(t, meetingRepo.meetings, 1)
+}
@@ -0,0 +1,25 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/repositories"
+)
+
+// TranscriptSearchService provides full-text search over the transcripts a user can access
+type TranscriptSearchService struct {
+	searchHandler *queries.SearchTranscriptsHandler
+}
+
+// NewTranscriptSearchService creates a new transcript search service
+func NewTranscriptSearchService(searchRepo repositories.TranscriptSearchRepository) *TranscriptSearchService {
+	return &TranscriptSearchService{
+		searchHandler: queries.NewSearchTranscriptsHandler(searchRepo),
+	}
+}
+
+// Search returns ranked segment hits with highlighted snippets and deep links
+func (s *TranscriptSearchService) Search(ctx context.Context, query queries.SearchTranscriptsQuery) (*queries.SearchTranscriptsResult, error) {
+	return s.searchHandler.Handle(ctx, query)
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+)
+
+// VocabularyService manages the custom vocabularies applied to a user's transcriptions
+type VocabularyService struct {
+	createHandler *commands.CreateVocabularyHandler
+	updateHandler *commands.UpdateVocabularyHandler
+	deleteHandler *commands.DeleteVocabularyHandler
+	listHandler   *queries.GetVocabulariesHandler
+	getHandler    *queries.GetVocabularyHandler
+}
+
+// NewVocabularyService creates a new vocabulary service
+func NewVocabularyService(vocabularyRepo repositories.VocabularyRepository) *VocabularyService {
+	return &VocabularyService{
+		createHandler: commands.NewCreateVocabularyHandler(vocabularyRepo),
+		updateHandler: commands.NewUpdateVocabularyHandler(vocabularyRepo),
+		deleteHandler: commands.NewDeleteVocabularyHandler(vocabularyRepo),
+		listHandler:   queries.NewGetVocabulariesHandler(vocabularyRepo),
+		getHandler:    queries.NewGetVocabularyHandler(vocabularyRepo),
+	}
+}
+
+// CreateVocabulary creates a vocabulary for the user
+func (s *VocabularyService) CreateVocabulary(ctx context.Context, cmd commands.CreateVocabularyCommand) (*entities.Vocabulary, error) {
+	return s.createHandler.Handle(ctx, cmd)
+}
+
+// UpdateVocabulary replaces the contents of one of the user's vocabularies
+func (s *VocabularyService) UpdateVocabulary(ctx context.Context, cmd commands.UpdateVocabularyCommand) (*entities.Vocabulary, error) {
+	return s.updateHandler.Handle(ctx, cmd)
+}
+
+// DeleteVocabulary deletes one of the user's vocabularies
+func (s *VocabularyService) DeleteVocabulary(ctx context.Context, id, userID string) error {
+	return s.deleteHandler.Handle(ctx, commands.DeleteVocabularyCommand{ID: id, UserID: userID})
+}
+
+// GetVocabularies lists the user's vocabularies
+func (s *VocabularyService) GetVocabularies(ctx context.Context, userID string) ([]*entities.Vocabulary, error) {
+	return s.listHandler.Handle(ctx, queries.GetVocabulariesQuery{UserID: userID})
+}
+
+// GetVocabulary returns one of the user's vocabularies
+func (s *VocabularyService) GetVocabulary(ctx context.Context, id, userID string) (*entities.Vocabulary, error) {
+	return s.getHandler.Handle(ctx, queries.GetVocabularyQuery{ID: id, UserID: userID})
+}
@@ -0,0 +1,50 @@
+package entities
+
+import (
+	"time"
+
+	"teammate/server/seedwork/domain"
+)
+
+// SpeakerShare is how much a speaker talked in a meeting
+type SpeakerShare struct {
+	Speaker      string  `json:"speaker"`
+	SpeakingTime float64 `json:"speaking_time_seconds"`
+	WordCount    int     `json:"word_count"`
+}
+
+// AnalyticsSnapshot holds the analytics of a meeting's latest completed transcription.
+// Trends across meetings are aggregated from snapshots instead of reprocessing transcripts.
+type AnalyticsSnapshot struct {
+	domain.BaseEntity
+	MeetingID       string         `json:"meeting_id" gorm:"column:meeting_id;not null"`
+	TranscriptionID string         `json:"transcription_id" gorm:"column:transcription_id;not null"`
+	DurationSeconds float64        `json:"duration_seconds" gorm:"column:duration_seconds;not null"`
+	WordCount       int            `json:"word_count" gorm:"column:word_count;not null"`
+	Sentiment       string         `json:"sentiment" gorm:"column:sentiment;not null"`
+	SentimentScore  float64        `json:"sentiment_score" gorm:"column:sentiment_score;not null"`
+	Speakers        []SpeakerShare `json:"speakers" gorm:"column:speakers;type:jsonb;serializer:json"`
+	Topics          []string       `json:"topics" gorm:"column:topics;type:jsonb;serializer:json"`
+
+	// Read from the meeting when snapshots are queried for trends
+	MeetingType      string    `json:"meeting_type" gorm:"column:meeting_type;->"`
+	MeetingStartTime time.Time `json:"meeting_start_time" gorm:"column:meeting_start_time;->"`
+}
+
+// NewAnalyticsSnapshot creates an empty snapshot of a meeting's transcription
+func NewAnalyticsSnapshot(meetingID, transcriptionID string) AnalyticsSnapshot {
+	snapshot := AnalyticsSnapshot{
+		MeetingID:       meetingID,
+		TranscriptionID: transcriptionID,
+		Sentiment:       "neutral",
+		Speakers:        []SpeakerShare{},
+		Topics:          []string{},
+	}
+	snapshot.SetID(domain.GenerateID())
+	return snapshot
+}
+
+// TableName sets the table name for GORM
+func (AnalyticsSnapshot) TableName() string {
+	return "analytics_snapshots"
+}
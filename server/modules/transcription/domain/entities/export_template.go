@@ -0,0 +1,75 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+
+	"teammate/server/seedwork/domain"
+)
+
+type ExportTemplateFormat string
+
+const (
+	MarkdownTemplate ExportTemplateFormat = "markdown"
+	HTMLTemplate     ExportTemplateFormat = "html"
+)
+
+// MaxExportTemplateSize is the largest template body accepted, in bytes
+const MaxExportTemplateSize = 64 * 1024
+
+var exportTemplateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// ExportTemplate is a user-uploaded text/template used to render Markdown or HTML exports.
+// Templates are referenced by name, which is unique per user.
+type ExportTemplate struct {
+	domain.BaseEntity
+	UserID      string               `json:"user_id" gorm:"column:user_id;not null"`
+	Name        string               `json:"name" gorm:"column:name;not null"`
+	Description string               `json:"description" gorm:"column:description;not null"`
+	Format      ExportTemplateFormat `json:"format" gorm:"column:format;not null"`
+	Body        string               `json:"body" gorm:"column:body;type:text;not null"`
+}
+
+// NewExportTemplate creates a new ExportTemplate entity
+func NewExportTemplate(userID, name, description string, format ExportTemplateFormat, body string) (ExportTemplate, error) {
+	template := ExportTemplate{UserID: userID}
+	if err := template.Update(name, description, format, body); err != nil {
+		return ExportTemplate{}, err
+	}
+	template.SetID(domain.GenerateID())
+	return template, nil
+}
+
+// Update replaces the contents of the template after validating them.
+// The template syntax is checked separately, see services.ParseExportTemplate.
+func (t *ExportTemplate) Update(name, description string, format ExportTemplateFormat, body string) error {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !exportTemplateNamePattern.MatchString(name) {
+		return domain.NewDomainError("INVALID_EXPORT_TEMPLATE", "Template names must be 1-64 lowercase letters, digits, '-' or '_'", domain.ErrInvalidInput)
+	}
+	if !format.IsValid() {
+		return domain.NewDomainError("INVALID_EXPORT_TEMPLATE", "Template format must be markdown or html", domain.ErrInvalidInput)
+	}
+	if strings.TrimSpace(body) == "" {
+		return domain.NewDomainError("INVALID_EXPORT_TEMPLATE", "Template body is required", domain.ErrInvalidInput)
+	}
+	if len(body) > MaxExportTemplateSize {
+		return domain.NewDomainError("INVALID_EXPORT_TEMPLATE", "Template body must be at most 64 KB", domain.ErrInvalidInput)
+	}
+
+	t.Name = name
+	t.Description = strings.TrimSpace(description)
+	t.Format = format
+	t.Body = body
+	return nil
+}
+
+// IsValid returns true if the format is a known template format
+func (f ExportTemplateFormat) IsValid() bool {
+	return f == MarkdownTemplate || f == HTMLTemplate
+}
+
+// TableName sets the table name for GORM
+func (ExportTemplate) TableName() string {
+	return "export_templates"
+}
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"teammate/server/seedwork/domain"
+)
+
+// AnswerMethod describes how the answer to a meeting question was produced
+type AnswerMethod string
+
+const (
+	// LemurAnswerMethod answers are generated by AssemblyAI LeMUR
+	LemurAnswerMethod AnswerMethod = "lemur"
+	// LLMAnswerMethod answers are generated by the configured language model
+	LLMAnswerMethod AnswerMethod = "llm"
+	// ExtractiveAnswerMethod answers quote the most relevant part of the transcript
+	ExtractiveAnswerMethod AnswerMethod = "extractive"
+)
+
+// AnswerCitation is a transcript segment an answer is based on. The text is kept as it was when
+// the question was asked, so the citation still reads correctly after the transcript is edited.
+type AnswerCitation struct {
+	SegmentID       string  `json:"segment_id"`
+	TranscriptionID string  `json:"transcription_id"`
+	Speaker         string  `json:"speaker,omitempty"`
+	Text            string  `json:"text"`
+	StartTime       float64 `json:"start_time"`
+	EndTime         float64 `json:"end_time"`
+}
+
+// MeetingQuestion is a question asked about a meeting and its answer
+type MeetingQuestion struct {
+	domain.BaseEntity
+	MeetingID string           `json:"meeting_id" gorm:"column:meeting_id;not null"`
+	UserID    string           `json:"user_id" gorm:"column:user_id;not null"`
+	Question  string           `json:"question" gorm:"column:question;type:text;not null"`
+	Answer    string           `json:"answer" gorm:"column:answer;type:text;not null"`
+	Citations []AnswerCitation `json:"citations" gorm:"column:citations;type:jsonb;serializer:json"`
+	Method    AnswerMethod     `json:"method" gorm:"column:method;not null"`
+	Model     string           `json:"model,omitempty" gorm:"column:model;not null"`
+}
+
+// NewMeetingQuestion creates an unanswered question about a meeting
+func NewMeetingQuestion(meetingID, userID, question string) MeetingQuestion {
+	meetingQuestion := MeetingQuestion{
+		MeetingID: meetingID,
+		UserID:    userID,
+		Question:  question,
+		Citations: []AnswerCitation{},
+	}
+	meetingQuestion.SetID(domain.GenerateID())
+	return meetingQuestion
+}
+
+// CiteSegment adds a transcript segment to the citations of the answer
+func (q *MeetingQuestion) CiteSegment(segment TranscriptSegment) {
+	q.Citations = append(q.Citations, AnswerCitation{
+		SegmentID:       segment.GetID(),
+		TranscriptionID: segment.TranscriptionID,
+		Speaker:         segment.Speaker,
+		Text:            segment.Text,
+		StartTime:       segment.StartTime,
+		EndTime:         segment.EndTime,
+	})
+}
+
+// TableName sets the table name for GORM
+func (MeetingQuestion) TableName() string {
+	return "meeting_questions"
+}
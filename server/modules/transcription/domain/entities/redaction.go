@@ -0,0 +1,156 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"teammate/server/seedwork/domain"
+)
+
+// PIIPolicy names a category of personally identifiable information to redact.
+// The values match AssemblyAI's redaction policies.
+type PIIPolicy string
+
+const (
+	PIIMedicalProcess       PIIPolicy = "medical_process"
+	PIIMedicalCondition     PIIPolicy = "medical_condition"
+	PIIBloodType            PIIPolicy = "blood_type"
+	PIIDrug                 PIIPolicy = "drug"
+	PIIInjury               PIIPolicy = "injury"
+	PIINumberSequence       PIIPolicy = "number_sequence"
+	PIIEmailAddress         PIIPolicy = "email_address"
+	PIIDateOfBirth          PIIPolicy = "date_of_birth"
+	PIIPhoneNumber          PIIPolicy = "phone_number"
+	PIIUSSocialSecurity     PIIPolicy = "us_social_security_number"
+	PIICreditCardNumber     PIIPolicy = "credit_card_number"
+	PIICreditCardCVV        PIIPolicy = "credit_card_cvv"
+	PIICreditCardExpiration PIIPolicy = "credit_card_expiration"
+	PIIPersonName           PIIPolicy = "person_name"
+	PIIPersonAge            PIIPolicy = "person_age"
+	PIIOrganization         PIIPolicy = "organization"
+	PIILocation             PIIPolicy = "location"
+	PIIEvent                PIIPolicy = "event"
+	PIILanguage             PIIPolicy = "language"
+	PIINationality          PIIPolicy = "nationality"
+	PIIReligion             PIIPolicy = "religion"
+	PIIPoliticalAffiliation PIIPolicy = "political_affiliation"
+	PIIOccupation           PIIPolicy = "occupation"
+	PIIUSBankNumber         PIIPolicy = "us_bank_number"
+	PIIUSDriversLicense     PIIPolicy = "us_drivers_license"
+	PIIUSPassportNumber     PIIPolicy = "us_passport_number"
+)
+
+var knownPIIPolicies = map[PIIPolicy]bool{
+	PIIMedicalProcess: true, PIIMedicalCondition: true, PIIBloodType: true, PIIDrug: true,
+	PIIInjury: true, PIINumberSequence: true, PIIEmailAddress: true, PIIDateOfBirth: true,
+	PIIPhoneNumber: true, PIIUSSocialSecurity: true, PIICreditCardNumber: true, PIICreditCardCVV: true,
+	PIICreditCardExpiration: true, PIIPersonName: true, PIIPersonAge: true, PIIOrganization: true,
+	PIILocation: true, PIIEvent: true, PIILanguage: true, PIINationality: true,
+	PIIReligion: true, PIIPoliticalAffiliation: true, PIIOccupation: true, PIIUSBankNumber: true,
+	PIIUSDriversLicense: true, PIIUSPassportNumber: true,
+}
+
+// DefaultPIIPolicies are redacted when redaction is enabled without choosing policies.
+// They are also the policies that can be redacted locally for providers without native support.
+var DefaultPIIPolicies = []PIIPolicy{PIIEmailAddress, PIIPhoneNumber, PIICreditCardNumber, PIIUSSocialSecurity}
+
+// IsValid returns true if the policy is a known redaction policy
+func (p PIIPolicy) IsValid() bool {
+	return knownPIIPolicies[p]
+}
+
+// RedactionToken is the placeholder that replaces redacted text, e.g. "[EMAIL_ADDRESS]".
+// It matches the entity name substitution used by AssemblyAI.
+func (p PIIPolicy) RedactionToken() string {
+	return "[" + strings.ToUpper(string(p)) + "]"
+}
+
+// RedactedSpan marks a redaction placeholder in a segment's text.
+// Start and End are character (not byte) offsets, End exclusive.
+type RedactedSpan struct {
+	Type  PIIPolicy `json:"type"`
+	Start int       `json:"start"`
+	End   int       `json:"end"`
+}
+
+var redactionTokenPattern = regexp.MustCompile(`\[([A-Z_]+)\]`)
+
+// FindRedactedSpans locates the redaction placeholders in text
+func FindRedactedSpans(text string) []RedactedSpan {
+	var spans []RedactedSpan
+	for _, match := range redactionTokenPattern.FindAllStringSubmatchIndex(text, -1) {
+		policy := PIIPolicy(strings.ToLower(text[match[2]:match[3]]))
+		if !policy.IsValid() {
+			continue
+		}
+		start := utf8.RuneCountInString(text[:match[0]])
+		spans = append(spans, RedactedSpan{
+			Type:  policy,
+			Start: start,
+			End:   start + utf8.RuneCountInString(text[match[0]:match[1]]),
+		})
+	}
+	return spans
+}
+
+// NormalizePIIPolicies validates policies and removes duplicates
+func NormalizePIIPolicies(policies []PIIPolicy) ([]PIIPolicy, error) {
+	result := make([]PIIPolicy, 0, len(policies))
+	seen := make(map[PIIPolicy]bool, len(policies))
+	for _, policy := range policies {
+		policy = PIIPolicy(strings.ToLower(strings.TrimSpace(string(policy))))
+		if !policy.IsValid() {
+			return nil, domain.NewDomainError("INVALID_PII_POLICY", "Unknown PII redaction policy: "+string(policy), domain.ErrInvalidInput)
+		}
+		if !seen[policy] {
+			seen[policy] = true
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+// RedactionSettings is a user's default PII redaction for transcriptions of their meetings.
+// Sessions can add redaction on top of the default but cannot turn it off.
+type RedactionSettings struct {
+	domain.BaseEntity
+	UserID         string      `json:"user_id" gorm:"column:user_id;not null;uniqueIndex"`
+	RedactPII      bool        `json:"redact_pii" gorm:"column:redact_pii;not null"`
+	RedactPIIAudio bool        `json:"redact_pii_audio" gorm:"column:redact_pii_audio;not null"`
+	PIIPolicies    []PIIPolicy `json:"pii_policies" gorm:"column:pii_policies;type:jsonb;serializer:json"`
+}
+
+// NewRedactionSettings creates the redaction settings of a user
+func NewRedactionSettings(userID string) RedactionSettings {
+	settings := RedactionSettings{
+		UserID:      userID,
+		PIIPolicies: []PIIPolicy{},
+	}
+	settings.SetID(domain.GenerateID())
+	return settings
+}
+
+// Update changes the redaction settings. Redacting audio implies redacting the transcript,
+// and enabling redaction without policies uses DefaultPIIPolicies.
+func (s *RedactionSettings) Update(redactPII, redactPIIAudio bool, policies []PIIPolicy) error {
+	normalized, err := NormalizePIIPolicies(policies)
+	if err != nil {
+		return err
+	}
+
+	redactPII = redactPII || redactPIIAudio
+	if redactPII && len(normalized) == 0 {
+		normalized = append(normalized, DefaultPIIPolicies...)
+	}
+
+	s.RedactPII = redactPII
+	s.RedactPIIAudio = redactPIIAudio
+	s.PIIPolicies = normalized
+	return nil
+}
+
+// TableName sets the table name for GORM
+func (RedactionSettings) TableName() string {
+	return "redaction_settings"
+}
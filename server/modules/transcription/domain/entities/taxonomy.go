@@ -0,0 +1,177 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	// MaxTaxonomyTopics is the largest number of topics in a taxonomy
+	MaxTaxonomyTopics = 100
+	// MaxTaxonomyTerms is the largest number of terms in a topic or sentiment word list
+	MaxTaxonomyTerms = 500
+	// MaxTermWeight is the largest weight a term can be given
+	MaxTermWeight = 10
+)
+
+// TaxonomyTerm is a weighted keyword or phrase. Synonyms count as the term itself.
+type TaxonomyTerm struct {
+	Term     string   `json:"term"`
+	Weight   float64  `json:"weight"`
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// TaxonomyTopic is a topic detected by any of its terms
+type TaxonomyTopic struct {
+	Name  string         `json:"name"`
+	Terms []TaxonomyTerm `json:"terms"`
+}
+
+// SentimentLexicon lists the words that make a segment positive or negative.
+// An empty lexicon falls back to the built-in one.
+type SentimentLexicon struct {
+	Positive []TaxonomyTerm `json:"positive"`
+	Negative []TaxonomyTerm `json:"negative"`
+}
+
+// IsEmpty returns true if the lexicon has no words
+func (l SentimentLexicon) IsEmpty() bool {
+	return len(l.Positive) == 0 && len(l.Negative) == 0
+}
+
+// Taxonomy is a topic taxonomy and sentiment lexicon of a user or, with a TeamID, of a team. The
+// meetings a user owns are analyzed with their active taxonomy, or else with the active taxonomy of
+// the first team they joined that has one, so everyone the meetings are shared with sees the same topics.
+type Taxonomy struct {
+	domain.BaseEntity
+	UserID      string           `json:"user_id" gorm:"column:user_id;not null"`
+	TeamID      *string          `json:"team_id,omitempty" gorm:"column:team_id"`
+	Name        string           `json:"name" gorm:"column:name;not null"`
+	Description string           `json:"description" gorm:"column:description;not null"`
+	Topics      []TaxonomyTopic  `json:"topics" gorm:"column:topics;type:jsonb;serializer:json"`
+	Sentiment   SentimentLexicon `json:"sentiment" gorm:"column:sentiment_lexicon;type:jsonb;serializer:json"`
+	Stemming    bool             `json:"stemming" gorm:"column:stemming;not null"`
+	Active      bool             `json:"active" gorm:"column:active;not null"`
+}
+
+// NewTaxonomy creates a new Taxonomy entity
+func NewTaxonomy(userID, name, description string, topics []TaxonomyTopic, sentiment SentimentLexicon, stemming bool) (Taxonomy, error) {
+	taxonomy := Taxonomy{UserID: userID}
+	if err := taxonomy.Update(name, description, topics, sentiment, stemming); err != nil {
+		return Taxonomy{}, err
+	}
+	taxonomy.SetID(domain.GenerateID())
+	return taxonomy, nil
+}
+
+// IsTeamTaxonomy returns true if the taxonomy is shared by a team rather than owned by a user
+func (t *Taxonomy) IsTeamTaxonomy() bool {
+	return t.TeamID != nil
+}
+
+// Update replaces the contents of the taxonomy after normalizing and validating them
+func (t *Taxonomy) Update(name, description string, topics []TaxonomyTopic, sentiment SentimentLexicon, stemming bool) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.NewDomainError("INVALID_TAXONOMY", "Taxonomy name is required", domain.ErrInvalidInput)
+	}
+	if len(topics) == 0 {
+		return domain.NewDomainError("INVALID_TAXONOMY", "Taxonomy needs at least one topic", domain.ErrInvalidInput)
+	}
+	if len(topics) > MaxTaxonomyTopics {
+		return domain.NewDomainError("INVALID_TAXONOMY", fmt.Sprintf("Taxonomy can have at most %d topics", MaxTaxonomyTopics), domain.ErrInvalidInput)
+	}
+
+	normalizedTopics := make([]TaxonomyTopic, 0, len(topics))
+	seenTopics := make(map[string]bool, len(topics))
+	for _, topic := range topics {
+		topicName := strings.Join(strings.Fields(topic.Name), " ")
+		if topicName == "" {
+			return domain.NewDomainError("INVALID_TAXONOMY", "Topic names are required", domain.ErrInvalidInput)
+		}
+		if seenTopics[strings.ToLower(topicName)] {
+			return domain.NewDomainError("INVALID_TAXONOMY", "Duplicate topic: "+topicName, domain.ErrInvalidInput)
+		}
+		seenTopics[strings.ToLower(topicName)] = true
+
+		terms, err := normalizeTaxonomyTerms(topic.Terms)
+		if err != nil {
+			return err
+		}
+		if len(terms) == 0 {
+			return domain.NewDomainError("INVALID_TAXONOMY", "Topic needs at least one term: "+topicName, domain.ErrInvalidInput)
+		}
+		normalizedTopics = append(normalizedTopics, TaxonomyTopic{Name: topicName, Terms: terms})
+	}
+
+	positive, err := normalizeTaxonomyTerms(sentiment.Positive)
+	if err != nil {
+		return err
+	}
+	negative, err := normalizeTaxonomyTerms(sentiment.Negative)
+	if err != nil {
+		return err
+	}
+
+	t.Name = name
+	t.Description = strings.TrimSpace(description)
+	t.Topics = normalizedTopics
+	t.Sentiment = SentimentLexicon{Positive: positive, Negative: negative}
+	t.Stemming = stemming
+	return nil
+}
+
+// TableName sets the table name for GORM
+func (Taxonomy) TableName() string {
+	return "analytics_taxonomies"
+}
+
+// normalizeTaxonomyTerms lowercases terms and synonyms, defaults weights to 1 and drops duplicate terms
+func normalizeTaxonomyTerms(terms []TaxonomyTerm) ([]TaxonomyTerm, error) {
+	if len(terms) > MaxTaxonomyTerms {
+		return nil, domain.NewDomainError("INVALID_TAXONOMY", fmt.Sprintf("Word lists can have at most %d terms", MaxTaxonomyTerms), domain.ErrInvalidInput)
+	}
+
+	result := make([]TaxonomyTerm, 0, len(terms))
+	seen := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		normalized := normalizeTaxonomyPhrase(term.Term)
+		if normalized == "" || seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+
+		if term.Weight < 0 || term.Weight > MaxTermWeight {
+			return nil, domain.NewDomainError("INVALID_TAXONOMY", fmt.Sprintf("Term weights must be between 0 and %d: %s", MaxTermWeight, normalized), domain.ErrInvalidInput)
+		}
+		if len(strings.Fields(normalized)) > MaxTermWords {
+			return nil, domain.NewDomainError("INVALID_TAXONOMY", "Terms must be at most 6 words: "+normalized, domain.ErrInvalidInput)
+		}
+		weight := term.Weight
+		if weight == 0 {
+			weight = 1
+		}
+
+		synonyms := make([]string, 0, len(term.Synonyms))
+		for _, synonym := range term.Synonyms {
+			synonym = normalizeTaxonomyPhrase(synonym)
+			if synonym == "" || synonym == normalized {
+				continue
+			}
+			if len(strings.Fields(synonym)) > MaxTermWords {
+				return nil, domain.NewDomainError("INVALID_TAXONOMY", "Synonyms must be at most 6 words: "+synonym, domain.ErrInvalidInput)
+			}
+			synonyms = append(synonyms, synonym)
+		}
+
+		result = append(result, TaxonomyTerm{Term: normalized, Weight: weight, Synonyms: normalizeTerms(synonyms)})
+	}
+	return result, nil
+}
+
+// normalizeTaxonomyPhrase lowercases a phrase and collapses its whitespace
+func normalizeTaxonomyPhrase(phrase string) string {
+	return strings.ToLower(strings.Join(strings.Fields(phrase), " "))
+}
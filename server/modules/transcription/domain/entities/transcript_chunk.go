@@ -0,0 +1,86 @@
+package entities
+
+import (
+	"strings"
+
+	"teammate/server/seedwork/domain"
+)
+
+// Embedding is the representation of a text produced by an Embedder.
+// Local lexical embedders fill Terms, remote neural embedders fill Vector.
+type Embedding struct {
+	Vector []float64          `json:"vector,omitempty"`
+	Terms  map[string]float64 `json:"terms,omitempty"`
+	Length int                `json:"length,omitempty"`
+}
+
+// CorpusStats summarizes the indexed passages a query is ranked against: how many there are, their
+// average length, and how many contain each of the query's terms
+type CorpusStats struct {
+	Documents         int
+	AverageLength     float64
+	DocumentFrequency map[string]int
+}
+
+// TranscriptChunk is a passage of consecutive segments indexed for retrieval
+type TranscriptChunk struct {
+	domain.BaseEntity
+	TranscriptionID string    `json:"transcription_id" gorm:"column:transcription_id;not null"`
+	MeetingID       string    `json:"meeting_id" gorm:"column:meeting_id;not null"`
+	ChunkIndex      int       `json:"chunk_index" gorm:"column:chunk_index;not null"`
+	Text            string    `json:"text" gorm:"column:text;type:text;not null"`
+	Speakers        []string  `json:"speakers" gorm:"column:speakers;type:jsonb;serializer:json"`
+	SegmentIDs      []string  `json:"segment_ids" gorm:"column:segment_ids;type:jsonb;serializer:json"`
+	StartTime       float64   `json:"start_time" gorm:"column:start_time;not null"`
+	EndTime         float64   `json:"end_time" gorm:"column:end_time;not null"`
+	Embedder        string    `json:"embedder" gorm:"column:embedder;not null"`
+	Embedding       Embedding `json:"-" gorm:"column:embedding;type:jsonb;serializer:json"`
+}
+
+// NewTranscriptChunk creates a chunk from consecutive segments of a transcription
+func NewTranscriptChunk(transcriptionID, meetingID string, chunkIndex int, segments []TranscriptSegment) TranscriptChunk {
+	chunk := TranscriptChunk{
+		TranscriptionID: transcriptionID,
+		MeetingID:       meetingID,
+		ChunkIndex:      chunkIndex,
+		Speakers:        []string{},
+		SegmentIDs:      []string{},
+	}
+	chunk.SetID(domain.GenerateID())
+
+	seenSpeakers := make(map[string]bool)
+	lines := make([]string, 0, len(segments))
+	for i, segment := range segments {
+		if i == 0 || segment.StartTime < chunk.StartTime {
+			chunk.StartTime = segment.StartTime
+		}
+		if segment.EndTime > chunk.EndTime {
+			chunk.EndTime = segment.EndTime
+		}
+		chunk.SegmentIDs = append(chunk.SegmentIDs, segment.GetID())
+
+		if segment.Speaker != "" && segment.Speaker != "speaker_unknown" {
+			if !seenSpeakers[segment.Speaker] {
+				seenSpeakers[segment.Speaker] = true
+				chunk.Speakers = append(chunk.Speakers, segment.Speaker)
+			}
+			lines = append(lines, segment.Speaker+": "+strings.TrimSpace(segment.Text))
+		} else {
+			lines = append(lines, strings.TrimSpace(segment.Text))
+		}
+	}
+	chunk.Text = strings.Join(lines, "\n")
+
+	return chunk
+}
+
+// SetEmbedding records the embedding and the embedder that produced it
+func (c *TranscriptChunk) SetEmbedding(embedder string, embedding Embedding) {
+	c.Embedder = embedder
+	c.Embedding = embedding
+}
+
+// TableName sets the table name for GORM
+func (TranscriptChunk) TableName() string {
+	return "transcript_chunks"
+}
@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"teammate/server/seedwork/domain"
+)
+
+type RevisionEditType string
+
+const (
+	OriginalRevision    RevisionEditType = "original"
+	EditTextRevision    RevisionEditType = "edit_text"
+	EditSpeakerRevision RevisionEditType = "edit_speaker"
+	EditSegmentRevision RevisionEditType = "edit_segment"
+	SplitRevision       RevisionEditType = "split"
+	MergeRevision       RevisionEditType = "merge"
+	RestoreRevision     RevisionEditType = "restore"
+)
+
+// TranscriptRevision is an immutable record of a single edit made to a transcription's segments.
+// Revision 0 always holds the segments as they were produced by the transcription provider.
+type TranscriptRevision struct {
+	domain.BaseEntity
+	TranscriptionID string              `json:"transcription_id" gorm:"column:transcription_id;not null"`
+	RevisionNumber  int                 `json:"revision_number" gorm:"column:revision_number;not null"`
+	EditType        RevisionEditType    `json:"edit_type" gorm:"column:edit_type;not null"`
+	EditedBy        string              `json:"edited_by,omitempty" gorm:"column:edited_by"`
+	SegmentIDs      []string            `json:"segment_ids" gorm:"column:segment_ids;type:jsonb;serializer:json"`
+	Before          []TranscriptSegment `json:"before" gorm:"column:before_segments;type:jsonb;serializer:json"`
+	After           []TranscriptSegment `json:"after" gorm:"column:after_segments;type:jsonb;serializer:json"`
+	Snapshot        []TranscriptSegment `json:"snapshot" gorm:"column:snapshot;type:jsonb;serializer:json"`
+	RestoredFrom    *int                `json:"restored_from,omitempty" gorm:"column:restored_from"`
+}
+
+// NewOriginalRevision creates revision 0, capturing the provider output before any manual edit
+func NewOriginalRevision(transcriptionID string, segments []TranscriptSegment) TranscriptRevision {
+	snapshot := copySegments(segments)
+	revision := TranscriptRevision{
+		TranscriptionID: transcriptionID,
+		RevisionNumber:  0,
+		EditType:        OriginalRevision,
+		SegmentIDs:      segmentIDs(snapshot),
+		Before:          []TranscriptSegment{},
+		After:           snapshot,
+		Snapshot:        snapshot,
+	}
+	revision.SetID(domain.GenerateID())
+	return revision
+}
+
+// NewTranscriptRevision creates a revision describing an edit.
+// before and after contain only the segments touched by the edit, snapshot the full segment list afterwards.
+func NewTranscriptRevision(transcriptionID string, revisionNumber int, editType RevisionEditType, editedBy string, before, after, snapshot []TranscriptSegment) TranscriptRevision {
+	before = copySegments(before)
+	after = copySegments(after)
+
+	ids := segmentIDs(before)
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+	for _, id := range segmentIDs(after) {
+		if !seen[id] {
+			ids = append(ids, id)
+			seen[id] = true
+		}
+	}
+
+	revision := TranscriptRevision{
+		TranscriptionID: transcriptionID,
+		RevisionNumber:  revisionNumber,
+		EditType:        editType,
+		EditedBy:        editedBy,
+		SegmentIDs:      ids,
+		Before:          before,
+		After:           after,
+		Snapshot:        copySegments(snapshot),
+	}
+	revision.SetID(domain.GenerateID())
+	return revision
+}
+
+// MarkRestoredFrom records which earlier revision this revision restored
+func (r *TranscriptRevision) MarkRestoredFrom(revisionNumber int) {
+	r.RestoredFrom = &revisionNumber
+}
+
+// IsOriginal returns true if this revision holds the unedited provider output
+func (r *TranscriptRevision) IsOriginal() bool {
+	return r.EditType == OriginalRevision
+}
+
+// SnapshotSegments returns a copy of the segments as they were after this revision
+func (r *TranscriptRevision) SnapshotSegments() []TranscriptSegment {
+	return copySegments(r.Snapshot)
+}
+
+// TableName sets the table name for GORM
+func (TranscriptRevision) TableName() string {
+	return "transcript_revisions"
+}
+
+func copySegments(segments []TranscriptSegment) []TranscriptSegment {
+	result := make([]TranscriptSegment, len(segments))
+	copy(result, segments)
+	return result
+}
+
+func segmentIDs(segments []TranscriptSegment) []string {
+	ids := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		ids = append(ids, segment.GetID())
+	}
+	return ids
+}
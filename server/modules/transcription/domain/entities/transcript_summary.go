@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"teammate/server/seedwork/domain"
+)
+
+// SummaryMethod describes how a summary was produced
+type SummaryMethod string
+
+const (
+	// LemurSummaryMethod summaries are generated by AssemblyAI LeMUR
+	LemurSummaryMethod SummaryMethod = "lemur"
+	// ExtractiveSummaryMethod summaries are sentences selected from the transcript locally
+	ExtractiveSummaryMethod SummaryMethod = "extractive"
+)
+
+// TranscriptSummary is the structured summary of a transcription. Each transcription keeps
+// only its latest summary; regenerating replaces it.
+type TranscriptSummary struct {
+	domain.BaseEntity
+	TranscriptionID string        `json:"transcription_id" gorm:"column:transcription_id;not null"`
+	MeetingID       string        `json:"meeting_id" gorm:"column:meeting_id;not null"`
+	Overview        string        `json:"overview" gorm:"column:overview;type:text;not null"`
+	KeyPoints       []string      `json:"key_points" gorm:"column:key_points;type:jsonb;serializer:json"`
+	Decisions       []string      `json:"decisions" gorm:"column:decisions;type:jsonb;serializer:json"`
+	AnswerFormat    string        `json:"answer_format,omitempty" gorm:"column:answer_format;type:text;not null"`
+	Method          SummaryMethod `json:"method" gorm:"column:method;not null"`
+	Model           string        `json:"model,omitempty" gorm:"column:model;not null"`
+}
+
+// NewTranscriptSummary creates an empty summary of a transcription
+func NewTranscriptSummary(transcriptionID, meetingID string, method SummaryMethod) TranscriptSummary {
+	summary := TranscriptSummary{
+		TranscriptionID: transcriptionID,
+		MeetingID:       meetingID,
+		KeyPoints:       []string{},
+		Decisions:       []string{},
+		Method:          method,
+	}
+	summary.SetID(domain.GenerateID())
+	return summary
+}
+
+// TableName sets the table name for GORM
+func (TranscriptSummary) TableName() string {
+	return "transcript_summaries"
+}
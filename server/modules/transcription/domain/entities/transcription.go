@@ -1,6 +1,9 @@
 package entities
 
 import (
+	"sort"
+	"strings"
+
 	"teammate/server/seedwork/domain"
 )
 
@@ -22,7 +25,9 @@ type Transcription struct {
 	Content       string              `json:"content" gorm:"column:content;type:text"`
 	Confidence    float64             `json:"confidence" gorm:"column:confidence"`
 	Provider      string              `json:"provider" gorm:"column:provider;not null"`
-	Segments      []TranscriptSegment `json:"segments" gorm:"foreignKey:TranscriptionID"`
+	// ProviderTranscriptID is the provider's own ID for the transcript, used to run LeMUR on AssemblyAI transcripts
+	ProviderTranscriptID string              `json:"provider_transcript_id,omitempty" gorm:"column:provider_transcript_id"`
+	Segments             []TranscriptSegment `json:"segments" gorm:"foreignKey:TranscriptionID"`
 }
 
 // NewTranscription creates a new Transcription entity
@@ -95,6 +100,233 @@ func (t *Transcription) AddSegment(speaker, text string, startTime, endTime, con
 	t.Segments = append(t.Segments, segment)
 }
 
+// GenerateContentFromSegments builds the plain-text transcript from the segments
+func (t *Transcription) GenerateContentFromSegments() string {
+	var builder strings.Builder
+	for _, segment := range t.Segments {
+		// Skip segments with empty text to avoid creating malformed content
+		if strings.TrimSpace(segment.Text) == "" {
+			continue
+		}
+
+		// Only normalize truly unknown speakers
+		if segment.Speaker != "" && segment.Speaker != "speaker_unknown" {
+			builder.WriteString(segment.Speaker + ": " + segment.Text + "\n")
+		} else {
+			builder.WriteString(segment.Text + "\n")
+		}
+	}
+	return builder.String()
+}
+
+// CalculateConfidence returns the average confidence of the segments
+func (t *Transcription) CalculateConfidence() float64 {
+	if len(t.Segments) == 0 {
+		return 0.0
+	}
+
+	var total float64
+	for _, segment := range t.Segments {
+		total += segment.Confidence
+	}
+	return total / float64(len(t.Segments))
+}
+
+// RegenerateContent recomputes content and confidence after the segments have changed
+func (t *Transcription) RegenerateContent() {
+	t.Content = t.GenerateContentFromSegments()
+	t.Confidence = t.CalculateConfidence()
+}
+
+// FindSegmentIndex returns the position of a segment in Segments, or -1 if it does not exist
+func (t *Transcription) FindSegmentIndex(segmentID string) int {
+	for i := range t.Segments {
+		if t.Segments[i].GetID() == segmentID {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReplaceSegments swaps in a new, already ordered set of segments, renumbering them and regenerating content
+func (t *Transcription) ReplaceSegments(segments []TranscriptSegment) {
+	t.Segments = segments
+	t.renumberSegments()
+	t.RegenerateContent()
+}
+
+// EditSegment changes the text and/or speaker of a segment. Nil values are left untouched.
+func (t *Transcription) EditSegment(segmentID string, text, speaker *string) (before, after TranscriptSegment, err error) {
+	index := t.FindSegmentIndex(segmentID)
+	if index < 0 {
+		return before, after, domain.NewDomainError("SEGMENT_NOT_FOUND", "Transcript segment not found", domain.ErrNotFound)
+	}
+	if text == nil && speaker == nil {
+		return before, after, domain.NewDomainError("INVALID_SEGMENT_EDIT", "Text or speaker is required", domain.ErrInvalidInput)
+	}
+	if text != nil && strings.TrimSpace(*text) == "" {
+		return before, after, domain.NewDomainError("INVALID_SEGMENT_EDIT", "Segment text cannot be empty", domain.ErrInvalidInput)
+	}
+
+	before = t.Segments[index]
+	if text != nil {
+		t.Segments[index].Text = strings.TrimSpace(*text)
+		t.Segments[index].Words = nil
+		t.Segments[index].MarkRedactions()
+	}
+	if speaker != nil {
+		t.Segments[index].Speaker = strings.TrimSpace(*speaker)
+	}
+	after = t.Segments[index]
+
+	t.RegenerateContent()
+	return before, after, nil
+}
+
+// SplitSegment splits a segment in two at a character offset of its text.
+// When splitTime is nil the boundary time is interpolated from the offset.
+func (t *Transcription) SplitSegment(segmentID string, offset int, splitTime *float64) (before TranscriptSegment, after []TranscriptSegment, err error) {
+	t.normalizeSegments()
+
+	index := t.FindSegmentIndex(segmentID)
+	if index < 0 {
+		return before, nil, domain.NewDomainError("SEGMENT_NOT_FOUND", "Transcript segment not found", domain.ErrNotFound)
+	}
+
+	original := t.Segments[index]
+	runes := []rune(original.Text)
+	if offset <= 0 || offset >= len(runes) {
+		return before, nil, domain.NewDomainError("INVALID_SPLIT_POSITION", "Split position must fall inside the segment text", domain.ErrInvalidInput)
+	}
+
+	firstText := strings.TrimSpace(string(runes[:offset]))
+	secondText := strings.TrimSpace(string(runes[offset:]))
+	if firstText == "" || secondText == "" {
+		return before, nil, domain.NewDomainError("INVALID_SPLIT_POSITION", "Both halves of a split segment must contain text", domain.ErrInvalidInput)
+	}
+
+	boundary := original.StartTime + original.GetDuration()*float64(offset)/float64(len(runes))
+	if splitTime != nil {
+		if *splitTime <= original.StartTime || *splitTime >= original.EndTime {
+			return before, nil, domain.NewDomainError("INVALID_SPLIT_TIME", "Split time must fall inside the segment", domain.ErrInvalidInput)
+		}
+		boundary = *splitTime
+	}
+
+	first := original
+	first.Text = firstText
+	first.EndTime = boundary
+	first.Words = nil
+	first.MarkRedactions()
+
+	second := NewTranscriptSegment(t.GetID(), original.Speaker, secondText, boundary, original.EndTime, original.Confidence, original.SequenceNumber+1)
+	second.MarkRedactions()
+
+	// Word timings follow the time boundary
+	for _, word := range original.Words {
+		if word.Start < boundary {
+			first.Words = append(first.Words, word)
+		} else {
+			second.Words = append(second.Words, word)
+		}
+	}
+
+	segments := make([]TranscriptSegment, 0, len(t.Segments)+1)
+	segments = append(segments, t.Segments[:index]...)
+	segments = append(segments, first, second)
+	segments = append(segments, t.Segments[index+1:]...)
+	t.ReplaceSegments(segments)
+
+	return original, []TranscriptSegment{t.Segments[index], t.Segments[index+1]}, nil
+}
+
+// MergeSegments joins adjacent segments into the first of them.
+// The merged segment keeps the first segment's speaker unless another is given.
+func (t *Transcription) MergeSegments(segmentIDs []string, speaker *string) (before []TranscriptSegment, after TranscriptSegment, err error) {
+	if len(segmentIDs) < 2 {
+		return nil, after, domain.NewDomainError("INVALID_MERGE", "At least two segments are required to merge", domain.ErrInvalidInput)
+	}
+
+	t.normalizeSegments()
+
+	indexes := make([]int, 0, len(segmentIDs))
+	seen := make(map[int]bool, len(segmentIDs))
+	for _, id := range segmentIDs {
+		index := t.FindSegmentIndex(id)
+		if index < 0 {
+			return nil, after, domain.NewDomainError("SEGMENT_NOT_FOUND", "Transcript segment not found", domain.ErrNotFound)
+		}
+		if seen[index] {
+			return nil, after, domain.NewDomainError("INVALID_MERGE", "Segments can only be merged once", domain.ErrInvalidInput)
+		}
+		seen[index] = true
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	for i := 1; i < len(indexes); i++ {
+		if indexes[i] != indexes[i-1]+1 {
+			return nil, after, domain.NewDomainError("INVALID_MERGE", "Only adjacent segments can be merged", domain.ErrInvalidInput)
+		}
+	}
+
+	first, last := indexes[0], indexes[len(indexes)-1]
+	before = copySegments(t.Segments[first : last+1])
+
+	merged := t.Segments[first]
+	merged.Words = nil
+	texts := make([]string, 0, len(before))
+	var weightedConfidence, totalDuration float64
+	for _, segment := range before {
+		if text := strings.TrimSpace(segment.Text); text != "" {
+			texts = append(texts, text)
+		}
+		merged.Words = append(merged.Words, segment.Words...)
+		if segment.StartTime < merged.StartTime {
+			merged.StartTime = segment.StartTime
+		}
+		if segment.EndTime > merged.EndTime {
+			merged.EndTime = segment.EndTime
+		}
+		weightedConfidence += segment.Confidence * segment.GetDuration()
+		totalDuration += segment.GetDuration()
+	}
+	merged.Text = strings.Join(texts, " ")
+	merged.MarkRedactions()
+	if totalDuration > 0 {
+		merged.Confidence = weightedConfidence / totalDuration
+	}
+	if speaker != nil {
+		merged.Speaker = strings.TrimSpace(*speaker)
+	}
+
+	segments := make([]TranscriptSegment, 0, len(t.Segments)-len(before)+1)
+	segments = append(segments, t.Segments[:first]...)
+	segments = append(segments, merged)
+	segments = append(segments, t.Segments[last+1:]...)
+	t.ReplaceSegments(segments)
+
+	return before, t.Segments[first], nil
+}
+
+// normalizeSegments orders segments by their current sequence and renumbers them from zero
+func (t *Transcription) normalizeSegments() {
+	sort.SliceStable(t.Segments, func(i, j int) bool {
+		if t.Segments[i].SequenceNumber != t.Segments[j].SequenceNumber {
+			return t.Segments[i].SequenceNumber < t.Segments[j].SequenceNumber
+		}
+		return t.Segments[i].StartTime < t.Segments[j].StartTime
+	})
+	t.renumberSegments()
+}
+
+// renumberSegments assigns sequence numbers from zero in slice order
+func (t *Transcription) renumberSegments() {
+	for i := range t.Segments {
+		t.Segments[i].SequenceNumber = i
+		t.Segments[i].TranscriptionID = t.GetID()
+	}
+}
+
 // TableName sets the table name for GORM
 func (Transcription) TableName() string {
 	return "transcriptions"
@@ -110,6 +342,20 @@ type TranscriptSegment struct {
 	EndTime         float64 `json:"end_time" gorm:"column:end_time;not null"`
 	Confidence      float64 `json:"confidence" gorm:"column:confidence"`
 	SequenceNumber  int     `json:"sequence_number" gorm:"column:sequence_number;not null"`
+
+	// Redactions marks the PII placeholders in Text so exports can highlight them
+	Redactions []RedactedSpan `json:"redactions,omitempty" gorm:"column:redactions;type:jsonb;serializer:json"`
+
+	// Words holds per-word timings when the provider reports them
+	Words []WordTiming `json:"words,omitempty" gorm:"column:words;type:jsonb;serializer:json"`
+}
+
+// WordTiming is the timing of a single recognized word, in seconds
+type WordTiming struct {
+	Text       string  `json:"text"`
+	Start      float64 `json:"start"`
+	End        float64 `json:"end"`
+	Confidence float64 `json:"confidence"`
 }
 
 // NewTranscriptSegment creates a new TranscriptSegment entity
@@ -155,6 +401,16 @@ func (ts *TranscriptSegment) IsHighConfidence() bool {
 	return ts.Confidence >= 0.8
 }
 
+// MarkRedactions records the redaction placeholders present in the segment text
+func (ts *TranscriptSegment) MarkRedactions() {
+	ts.Redactions = FindRedactedSpans(ts.Text)
+}
+
+// IsRedacted returns true if PII was redacted from the segment
+func (ts *TranscriptSegment) IsRedacted() bool {
+	return len(ts.Redactions) > 0
+}
+
 // TableName sets the table name for GORM
 func (TranscriptSegment) TableName() string {
 	return "transcript_segments"
@@ -0,0 +1,156 @@
+package entities
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEditableTranscription() Transcription {
+	transcription := NewTranscription("meeting-1", "audio.wav", "mock")
+	transcription.AddSegment("Speaker A", "Hello everyone. Let's get started.", 0, 4, 0.9, 0)
+	transcription.AddSegment("Speaker B", "Thanks for joining.", 4, 6, 0.8, 1)
+	transcription.AddSegment("Speaker B", "First item is the roadmap.", 6, 10, 0.7, 2)
+	transcription.RegenerateContent()
+	return transcription
+}
+
+func TestTranscription_EditSegment(t *testing.T) {
+	transcription := newEditableTranscription()
+	segmentID := transcription.Segments[1].GetID()
+	text := "Thanks for joining us."
+	speaker := "Speaker C"
+
+	before, after, err := transcription.EditSegment(segmentID, &text, &speaker)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Thanks for joining.", before.Text)
+	assert.Equal(t, "Speaker B", before.Speaker)
+	assert.Equal(t, text, after.Text)
+	assert.Equal(t, speaker, after.Speaker)
+	assert.Contains(t, transcription.Content, "Speaker C: Thanks for joining us.\n")
+}
+
+func TestTranscription_EditSegment_Errors(t *testing.T) {
+	transcription := newEditableTranscription()
+	empty := "   "
+
+	_, _, err := transcription.EditSegment("missing", &empty, nil)
+	assert.Error(t, err)
+
+	_, _, err = transcription.EditSegment(transcription.Segments[0].GetID(), nil, nil)
+	assert.Error(t, err)
+
+	_, _, err = transcription.EditSegment(transcription.Segments[0].GetID(), &empty, nil)
+	assert.Error(t, err)
+}
+
+func TestTranscription_SplitSegment(t *testing.T) {
+	transcription := newEditableTranscription()
+	original := transcription.Segments[0]
+
+	before, after, err := transcription.SplitSegment(original.GetID(), len("Hello everyone."), nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, original.Text, before.Text)
+	require.Len(t, after, 2)
+	require.Len(t, transcription.Segments, 4)
+
+	assert.Equal(t, original.GetID(), after[0].GetID(), "first half keeps the original segment ID")
+	assert.Equal(t, "Hello everyone.", after[0].Text)
+	assert.Equal(t, "Let's get started.", after[1].Text)
+	assert.Equal(t, after[0].EndTime, after[1].StartTime)
+	assert.Equal(t, 0.0, after[0].StartTime)
+	assert.Equal(t, 4.0, after[1].EndTime)
+	assert.Equal(t, "Speaker A", after[1].Speaker)
+
+	for i, segment := range transcription.Segments {
+		assert.Equal(t, i, segment.SequenceNumber)
+	}
+	assert.Equal(t, "Speaker A: Hello everyone.\nSpeaker A: Let's get started.\nSpeaker B: Thanks for joining.\nSpeaker B: First item is the roadmap.\n", transcription.Content)
+}
+
+func TestTranscription_SplitSegment_ExplicitTime(t *testing.T) {
+	transcription := newEditableTranscription()
+	splitTime := 1.5
+
+	_, after, err := transcription.SplitSegment(transcription.Segments[0].GetID(), 15, &splitTime)
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, after[0].EndTime)
+	assert.Equal(t, 1.5, after[1].StartTime)
+
+	outside := 7.0
+	_, _, err = transcription.SplitSegment(transcription.Segments[0].GetID(), 5, &outside)
+	assert.Error(t, err)
+
+	_, _, err = transcription.SplitSegment(transcription.Segments[0].GetID(), 0, nil)
+	assert.Error(t, err)
+}
+
+func TestTranscription_MergeSegments(t *testing.T) {
+	transcription := newEditableTranscription()
+	first, second := transcription.Segments[1], transcription.Segments[2]
+
+	before, after, err := transcription.MergeSegments([]string{second.GetID(), first.GetID()}, nil)
+
+	require.NoError(t, err)
+	require.Len(t, before, 2)
+	require.Len(t, transcription.Segments, 2)
+	assert.Equal(t, first.GetID(), after.GetID())
+	assert.Equal(t, "Thanks for joining. First item is the roadmap.", after.Text)
+	assert.Equal(t, 4.0, after.StartTime)
+	assert.Equal(t, 10.0, after.EndTime)
+	assert.InDelta(t, (0.8*2+0.7*4)/6, after.Confidence, 0.0001)
+	assert.Equal(t, 1, after.SequenceNumber)
+}
+
+func TestTranscription_WordTimingsFollowEdits(t *testing.T) {
+	transcription := newEditableTranscription()
+	transcription.Segments[0].Words = []WordTiming{
+		{Text: "Hello", Start: 0, End: 0.5}, {Text: "everyone.", Start: 0.5, End: 1.2},
+		{Text: "Let's", Start: 2, End: 2.4}, {Text: "get", Start: 2.4, End: 2.8}, {Text: "started.", Start: 2.8, End: 3.5},
+	}
+	splitTime := 1.5
+
+	_, after, err := transcription.SplitSegment(transcription.Segments[0].GetID(), 15, &splitTime)
+	require.NoError(t, err)
+	assert.Len(t, after[0].Words, 2)
+	assert.Len(t, after[1].Words, 3)
+
+	_, merged, err := transcription.MergeSegments([]string{after[0].GetID(), after[1].GetID()}, nil)
+	require.NoError(t, err)
+	assert.Len(t, merged.Words, 5)
+
+	text := "Hi all."
+	_, edited, err := transcription.EditSegment(merged.GetID(), &text, nil)
+	require.NoError(t, err)
+	assert.Empty(t, edited.Words)
+}
+
+func TestTranscription_MergeSegments_RequiresAdjacent(t *testing.T) {
+	transcription := newEditableTranscription()
+
+	_, _, err := transcription.MergeSegments([]string{transcription.Segments[0].GetID(), transcription.Segments[2].GetID()}, nil)
+	assert.Error(t, err)
+
+	_, _, err = transcription.MergeSegments([]string{transcription.Segments[0].GetID()}, nil)
+	assert.Error(t, err)
+}
+
+func TestNewTranscriptRevision_SegmentIDs(t *testing.T) {
+	transcription := newEditableTranscription()
+	before, after, err := transcription.SplitSegment(transcription.Segments[0].GetID(), 15, nil)
+	require.NoError(t, err)
+
+	revision := NewTranscriptRevision(transcription.GetID(), 1, SplitRevision, "user-1", []TranscriptSegment{before}, after, transcription.Segments)
+
+	assert.Equal(t, []string{after[0].GetID(), after[1].GetID()}, revision.SegmentIDs)
+	assert.Len(t, revision.Snapshot, 4)
+
+	// The snapshot must not change when the transcription is edited again
+	text := "Changed"
+	_, _, err = transcription.EditSegment(transcription.Segments[0].GetID(), &text, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello everyone.", revision.Snapshot[0].Text)
+}
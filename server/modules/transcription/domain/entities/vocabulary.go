@@ -0,0 +1,118 @@
+package entities
+
+import (
+	"strings"
+
+	"teammate/server/seedwork/domain"
+)
+
+type BoostLevel string
+
+const (
+	BoostLow     BoostLevel = "low"
+	BoostDefault BoostLevel = "default"
+	BoostHigh    BoostLevel = "high"
+)
+
+const (
+	// MaxVocabularyTerms is the number of word boost terms accepted by AssemblyAI per transcript
+	MaxVocabularyTerms = 1000
+	// MaxTermWords is the longest phrase, in words, accepted as a word boost term
+	MaxTermWords = 6
+)
+
+// SpellingRule replaces any of the From spellings with To
+type SpellingRule struct {
+	From []string `json:"from"`
+	To   string   `json:"to"`
+}
+
+// Vocabulary is a user's list of terms to boost and spelling rules to apply when transcribing.
+// A vocabulary without a meeting type applies to all of the user's meetings.
+type Vocabulary struct {
+	domain.BaseEntity
+	UserID      string         `json:"user_id" gorm:"column:user_id;not null"`
+	Name        string         `json:"name" gorm:"column:name;not null"`
+	MeetingType string         `json:"meeting_type,omitempty" gorm:"column:meeting_type;not null"`
+	Terms       []string       `json:"terms" gorm:"column:terms;type:jsonb;serializer:json"`
+	BoostParam  BoostLevel     `json:"boost_param" gorm:"column:boost_param;not null"`
+	Spellings   []SpellingRule `json:"spellings" gorm:"column:spellings;type:jsonb;serializer:json"`
+}
+
+// NewVocabulary creates a new Vocabulary entity
+func NewVocabulary(userID, name, meetingType string, terms []string, boostParam BoostLevel, spellings []SpellingRule) (Vocabulary, error) {
+	vocabulary := Vocabulary{UserID: userID}
+	if err := vocabulary.Update(name, meetingType, terms, boostParam, spellings); err != nil {
+		return Vocabulary{}, err
+	}
+	vocabulary.SetID(domain.GenerateID())
+	return vocabulary, nil
+}
+
+// Update replaces the contents of the vocabulary after normalizing and validating them
+func (v *Vocabulary) Update(name, meetingType string, terms []string, boostParam BoostLevel, spellings []SpellingRule) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return domain.NewDomainError("INVALID_VOCABULARY", "Vocabulary name is required", domain.ErrInvalidInput)
+	}
+
+	if boostParam == "" {
+		boostParam = BoostDefault
+	}
+	if boostParam != BoostLow && boostParam != BoostDefault && boostParam != BoostHigh {
+		return domain.NewDomainError("INVALID_VOCABULARY", "Boost param must be low, default or high", domain.ErrInvalidInput)
+	}
+
+	normalizedTerms := normalizeTerms(terms)
+	if len(normalizedTerms) > MaxVocabularyTerms {
+		return domain.NewDomainError("INVALID_VOCABULARY", "Vocabulary has too many terms", domain.ErrInvalidInput)
+	}
+	for _, term := range normalizedTerms {
+		if len(strings.Fields(term)) > MaxTermWords {
+			return domain.NewDomainError("INVALID_VOCABULARY", "Terms must be at most 6 words: "+term, domain.ErrInvalidInput)
+		}
+	}
+
+	normalizedSpellings := make([]SpellingRule, 0, len(spellings))
+	for _, spelling := range spellings {
+		to := strings.TrimSpace(spelling.To)
+		from := normalizeTerms(spelling.From)
+		if to == "" || len(from) == 0 {
+			return domain.NewDomainError("INVALID_VOCABULARY", "Spelling rules need at least one source spelling and a replacement", domain.ErrInvalidInput)
+		}
+		normalizedSpellings = append(normalizedSpellings, SpellingRule{From: from, To: to})
+	}
+
+	v.Name = name
+	v.MeetingType = strings.TrimSpace(meetingType)
+	v.Terms = normalizedTerms
+	v.BoostParam = boostParam
+	v.Spellings = normalizedSpellings
+	return nil
+}
+
+// AppliesTo returns true if the vocabulary should be used for meetings of the given type
+func (v *Vocabulary) AppliesTo(meetingType string) bool {
+	return v.MeetingType == "" || v.MeetingType == meetingType
+}
+
+// TableName sets the table name for GORM
+func (Vocabulary) TableName() string {
+	return "vocabularies"
+}
+
+// normalizeTerms trims terms and drops blanks and case-insensitive duplicates, keeping the first spelling
+func normalizeTerms(terms []string) []string {
+	result := make([]string, 0, len(terms))
+	seen := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		term = strings.Join(strings.Fields(term), " ")
+		key := strings.ToLower(term)
+		if term == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, term)
+	}
+	return result
+}
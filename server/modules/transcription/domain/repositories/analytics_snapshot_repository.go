@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// AnalyticsSnapshotCriteria selects the snapshots of meetings a user may see. With a TeamID the
+// meetings owned by the team's members are selected instead; callers check the user is a member.
+type AnalyticsSnapshotCriteria struct {
+	UserID      string
+	OwnedOnly   bool
+	TeamID      string
+	MeetingType string
+	From        time.Time
+	To          time.Time
+}
+
+// AnalyticsSnapshotRepository defines the interface for per-meeting analytics snapshot persistence
+type AnalyticsSnapshotRepository interface {
+	ReplaceSnapshot(ctx context.Context, snapshot *entities.AnalyticsSnapshot) error
+	DeleteByMeetingID(ctx context.Context, meetingID string) error
+	FindAccessibleSnapshots(ctx context.Context, criteria AnalyticsSnapshotCriteria) ([]*entities.AnalyticsSnapshot, error)
+	FindUnsnapshottedMeetingIDs(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// ExportTemplateRepository defines the interface for user export template persistence
+type ExportTemplateRepository interface {
+	Save(ctx context.Context, template *entities.ExportTemplate) error
+	Update(ctx context.Context, template *entities.ExportTemplate) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*entities.ExportTemplate, error)
+	FindByUserID(ctx context.Context, userID string) ([]*entities.ExportTemplate, error)
+	FindByUserIDAndName(ctx context.Context, userID, name string) (*entities.ExportTemplate, error)
+}
+
+// ExportContextRepository loads the meeting details that exports can include
+type ExportContextRepository interface {
+	FindByMeetingID(ctx context.Context, meetingID string) (*ExportContext, error)
+}
+
+// ExportContext holds the meeting, participants and action items of a transcription
+type ExportContext struct {
+	Meeting      *ExportMeeting      `json:"meeting,omitempty"`
+	Participants []ExportParticipant `json:"participants"`
+	ActionItems  []ExportActionItem  `json:"action_items"`
+}
+
+// ExportMeeting is the meeting a transcription belongs to
+type ExportMeeting struct {
+	Title     string     `json:"title"`
+	Type      string     `json:"type"`
+	StartTime time.Time  `json:"start_time"`
+	EndTime   *time.Time `json:"end_time,omitempty"`
+}
+
+// ExportParticipant is a participant of the meeting
+type ExportParticipant struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+	Role  string `json:"role,omitempty"`
+}
+
+// ExportActionItem is an action item raised in the meeting
+type ExportActionItem struct {
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Assignee    string     `json:"assignee,omitempty"`
+	Priority    string     `json:"priority"`
+	Status      string     `json:"status"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+}
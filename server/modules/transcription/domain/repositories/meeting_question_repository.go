@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// MeetingQuestionRepository defines the interface for meeting question history persistence.
+// Questions are append-only, so there are no update operations.
+type MeetingQuestionRepository interface {
+	Save(ctx context.Context, question *entities.MeetingQuestion) error
+	// FindByID returns nil if the question does not exist
+	FindByID(ctx context.Context, id string) (*entities.MeetingQuestion, error)
+	// FindByMeetingID returns a page of the questions about a meeting, newest first, and their total
+	FindByMeetingID(ctx context.Context, meetingID string, limit, offset int) ([]*entities.MeetingQuestion, int64, error)
+}
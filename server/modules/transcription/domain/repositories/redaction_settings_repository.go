@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// RedactionSettingsRepository defines the interface for per-user redaction settings persistence
+type RedactionSettingsRepository interface {
+	// Save creates or replaces the settings of the user
+	Save(ctx context.Context, settings *entities.RedactionSettings) error
+
+	// FindByUserID returns the user's settings, or nil if the user has none
+	FindByUserID(ctx context.Context, userID string) (*entities.RedactionSettings, error)
+}
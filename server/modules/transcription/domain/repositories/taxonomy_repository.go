@@ -0,0 +1,27 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// TaxonomyRepository defines the interface for analytics taxonomy persistence
+type TaxonomyRepository interface {
+	Save(ctx context.Context, taxonomy *entities.Taxonomy) error
+	Update(ctx context.Context, taxonomy *entities.Taxonomy) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*entities.Taxonomy, error)
+	// FindByUserID returns the user's own taxonomies, leaving out team taxonomies they created
+	FindByUserID(ctx context.Context, userID string) ([]*entities.Taxonomy, error)
+	FindByTeamIDs(ctx context.Context, teamIDs []string) ([]*entities.Taxonomy, error)
+
+	// FindActiveForUser returns the taxonomy used for the meetings the user owns: their active taxonomy,
+	// else the active taxonomy of the first team they joined that has one, or nil for the built-in one
+	FindActiveForUser(ctx context.Context, userID string) (*entities.Taxonomy, error)
+
+	// SetActive makes a taxonomy the user's only active one; an empty id deactivates them all
+	SetActive(ctx context.Context, userID, id string) error
+	// SetTeamActive makes a taxonomy the team's only active one; an empty id deactivates them all
+	SetTeamActive(ctx context.Context, teamID, id string) error
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// ChunkSearchCriteria selects the indexed passages a user may retrieve
+type ChunkSearchCriteria struct {
+	UserID    string
+	MeetingID string
+	Embedder  string
+
+	// Query keeps passages sharing at least one full-text term with it, best matches first.
+	// Only lexical embedders prefilter their candidates this way.
+	Query string
+	Limit int
+}
+
+// ChunkLookupCriteria identifies a single indexed passage a user may retrieve, by its own ID
+// or by the ID of a segment it contains
+type ChunkLookupCriteria struct {
+	UserID    string
+	Embedder  string
+	ChunkID   string
+	SegmentID string
+}
+
+// TranscriptChunkRepository defines the interface for passage index persistence
+type TranscriptChunkRepository interface {
+	ReplaceChunks(ctx context.Context, transcriptionID, embedder string, chunks []entities.TranscriptChunk) error
+	DeleteByTranscriptionID(ctx context.Context, transcriptionID string) error
+	FindAccessibleChunks(ctx context.Context, criteria ChunkSearchCriteria) ([]*entities.TranscriptChunk, error)
+	// FindCorpusStats summarizes every passage matching the criteria, ignoring Query and Limit
+	FindCorpusStats(ctx context.Context, criteria ChunkSearchCriteria, terms []string) (*entities.CorpusStats, error)
+	// FindAccessibleChunk returns nil if no passage matches or the user cannot access it
+	FindAccessibleChunk(ctx context.Context, criteria ChunkLookupCriteria) (*entities.TranscriptChunk, error)
+	FindUnindexedTranscriptionIDs(ctx context.Context, embedder string) ([]string, error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// TranscriptRevisionRepository defines the interface for transcript revision persistence.
+// Revisions are append-only, so there are no update or delete operations. Save returns an error
+// wrapping domain.ErrAlreadyExists when the transcription already has a revision with that number.
+type TranscriptRevisionRepository interface {
+	Save(ctx context.Context, revision *entities.TranscriptRevision) error
+	FindByTranscriptionID(ctx context.Context, transcriptionID string) ([]*entities.TranscriptRevision, error)
+	FindByNumber(ctx context.Context, transcriptionID string, revisionNumber int) (*entities.TranscriptRevision, error)
+	FindLatest(ctx context.Context, transcriptionID string) (*entities.TranscriptRevision, error)
+}
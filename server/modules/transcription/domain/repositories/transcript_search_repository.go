@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+	"time"
+)
+
+// TranscriptSearchCriteria describes a full-text search over transcript segments.
+// Results are always limited to meetings the user owns or that have been shared with them.
+type TranscriptSearchCriteria struct {
+	UserID      string
+	Query       string
+	MeetingID   string
+	Speaker     string
+	MeetingType string
+	From        *time.Time
+	To          *time.Time
+	Limit       int
+	Offset      int
+}
+
+// TranscriptSearchHit is a single ranked segment matching a search
+type TranscriptSearchHit struct {
+	SegmentID        string    `json:"segment_id"`
+	TranscriptionID  string    `json:"transcription_id"`
+	MeetingID        string    `json:"meeting_id"`
+	MeetingTitle     string    `json:"meeting_title"`
+	MeetingType      string    `json:"meeting_type"`
+	MeetingStartTime time.Time `json:"meeting_start_time"`
+	Speaker          string    `json:"speaker"`
+	Text             string    `json:"text"`
+	Snippet          string    `json:"snippet"`
+	StartTime        float64   `json:"start_time"`
+	EndTime          float64   `json:"end_time"`
+	Rank             float64   `json:"rank"`
+}
+
+// TranscriptSearchRepository defines the interface for searching transcript text
+type TranscriptSearchRepository interface {
+	SearchSegments(ctx context.Context, criteria TranscriptSearchCriteria) ([]TranscriptSearchHit, int64, error)
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// TranscriptSummaryRepository defines the interface for transcript summary persistence
+type TranscriptSummaryRepository interface {
+	ReplaceSummary(ctx context.Context, summary *entities.TranscriptSummary) error
+	FindByTranscriptionID(ctx context.Context, transcriptionID string) (*entities.TranscriptSummary, error)
+}
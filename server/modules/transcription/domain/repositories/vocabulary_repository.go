@@ -0,0 +1,19 @@
+package repositories
+
+import (
+	"context"
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// VocabularyRepository defines the interface for custom vocabulary persistence
+type VocabularyRepository interface {
+	Save(ctx context.Context, vocabulary *entities.Vocabulary) error
+	Update(ctx context.Context, vocabulary *entities.Vocabulary) error
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*entities.Vocabulary, error)
+	FindByUserID(ctx context.Context, userID string) ([]*entities.Vocabulary, error)
+
+	// FindForMeetingType returns the user's vocabularies that apply to the meeting type,
+	// including those that apply to all meeting types
+	FindForMeetingType(ctx context.Context, userID, meetingType string) ([]*entities.Vocabulary, error)
+}
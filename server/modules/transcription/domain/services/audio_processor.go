@@ -44,6 +44,7 @@ type AudioProcessingResult struct {
 	Message         string                       `json:"message,omitempty"`
 	ProcessingMode  ProcessingMode               `json:"processing_mode"`        // Indicates which mode was used
 	FirebaseURL     string                       `json:"firebase_url,omitempty"` // URL to the uploaded audio file
+	AudioRedacted   bool                         `json:"audio_redacted"`         // Whether PII was removed from the uploaded audio
 }
 
 // AudioProcessingOptions contains configuration options for audio processing
@@ -62,6 +63,16 @@ type AudioProcessingOptions struct {
 	MaxLatency    int    `json:"max_latency,omitempty"`    // Maximum acceptable latency in seconds for batch mode
 	CostOptimized bool   `json:"cost_optimized,omitempty"` // Whether to prioritize cost over speed
 	QualityLevel  string `json:"quality_level,omitempty"`  // Quality level (basic, standard, premium)
+
+	// Custom vocabulary options
+	WordBoost      []string                `json:"word_boost,omitempty"`      // Terms the provider should be more likely to recognize
+	BoostParam     entities.BoostLevel     `json:"boost_param,omitempty"`     // How strongly to boost the terms (low, default, high)
+	CustomSpelling []entities.SpellingRule `json:"custom_spelling,omitempty"` // Spelling replacements applied to the transcript
+
+	// PII redaction options
+	RedactPII      bool                 `json:"redact_pii,omitempty"`       // Replace PII in the transcript with placeholders
+	RedactPIIAudio bool                 `json:"redact_pii_audio,omitempty"` // Also bleep PII in the stored audio
+	PIIPolicies    []entities.PIIPolicy `json:"pii_policies,omitempty"`     // Categories of PII to redact
 }
 
 // AudioProcessor defines the contract for processing audio streams (both real-time and batch)
@@ -91,6 +102,18 @@ type AudioProcessor interface {
 	GetSupportedModes() []ProcessingMode
 }
 
+// VocabularyAwareProcessor is implemented by processors whose provider applies word boost and
+// custom spelling natively. Spelling rules are applied in post-processing for all other processors.
+type VocabularyAwareProcessor interface {
+	SupportsCustomVocabulary() bool
+}
+
+// RedactionAwareProcessor is implemented by processors whose provider redacts PII natively.
+// Transcripts from all other processors are redacted locally for the policies that support it.
+type RedactionAwareProcessor interface {
+	SupportsPIIRedaction() bool
+}
+
 // BatchAudioProcessor extends AudioProcessor for batch/post-processing capabilities
 type BatchAudioProcessor interface {
 	AudioProcessor
@@ -196,14 +219,17 @@ type AudioProcessorFactory interface {
 
 // ProviderCapabilities describes what a transcription provider supports
 type ProviderCapabilities struct {
-	Provider            string             `json:"provider"`
-	SupportedModes      []ProcessingMode   `json:"supported_modes"`
-	SupportedLanguages  []string           `json:"supported_languages"`
-	SupportsDiarization bool               `json:"supports_diarization"`
-	SupportsRealTime    bool               `json:"supports_real_time"`
-	SupportsBatch       bool               `json:"supports_batch"`
-	MaxAudioDuration    int                `json:"max_audio_duration"` // seconds
-	SupportedFormats    []string           `json:"supported_formats"`
-	PricingPerMinute    map[string]float64 `json:"pricing_per_minute"` // pricing by mode
-	EstimatedLatency    map[string]int     `json:"estimated_latency"`  // latency by mode in seconds
+	Provider               string             `json:"provider"`
+	SupportedModes         []ProcessingMode   `json:"supported_modes"`
+	SupportedLanguages     []string           `json:"supported_languages"`
+	SupportsDiarization    bool               `json:"supports_diarization"`
+	SupportsRealTime       bool               `json:"supports_real_time"`
+	SupportsBatch          bool               `json:"supports_batch"`
+	SupportsWordBoost      bool               `json:"supports_word_boost"`
+	SupportsCustomSpelling bool               `json:"supports_custom_spelling"`
+	SupportsPIIRedaction   bool               `json:"supports_pii_redaction"`
+	MaxAudioDuration       int                `json:"max_audio_duration"` // seconds
+	SupportedFormats       []string           `json:"supported_formats"`
+	PricingPerMinute       map[string]float64 `json:"pricing_per_minute"` // pricing by mode
+	EstimatedLatency       map[string]int     `json:"estimated_latency"`  // latency by mode in seconds
 }
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"teammate/server/seedwork/domain"
+)
+
+// audioContentTypes maps audio formats to their MIME types
+var audioContentTypes = map[string]string{
+	"wav":  "audio/wav",
+	"mp3":  "audio/mpeg",
+	"m4a":  "audio/mp4",
+	"flac": "audio/flac",
+	"opus": "audio/opus",
+	"ogg":  "audio/ogg",
+	"webm": "audio/webm",
+	"aac":  "audio/aac",
+	"mp4":  "video/mp4",
+}
+
+// contentTypeFormats maps MIME types, including common aliases, to audio formats
+var contentTypeFormats = map[string]string{
+	"audio/wav":       "wav",
+	"audio/wave":      "wav",
+	"audio/x-wav":     "wav",
+	"audio/vnd.wave":  "wav",
+	"audio/mpeg":      "mp3",
+	"audio/mp3":       "mp3",
+	"audio/mp4":       "m4a",
+	"audio/x-m4a":     "m4a",
+	"audio/m4a":       "m4a",
+	"audio/flac":      "flac",
+	"audio/x-flac":    "flac",
+	"audio/opus":      "opus",
+	"audio/ogg":       "ogg",
+	"audio/webm":      "webm",
+	"audio/aac":       "aac",
+	"video/mp4":       "mp4",
+	"application/ogg": "ogg",
+}
+
+// DetectAudioFormat returns the format of a recording from its file name, falling back to
+// its content type. The result is empty when neither identifies the format.
+func DetectAudioFormat(fileName, contentType string) string {
+	if ext := strings.ToLower(strings.TrimPrefix(path.Ext(fileName), ".")); ext != "" {
+		if _, ok := audioContentTypes[ext]; ok {
+			return ext
+		}
+	}
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		return contentTypeFormats[mediaType]
+	}
+	return ""
+}
+
+// AudioContentType returns the MIME type of an audio format
+func AudioContentType(format string) string {
+	if contentType, ok := audioContentTypes[format]; ok {
+		return contentType
+	}
+	return "application/octet-stream"
+}
+
+// ValidateAudioFormat checks that a provider can transcribe recordings in a format
+func ValidateAudioFormat(format string, capabilities *ProviderCapabilities) error {
+	format = strings.ToLower(format)
+	for _, supported := range capabilities.SupportedFormats {
+		if format == supported {
+			return nil
+		}
+	}
+
+	message := fmt.Sprintf("Provider %s supports %s audio", capabilities.Provider, strings.Join(capabilities.SupportedFormats, ", "))
+	if format == "" {
+		message = "Audio format could not be determined. " + message
+	}
+	return domain.NewDomainError("UNSUPPORTED_AUDIO_FORMAT", message, domain.ErrInvalidInput)
+}
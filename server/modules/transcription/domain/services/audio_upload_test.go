@@ -0,0 +1,23 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectAudioFormat(t *testing.T) {
+	assert.Equal(t, "mp3", DetectAudioFormat("Zoom Recording.MP3", ""))
+	assert.Equal(t, "m4a", DetectAudioFormat("audio_only.m4a", "application/octet-stream"))
+	assert.Equal(t, "wav", DetectAudioFormat("recording", "audio/x-wav"))
+	assert.Equal(t, "flac", DetectAudioFormat("recording.bin", "audio/flac; charset=binary"))
+	assert.Equal(t, "", DetectAudioFormat("notes.txt", "text/plain"))
+}
+
+func TestValidateAudioFormat(t *testing.T) {
+	capabilities := &ProviderCapabilities{Provider: "mock", SupportedFormats: []string{"wav", "mp3"}}
+
+	assert.NoError(t, ValidateAudioFormat("MP3", capabilities))
+	assert.ErrorContains(t, ValidateAudioFormat("m4a", capabilities), "Provider mock supports wav, mp3 audio")
+	assert.ErrorContains(t, ValidateAudioFormat("", capabilities), "could not be determined")
+}
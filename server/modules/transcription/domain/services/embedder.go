@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"math"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// Embedder turns text into embeddings and scores documents against a query.
+// Dense embedders typically score with CosineSimilarity and are given every passage the user can
+// retrieve; lexical embedders also implement LexicalEmbedder.
+type Embedder interface {
+	// Name identifies the embedder; chunks are only compared with embeddings from the same embedder
+	Name() string
+
+	// EmbedDocuments produces one embedding per document text
+	EmbedDocuments(ctx context.Context, texts []string) ([]entities.Embedding, error)
+
+	// EmbedQuery produces the embedding of a search query
+	EmbedQuery(ctx context.Context, text string) (entities.Embedding, error)
+
+	// Score returns one relevance score per document; higher is more relevant
+	Score(query entities.Embedding, documents []entities.Embedding) []float64
+}
+
+// LexicalEmbedder is an Embedder that only matches passages sharing terms with the query. Its
+// candidates are prefiltered with full-text search, and since the prefiltered passages are not a
+// fair sample of the corpus it scores them with statistics of every passage the user can retrieve.
+type LexicalEmbedder interface {
+	Embedder
+
+	// ScoreInCorpus returns one relevance score per document, weighing the query terms by corpus
+	ScoreInCorpus(query entities.Embedding, documents []entities.Embedding, corpus entities.CorpusStats) []float64
+}
+
+// ChunkingOptions controls how segments are grouped into passages
+type ChunkingOptions struct {
+	MaxWords int `json:"max_words"`
+}
+
+// DefaultChunkingOptions returns passage sizes suited to meeting transcripts
+func DefaultChunkingOptions() ChunkingOptions {
+	return ChunkingOptions{MaxWords: 120}
+}
+
+// ChunkSegments groups consecutive segments into passages of at most MaxWords words.
+// A segment is never split, so a single long segment becomes a passage on its own.
+func ChunkSegments(transcriptionID, meetingID string, segments []entities.TranscriptSegment, options ChunkingOptions) []entities.TranscriptChunk {
+	if options.MaxWords <= 0 {
+		options = DefaultChunkingOptions()
+	}
+
+	var chunks []entities.TranscriptChunk
+	var current []entities.TranscriptSegment
+	words := 0
+
+	flush := func() {
+		if len(current) == 0 {
+			return
+		}
+		chunks = append(chunks, entities.NewTranscriptChunk(transcriptionID, meetingID, len(chunks), current))
+		current = nil
+		words = 0
+	}
+
+	for _, segment := range segments {
+		if strings.TrimSpace(segment.Text) == "" {
+			continue
+		}
+		segmentWords := segment.GetWordCount()
+		if words > 0 && words+segmentWords > options.MaxWords {
+			flush()
+		}
+		current = append(current, segment)
+		words += segmentWords
+	}
+	flush()
+
+	return chunks
+}
+
+// CosineSimilarity compares two dense embeddings
+func CosineSimilarity(a, b []float64) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
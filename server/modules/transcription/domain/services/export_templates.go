@@ -0,0 +1,309 @@
+package services
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/domain"
+)
+
+// ExportTemplateRenderer executes a parsed export template
+type ExportTemplateRenderer interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+// BuiltinExportTemplate is an export template shipped with the server, available in both formats
+type BuiltinExportTemplate struct {
+	Name        string
+	Description string
+	Markdown    string
+	HTML        string
+}
+
+// Body returns the template body for a format
+func (t BuiltinExportTemplate) Body(format entities.ExportTemplateFormat) string {
+	if format == entities.HTMLTemplate {
+		return htmlLayoutStart + t.HTML + htmlLayoutEnd
+	}
+	return t.Markdown
+}
+
+// SpeakerGroup collects the segments of one speaker, as returned by the bySpeaker template function
+type SpeakerGroup struct {
+	Speaker  string
+	Segments []entities.TranscriptSegment
+	Duration float64 // Seconds spoken
+	Words    int
+	Share    float64 // Fraction of the total speaking time
+}
+
+// ExportTemplateFuncs are the functions available to export templates
+func ExportTemplateFuncs() map[string]interface{} {
+	return map[string]interface{}{
+		"timestamp": FormatTimestamp,
+		"percent": func(value float64) string {
+			return fmt.Sprintf("%.1f%%", value*100)
+		},
+		"date":      formatTemplateDate,
+		"join":      strings.Join,
+		"upper":     strings.ToUpper,
+		"lower":     strings.ToLower,
+		"trim":      strings.TrimSpace,
+		"inc":       func(i int) int { return i + 1 },
+		"default":   templateDefault,
+		"cell":      markdownCell,
+		"bySpeaker": GroupSegmentsBySpeaker,
+	}
+}
+
+// ParseExportTemplate parses a template body, using html/template for HTML so values are escaped
+func ParseExportTemplate(name string, format entities.ExportTemplateFormat, body string) (ExportTemplateRenderer, error) {
+	var renderer ExportTemplateRenderer
+	var err error
+	if format == entities.HTMLTemplate {
+		renderer, err = htmltemplate.New(name).Funcs(ExportTemplateFuncs()).Parse(body)
+	} else {
+		renderer, err = texttemplate.New(name).Funcs(ExportTemplateFuncs()).Parse(body)
+	}
+	if err != nil {
+		return nil, domain.NewDomainError("INVALID_EXPORT_TEMPLATE", "Invalid template: "+err.Error(), domain.ErrInvalidInput)
+	}
+	return renderer, nil
+}
+
+// FindBuiltinExportTemplate returns the built-in template with the given name
+func FindBuiltinExportTemplate(name string) (BuiltinExportTemplate, bool) {
+	for _, template := range BuiltinExportTemplates {
+		if template.Name == name {
+			return template, true
+		}
+	}
+	return BuiltinExportTemplate{}, false
+}
+
+// FormatTimestamp formats seconds as MM:SS, or H:MM:SS from one hour on
+func FormatTimestamp(seconds float64) string {
+	total := int(seconds)
+	if total < 0 {
+		total = 0
+	}
+	if total >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", total/3600, total/60%60, total%60)
+	}
+	return fmt.Sprintf("%02d:%02d", total/60, total%60)
+}
+
+// GroupSegmentsBySpeaker groups segments by speaker, ordered by the speaker's first segment
+func GroupSegmentsBySpeaker(segments []entities.TranscriptSegment) []SpeakerGroup {
+	var groups []SpeakerGroup
+	indexes := make(map[string]int)
+	var totalDuration float64
+	for _, segment := range segments {
+		speaker := segment.Speaker
+		if speaker == "" {
+			speaker = "Speaker Unknown"
+		}
+		index, ok := indexes[speaker]
+		if !ok {
+			index = len(groups)
+			indexes[speaker] = index
+			groups = append(groups, SpeakerGroup{Speaker: speaker})
+		}
+		groups[index].Segments = append(groups[index].Segments, segment)
+		groups[index].Duration += segment.GetDuration()
+		groups[index].Words += segment.GetWordCount()
+		totalDuration += segment.GetDuration()
+	}
+	for i := range groups {
+		if totalDuration > 0 {
+			groups[i].Share = groups[i].Duration / totalDuration
+		}
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Segments[0].StartTime < groups[j].Segments[0].StartTime
+	})
+	return groups
+}
+
+// formatTemplateDate formats a time.Time or *time.Time, printing nothing for nil or zero times
+func formatTemplateDate(value interface{}, layout string) string {
+	switch t := value.(type) {
+	case time.Time:
+		if !t.IsZero() {
+			return t.Format(layout)
+		}
+	case *time.Time:
+		if t != nil && !t.IsZero() {
+			return t.Format(layout)
+		}
+	}
+	return ""
+}
+
+// templateDefault returns value unless it is empty, in which case fallback is returned
+func templateDefault(fallback string, value interface{}) string {
+	if value == nil {
+		return fallback
+	}
+	if text := strings.TrimSpace(fmt.Sprint(value)); text != "" {
+		return text
+	}
+	return fallback
+}
+
+// markdownCell makes a value safe to place in a Markdown table cell
+func markdownCell(value string) string {
+	value = strings.Join(strings.Fields(value), " ")
+	return strings.ReplaceAll(value, "|", `\|`)
+}
+
+const htmlLayoutStart = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="UTF-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: Arial, sans-serif; margin: 40px; color: #222; line-height: 1.5; }
+h1 { border-bottom: 2px solid #333; padding-bottom: 8px; }
+.meta { background-color: #f5f5f5; padding: 12px 16px; }
+.segment { margin-bottom: 12px; }
+.timestamp { color: #666; font-size: 0.9em; }
+.speaker { font-weight: bold; color: #0066cc; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+</style>
+</head>
+<body>
+`
+
+const htmlLayoutEnd = `
+</body>
+</html>
+`
+
+// BuiltinExportTemplates are available to every user
+var BuiltinExportTemplates = []BuiltinExportTemplate{
+	{
+		Name:        "transcript",
+		Description: "Full speaker-attributed transcript with meeting details",
+		Markdown: `# {{.Title}}
+{{with .Meeting}}
+- **Meeting:** {{.Title}}
+- **Date:** {{date .StartTime "January 2, 2006 15:04"}}{{end}}{{if .Participants}}
+- **Participants:** {{range $i, $p := .Participants}}{{if $i}}, {{end}}{{$p.Name}}{{end}}{{end}}
+
+## Transcript
+{{range .Segments}}
+{{if $.Options.IncludeTimestamps}}` + "`[{{timestamp .StartTime}}]`" + ` {{end}}{{with .Speaker}}**{{.}}:** {{end}}{{.Text}}
+{{end}}{{if and .Options.IncludeStats .Stats}}
+## Statistics
+
+- Duration: {{timestamp .Stats.TotalDuration}}
+- Speakers: {{.Stats.SpeakerCount}}
+- Words: {{.Stats.WordCount}}
+- Average confidence: {{percent .Stats.AverageConfidence}}
+{{end}}`,
+		HTML: `<h1>{{.Title}}</h1>
+{{with .Meeting}}<div class="meta"><p><strong>Meeting:</strong> {{.Title}}<br><strong>Date:</strong> {{date .StartTime "January 2, 2006 15:04"}}</p></div>{{end}}
+{{if .Participants}}<p><strong>Participants:</strong> {{range $i, $p := .Participants}}{{if $i}}, {{end}}{{$p.Name}}{{end}}</p>{{end}}
+<h2>Transcript</h2>
+{{range .Segments}}<div class="segment">{{if $.Options.IncludeTimestamps}}<span class="timestamp">[{{timestamp .StartTime}}]</span> {{end}}{{with .Speaker}}<span class="speaker">{{.}}:</span> {{end}}{{.Text}}</div>
+{{end}}{{if and .Options.IncludeStats .Stats}}<h2>Statistics</h2>
+<ul>
+<li>Duration: {{timestamp .Stats.TotalDuration}}</li>
+<li>Speakers: {{.Stats.SpeakerCount}}</li>
+<li>Words: {{.Stats.WordCount}}</li>
+<li>Average confidence: {{percent .Stats.AverageConfidence}}</li>
+</ul>{{end}}`,
+	},
+	{
+		Name:        "meeting_minutes",
+		Description: "Attendees, action items and discussion notes",
+		Markdown: `# Meeting Minutes: {{.Title}}
+{{with .Meeting}}
+**Date:** {{date .StartTime "January 2, 2006 15:04"}}{{with .EndTime}} – {{date . "15:04"}}{{end}}
+{{end}}
+**Attendees:** {{if .Participants}}{{range $i, $p := .Participants}}{{if $i}}, {{end}}{{$p.Name}}{{end}}{{else}}{{join .Speakers ", "}}{{end}}
+
+## Action Items
+{{if .ActionItems}}
+| # | Action | Owner | Due | Priority |
+|---|--------|-------|-----|----------|
+{{range $i, $a := .ActionItems}}| {{inc $i}} | {{cell $a.Title}} | {{cell (default "Unassigned" $a.Assignee)}} | {{default "—" (date $a.DueDate "2006-01-02")}} | {{$a.Priority}} |
+{{end}}{{else}}
+_No action items recorded._
+{{end}}
+## Discussion
+{{range .Segments}}
+- **{{default "Speaker Unknown" .Speaker}}** ({{timestamp .StartTime}}): {{.Text}}{{end}}
+`,
+		HTML: `<h1>Meeting Minutes: {{.Title}}</h1>
+{{with .Meeting}}<p><strong>Date:</strong> {{date .StartTime "January 2, 2006 15:04"}}{{with .EndTime}} – {{date . "15:04"}}{{end}}</p>{{end}}
+<p><strong>Attendees:</strong> {{if .Participants}}{{range $i, $p := .Participants}}{{if $i}}, {{end}}{{$p.Name}}{{end}}{{else}}{{join .Speakers ", "}}{{end}}</p>
+<h2>Action Items</h2>
+{{if .ActionItems}}<table>
+<tr><th>#</th><th>Action</th><th>Owner</th><th>Due</th><th>Priority</th></tr>
+{{range $i, $a := .ActionItems}}<tr><td>{{inc $i}}</td><td>{{$a.Title}}</td><td>{{default "Unassigned" $a.Assignee}}</td><td>{{default "—" (date $a.DueDate "2006-01-02")}}</td><td>{{$a.Priority}}</td></tr>
+{{end}}</table>{{else}}<p><em>No action items recorded.</em></p>{{end}}
+<h2>Discussion</h2>
+<ul>
+{{range .Segments}}<li><span class="speaker">{{default "Speaker Unknown" .Speaker}}</span> <span class="timestamp">({{timestamp .StartTime}})</span>: {{.Text}}</li>
+{{end}}</ul>`,
+	},
+	{
+		Name:        "standup_notes",
+		Description: "Updates grouped by person, followed by open follow-ups",
+		Markdown: `# Standup Notes: {{if .Meeting}}{{date .Meeting.StartTime "Monday, January 2, 2006"}}{{else}}{{date .GeneratedAt "Monday, January 2, 2006"}}{{end}}
+{{range bySpeaker .Segments}}
+## {{.Speaker}}
+{{range .Segments}}
+- {{.Text}}{{end}}
+{{end}}{{if .ActionItems}}
+## Follow-ups
+{{range .ActionItems}}
+- [ ] {{.Title}}{{with .Assignee}} (@{{.}}){{end}}{{end}}
+{{end}}`,
+		HTML: `<h1>Standup Notes: {{if .Meeting}}{{date .Meeting.StartTime "Monday, January 2, 2006"}}{{else}}{{date .GeneratedAt "Monday, January 2, 2006"}}{{end}}</h1>
+{{range bySpeaker .Segments}}<h2>{{.Speaker}}</h2>
+<ul>
+{{range .Segments}}<li>{{.Text}}</li>
+{{end}}</ul>
+{{end}}{{if .ActionItems}}<h2>Follow-ups</h2>
+<ul>
+{{range .ActionItems}}<li><input type="checkbox" disabled> {{.Title}}{{with .Assignee}} (@{{.}}){{end}}</li>
+{{end}}</ul>{{end}}`,
+	},
+	{
+		Name:        "interview",
+		Description: "Speaking time per participant and a question-and-answer transcript",
+		Markdown: `# Interview: {{.Title}}
+{{with .Meeting}}
+**Date:** {{date .StartTime "January 2, 2006"}}
+{{end}}
+## Speaking Time
+{{range bySpeaker .Segments}}
+- **{{.Speaker}}:** {{timestamp .Duration}} ({{percent .Share}}), {{.Words}} words{{end}}
+
+## Transcript
+{{range .Segments}}
+**{{default "Speaker Unknown" .Speaker}}** ` + "`{{timestamp .StartTime}}`" + `
+
+{{.Text}}
+{{end}}`,
+		HTML: `<h1>Interview: {{.Title}}</h1>
+{{with .Meeting}}<p><strong>Date:</strong> {{date .StartTime "January 2, 2006"}}</p>{{end}}
+<h2>Speaking Time</h2>
+<ul>
+{{range bySpeaker .Segments}}<li><strong>{{.Speaker}}:</strong> {{timestamp .Duration}} ({{percent .Share}}), {{.Words}} words</li>
+{{end}}</ul>
+<h2>Transcript</h2>
+{{range .Segments}}<div class="segment"><span class="speaker">{{default "Speaker Unknown" .Speaker}}</span> <span class="timestamp">{{timestamp .StartTime}}</span><br>{{.Text}}</div>
+{{end}}`,
+	},
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportTemplateTestData mirrors the fields the built-in templates read
+type exportTemplateTestData struct {
+	Title       string
+	GeneratedAt time.Time
+	Meeting     *struct {
+		Title     string
+		StartTime time.Time
+		EndTime   *time.Time
+	}
+	Participants []struct{ Name string }
+	Speakers     []string
+	Segments     []entities.TranscriptSegment
+	Stats        *struct {
+		TotalDuration           float64
+		SpeakerCount, WordCount int
+		AverageConfidence       float64
+	}
+	ActionItems []struct {
+		Title, Assignee, Priority string
+		DueDate                   *time.Time
+	}
+	Options struct{ IncludeTimestamps, IncludeStats bool }
+}
+
+func TestBuiltinExportTemplates_Render(t *testing.T) {
+	due := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)
+	data := exportTemplateTestData{
+		Title:       "Sprint <Planning>",
+		GeneratedAt: time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+		Speakers:    []string{"Alice", "Bob"},
+		Segments: []entities.TranscriptSegment{
+			entities.NewTranscriptSegment("tr-1", "Alice", "Let's ship the | export", 0, 30, 0.9, 1),
+			entities.NewTranscriptSegment("tr-1", "Bob", "I'll write the docs", 30, 40, 0.9, 2),
+		},
+		ActionItems: []struct {
+			Title, Assignee, Priority string
+			DueDate                   *time.Time
+		}{{Title: "Write docs", Assignee: "Bob", Priority: "high", DueDate: &due}},
+	}
+	data.Options.IncludeTimestamps = true
+
+	for _, builtin := range BuiltinExportTemplates {
+		for _, format := range []entities.ExportTemplateFormat{entities.MarkdownTemplate, entities.HTMLTemplate} {
+			renderer, err := ParseExportTemplate(builtin.Name, format, builtin.Body(format))
+			require.NoError(t, err, builtin.Name)
+
+			var output bytes.Buffer
+			require.NoError(t, renderer.Execute(&output, data), "%s %s", builtin.Name, format)
+			assert.Contains(t, output.String(), "write the docs", builtin.Name)
+			if format == entities.HTMLTemplate {
+				assert.NotContains(t, output.String(), "<Planning>", builtin.Name)
+			}
+		}
+	}
+
+	minutes, _ := FindBuiltinExportTemplate("meeting_minutes")
+	renderer, err := ParseExportTemplate(minutes.Name, entities.MarkdownTemplate, minutes.Body(entities.MarkdownTemplate))
+	require.NoError(t, err)
+	var output bytes.Buffer
+	require.NoError(t, renderer.Execute(&output, data))
+	assert.Contains(t, output.String(), "**Attendees:** Alice, Bob")
+	assert.Contains(t, output.String(), "| 1 | Write docs | Bob | 2026-03-06 | high |")
+}
+
+func TestParseExportTemplate_Invalid(t *testing.T) {
+	_, err := ParseExportTemplate("broken", entities.MarkdownTemplate, "{{range .Segments}}")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid template")
+}
+
+func TestGroupSegmentsBySpeaker(t *testing.T) {
+	groups := GroupSegmentsBySpeaker([]entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "Alice", "one two three", 0, 30, 0.9, 1),
+		entities.NewTranscriptSegment("tr-1", "", "hello", 30, 40, 0.9, 2),
+		entities.NewTranscriptSegment("tr-1", "Alice", "four", 40, 50, 0.9, 3),
+	})
+
+	require.Len(t, groups, 2)
+	assert.Equal(t, "Alice", groups[0].Speaker)
+	assert.Len(t, groups[0].Segments, 2)
+	assert.Equal(t, 40.0, groups[0].Duration)
+	assert.Equal(t, 4, groups[0].Words)
+	assert.InDelta(t, 0.8, groups[0].Share, 0.001)
+	assert.Equal(t, "Speaker Unknown", groups[1].Speaker)
+}
+
+func TestFormatTimestamp(t *testing.T) {
+	assert.Equal(t, "00:00", FormatTimestamp(-3))
+	assert.Equal(t, "01:05", FormatTimestamp(65.9))
+	assert.Equal(t, "1:02:05", FormatTimestamp(3725))
+}
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// QuestionRequest is a question about a meeting and the transcript segments retrieved for it
+type QuestionRequest struct {
+	Question string
+	// Transcriptions are the completed transcriptions of the meeting
+	Transcriptions []*entities.Transcription
+	// Sources are the retrieved segments, most relevant passage first
+	Sources []entities.TranscriptSegment
+}
+
+// QuestionAnswer is the answer to a meeting question
+type QuestionAnswer struct {
+	Answer string
+	// Sources are the indexes of the request sources the answer relies on; empty means all of them
+	Sources []int
+	Method  entities.AnswerMethod
+	Model   string
+}
+
+// QuestionAnswerer answers questions about meetings from their transcripts
+type QuestionAnswerer interface {
+	// Supports returns true if the answerer can answer questions about the transcriptions
+	Supports(transcriptions []*entities.Transcription) bool
+
+	// Answer answers the question from the retrieved sources
+	Answer(ctx context.Context, request QuestionRequest) (*QuestionAnswer, error)
+}
+
+// LanguageModel is a text generation model that completes prompts
+type LanguageModel interface {
+	// Name identifies the model, e.g. "gpt-4o-mini"
+	Name() string
+
+	// Complete returns the model's response to the system instructions and user prompt
+	Complete(ctx context.Context, system, prompt string) (string, error)
+}
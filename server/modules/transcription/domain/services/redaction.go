@@ -0,0 +1,143 @@
+package services
+
+import (
+	"regexp"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// ApplyRedactionSettings adds the user's default redaction to the processing options.
+// Redaction requested by either the session or the default is enabled, and their policies are combined.
+func ApplyRedactionSettings(options AudioProcessingOptions, settings *entities.RedactionSettings) AudioProcessingOptions {
+	policies := append([]entities.PIIPolicy{}, options.PIIPolicies...)
+	if settings != nil {
+		options.RedactPII = options.RedactPII || settings.RedactPII
+		options.RedactPIIAudio = options.RedactPIIAudio || settings.RedactPIIAudio
+		if settings.RedactPII {
+			policies = append(policies, settings.PIIPolicies...)
+		}
+	}
+
+	options.RedactPII = options.RedactPII || options.RedactPIIAudio
+	if !options.RedactPII {
+		options.PIIPolicies = nil
+		return options
+	}
+
+	// Invalid policies from the session are dropped rather than failing the transcription
+	valid := make([]entities.PIIPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if policy.IsValid() {
+			valid = append(valid, policy)
+		}
+	}
+	normalized, _ := entities.NormalizePIIPolicies(valid)
+	if len(normalized) == 0 {
+		normalized = append(normalized, entities.DefaultPIIPolicies...)
+	}
+	options.PIIPolicies = normalized
+	return options
+}
+
+// localRedactors are applied in order; card numbers and SSNs go first so their digits
+// are not partially taken by the phone number pattern.
+var localRedactors = []struct {
+	policy  entities.PIIPolicy
+	pattern *regexp.Regexp
+	accept  func(match string) bool
+}{
+	{
+		policy:  entities.PIICreditCardNumber,
+		pattern: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		accept:  passesLuhn,
+	},
+	{
+		policy:  entities.PIIUSSocialSecurity,
+		pattern: regexp.MustCompile(`\b\d{3}[- ]\d{2}[- ]\d{4}\b`),
+	},
+	{
+		policy:  entities.PIIPhoneNumber,
+		pattern: regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{3}\)|\b\d{3})[\s.-]?\d{3}[\s.-]?\d{4}\b`),
+	},
+	{
+		// Written addresses and the way they are usually spoken, e.g. "jane at example dot com"
+		policy:  entities.PIIEmailAddress,
+		pattern: regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b|\b[a-z0-9._%+-]+ at [a-z0-9-]+(?: dot [a-z0-9-]+)* dot (?:com|org|net|edu|gov|io|co|ai|dev|app|us|uk|ca|de)\b`),
+	},
+}
+
+// LocalPIIPolicies returns the policies RedactSegments can apply
+func LocalPIIPolicies() []entities.PIIPolicy {
+	policies := make([]entities.PIIPolicy, len(localRedactors))
+	for i, redactor := range localRedactors {
+		policies[i] = redactor.policy
+	}
+	return policies
+}
+
+// RedactSegments replaces PII in segment text with placeholders for the requested policies
+// that can be detected locally, and records the redacted spans. Other policies are ignored.
+func RedactSegments(segments []entities.TranscriptSegment, policies []entities.PIIPolicy) []entities.TranscriptSegment {
+	requested := make(map[entities.PIIPolicy]bool, len(policies))
+	for _, policy := range policies {
+		requested[policy] = true
+	}
+
+	result := make([]entities.TranscriptSegment, len(segments))
+	for i, segment := range segments {
+		original := segment.Text
+		for _, redactor := range localRedactors {
+			if !requested[redactor.policy] {
+				continue
+			}
+			token := redactor.policy.RedactionToken()
+			accept := redactor.accept
+			segment.Text = redactor.pattern.ReplaceAllStringFunc(segment.Text, func(match string) string {
+				if accept != nil && !accept(match) {
+					return match
+				}
+				return token
+			})
+		}
+		// Word timings still hold the unredacted words
+		if segment.Text != original {
+			segment.Words = nil
+		}
+		segment.MarkRedactions()
+		result[i] = segment
+	}
+	return result
+}
+
+// MarkRedactedSegments records the placeholders inserted by a provider that redacts natively
+func MarkRedactedSegments(segments []entities.TranscriptSegment) []entities.TranscriptSegment {
+	result := make([]entities.TranscriptSegment, len(segments))
+	for i, segment := range segments {
+		segment.MarkRedactions()
+		result[i] = segment
+	}
+	return result
+}
+
+// passesLuhn reports whether the digits in value form a valid card number checksum
+func passesLuhn(value string) bool {
+	sum, count := 0, 0
+	double := false
+	for i := len(value) - 1; i >= 0; i-- {
+		c := value[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		digit := int(c - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+		count++
+	}
+	return count >= 13 && sum%10 == 0
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactionSettings_Update(t *testing.T) {
+	settings := entities.NewRedactionSettings("user-1")
+
+	require.NoError(t, settings.Update(false, true, nil))
+	assert.True(t, settings.RedactPII)
+	assert.True(t, settings.RedactPIIAudio)
+	assert.Equal(t, entities.DefaultPIIPolicies, settings.PIIPolicies)
+
+	require.NoError(t, settings.Update(true, false, []entities.PIIPolicy{" Person_Name ", "person_name", entities.PIIEmailAddress}))
+	assert.Equal(t, []entities.PIIPolicy{entities.PIIPersonName, entities.PIIEmailAddress}, settings.PIIPolicies)
+
+	assert.Error(t, settings.Update(true, false, []entities.PIIPolicy{"shoe_size"}))
+}
+
+func TestApplyRedactionSettings(t *testing.T) {
+	settings := entities.NewRedactionSettings("user-1")
+	require.NoError(t, settings.Update(true, false, []entities.PIIPolicy{entities.PIIPersonName}))
+
+	options := ApplyRedactionSettings(AudioProcessingOptions{
+		RedactPIIAudio: true,
+		PIIPolicies:    []entities.PIIPolicy{entities.PIIPhoneNumber, "unknown", entities.PIIPersonName},
+	}, &settings)
+
+	assert.True(t, options.RedactPII)
+	assert.True(t, options.RedactPIIAudio)
+	assert.Equal(t, []entities.PIIPolicy{entities.PIIPhoneNumber, entities.PIIPersonName}, options.PIIPolicies)
+
+	// A session cannot turn off the default redaction
+	options = ApplyRedactionSettings(AudioProcessingOptions{}, &settings)
+	assert.True(t, options.RedactPII)
+	assert.Equal(t, []entities.PIIPolicy{entities.PIIPersonName}, options.PIIPolicies)
+
+	// Without settings or session redaction nothing is redacted
+	options = ApplyRedactionSettings(AudioProcessingOptions{PIIPolicies: []entities.PIIPolicy{entities.PIIDrug}}, nil)
+	assert.False(t, options.RedactPII)
+	assert.Empty(t, options.PIIPolicies)
+
+	options = ApplyRedactionSettings(AudioProcessingOptions{RedactPII: true}, nil)
+	assert.Equal(t, entities.DefaultPIIPolicies, options.PIIPolicies)
+}
+
+func TestRedactSegments(t *testing.T) {
+	segments := []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "Speaker A", "Email jane.doe@example.com or jane at example dot com.", 0, 2, 0.9, 1),
+		entities.NewTranscriptSegment("tr-1", "Speaker B", "Call (555) 123-4567, my SSN is 123-45-6789.", 2, 4, 0.9, 2),
+		entities.NewTranscriptSegment("tr-1", "Speaker A", "Card 4111 1111 1111 1111, order 1234 5678 9012 3456.", 4, 6, 0.9, 3),
+	}
+
+	result := RedactSegments(segments, entities.DefaultPIIPolicies)
+
+	require.Len(t, result, 3)
+	assert.Equal(t, "Email [EMAIL_ADDRESS] or [EMAIL_ADDRESS].", result[0].Text)
+	assert.Equal(t, "Call [PHONE_NUMBER], my SSN is [US_SOCIAL_SECURITY_NUMBER].", result[1].Text)
+	// Only numbers passing the Luhn check are treated as card numbers
+	assert.Equal(t, "Card [CREDIT_CARD_NUMBER], order 1234 5678 9012 3456.", result[2].Text)
+
+	require.Len(t, result[1].Redactions, 2)
+	assert.Equal(t, entities.RedactedSpan{Type: entities.PIIPhoneNumber, Start: 5, End: 19}, result[1].Redactions[0])
+	assert.Equal(t, entities.PIIUSSocialSecurity, result[1].Redactions[1].Type)
+	assert.True(t, result[0].IsRedacted())
+
+	// The input segments are left untouched
+	assert.Equal(t, "Call (555) 123-4567, my SSN is 123-45-6789.", segments[1].Text)
+	assert.False(t, segments[1].IsRedacted())
+}
+
+func TestRedactSegments_OnlyRequestedPolicies(t *testing.T) {
+	segments := []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "Speaker A", "Reach me at 555-123-4567 or bob@example.com.", 0, 2, 0.9, 1),
+	}
+
+	segments[0].Words = []entities.WordTiming{{Text: "Reach", Start: 0, End: 0.3}}
+
+	result := RedactSegments(segments, []entities.PIIPolicy{entities.PIIEmailAddress, entities.PIIPersonName})
+
+	assert.Equal(t, "Reach me at 555-123-4567 or [EMAIL_ADDRESS].", result[0].Text)
+	require.Len(t, result[0].Redactions, 1)
+	assert.Equal(t, entities.PIIEmailAddress, result[0].Redactions[0].Type)
+	// Word timings would still reveal the redacted words
+	assert.Empty(t, result[0].Words)
+}
+
+func TestMarkRedactedSegments(t *testing.T) {
+	segments := []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "Speaker A", "Hi [PERSON_NAME], née [LOCATION] [NOT_A_POLICY].", 0, 2, 0.9, 1),
+	}
+
+	result := MarkRedactedSegments(segments)
+
+	require.Len(t, result[0].Redactions, 2)
+	assert.Equal(t, entities.RedactedSpan{Type: entities.PIIPersonName, Start: 3, End: 16}, result[0].Redactions[0])
+	// Offsets count characters, not bytes
+	assert.Equal(t, entities.RedactedSpan{Type: entities.PIILocation, Start: 22, End: 32}, result[0].Redactions[1])
+}
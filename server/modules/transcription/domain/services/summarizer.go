@@ -0,0 +1,34 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// SummaryRequest is a transcription to summarize
+type SummaryRequest struct {
+	Transcription *entities.Transcription
+	Segments      []entities.TranscriptSegment
+	// AnswerFormat describes how the overview should be written, e.g. "one short paragraph" or
+	// "TLDR". Summarizers that cannot follow instructions ignore it.
+	AnswerFormat string
+}
+
+// SummaryContent is the structured summary of a transcription
+type SummaryContent struct {
+	Overview  string
+	KeyPoints []string
+	Decisions []string
+	Method    entities.SummaryMethod
+	Model     string
+}
+
+// Summarizer produces structured summaries of transcriptions
+type Summarizer interface {
+	// Supports returns true if the summarizer can summarize the transcription
+	Supports(transcription *entities.Transcription) bool
+
+	// Summarize produces the overview, key points and decisions of a transcription
+	Summarize(ctx context.Context, request SummaryRequest) (*SummaryContent, error)
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// DefaultTaxonomyID identifies the built-in taxonomy used when a user has no active taxonomy
+const DefaultTaxonomyID = "default"
+
+// defaultTopics are the built-in topics and their keywords
+var defaultTopics = []struct {
+	name     string
+	keywords []string
+}{
+	{"Meeting Management", []string{"meeting", "agenda", "schedule", "plan", "organize"}},
+	{"Technical Discussion", []string{"technical", "development", "code", "system", "architecture", "bug", "feature"}},
+	{"Business Strategy", []string{"business", "strategy", "market", "customer", "revenue", "growth"}},
+	{"Project Planning", []string{"project", "timeline", "deadline", "milestone", "deliverable", "task"}},
+	{"Team Coordination", []string{"team", "collaboration", "communication", "assign", "responsibility"}},
+	{"Decision Making", []string{"decision", "approve", "choose", "select", "vote", "agree"}},
+	{"Problem Solving", []string{"problem", "issue", "solution", "fix", "resolve", "troubleshoot"}},
+	{"Review & Feedback", []string{"review", "feedback", "comment", "suggestion", "improvement"}},
+}
+
+var (
+	defaultPositiveWords = []string{"good", "great", "excellent", "positive", "agree", "success", "happy", "wonderful", "perfect", "amazing"}
+	defaultNegativeWords = []string{"bad", "terrible", "awful", "negative", "disagree", "failure", "sad", "problem", "issue", "wrong"}
+)
+
+// DefaultTaxonomy returns the built-in topic taxonomy and sentiment lexicon. It seeds new
+// taxonomies and applies to users without an active taxonomy of their own.
+func DefaultTaxonomy() entities.Taxonomy {
+	taxonomy := entities.Taxonomy{
+		Name:        "Default",
+		Description: "Built-in topics and sentiment words",
+		Topics:      make([]entities.TaxonomyTopic, len(defaultTopics)),
+		Sentiment:   DefaultSentimentLexicon(),
+		Stemming:    true,
+	}
+	taxonomy.SetID(DefaultTaxonomyID)
+	for i, topic := range defaultTopics {
+		taxonomy.Topics[i] = entities.TaxonomyTopic{Name: topic.name, Terms: weightedTerms(topic.keywords)}
+	}
+	return taxonomy
+}
+
+// DefaultSentimentLexicon returns the built-in sentiment words
+func DefaultSentimentLexicon() entities.SentimentLexicon {
+	return entities.SentimentLexicon{
+		Positive: weightedTerms(defaultPositiveWords),
+		Negative: weightedTerms(defaultNegativeWords),
+	}
+}
+
+func weightedTerms(words []string) []entities.TaxonomyTerm {
+	terms := make([]entities.TaxonomyTerm, len(words))
+	for i, word := range words {
+		terms[i] = entities.TaxonomyTerm{Term: word, Weight: 1}
+	}
+	return terms
+}
+
+// TopicMatch is a topic found in a text, with the summed weight of its matching terms
+type TopicMatch struct {
+	Topic string
+	Score float64
+}
+
+// TaxonomyMatcher finds the topics and sentiment of texts using a taxonomy
+type TaxonomyMatcher struct {
+	stemming bool
+	topics   []compiledTopic
+	positive []compiledTerm
+	negative []compiledTerm
+}
+
+type compiledTopic struct {
+	name  string
+	terms []compiledTerm
+	words []string
+}
+
+// compiledTerm holds the token sequences of a term and its synonyms
+type compiledTerm struct {
+	weight   float64
+	variants [][]string
+}
+
+// NewTaxonomyMatcher compiles a taxonomy for matching. An empty sentiment lexicon falls back to the built-in one.
+func NewTaxonomyMatcher(taxonomy *entities.Taxonomy) *TaxonomyMatcher {
+	matcher := &TaxonomyMatcher{stemming: taxonomy.Stemming}
+
+	for _, topic := range taxonomy.Topics {
+		compiled := compiledTopic{name: topic.Name}
+		for _, term := range topic.Terms {
+			compiled.terms = append(compiled.terms, matcher.compileTerm(term))
+			compiled.words = append(compiled.words, term.Term)
+		}
+		matcher.topics = append(matcher.topics, compiled)
+	}
+
+	lexicon := taxonomy.Sentiment
+	if lexicon.IsEmpty() {
+		lexicon = DefaultSentimentLexicon()
+	}
+	for _, term := range lexicon.Positive {
+		matcher.positive = append(matcher.positive, matcher.compileTerm(term))
+	}
+	for _, term := range lexicon.Negative {
+		matcher.negative = append(matcher.negative, matcher.compileTerm(term))
+	}
+
+	return matcher
+}
+
+// Tokenize splits a text into lowercase words, stemmed if the taxonomy uses stemming
+func (m *TaxonomyMatcher) Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if m.stemming {
+		for i, word := range words {
+			words[i] = Stem(word)
+		}
+	}
+	return words
+}
+
+// TopicNames returns the names of the taxonomy's topics
+func (m *TaxonomyMatcher) TopicNames() []string {
+	names := make([]string, len(m.topics))
+	for i, topic := range m.topics {
+		names[i] = topic.name
+	}
+	return names
+}
+
+// TopicTerms returns the terms of a topic
+func (m *TaxonomyMatcher) TopicTerms(name string) []string {
+	for _, topic := range m.topics {
+		if topic.name == name {
+			return topic.words
+		}
+	}
+	return nil
+}
+
+// MatchTopics returns the topics found in tokenized text. Each matching term adds its weight once.
+func (m *TaxonomyMatcher) MatchTopics(tokens []string) []TopicMatch {
+	var matches []TopicMatch
+	for _, topic := range m.topics {
+		score := matchWeight(topic.terms, tokens)
+		if score > 0 {
+			matches = append(matches, TopicMatch{Topic: topic.name, Score: score})
+		}
+	}
+	return matches
+}
+
+// SentimentScore returns the weight of positive minus negative terms found in tokenized text,
+// per word of the text
+func (m *TaxonomyMatcher) SentimentScore(tokens []string) float64 {
+	if len(tokens) == 0 {
+		return 0
+	}
+	return (matchWeight(m.positive, tokens) - matchWeight(m.negative, tokens)) / float64(len(tokens))
+}
+
+func (m *TaxonomyMatcher) compileTerm(term entities.TaxonomyTerm) compiledTerm {
+	compiled := compiledTerm{weight: term.Weight}
+	for _, phrase := range append([]string{term.Term}, term.Synonyms...) {
+		if tokens := m.Tokenize(phrase); len(tokens) > 0 {
+			compiled.variants = append(compiled.variants, tokens)
+		}
+	}
+	return compiled
+}
+
+// matchWeight sums the weights of the terms with a variant occurring in tokens
+func matchWeight(terms []compiledTerm, tokens []string) float64 {
+	total := 0.0
+	for _, term := range terms {
+		for _, variant := range term.variants {
+			if containsSequence(tokens, variant) {
+				total += term.weight
+				break
+			}
+		}
+	}
+	return total
+}
+
+// containsSequence returns true if sequence occurs as consecutive tokens
+func containsSequence(tokens, sequence []string) bool {
+	for i := 0; i+len(sequence) <= len(tokens); i++ {
+		match := true
+		for j, word := range sequence {
+			if tokens[i+j] != word {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// pluralSuffixes are removed from the end of words first
+var pluralSuffixes = []stemRule{
+	{"ies", "y"},
+	{"s", ""},
+}
+
+// stemSuffixes are removed from the end of words after plurals, longest first
+var stemSuffixes = []stemRule{
+	{"ational", "ate"},
+	{"ization", "ize"},
+	{"fulness", "ful"},
+	{"iveness", "ive"},
+	{"ousness", "ous"},
+	{"ement", ""},
+	{"ingly", ""},
+	{"ment", ""},
+	{"edly", ""},
+	{"ily", "y"},
+	{"ing", ""},
+	{"ly", ""},
+	{"ed", ""},
+}
+
+type stemRule struct {
+	suffix      string
+	replacement string
+}
+
+// Stem reduces an English word to a stem shared by its inflections, e.g. "fixes", "fixed" and "fixing"
+// all become "fix". It is a light suffix stripper rather than a full Porter stemmer, applied to both
+// the taxonomy terms and the transcript so matching only depends on the two agreeing.
+func Stem(word string) string {
+	if len([]rune(word)) <= 3 {
+		return word
+	}
+
+	if stem, rule, ok := stripSuffix(word, pluralSuffixes); ok {
+		// "ss", "us" and "is" endings are not plurals, e.g. "status"
+		if rule.suffix != "s" || !(strings.HasSuffix(stem, "s") || strings.HasSuffix(stem, "u") || strings.HasSuffix(stem, "i")) {
+			word = stem + rule.replacement
+			if rule.suffix == "s" && strings.HasSuffix(word, "e") && hasSibilantEnding(strings.TrimSuffix(word, "e")) {
+				word = strings.TrimSuffix(word, "e")
+			}
+		}
+	}
+
+	if stem, rule, ok := stripSuffix(word, stemSuffixes); ok {
+		switch {
+		case rule.replacement != "":
+			word = stem + rule.replacement
+		case strings.HasPrefix(rule.suffix, "ed") && strings.HasSuffix(stem, "e"):
+			// "agreed" keeps the double e of "agree"
+			word = stem + "e"
+		default:
+			word = undoubleConsonant(stem)
+		}
+	}
+
+	// A trailing e is dropped so "resolve" and "resolved" agree
+	if len([]rune(word)) > 3 && strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "ee") {
+		word = strings.TrimSuffix(word, "e")
+	}
+	return word
+}
+
+// stripSuffix removes the first matching suffix that leaves a stem of at least three letters
+func stripSuffix(word string, rules []stemRule) (string, stemRule, bool) {
+	for _, rule := range rules {
+		if !strings.HasSuffix(word, rule.suffix) {
+			continue
+		}
+		stem := strings.TrimSuffix(word, rule.suffix)
+		if len([]rune(stem)) < 3 {
+			return word, rule, false
+		}
+		return stem, rule, true
+	}
+	return word, stemRule{}, false
+}
+
+// hasSibilantEnding returns true for stems whose plural takes "es", e.g. "fix" or "match"
+func hasSibilantEnding(word string) bool {
+	for _, ending := range []string{"x", "ch", "sh", "ss", "z"} {
+		if strings.HasSuffix(word, ending) {
+			return true
+		}
+	}
+	return false
+}
+
+// undoubleConsonant turns "plann" from "planning" back into "plan"
+func undoubleConsonant(word string) string {
+	runes := []rune(word)
+	n := len(runes)
+	if n < 3 || runes[n-1] != runes[n-2] {
+		return word
+	}
+	switch runes[n-1] {
+	case 'a', 'e', 'i', 'o', 'u', 'l', 's', 'z':
+		return word
+	}
+	return string(runes[:n-1])
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStem(t *testing.T) {
+	groups := [][]string{
+		{"fix", "fixes", "fixed", "fixing"},
+		{"resolve", "resolved", "resolves"},
+		{"plan", "plans", "planned", "planning"},
+		{"agree", "agreed", "agrees", "agreeing"},
+		{"improvement", "improvements"},
+		{"strategy", "strategies"},
+		{"happy", "happily"},
+	}
+	for _, group := range groups {
+		for _, word := range group[1:] {
+			assert.Equal(t, Stem(group[0]), Stem(word), word)
+		}
+	}
+
+	assert.Equal(t, "status", Stem("status"))
+	assert.Equal(t, "success", Stem("success"))
+	assert.Equal(t, "bug", Stem("bug"))
+}
+
+func TestTaxonomyMatcher_MatchTopics(t *testing.T) {
+	taxonomy, err := entities.NewTaxonomy("user-1", "Sales", "", []entities.TaxonomyTopic{
+		{Name: "Pipeline", Terms: []entities.TaxonomyTerm{
+			{Term: "deal", Weight: 2},
+			{Term: "Sales Pipeline", Synonyms: []string{"funnel"}},
+		}},
+		{Name: "Pricing", Terms: []entities.TaxonomyTerm{{Term: "discount"}}},
+	}, entities.SentimentLexicon{}, true)
+	require.NoError(t, err)
+
+	matcher := NewTaxonomyMatcher(&taxonomy)
+
+	matches := matcher.MatchTopics(matcher.Tokenize("Two deals moved through our sales pipelines; the deal desk is happy."))
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Pipeline", matches[0].Topic)
+	assert.Equal(t, 3.0, matches[0].Score)
+
+	matches = matcher.MatchTopics(matcher.Tokenize("The funnel needs a discount"))
+	require.Len(t, matches, 2)
+	assert.Equal(t, 1.0, matches[0].Score)
+
+	// Phrases only match as consecutive words
+	assert.Empty(t, matcher.MatchTopics(matcher.Tokenize("our pipeline of sales")))
+}
+
+func TestTaxonomyMatcher_SentimentScore(t *testing.T) {
+	taxonomy := DefaultTaxonomy()
+	matcher := NewTaxonomyMatcher(&taxonomy)
+	assert.InDelta(t, 0.25, matcher.SentimentScore(matcher.Tokenize("we all agreed here")), 0.001)
+	assert.InDelta(t, -0.5, matcher.SentimentScore(matcher.Tokenize("Problems everywhere")), 0.001)
+
+	custom, err := entities.NewTaxonomy("user-1", "Custom", "", []entities.TaxonomyTopic{
+		{Name: "Any", Terms: []entities.TaxonomyTerm{{Term: "any"}}},
+	}, entities.SentimentLexicon{Negative: []entities.TaxonomyTerm{{Term: "blocker", Weight: 3}}}, false)
+	require.NoError(t, err)
+	matcher = NewTaxonomyMatcher(&custom)
+	assert.InDelta(t, -1.0, matcher.SentimentScore(matcher.Tokenize("a big blocker")), 0.001)
+	// Custom lexicons replace the built-in words
+	assert.Equal(t, 0.0, matcher.SentimentScore(matcher.Tokenize("great")))
+}
+
+func TestNewTaxonomy_Validation(t *testing.T) {
+	topics := []entities.TaxonomyTopic{{Name: "Hiring", Terms: []entities.TaxonomyTerm{{Term: " Candidate  Pipeline "}, {Term: "candidate pipeline"}}}}
+	taxonomy, err := entities.NewTaxonomy("user-1", "Recruiting", "", topics, entities.SentimentLexicon{}, true)
+	require.NoError(t, err)
+	require.Len(t, taxonomy.Topics[0].Terms, 1)
+	assert.Equal(t, "candidate pipeline", taxonomy.Topics[0].Terms[0].Term)
+	assert.Equal(t, 1.0, taxonomy.Topics[0].Terms[0].Weight)
+
+	invalid := map[string][]entities.TaxonomyTopic{
+		"no topics":       nil,
+		"no terms":        {{Name: "Empty"}},
+		"duplicate topic": {{Name: "A", Terms: []entities.TaxonomyTerm{{Term: "a"}}}, {Name: "a", Terms: []entities.TaxonomyTerm{{Term: "b"}}}},
+		"negative weight": {{Name: "A", Terms: []entities.TaxonomyTerm{{Term: "a", Weight: -1}}}},
+	}
+	for name, topics := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := entities.NewTaxonomy("user-1", "Invalid", "", topics, entities.SentimentLexicon{}, true)
+			assert.Error(t, err)
+		})
+	}
+}
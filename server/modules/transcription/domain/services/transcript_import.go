@@ -0,0 +1,403 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/domain"
+)
+
+// Transcript import formats
+const (
+	ImportFormatSRT  = "srt"
+	ImportFormatVTT  = "vtt"
+	ImportFormatJSON = "json"
+)
+
+const (
+	// importedCueConfidence is the confidence of subtitle cues, which carry none; their text is taken as given
+	importedCueConfidence = 1.0
+	// maxMergedCueGap is the longest pause, in seconds, between cues of one speaker merged into a segment
+	maxMergedCueGap = 1.5
+	// maxMergedSegmentDuration is the longest segment, in seconds, built by merging cues
+	maxMergedSegmentDuration = 30.0
+)
+
+// ImportOptions controls how speakers are read from subtitle files
+type ImportOptions struct {
+	// SpeakerPrefixes reads a "Speaker: text" prefix of a cue as its speaker. It is off by default
+	// because captions such as "Note: ..." or "Step 2: ..." would become speakers.
+	SpeakerPrefixes bool
+}
+
+// ImportedTranscript is a transcript parsed from another tool's export
+type ImportedTranscript struct {
+	Title     string
+	StartedAt *time.Time
+	Segments  []entities.TranscriptSegment
+}
+
+// Speakers returns the distinct speakers of the transcript in order of appearance
+func (t *ImportedTranscript) Speakers() []string {
+	seen := make(map[string]bool)
+	var speakers []string
+	for _, segment := range t.Segments {
+		if segment.Speaker != "" && !seen[segment.Speaker] {
+			seen[segment.Speaker] = true
+			speakers = append(speakers, segment.Speaker)
+		}
+	}
+	return speakers
+}
+
+// Duration returns the end time of the last segment in seconds
+func (t *ImportedTranscript) Duration() float64 {
+	var duration float64
+	for _, segment := range t.Segments {
+		if segment.EndTime > duration {
+			duration = segment.EndTime
+		}
+	}
+	return duration
+}
+
+// TranscriptInterchange is the JSON interchange format for imported transcripts. It is a
+// superset of the JSON export, so exports with timestamps and speakers can be imported again.
+// Times are seconds from the start of the meeting.
+//
+//	{
+//	  "format_version": "1.0",
+//	  "title": "Weekly sync",
+//	  "started_at": "2024-03-01T10:00:00Z",
+//	  "segments": [
+//	    {"speaker": "Anna", "text": "Let's start.", "start_time": 0.0, "end_time": 1.8, "confidence": 0.97}
+//	  ]
+//	}
+type TranscriptInterchange struct {
+	FormatVersion string                 `json:"format_version"`
+	Title         string                 `json:"title,omitempty"`
+	StartedAt     *time.Time             `json:"started_at,omitempty"`
+	Segments      []InterchangeSegment   `json:"segments"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// InterchangeSegment is a segment of the JSON interchange format. Confidence defaults to 1.
+type InterchangeSegment struct {
+	Speaker    string                `json:"speaker,omitempty"`
+	Text       string                `json:"text"`
+	StartTime  *float64              `json:"start_time"`
+	EndTime    *float64              `json:"end_time"`
+	Confidence *float64              `json:"confidence,omitempty"`
+	Words      []entities.WordTiming `json:"words,omitempty"`
+}
+
+// DetectImportFormat returns the format of a transcript file from its extension, falling back to its content
+func DetectImportFormat(fileName string, data []byte) string {
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), ".")) {
+	case ImportFormatSRT:
+		return ImportFormatSRT
+	case ImportFormatVTT, "webvtt":
+		return ImportFormatVTT
+	case ImportFormatJSON:
+		return ImportFormatJSON
+	}
+
+	content := bytes.TrimSpace(bytes.TrimPrefix(data, []byte("\ufeff")))
+	switch {
+	case bytes.HasPrefix(content, []byte("WEBVTT")):
+		return ImportFormatVTT
+	case bytes.HasPrefix(content, []byte("{")):
+		return ImportFormatJSON
+	default:
+		return ImportFormatSRT
+	}
+}
+
+// ParseTranscript parses a transcript in one of the import formats
+func ParseTranscript(format string, data []byte, options ImportOptions) (*ImportedTranscript, error) {
+	var transcript *ImportedTranscript
+	var err error
+	switch strings.ToLower(format) {
+	case ImportFormatSRT:
+		transcript, err = ParseSRT(data, options)
+	case ImportFormatVTT, "webvtt":
+		transcript, err = ParseWebVTT(data, options)
+	case ImportFormatJSON:
+		transcript, err = ParseTranscriptJSON(data)
+	default:
+		return nil, domain.NewDomainError("UNSUPPORTED_IMPORT_FORMAT", fmt.Sprintf("Unsupported import format %q (supported: srt, vtt, json)", format), domain.ErrInvalidInput)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(transcript.Segments) == 0 {
+		return nil, invalidTranscript("transcript has no segments")
+	}
+	return transcript, nil
+}
+
+// subtitleCue is a cue of an SRT or WebVTT file
+type subtitleCue struct {
+	start   float64
+	end     float64
+	speaker string
+	text    string
+}
+
+var (
+	// voiceTagPattern matches a WebVTT voice span, e.g. <v Anna> or <v.loud Anna>
+	voiceTagPattern = regexp.MustCompile(`<v(?:\.[^\s>]*)?\s+([^>]+)>`)
+	// cueTagPattern matches any WebVTT cue tag, including inline timestamps
+	cueTagPattern = regexp.MustCompile(`</?[^>]*>`)
+	// speakerPrefixPattern matches a "Speaker:" line or a "Speaker: text" prefix of at most four words
+	speakerPrefixPattern = regexp.MustCompile(`^(\p{L}[\p{L}\p{M}\d.'_-]*(?: [\p{L}\p{M}\d.'_-]+){0,3}):\s*(.*)$`)
+)
+
+// ParseSRT parses a SubRip file. Speakers are read from a line holding only "Speaker:", as written
+// by the SRT export, and carried over to following cues until another speaker is named.
+func ParseSRT(data []byte, options ImportOptions) (*ImportedTranscript, error) {
+	var cues []subtitleCue
+	for _, block := range cueBlocks(data) {
+		timing := 0
+		if !strings.Contains(block.lines[0], "-->") {
+			timing = 1
+		}
+		if timing >= len(block.lines) || !strings.Contains(block.lines[timing], "-->") {
+			return nil, invalidTranscript(fmt.Sprintf("line %d: expected a cue timing line", block.line+timing))
+		}
+		start, end, err := parseCueTiming(block.lines[timing])
+		if err != nil {
+			return nil, invalidTranscript(fmt.Sprintf("line %d: %v", block.line+timing, err))
+		}
+
+		speaker, text := splitSpeaker(block.lines[timing+1:], options.SpeakerPrefixes)
+		text = strings.TrimSpace(cueTagPattern.ReplaceAllString(text, ""))
+		cues = append(cues, subtitleCue{start: start, end: end, speaker: speaker, text: html.UnescapeString(text)})
+	}
+	return &ImportedTranscript{Segments: cuesToSegments(cues)}, nil
+}
+
+// ParseWebVTT parses a WebVTT file. Speakers are read from <v> voice tags, or from a "Speaker:"
+// line when a cue has none.
+func ParseWebVTT(data []byte, options ImportOptions) (*ImportedTranscript, error) {
+	blocks := cueBlocks(data)
+	if len(blocks) == 0 || !strings.HasPrefix(blocks[0].lines[0], "WEBVTT") {
+		return nil, invalidTranscript("WebVTT files must start with WEBVTT")
+	}
+
+	var cues []subtitleCue
+	for _, block := range blocks[1:] {
+		first := block.lines[0]
+		if first == "NOTE" || strings.HasPrefix(first, "NOTE ") || first == "STYLE" || first == "REGION" {
+			continue
+		}
+
+		timing := 0
+		if !strings.Contains(first, "-->") {
+			timing = 1
+		}
+		if timing >= len(block.lines) || !strings.Contains(block.lines[timing], "-->") {
+			return nil, invalidTranscript(fmt.Sprintf("line %d: expected a cue timing line", block.line+timing))
+		}
+		start, end, err := parseCueTiming(block.lines[timing])
+		if err != nil {
+			return nil, invalidTranscript(fmt.Sprintf("line %d: %v", block.line+timing, err))
+		}
+
+		lines := block.lines[timing+1:]
+		speaker := ""
+		if match := voiceTagPattern.FindStringSubmatch(strings.Join(lines, " ")); match != nil {
+			speaker = html.UnescapeString(strings.TrimSpace(match[1]))
+		}
+		stripped := make([]string, len(lines))
+		for i, line := range lines {
+			stripped[i] = cueTagPattern.ReplaceAllString(line, "")
+		}
+		text := strings.Join(stripped, " ")
+		if speaker == "" {
+			speaker, text = splitSpeaker(stripped, options.SpeakerPrefixes)
+		}
+		cues = append(cues, subtitleCue{start: start, end: end, speaker: speaker, text: html.UnescapeString(strings.TrimSpace(text))})
+	}
+	return &ImportedTranscript{Segments: cuesToSegments(cues)}, nil
+}
+
+// ParseTranscriptJSON parses the JSON interchange format, see TranscriptInterchange
+func ParseTranscriptJSON(data []byte) (*ImportedTranscript, error) {
+	var interchange TranscriptInterchange
+	if err := json.Unmarshal(bytes.TrimPrefix(data, []byte("\ufeff")), &interchange); err != nil {
+		return nil, invalidTranscript("invalid JSON: " + err.Error())
+	}
+	if version := interchange.FormatVersion; version != "" && version != "1" && version != "1.0" {
+		return nil, invalidTranscript(fmt.Sprintf("unsupported format_version %q", version))
+	}
+
+	segments := make([]entities.TranscriptSegment, 0, len(interchange.Segments))
+	for i, segment := range interchange.Segments {
+		text := strings.TrimSpace(segment.Text)
+		if text == "" {
+			continue
+		}
+		if segment.StartTime == nil || segment.EndTime == nil {
+			return nil, invalidTranscript(fmt.Sprintf("segment %d: start_time and end_time are required", i+1))
+		}
+		if *segment.StartTime < 0 || *segment.EndTime < *segment.StartTime {
+			return nil, invalidTranscript(fmt.Sprintf("segment %d: invalid time range %.3f-%.3f", i+1, *segment.StartTime, *segment.EndTime))
+		}
+		confidence := importedCueConfidence
+		if segment.Confidence != nil {
+			if *segment.Confidence < 0 || *segment.Confidence > 1 {
+				return nil, invalidTranscript(fmt.Sprintf("segment %d: confidence must be between 0 and 1", i+1))
+			}
+			confidence = *segment.Confidence
+		}
+
+		imported := entities.NewTranscriptSegment("", strings.TrimSpace(segment.Speaker), text, *segment.StartTime, *segment.EndTime, confidence, 0)
+		imported.Words = segment.Words
+		segments = append(segments, imported)
+	}
+
+	sort.SliceStable(segments, func(i, j int) bool { return segments[i].StartTime < segments[j].StartTime })
+	for i := range segments {
+		segments[i].SequenceNumber = i + 1
+	}
+	return &ImportedTranscript{
+		Title:     strings.TrimSpace(interchange.Title),
+		StartedAt: interchange.StartedAt,
+		Segments:  segments,
+	}, nil
+}
+
+// cueBlock is a run of non-empty lines and the line number it starts at
+type cueBlock struct {
+	line  int
+	lines []string
+}
+
+// cueBlocks splits a subtitle file into blocks separated by blank lines
+func cueBlocks(data []byte) []cueBlock {
+	text := strings.TrimPrefix(string(data), "\ufeff")
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	var blocks []cueBlock
+	var current *cueBlock
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \t")
+		if strings.TrimSpace(line) == "" {
+			current = nil
+			continue
+		}
+		if current == nil {
+			blocks = append(blocks, cueBlock{line: i + 1})
+			current = &blocks[len(blocks)-1]
+		}
+		current.lines = append(current.lines, line)
+	}
+	return blocks
+}
+
+// parseCueTiming parses "00:01:02,500 --> 00:01:04,000" followed by optional WebVTT cue settings
+func parseCueTiming(line string) (float64, float64, error) {
+	parts := strings.SplitN(line, "-->", 2)
+	start, err := parseCueTimestamp(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, err
+	}
+	fields := strings.Fields(parts[1])
+	if len(fields) == 0 {
+		return 0, 0, fmt.Errorf("missing end time")
+	}
+	end, err := parseCueTimestamp(fields[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("cue ends before it starts")
+	}
+	return start, end, nil
+}
+
+// parseCueTimestamp parses [hh:]mm:ss[,.]mmm into seconds
+func parseCueTimestamp(value string) (float64, error) {
+	parts := strings.Split(strings.Replace(value, ",", ".", 1), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid timestamp %q", value)
+	}
+
+	var seconds float64
+	for i, part := range parts {
+		number, err := strconv.ParseFloat(part, 64)
+		if err != nil || number < 0 || (i > 0 && number >= 60) {
+			return 0, fmt.Errorf("invalid timestamp %q", value)
+		}
+		seconds = seconds*60 + number
+	}
+	return seconds, nil
+}
+
+// splitSpeaker reads the speaker of a cue from a first line holding only "Speaker:" followed by the
+// text, or with prefixes from a "Speaker: text" prefix, and returns the remaining text on one line
+func splitSpeaker(lines []string, prefixes bool) (string, string) {
+	if len(lines) == 0 {
+		return "", ""
+	}
+	speaker := ""
+	match := speakerPrefixPattern.FindStringSubmatch(strings.TrimSpace(lines[0]))
+	if match != nil && utf8.RuneCountInString(match[1]) <= 40 {
+		switch {
+		case match[2] == "" && len(lines) > 1:
+			speaker = match[1]
+			lines = lines[1:]
+		case match[2] != "" && prefixes:
+			speaker = match[1]
+			lines = append([]string{match[2]}, lines[1:]...)
+		}
+	}
+	return speaker, strings.TrimSpace(strings.Join(lines, " "))
+}
+
+// cuesToSegments carries speakers over to unnamed cues and merges consecutive cues of a speaker
+// into segments, as subtitle cues split speech into caption-sized pieces
+func cuesToSegments(cues []subtitleCue) []entities.TranscriptSegment {
+	sort.SliceStable(cues, func(i, j int) bool { return cues[i].start < cues[j].start })
+
+	var segments []entities.TranscriptSegment
+	speaker := ""
+	for _, cue := range cues {
+		if cue.speaker != "" {
+			speaker = cue.speaker
+		}
+		if cue.text == "" {
+			continue
+		}
+
+		if n := len(segments); n > 0 {
+			last := &segments[n-1]
+			if last.Speaker == speaker && cue.start-last.EndTime <= maxMergedCueGap && cue.end-last.StartTime <= maxMergedSegmentDuration {
+				last.Text += " " + cue.text
+				if cue.end > last.EndTime {
+					last.EndTime = cue.end
+				}
+				continue
+			}
+		}
+		segments = append(segments, entities.NewTranscriptSegment("", speaker, cue.text, cue.start, cue.end, importedCueConfidence, len(segments)+1))
+	}
+	return segments
+}
+
+// invalidTranscript reports a transcript file that cannot be parsed
+func invalidTranscript(message string) error {
+	return domain.NewDomainError("INVALID_TRANSCRIPT", "Invalid transcript: "+message, domain.ErrInvalidInput)
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSRT(t *testing.T) {
+	data := "\ufeff1\r\n00:00:01,000 --> 00:00:03,500\r\nАнна:\r\nДобрый день,\r\nколлеги.\r\n\r\n" +
+		"2\r\n00:00:03,800 --> 00:00:05,000\r\nНачнём с <i>релиза</i>.\r\n\r\n" +
+		"3\r\n00:00:09,000 --> 00:00:11,000\r\nBob Smith: Sounds good &amp; ready.\r\n"
+
+	transcript, err := ParseTranscript(ImportFormatSRT, []byte(data), ImportOptions{SpeakerPrefixes: true})
+	require.NoError(t, err)
+	require.Len(t, transcript.Segments, 2)
+
+	first := transcript.Segments[0]
+	assert.Equal(t, "Анна", first.Speaker)
+	assert.Equal(t, "Добрый день, коллеги. Начнём с релиза.", first.Text)
+	assert.Equal(t, 1.0, first.StartTime)
+	assert.Equal(t, 5.0, first.EndTime)
+	assert.Equal(t, 1, first.SequenceNumber)
+
+	second := transcript.Segments[1]
+	assert.Equal(t, "Bob Smith", second.Speaker)
+	assert.Equal(t, "Sounds good & ready.", second.Text)
+	assert.Equal(t, 2, second.SequenceNumber)
+
+	assert.Equal(t, []string{"Анна", "Bob Smith"}, transcript.Speakers())
+	assert.Equal(t, 11.0, transcript.Duration())
+}
+
+func TestParseSRT_FalseSpeakers(t *testing.T) {
+	data := "1\n00:00:01,000 --> 00:00:03,000\nNote: bring the slides\n\n" +
+		"2\n00:00:10,000 --> 00:00:12,000\nАнна:\nStep 2: deploy the release\n\n" +
+		"3\n00:00:12,500 --> 00:00:14,000\nTODO: update the docs\n\n" +
+		"4\n00:00:20,000 --> 00:00:21,000\nTODO:\n"
+
+	// Prefixes are only read as speakers on request, a line holding only a name always is
+	transcript, err := ParseSRT([]byte(data), ImportOptions{})
+	require.NoError(t, err)
+	require.Len(t, transcript.Segments, 3)
+	assert.Equal(t, "", transcript.Segments[0].Speaker)
+	assert.Equal(t, "Note: bring the slides", transcript.Segments[0].Text)
+	assert.Equal(t, "Анна", transcript.Segments[1].Speaker)
+	assert.Equal(t, "Step 2: deploy the release TODO: update the docs", transcript.Segments[1].Text)
+	assert.Equal(t, "Анна", transcript.Segments[2].Speaker)
+	assert.Equal(t, "TODO:", transcript.Segments[2].Text)
+	assert.Equal(t, []string{"Анна"}, transcript.Speakers())
+}
+
+func TestParseSRT_Errors(t *testing.T) {
+	_, err := ParseSRT([]byte("1\nHello there\n"), ImportOptions{})
+	assert.ErrorContains(t, err, "line 2: expected a cue timing line")
+
+	_, err = ParseSRT([]byte("1\n00:00:05,000 --> 00:00:01,000\nHello\n"), ImportOptions{})
+	assert.ErrorContains(t, err, "cue ends before it starts")
+
+	_, err = ParseSRT([]byte("1\n00:00:61,000 --> 00:01:01,000\nHello\n"), ImportOptions{})
+	assert.ErrorContains(t, err, "invalid timestamp")
+
+	_, err = ParseTranscript(ImportFormatSRT, []byte("\n\n"), ImportOptions{})
+	assert.ErrorContains(t, err, "transcript has no segments")
+}
+
+func TestParseWebVTT(t *testing.T) {
+	data := `WEBVTT
+Kind: captions
+
+NOTE exported from another tool
+
+STYLE
+::cue { color: white }
+
+intro
+00:00.500 --> 00:02.000 align:start
+<v.loud 田中>こんにちは</v>
+
+00:00:02.100 --> 00:00:04.000
+<v 田中>今日の<00:00:03.000><c>議題</c></v>
+
+00:00:10.000 --> 00:00:12.000
+Anna: Thanks &lt;all&gt;
+`
+
+	transcript, err := ParseTranscript(ImportFormatVTT, []byte(data), ImportOptions{SpeakerPrefixes: true})
+	require.NoError(t, err)
+	require.Len(t, transcript.Segments, 2)
+	assert.Equal(t, "田中", transcript.Segments[0].Speaker)
+	assert.Equal(t, "こんにちは 今日の議題", transcript.Segments[0].Text)
+	assert.Equal(t, 0.5, transcript.Segments[0].StartTime)
+	assert.Equal(t, 4.0, transcript.Segments[0].EndTime)
+	assert.Equal(t, "Anna", transcript.Segments[1].Speaker)
+	assert.Equal(t, "Thanks <all>", transcript.Segments[1].Text)
+
+	// Without prefixes the last cue keeps the voice of the previous one
+	transcript, err = ParseWebVTT([]byte(data), ImportOptions{})
+	require.NoError(t, err)
+	require.Len(t, transcript.Segments, 2)
+	assert.Equal(t, "田中", transcript.Segments[1].Speaker)
+	assert.Equal(t, "Anna: Thanks <all>", transcript.Segments[1].Text)
+
+	_, err = ParseWebVTT([]byte("00:00.500 --> 00:02.000\nHello\n"), ImportOptions{})
+	assert.ErrorContains(t, err, "must start with WEBVTT")
+}
+
+func TestParseTranscriptJSON(t *testing.T) {
+	data := `{
+		"format_version": "1.0",
+		"title": "Weekly sync",
+		"started_at": "2024-03-01T10:00:00Z",
+		"segments": [
+			{"speaker": "Bob", "text": "Second", "start_time": 4, "end_time": 6},
+			{"speaker": "Anna", "text": "First", "start_time": 0, "end_time": 3.5, "confidence": 0.8,
+			 "words": [{"text": "First", "start": 0, "end": 3.5, "confidence": 0.8}]},
+			{"text": "  ", "start_time": 7, "end_time": 8}
+		]
+	}`
+
+	transcript, err := ParseTranscript(ImportFormatJSON, []byte(data), ImportOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Weekly sync", transcript.Title)
+	require.NotNil(t, transcript.StartedAt)
+	assert.Equal(t, 2024, transcript.StartedAt.Year())
+	require.Len(t, transcript.Segments, 2)
+	assert.Equal(t, "Anna", transcript.Segments[0].Speaker)
+	assert.Equal(t, 0.8, transcript.Segments[0].Confidence)
+	assert.Len(t, transcript.Segments[0].Words, 1)
+	assert.Equal(t, 1, transcript.Segments[0].SequenceNumber)
+	assert.Equal(t, 1.0, transcript.Segments[1].Confidence)
+
+	_, err = ParseTranscriptJSON([]byte(`{"segments": [{"text": "Hi", "start_time": 1}]}`))
+	assert.ErrorContains(t, err, "segment 1: start_time and end_time are required")
+	_, err = ParseTranscriptJSON([]byte(`{"format_version": "2.0", "segments": []}`))
+	assert.ErrorContains(t, err, "unsupported format_version")
+	_, err = ParseTranscriptJSON([]byte(`[1, 2]`))
+	assert.ErrorContains(t, err, "invalid JSON")
+}
+
+func TestDetectImportFormat(t *testing.T) {
+	assert.Equal(t, ImportFormatVTT, DetectImportFormat("meeting.VTT", nil))
+	assert.Equal(t, ImportFormatSRT, DetectImportFormat("meeting.srt", []byte("WEBVTT")))
+	assert.Equal(t, ImportFormatVTT, DetectImportFormat("upload", []byte("\ufeffWEBVTT\n\n")))
+	assert.Equal(t, ImportFormatJSON, DetectImportFormat("upload", []byte(` {"segments": []}`)))
+	assert.Equal(t, ImportFormatSRT, DetectImportFormat("upload.txt", []byte("1\n00:00:01,000 --> 00:00:02,000\nHi")))
+
+	_, err := ParseTranscript("docx", nil, ImportOptions{})
+	assert.ErrorContains(t, err, "Unsupported import format")
+}
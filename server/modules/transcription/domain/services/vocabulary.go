@@ -0,0 +1,124 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// ApplyVocabularies merges the vocabularies that apply to a meeting type into the processing options.
+// Terms and spelling rules already present in the options are kept; an explicit boost param wins,
+// otherwise the strongest boost of the merged vocabularies is used.
+func ApplyVocabularies(options AudioProcessingOptions, vocabularies []*entities.Vocabulary, meetingType string) AudioProcessingOptions {
+	terms := append([]string{}, options.WordBoost...)
+	seenTerms := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		seenTerms[strings.ToLower(term)] = true
+	}
+	spellings := append([]entities.SpellingRule{}, options.CustomSpelling...)
+	boost := options.BoostParam
+	strongest := entities.BoostLevel("")
+
+	for _, vocabulary := range vocabularies {
+		if !vocabulary.AppliesTo(meetingType) {
+			continue
+		}
+		for _, term := range vocabulary.Terms {
+			key := strings.ToLower(term)
+			if seenTerms[key] || len(terms) >= entities.MaxVocabularyTerms {
+				continue
+			}
+			seenTerms[key] = true
+			terms = append(terms, term)
+		}
+		spellings = append(spellings, vocabulary.Spellings...)
+		if len(vocabulary.Terms) > 0 && boostRank(vocabulary.BoostParam) > boostRank(strongest) {
+			strongest = vocabulary.BoostParam
+		}
+	}
+
+	if boost == "" && len(terms) > 0 {
+		boost = strongest
+	}
+
+	options.WordBoost = terms
+	options.BoostParam = boost
+	options.CustomSpelling = spellings
+	return options
+}
+
+// ApplySpellingRules rewrites segment text using the spelling rules. Matching is case-insensitive
+// and limited to whole words, so "gitscribe" becomes "GitScribe" but "gitscribes" is left alone.
+func ApplySpellingRules(segments []entities.TranscriptSegment, rules []entities.SpellingRule) []entities.TranscriptSegment {
+	if len(rules) == 0 {
+		return segments
+	}
+
+	patterns := compileSpellingRules(rules)
+	result := make([]entities.TranscriptSegment, len(segments))
+	for i, segment := range segments {
+		for _, pattern := range patterns {
+			segment.Text = pattern.expression.ReplaceAllLiteralString(segment.Text, pattern.replacement)
+		}
+		result[i] = segment
+	}
+	return result
+}
+
+type spellingPattern struct {
+	expression  *regexp.Regexp
+	replacement string
+}
+
+func compileSpellingRules(rules []entities.SpellingRule) []spellingPattern {
+	patterns := make([]spellingPattern, 0, len(rules))
+	for _, rule := range rules {
+		alternatives := make([]string, 0, len(rule.From))
+		for _, from := range rule.From {
+			from = strings.TrimSpace(from)
+			if from == "" {
+				continue
+			}
+			alternatives = append(alternatives, wordPattern(from))
+		}
+		if len(alternatives) == 0 {
+			continue
+		}
+		patterns = append(patterns, spellingPattern{
+			expression:  regexp.MustCompile("(?i)" + strings.Join(alternatives, "|")),
+			replacement: rule.To,
+		})
+	}
+	return patterns
+}
+
+// wordPattern quotes a spelling and anchors it on word boundaries where it starts or ends with a word character
+func wordPattern(word string) string {
+	pattern := regexp.QuoteMeta(word)
+	runes := []rune(word)
+	if isWordRune(runes[0]) {
+		pattern = `\b` + pattern
+	}
+	if isWordRune(runes[len(runes)-1]) {
+		pattern = pattern + `\b`
+	}
+	return pattern
+}
+
+func isWordRune(r rune) bool {
+	return r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_')
+}
+
+func boostRank(level entities.BoostLevel) int {
+	switch level {
+	case entities.BoostLow:
+		return 1
+	case entities.BoostDefault:
+		return 2
+	case entities.BoostHigh:
+		return 3
+	}
+	return 0
+}
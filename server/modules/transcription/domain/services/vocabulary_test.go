@@ -0,0 +1,91 @@
+package services
+
+import (
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVocabulary(t *testing.T, meetingType string, terms []string, boost entities.BoostLevel, spellings []entities.SpellingRule) *entities.Vocabulary {
+	vocabulary, err := entities.NewVocabulary("user-1", "Test", meetingType, terms, boost, spellings)
+	require.NoError(t, err)
+	return &vocabulary
+}
+
+func TestNewVocabulary_Validation(t *testing.T) {
+	vocabulary, err := entities.NewVocabulary("user-1", "  Product  ", "", []string{" GitScribe ", "gitscribe", "", "Kubernetes"}, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Product", vocabulary.Name)
+	assert.Equal(t, []string{"GitScribe", "Kubernetes"}, vocabulary.Terms)
+	assert.Equal(t, entities.BoostDefault, vocabulary.BoostParam)
+
+	_, err = entities.NewVocabulary("user-1", "", "", nil, "", nil)
+	assert.Error(t, err)
+
+	_, err = entities.NewVocabulary("user-1", "Names", "", nil, "extreme", nil)
+	assert.Error(t, err)
+
+	_, err = entities.NewVocabulary("user-1", "Names", "", []string{"one two three four five six seven"}, "", nil)
+	assert.Error(t, err)
+
+	_, err = entities.NewVocabulary("user-1", "Names", "", nil, "", []entities.SpellingRule{{From: []string{" "}, To: "Sarah"}})
+	assert.Error(t, err)
+}
+
+func TestApplyVocabularies(t *testing.T) {
+	general := newTestVocabulary(t, "", []string{"GitScribe", "LeMUR"}, entities.BoostLow,
+		[]entities.SpellingRule{{From: []string{"git scribe"}, To: "GitScribe"}})
+	zoom := newTestVocabulary(t, "zoom", []string{"gitscribe", "Zoom Rooms"}, entities.BoostHigh, nil)
+	teams := newTestVocabulary(t, "microsoft_teams", []string{"Copilot"}, entities.BoostHigh, nil)
+
+	options := ApplyVocabularies(AudioProcessingOptions{
+		Provider:  "assemblyai",
+		WordBoost: []string{"Sprint"},
+	}, []*entities.Vocabulary{general, zoom, teams}, "zoom")
+
+	assert.Equal(t, "assemblyai", options.Provider)
+	assert.Equal(t, []string{"Sprint", "GitScribe", "LeMUR", "Zoom Rooms"}, options.WordBoost)
+	assert.Equal(t, entities.BoostHigh, options.BoostParam)
+	require.Len(t, options.CustomSpelling, 1)
+	assert.Equal(t, "GitScribe", options.CustomSpelling[0].To)
+}
+
+func TestApplyVocabularies_ExplicitBoostWins(t *testing.T) {
+	vocabulary := newTestVocabulary(t, "", []string{"GitScribe"}, entities.BoostHigh, nil)
+
+	options := ApplyVocabularies(AudioProcessingOptions{BoostParam: entities.BoostLow}, []*entities.Vocabulary{vocabulary}, "zoom")
+
+	assert.Equal(t, entities.BoostLow, options.BoostParam)
+
+	options = ApplyVocabularies(AudioProcessingOptions{}, nil, "zoom")
+	assert.Empty(t, options.WordBoost)
+	assert.Equal(t, entities.BoostLevel(""), options.BoostParam)
+}
+
+func TestApplySpellingRules(t *testing.T) {
+	segments := []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "Speaker A", "We shipped git scribe and Git Scribe today.", 0, 2, 0.9, 1),
+		entities.NewTranscriptSegment("tr-1", "Speaker B", "Ask Sara or sarah, not Sarahs.", 2, 4, 0.9, 2),
+		entities.NewTranscriptSegment("tr-1", "Speaker A", "Deploy to k8s with C++ tooling.", 4, 6, 0.9, 3),
+	}
+	rules := []entities.SpellingRule{
+		{From: []string{"git scribe"}, To: "GitScribe"},
+		{From: []string{"Sara", "sarah"}, To: "Sarah"},
+		{From: []string{"k8s"}, To: "Kubernetes"},
+		{From: []string{"c++"}, To: "C++"},
+	}
+
+	result := ApplySpellingRules(segments, rules)
+
+	require.Len(t, result, 3)
+	assert.Equal(t, "We shipped GitScribe and GitScribe today.", result[0].Text)
+	assert.Equal(t, "Ask Sarah or Sarah, not Sarahs.", result[1].Text)
+	assert.Equal(t, "Deploy to Kubernetes with C++ tooling.", result[2].Text)
+	assert.Equal(t, segments[0].GetID(), result[0].GetID())
+
+	// The input segments are left untouched
+	assert.Equal(t, "We shipped git scribe and Git Scribe today.", segments[0].Text)
+}
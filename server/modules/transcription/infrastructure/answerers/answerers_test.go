@@ -0,0 +1,129 @@
+package answerers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
+)
+
+func newTestSources() []entities.TranscriptSegment {
+	return []entities.TranscriptSegment{
+		entities.NewTranscriptSegment("tr-1", "Anna", "Marketing needs the assets by Monday.", 61, 64, 0.9, 1),
+		entities.NewTranscriptSegment("tr-1", "Ben", "We decided the launch date is March third.", 65, 69, 0.9, 2),
+	}
+}
+
+// stubLanguageModel returns a fixed response and records the prompt
+type stubLanguageModel struct {
+	response string
+	err      error
+	prompt   string
+}
+
+func (m *stubLanguageModel) Name() string {
+	return "test-model"
+}
+
+func (m *stubLanguageModel) Complete(ctx context.Context, system, prompt string) (string, error) {
+	m.prompt = prompt
+	return m.response, m.err
+}
+
+func TestLLMAnswerer_Answer(t *testing.T) {
+	model := &stubLanguageModel{response: " The launch is on March third [2], after the assets arrive [1][2][7]. "}
+	answer, err := NewLLMAnswerer(model).Answer(context.Background(), services.QuestionRequest{
+		Question: "When is the launch?",
+		Sources:  newTestSources(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "The launch is on March third [2], after the assets arrive [1][2][7].", answer.Answer)
+	assert.Equal(t, []int{1, 0}, answer.Sources)
+	assert.Equal(t, entities.LLMAnswerMethod, answer.Method)
+	assert.Equal(t, "test-model", answer.Model)
+	assert.Contains(t, model.prompt, "[2] (1:05) Ben: We decided the launch date is March third.")
+	assert.True(t, strings.HasSuffix(model.prompt, "Question: When is the launch?"))
+}
+
+func TestLLMAnswerer_AnswerError(t *testing.T) {
+	_, err := NewLLMAnswerer(&stubLanguageModel{err: errors.New("rate limited")}).Answer(context.Background(), services.QuestionRequest{
+		Question: "When is the launch?",
+		Sources:  newTestSources(),
+	})
+	assert.Error(t, err)
+
+	_, err = NewLLMAnswerer(&stubLanguageModel{response: "  "}).Answer(context.Background(), services.QuestionRequest{
+		Question: "When is the launch?",
+		Sources:  newTestSources(),
+	})
+	assert.Error(t, err)
+}
+
+func TestExtractiveAnswerer_Answer(t *testing.T) {
+	answer, err := NewExtractiveAnswerer().Answer(context.Background(), services.QuestionRequest{
+		Question: "What did we decide about the launch date?",
+		Sources:  newTestSources(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `Ben said: "We decided the launch date is March third."`, answer.Answer)
+	assert.Equal(t, []int{1}, answer.Sources)
+	assert.Equal(t, entities.ExtractiveAnswerMethod, answer.Method)
+
+	_, err = NewExtractiveAnswerer().Answer(context.Background(), services.QuestionRequest{Question: "Anything?"})
+	assert.Error(t, err)
+}
+
+// stubLemurClient answers every question with a fixed answer and records the request
+type stubLemurClient struct {
+	request *assemblyai.LemurQuestionAnswerRequest
+}
+
+func (c *stubLemurClient) LemurQuestionAnswer(ctx context.Context, request *assemblyai.LemurQuestionAnswerRequest) (*assemblyai.LemurQuestionAnswerResponse, error) {
+	c.request = request
+	return &assemblyai.LemurQuestionAnswerResponse{Response: []assemblyai.LemurAnswer{
+		{Question: request.Questions[0].Question, Answer: " March third. "},
+	}}, nil
+}
+
+func TestLemurAnswerer(t *testing.T) {
+	client := &stubLemurClient{}
+	answerer := NewLemurAnswerer(client, "")
+
+	first := entities.NewTranscription("meeting-1", "", "assemblyai")
+	first.ProviderTranscriptID = "aai-1"
+	second := entities.NewTranscription("meeting-1", "", "assemblyai")
+	second.ProviderTranscriptID = "aai-2"
+	other := entities.NewTranscription("meeting-1", "", "deepgram")
+
+	assert.True(t, answerer.Supports([]*entities.Transcription{&first, &second}))
+	assert.False(t, answerer.Supports([]*entities.Transcription{&first, &other}))
+	assert.False(t, answerer.Supports(nil))
+
+	answer, err := answerer.Answer(context.Background(), services.QuestionRequest{
+		Question:       "When is the launch?",
+		Transcriptions: []*entities.Transcription{&first, &second},
+		Sources:        newTestSources(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "March third.", answer.Answer)
+	assert.Empty(t, answer.Sources)
+	assert.Equal(t, entities.LemurAnswerMethod, answer.Method)
+
+	assert.Equal(t, []string{"aai-1", "aai-2"}, client.request.TranscriptIDs)
+	assert.Nil(t, client.request.FinalModel)
+	assert.Contains(t, *client.request.Questions[0].Context, "Ben: We decided the launch date is March third.")
+}
+
+func TestFormatOffset(t *testing.T) {
+	assert.Equal(t, "0:00", formatOffset(-1))
+	assert.Equal(t, "1:05", formatOffset(65.8))
+	assert.Equal(t, "1:01:01", formatOffset(3661))
+}
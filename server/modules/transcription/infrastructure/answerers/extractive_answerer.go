@@ -0,0 +1,66 @@
+package answerers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/modules/transcription/infrastructure/embedders"
+)
+
+// Ensure ExtractiveAnswerer implements QuestionAnswerer
+var _ services.QuestionAnswerer = (*ExtractiveAnswerer)(nil)
+
+// ExtractiveAnswerer answers without a language model by quoting the retrieved segment that shares
+// the most words with the question. It is the fallback when no model is configured or available.
+type ExtractiveAnswerer struct{}
+
+// NewExtractiveAnswerer creates an extractive answerer
+func NewExtractiveAnswerer() *ExtractiveAnswerer {
+	return &ExtractiveAnswerer{}
+}
+
+// Supports returns true for every meeting
+func (a *ExtractiveAnswerer) Supports(transcriptions []*entities.Transcription) bool {
+	return true
+}
+
+// Answer quotes the best matching source. Ties go to the source from the more relevant passage.
+func (a *ExtractiveAnswerer) Answer(ctx context.Context, request services.QuestionRequest) (*services.QuestionAnswer, error) {
+	if len(request.Sources) == 0 {
+		return nil, fmt.Errorf("no sources to quote")
+	}
+
+	questionTerms := make(map[string]bool)
+	for _, token := range embedders.Tokenize(request.Question) {
+		questionTerms[token] = true
+	}
+
+	best, bestOverlap := 0, -1
+	for i, source := range request.Sources {
+		overlap := 0
+		seen := make(map[string]bool)
+		for _, token := range embedders.Tokenize(source.Text) {
+			if questionTerms[token] && !seen[token] {
+				seen[token] = true
+				overlap++
+			}
+		}
+		if overlap > bestOverlap {
+			best, bestOverlap = i, overlap
+		}
+	}
+
+	source := request.Sources[best]
+	answer := fmt.Sprintf("%q", strings.TrimSpace(source.Text))
+	if source.Speaker != "" && source.Speaker != "speaker_unknown" {
+		answer = source.Speaker + " said: " + answer
+	}
+	return &services.QuestionAnswer{
+		Answer:  answer,
+		Sources: []int{best},
+		Method:  entities.ExtractiveAnswerMethod,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package answerers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
+)
+
+// Ensure LemurAnswerer implements QuestionAnswerer
+var _ services.QuestionAnswerer = (*LemurAnswerer)(nil)
+
+const (
+	lemurAnswerContext = "This is a transcript of a work meeting. Answer only from what was said in the meeting."
+	lemurAnswerFormat  = "A short answer of one to three sentences"
+)
+
+// LemurClient is the part of the AssemblyAI client used to answer questions
+type LemurClient interface {
+	LemurQuestionAnswer(ctx context.Context, request *assemblyai.LemurQuestionAnswerRequest) (*assemblyai.LemurQuestionAnswerResponse, error)
+}
+
+// LemurAnswerer answers questions about meetings transcribed by AssemblyAI with LeMUR question-answer.
+// LeMUR reads the full transcripts, so the retrieved sources are passed along as context and cited
+// as the evidence for the answer.
+type LemurAnswerer struct {
+	client LemurClient
+	model  string
+}
+
+// NewLemurAnswerer creates a LeMUR answerer. An empty model uses AssemblyAI's default.
+func NewLemurAnswerer(client LemurClient, model string) *LemurAnswerer {
+	return &LemurAnswerer{client: client, model: model}
+}
+
+// Supports returns true if every transcription was made by AssemblyAI
+func (a *LemurAnswerer) Supports(transcriptions []*entities.Transcription) bool {
+	if len(transcriptions) == 0 {
+		return false
+	}
+	for _, transcription := range transcriptions {
+		if transcription.Provider != "assemblyai" || transcription.ProviderTranscriptID == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Answer asks LeMUR the question about the meeting's transcripts
+func (a *LemurAnswerer) Answer(ctx context.Context, request services.QuestionRequest) (*services.QuestionAnswer, error) {
+	transcriptIDs := make([]string, len(request.Transcriptions))
+	for i, transcription := range request.Transcriptions {
+		transcriptIDs[i] = transcription.ProviderTranscriptID
+	}
+
+	lemurRequest := &assemblyai.LemurQuestionAnswerRequest{
+		TranscriptIDs: transcriptIDs,
+		Context:       assemblyai.String(lemurAnswerContext),
+		Questions: []assemblyai.LemurQuestion{{
+			Question:     request.Question,
+			Context:      assemblyai.String(sourcesContext(request.Sources)),
+			AnswerFormat: assemblyai.String(lemurAnswerFormat),
+		}},
+	}
+	if a.model != "" {
+		lemurRequest.FinalModel = assemblyai.String(a.model)
+	}
+
+	response, err := a.client.LemurQuestionAnswer(ctx, lemurRequest)
+	if err != nil {
+		return nil, fmt.Errorf("LeMUR question failed: %w", err)
+	}
+	if len(response.Response) == 0 || strings.TrimSpace(response.Response[0].Answer) == "" {
+		return nil, fmt.Errorf("LeMUR returned no answer")
+	}
+
+	return &services.QuestionAnswer{
+		Answer: strings.TrimSpace(response.Response[0].Answer),
+		Method: entities.LemurAnswerMethod,
+		Model:  a.model,
+	}, nil
+}
+
+// sourcesContext points LeMUR at the parts of the meeting retrieved for the question
+func sourcesContext(sources []entities.TranscriptSegment) string {
+	if len(sources) == 0 {
+		return ""
+	}
+	return "These parts of the meeting look most relevant:\n" + formatSources(sources)
+}
@@ -0,0 +1,100 @@
+package answerers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+)
+
+// Ensure LLMAnswerer implements QuestionAnswerer
+var _ services.QuestionAnswerer = (*LLMAnswerer)(nil)
+
+const llmAnswerInstructions = `You answer questions about a work meeting using only the numbered transcript excerpts provided.
+Answer in one to three sentences. Cite the excerpts you used by their number in square brackets, e.g. [2].
+If the excerpts do not answer the question, say that the meeting did not cover it.`
+
+// sourceReference matches the excerpt numbers cited in an answer
+var sourceReference = regexp.MustCompile(`\[(\d+)\]`)
+
+// LLMAnswerer answers questions about any meeting with a language model, given only the retrieved
+// sources. The excerpts the model cites become the answer's sources.
+type LLMAnswerer struct {
+	model services.LanguageModel
+}
+
+// NewLLMAnswerer creates an answerer backed by a language model
+func NewLLMAnswerer(model services.LanguageModel) *LLMAnswerer {
+	return &LLMAnswerer{model: model}
+}
+
+// Supports returns true for every meeting
+func (a *LLMAnswerer) Supports(transcriptions []*entities.Transcription) bool {
+	return true
+}
+
+// Answer prompts the language model with the question and the numbered sources
+func (a *LLMAnswerer) Answer(ctx context.Context, request services.QuestionRequest) (*services.QuestionAnswer, error) {
+	prompt := fmt.Sprintf("Transcript excerpts:\n%s\nQuestion: %s", formatSources(request.Sources), request.Question)
+
+	response, err := a.model.Complete(ctx, llmAnswerInstructions, prompt)
+	if err != nil {
+		return nil, fmt.Errorf("language model failed: %w", err)
+	}
+	response = strings.TrimSpace(response)
+	if response == "" {
+		return nil, fmt.Errorf("language model returned no answer")
+	}
+
+	return &services.QuestionAnswer{
+		Answer:  response,
+		Sources: citedSources(response, len(request.Sources)),
+		Method:  entities.LLMAnswerMethod,
+		Model:   a.model.Name(),
+	}, nil
+}
+
+// citedSources returns the indexes of the sources referenced as [n] in an answer, in citation order
+func citedSources(answer string, sourceCount int) []int {
+	var cited []int
+	seen := make(map[int]bool)
+	for _, match := range sourceReference.FindAllStringSubmatch(answer, -1) {
+		number, err := strconv.Atoi(match[1])
+		if err != nil || number < 1 || number > sourceCount || seen[number-1] {
+			continue
+		}
+		seen[number-1] = true
+		cited = append(cited, number-1)
+	}
+	return cited
+}
+
+// formatSources numbers the sources from 1 with their time in the recording and speaker
+func formatSources(sources []entities.TranscriptSegment) string {
+	var builder strings.Builder
+	for i, source := range sources {
+		fmt.Fprintf(&builder, "[%d] (%s) ", i+1, formatOffset(source.StartTime))
+		if source.Speaker != "" && source.Speaker != "speaker_unknown" {
+			builder.WriteString(source.Speaker + ": ")
+		}
+		builder.WriteString(strings.TrimSpace(source.Text))
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// formatOffset formats seconds into the recording as m:ss or h:mm:ss
+func formatOffset(seconds float64) string {
+	total := int(seconds)
+	if total < 0 {
+		total = 0
+	}
+	if total >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", total/3600, total%3600/60, total%60)
+	}
+	return fmt.Sprintf("%d:%02d", total/60, total%60)
+}
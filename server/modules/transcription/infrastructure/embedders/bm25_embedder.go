@@ -0,0 +1,159 @@
+package embedders
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+)
+
+// Ensure BM25Embedder implements LexicalEmbedder
+var _ services.LexicalEmbedder = (*BM25Embedder)(nil)
+
+// BM25Embedder is a local lexical embedder. Documents are stored as term frequencies and
+// ranked with Okapi BM25 at query time, so no external service is needed.
+type BM25Embedder struct {
+	k1 float64
+	b  float64
+}
+
+// NewBM25Embedder creates a BM25 embedder with the usual k1 = 1.2 and b = 0.75
+func NewBM25Embedder() *BM25Embedder {
+	return &BM25Embedder{k1: 1.2, b: 0.75}
+}
+
+// Name identifies the embedder
+func (e *BM25Embedder) Name() string {
+	return "bm25-local"
+}
+
+// EmbedDocuments produces term frequency embeddings
+func (e *BM25Embedder) EmbedDocuments(ctx context.Context, texts []string) ([]entities.Embedding, error) {
+	embeddings := make([]entities.Embedding, len(texts))
+	for i, text := range texts {
+		embeddings[i] = termFrequencies(text)
+	}
+	return embeddings, nil
+}
+
+// EmbedQuery produces the term frequency embedding of a query
+func (e *BM25Embedder) EmbedQuery(ctx context.Context, text string) (entities.Embedding, error) {
+	return termFrequencies(text), nil
+}
+
+// Score ranks documents with BM25, using the documents themselves as the corpus
+func (e *BM25Embedder) Score(query entities.Embedding, documents []entities.Embedding) []float64 {
+	corpus := entities.CorpusStats{Documents: len(documents), DocumentFrequency: make(map[string]int, len(query.Terms))}
+	totalLength := 0
+	for _, document := range documents {
+		totalLength += document.Length
+		for term := range query.Terms {
+			if document.Terms[term] > 0 {
+				corpus.DocumentFrequency[term]++
+			}
+		}
+	}
+	if len(documents) > 0 {
+		corpus.AverageLength = float64(totalLength) / float64(len(documents))
+	}
+	return e.ScoreInCorpus(query, documents, corpus)
+}
+
+// ScoreInCorpus ranks documents with BM25, taking document frequencies and the average length from corpus
+func (e *BM25Embedder) ScoreInCorpus(query entities.Embedding, documents []entities.Embedding, corpus entities.CorpusStats) []float64 {
+	scores := make([]float64, len(documents))
+	if len(documents) == 0 || len(query.Terms) == 0 {
+		return scores
+	}
+
+	// The documents belong to the corpus, even if it was counted before some were indexed
+	n := float64(corpus.Documents)
+	if n < float64(len(documents)) {
+		n = float64(len(documents))
+	}
+	averageLength := corpus.AverageLength
+	if averageLength == 0 {
+		averageLength = 1
+	}
+
+	for i, document := range documents {
+		var score float64
+		lengthNorm := e.k1 * (1 - e.b + e.b*float64(document.Length)/averageLength)
+		for term := range query.Terms {
+			tf := document.Terms[term]
+			if tf == 0 {
+				continue
+			}
+			df := math.Min(float64(corpus.DocumentFrequency[term]), n)
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (e.k1 + 1) / (tf + lengthNorm)
+		}
+		scores[i] = score
+	}
+
+	return scores
+}
+
+func termFrequencies(text string) entities.Embedding {
+	tokens := Tokenize(text)
+	terms := make(map[string]float64, len(tokens))
+	for _, token := range tokens {
+		terms[token]++
+	}
+	return entities.Embedding{Terms: terms, Length: len(tokens)}
+}
+
+// Tokenize lowercases text, splits it into words, drops stop words and applies light stemming
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '\''
+	})
+
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		field = strings.Trim(field, "'")
+		field = strings.TrimSuffix(field, "'s")
+		if len(field) < 2 || stopWords[field] {
+			continue
+		}
+		tokens = append(tokens, stem(field))
+	}
+	return tokens
+}
+
+// stem strips common English inflections so that, for example, "meetings" and "meeting"
+// share a term. It is deliberately conservative.
+func stem(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return word[:len(word)-3] + "y"
+	case len(word) > 4 && strings.HasSuffix(word, "sses"):
+		return word[:len(word)-2]
+	case len(word) > 5 && strings.HasSuffix(word, "ing"):
+		return word[:len(word)-3]
+	case len(word) > 4 && strings.HasSuffix(word, "ed") && !strings.HasSuffix(word, "eed"):
+		return word[:len(word)-2]
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us"):
+		return word[:len(word)-1]
+	}
+	return word
+}
+
+var stopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "am": true, "an": true,
+	"and": true, "any": true, "are": true, "as": true, "at": true, "be": true, "been": true,
+	"but": true, "by": true, "can": true, "could": true, "did": true, "do": true, "does": true,
+	"for": true, "from": true, "had": true, "has": true, "have": true, "he": true, "her": true,
+	"him": true, "his": true, "how": true, "i": true, "if": true, "in": true, "into": true,
+	"is": true, "it": true, "its": true, "i'm": true, "it's": true, "just": true, "like": true,
+	"me": true, "my": true, "no": true, "not": true, "of": true, "oh": true, "ok": true,
+	"okay": true, "on": true, "or": true, "our": true, "so": true, "she": true, "that": true,
+	"the": true, "their": true, "them": true, "then": true, "there": true, "these": true,
+	"they": true, "this": true, "to": true, "uh": true, "um": true, "up": true, "us": true,
+	"was": true, "we": true, "were": true, "what": true, "when": true, "which": true,
+	"who": true, "will": true, "with": true, "would": true, "yeah": true, "yes": true,
+	"you": true, "your": true,
+}
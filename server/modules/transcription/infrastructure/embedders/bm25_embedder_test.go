@@ -0,0 +1,49 @@
+package embedders
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize("We discussed the budgets for Q3, and Alice's meetings are tomorrow!")
+
+	assert.Equal(t, []string{"discuss", "budget", "q3", "alice", "meeting", "tomorrow"}, tokens)
+}
+
+func TestBM25Embedder_Score(t *testing.T) {
+	embedder := NewBM25Embedder()
+	ctx := context.Background()
+
+	documents, err := embedder.EmbedDocuments(ctx, []string{
+		"Alice: Let's review the marketing plan for next quarter.",
+		"Bob: The budget for the hiring plan is approved. Budget review is on Friday.",
+		"Carol: I will send the notes after lunch.",
+	})
+	require.NoError(t, err)
+	require.Len(t, documents, 3)
+
+	query, err := embedder.EmbedQuery(ctx, "When is the budget review?")
+	require.NoError(t, err)
+
+	scores := embedder.Score(query, documents)
+	require.Len(t, scores, 3)
+	assert.Greater(t, scores[1], scores[0])
+	assert.Greater(t, scores[0], 0.0)
+	assert.Equal(t, 0.0, scores[2])
+}
+
+func TestBM25Embedder_Score_EmptyQuery(t *testing.T) {
+	embedder := NewBM25Embedder()
+
+	documents, err := embedder.EmbedDocuments(context.Background(), []string{"budget review"})
+	require.NoError(t, err)
+
+	query, err := embedder.EmbedQuery(context.Background(), "the and of")
+	require.NoError(t, err)
+
+	assert.Equal(t, []float64{0}, embedder.Score(query, documents))
+}
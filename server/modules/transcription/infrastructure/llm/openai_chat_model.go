@@ -0,0 +1,121 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"teammate/server/modules/transcription/domain/services"
+)
+
+// Ensure OpenAIChatModel implements LanguageModel
+var _ services.LanguageModel = (*OpenAIChatModel)(nil)
+
+const (
+	// DefaultOpenAIBaseURL is the OpenAI API; compatible servers (Azure, vLLM, Ollama) use their own URL
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+	chatRequestTimeout = 60 * time.Second
+	// maxChatResponseSize bounds the response body read from the API
+	maxChatResponseSize = 1 << 20
+)
+
+// OpenAIChatModel completes prompts with an OpenAI-compatible chat completions API
+type OpenAIChatModel struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+// NewOpenAIChatModel creates a chat model. An empty base URL uses the OpenAI API.
+func NewOpenAIChatModel(baseURL, apiKey, model string) *OpenAIChatModel {
+	if baseURL == "" {
+		baseURL = DefaultOpenAIBaseURL
+	}
+	return &OpenAIChatModel{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		model:      model,
+		httpClient: &http.Client{Timeout: chatRequestTimeout},
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+}
+
+type chatCompletionResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// Name returns the model name
+func (m *OpenAIChatModel) Name() string {
+	return m.model
+}
+
+// Complete sends the instructions as the system message and the prompt as the user message
+func (m *OpenAIChatModel) Complete(ctx context.Context, system, prompt string) (string, error) {
+	body, err := json.Marshal(chatCompletionRequest{
+		Model: m.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: prompt},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if m.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	}
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("chat completion request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxChatResponseSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read chat completion: %w", err)
+	}
+
+	var completion chatCompletionResponse
+	if err := json.Unmarshal(data, &completion); err != nil {
+		return "", fmt.Errorf("invalid chat completion response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if completion.Error != nil && completion.Error.Message != "" {
+			return "", fmt.Errorf("chat completion failed (status %d): %s", resp.StatusCode, completion.Error.Message)
+		}
+		return "", fmt.Errorf("chat completion failed with status %d", resp.StatusCode)
+	}
+	if len(completion.Choices) == 0 {
+		return "", fmt.Errorf("chat completion returned no choices")
+	}
+	return completion.Choices[0].Message.Content, nil
+}
@@ -0,0 +1,44 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIChatModel_Complete(t *testing.T) {
+	var received chatCompletionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"March third [1]."}}]}`))
+	}))
+	defer server.Close()
+
+	model := NewOpenAIChatModel(server.URL+"/v1/", "secret", "gpt-4o-mini")
+	response, err := model.Complete(context.Background(), "Be brief.", "When is the launch?")
+	require.NoError(t, err)
+
+	assert.Equal(t, "March third [1].", response)
+	assert.Equal(t, "gpt-4o-mini", model.Name())
+	assert.Equal(t, "gpt-4o-mini", received.Model)
+	assert.Equal(t, []chatMessage{{Role: "system", Content: "Be brief."}, {Role: "user", Content: "When is the launch?"}}, received.Messages)
+}
+
+func TestOpenAIChatModel_CompleteError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":{"message":"Rate limit reached"}}`))
+	}))
+	defer server.Close()
+
+	_, err := NewOpenAIChatModel(server.URL, "", "gpt-4o-mini").Complete(context.Background(), "", "When?")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Rate limit reached")
+}
@@ -95,8 +95,11 @@ func (p *MockAssemblyAIProvider) EndSession(ctx context.Context, sessionID strin
 	// Concatenate all audio chunks
 	audioData := p.concatenateAudioChunks(session.AudioChunks)
 
-	// Upload to Firebase Storage (if uploader is available)
-	if p.firebaseUploader != nil {
+	// Upload to Firebase Storage (if uploader is available). The mock cannot redact audio,
+	// so audio is not stored at all when audio redaction is requested.
+	if session.Options.RedactPIIAudio {
+		log.Printf("Mock: Skipping audio upload, audio redaction is not supported")
+	} else if p.firebaseUploader != nil {
 		firebaseURL, err := p.firebaseUploader.UploadAudio(ctx, audioData, session.Metadata.MeetingID, sessionID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to upload audio to Firebase: %w", err)
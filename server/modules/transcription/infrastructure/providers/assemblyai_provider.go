@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -58,6 +59,16 @@ func (p *AssemblyAIProvider) GetSupportedModes() []services.ProcessingMode {
 	}
 }
 
+// SupportsPIIRedaction reports that AssemblyAI redacts PII from transcripts and audio itself
+func (p *AssemblyAIProvider) SupportsPIIRedaction() bool {
+	return true
+}
+
+// SupportsCustomVocabulary reports that AssemblyAI applies word boost and custom spelling itself
+func (p *AssemblyAIProvider) SupportsCustomVocabulary() bool {
+	return true
+}
+
 // StartSession initializes a new AssemblyAI processing session
 func (p *AssemblyAIProvider) StartSession(ctx context.Context, metadata services.AudioStreamMetadata, options services.AudioProcessingOptions) (string, error) {
 	sessionID := metadata.SessionID
@@ -109,13 +120,16 @@ func (p *AssemblyAIProvider) EndSession(ctx context.Context, sessionID string) (
 	// Concatenate all audio chunks
 	audioData := p.concatenateAudioChunks(session.AudioChunks)
 
-	// Upload to Firebase Storage
-	firebaseURL, err := p.firebaseUploader.UploadAudio(ctx, audioData, session.Metadata.MeetingID, sessionID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to upload audio to Firebase: %w", err)
+	// Upload to Firebase Storage. When audio redaction is requested only the redacted
+	// audio is stored, once AssemblyAI has produced it.
+	if !session.Options.RedactPIIAudio {
+		firebaseURL, err := p.firebaseUploader.UploadAudio(ctx, audioData, session.Metadata.MeetingID, sessionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload audio to Firebase: %w", err)
+		}
+		session.FirebaseURL = firebaseURL
+		log.Printf("AssemblyAI: Audio uploaded to Firebase for session %s: %s", sessionID, firebaseURL)
 	}
-	session.FirebaseURL = firebaseURL
-	log.Printf("AssemblyAI: Audio uploaded to Firebase for session %s: %s", sessionID, firebaseURL)
 
 	// Upload to AssemblyAI
 	uploadResp, err := p.client.UploadFile(ctx, io.NopCloser(bytes.NewReader(audioData)))
@@ -141,6 +155,16 @@ func (p *AssemblyAIProvider) EndSession(ctx context.Context, sessionID string) (
 		return nil, fmt.Errorf("failed to get transcript result: %w", err)
 	}
 
+	// Store the redacted audio in place of the original
+	audioRedacted := false
+	if session.Options.RedactPIIAudio {
+		if err := p.storeRedactedAudio(ctx, session); err != nil {
+			log.Printf("AssemblyAI: Failed to store redacted audio for session %s, audio will not be kept: %v", sessionID, err)
+		} else {
+			audioRedacted = true
+		}
+	}
+
 	// Process results
 	segments := p.convertToTranscriptSegments(transcript, sessionID)
 	session.Status = entities.Completed
@@ -152,6 +176,7 @@ func (p *AssemblyAIProvider) EndSession(ctx context.Context, sessionID string) (
 		ProcessingMode:  session.Options.Mode,
 		Message:         fmt.Sprintf("Transcription completed with %d segments", len(segments)),
 		FirebaseURL:     session.FirebaseURL,
+		AudioRedacted:   audioRedacted,
 	}
 
 	// Clean up session after delay
@@ -240,9 +265,88 @@ func (p *AssemblyAIProvider) buildTranscriptRequest(audioURL string, options ser
 		request.SpeechThreshold = &threshold
 	}
 
+	if options.RedactPII {
+		policies := make([]assemblyai.PIIPolicy, len(options.PIIPolicies))
+		for i, policy := range options.PIIPolicies {
+			policies[i] = assemblyai.PIIPolicy(policy)
+		}
+		request.WithRedactPII(true, policies...)
+		// Entity name placeholders let us record which kind of PII was redacted
+		request.RedactPIISub = assemblyai.String("entity_name")
+		request.RedactPIIAudio = assemblyai.Bool(options.RedactPIIAudio)
+	}
+
+	if len(options.WordBoost) > 0 {
+		request.WithWordBoost(options.WordBoost, string(options.BoostParam))
+	}
+
+	if len(options.CustomSpelling) > 0 {
+		spellings := make([]assemblyai.CustomSpelling, len(options.CustomSpelling))
+		for i, rule := range options.CustomSpelling {
+			spellings[i] = assemblyai.CustomSpelling{From: rule.From, To: rule.To}
+		}
+		request.WithCustomSpelling(spellings)
+	}
+
 	return request
 }
 
+// storeRedactedAudio waits for AssemblyAI to produce the redacted audio and uploads it to Firebase
+func (p *AssemblyAIProvider) storeRedactedAudio(ctx context.Context, session *AssemblyAISession) error {
+	redactedAudioURL, err := p.waitForRedactedAudio(ctx, session.TranscriptID)
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, redactedAudioURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create redacted audio request: %w", err)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to download redacted audio: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download redacted audio: status %d", response.StatusCode)
+	}
+
+	firebaseURL, err := p.firebaseUploader.UploadAudioStream(ctx, response.Body, session.Metadata.MeetingID, session.SessionID)
+	if err != nil {
+		return fmt.Errorf("failed to upload redacted audio to Firebase: %w", err)
+	}
+	session.FirebaseURL = firebaseURL
+	log.Printf("AssemblyAI: Redacted audio uploaded to Firebase for session %s: %s", session.SessionID, firebaseURL)
+	return nil
+}
+
+// waitForRedactedAudio polls until the redacted audio of a completed transcript is ready
+func (p *AssemblyAIProvider) waitForRedactedAudio(ctx context.Context, transcriptID string) (string, error) {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	timeout := time.NewTimer(10 * time.Minute)
+	defer timeout.Stop()
+
+	for {
+		redactedAudio, err := p.client.GetRedactedAudio(ctx, transcriptID)
+		if err == nil && redactedAudio.Status == "redacted_audio_ready" && redactedAudio.RedactedAudioURL != "" {
+			return redactedAudio.RedactedAudioURL, nil
+		}
+		if err != nil {
+			log.Printf("Redacted audio for transcript %s not available yet: %v", transcriptID, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-timeout.C:
+			return "", fmt.Errorf("redacted audio polling timeout for ID: %s", transcriptID)
+		case <-ticker.C:
+		}
+	}
+}
+
 // Helper method to poll for transcript completion
 func (p *AssemblyAIProvider) pollForCompletion(ctx context.Context, transcriptID string) (*assemblyai.Transcript, error) {
 	ticker := time.NewTicker(5 * time.Second)
@@ -308,6 +412,14 @@ func (p *AssemblyAIProvider) convertToTranscriptSegments(transcript *assemblyai.
 				utterance.Confidence,
 				i+1,
 			)
+			for _, word := range utterance.Words {
+				segment.Words = append(segment.Words, entities.WordTiming{
+					Text:       word.Text,
+					Start:      float64(word.Start) / 1000.0,
+					End:        float64(word.End) / 1000.0,
+					Confidence: word.Confidence,
+				})
+			}
 			segments = append(segments, segment)
 		}
 	} else if transcript.Text != nil {
@@ -0,0 +1,171 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	"teammate/server/seedwork/domain"
+)
+
+const (
+	// maxAudioURLRedirects bounds how many redirects are followed when fetching a recording
+	maxAudioURLRedirects = 5
+)
+
+// errBlockedAddress is returned when a recording URL points to a non-public address
+var errBlockedAddress = errors.New("address is not publicly routable")
+
+// nonPublicPrefixes are ranges that are not reachable on the internet but are not covered
+// by the netip classification methods
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"),   // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // documentation
+	netip.MustParsePrefix("198.18.0.0/15"),   // benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // documentation
+	netip.MustParsePrefix("203.0.113.0/24"),  // documentation
+	netip.MustParsePrefix("240.0.0.0/4"),     // reserved, including broadcast
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, which can reach private IPv4 ranges
+	netip.MustParsePrefix("64:ff9b:1::/48"),  // local-use NAT64
+	netip.MustParsePrefix("2001:db8::/32"),   // documentation
+}
+
+// AudioURLFetcher downloads recordings from user-supplied URLs. Only http and https URLs are
+// fetched, and every connection, including those made for redirects, is refused unless the
+// address it dials is publicly routable, so URLs cannot be used to reach internal services.
+type AudioURLFetcher struct {
+	client    *http.Client
+	resolver  *net.Resolver
+	allowAddr func(netip.Addr) bool
+}
+
+// NewAudioURLFetcher creates a fetcher for recordings on the public internet
+func NewAudioURLFetcher() *AudioURLFetcher {
+	f := &AudioURLFetcher{
+		resolver:  net.DefaultResolver,
+		allowAddr: isPublicAddr,
+	}
+
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		// Checking the address being dialed, rather than the resolved host name, also
+		// covers DNS rebinding between validation and download
+		Control: func(network, address string, conn syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil || !f.allowAddr(addr) {
+				return fmt.Errorf("%s: %w", host, errBlockedAddress)
+			}
+			return nil
+		},
+	}
+
+	f.client = &http.Client{
+		Transport: &http.Transport{
+			Proxy:                  nil, // A proxy would be dialed instead of the recording's host
+			DialContext:            dialer.DialContext,
+			TLSHandshakeTimeout:    10 * time.Second,
+			ResponseHeaderTimeout:  30 * time.Second,
+			MaxResponseHeaderBytes: 64 << 10,
+			ForceAttemptHTTP2:      true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxAudioURLRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxAudioURLRedirects)
+			}
+			return validateAudioURLScheme(req.URL)
+		},
+	}
+	return f
+}
+
+// ValidateURL checks that a recording URL is http or https and that its host only
+// resolves to publicly routable addresses
+func (f *AudioURLFetcher) ValidateURL(ctx context.Context, rawURL string) error {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return domain.NewDomainError("INVALID_AUDIO_URL", "Audio URL is not a valid URL", domain.ErrInvalidInput)
+	}
+	if err := validateAudioURLScheme(target); err != nil {
+		return domain.NewDomainError("INVALID_AUDIO_URL", err.Error(), domain.ErrInvalidInput)
+	}
+
+	host := target.Hostname()
+	addrs := []netip.Addr{}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = append(addrs, addr)
+	} else if addrs, err = f.resolver.LookupNetIP(ctx, "ip", host); err != nil {
+		return domain.NewDomainError("INVALID_AUDIO_URL", "Audio URL host could not be resolved", domain.ErrInvalidInput)
+	}
+
+	for _, addr := range addrs {
+		if !f.allowAddr(addr) {
+			return domain.NewDomainError("INVALID_AUDIO_URL", "Audio URL must point to a public host", domain.ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// Fetch starts downloading a recording and returns its body and content type.
+// The caller must close the body.
+func (f *AudioURLFetcher) Fetch(ctx context.Context, rawURL string) (io.ReadCloser, string, error) {
+	if err := f.ValidateURL(ctx, rawURL); err != nil {
+		return nil, "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "audio/*, video/mp4, application/octet-stream")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download audio: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, "", fmt.Errorf("failed to download audio: server responded with %s", resp.Status)
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+// validateAudioURLScheme accepts absolute http and https URLs without credentials
+func validateAudioURLScheme(target *url.URL) error {
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return fmt.Errorf("audio URL must use http or https")
+	}
+	if target.Hostname() == "" {
+		return fmt.Errorf("audio URL must include a host")
+	}
+	if target.User != nil {
+		return fmt.Errorf("audio URL must not include credentials")
+	}
+	return nil
+}
+
+// isPublicAddr reports whether an address is publicly routable
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,11 +1,14 @@
 package providers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
 	"log"
+	"net/url"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
@@ -138,6 +141,85 @@ func (f *FirebaseStorageUploader) UploadAudioStream(ctx context.Context, reader
 	return signedURL, nil
 }
 
+// UploadDocument uploads an export document and returns a signed URL that expires at expiresAt.
+// Unlike audio uploads there is no fallback to a gs:// URL, which could not honour the expiry;
+// a lifecycle rule on the exports/ prefix should remove the objects themselves.
+func (f *FirebaseStorageUploader) UploadDocument(ctx context.Context, data []byte, objectName, fileName, contentType string, expiresAt time.Time) (string, error) {
+	return f.UploadDocumentStream(ctx, bytes.NewReader(data), objectName, fileName, contentType, expiresAt)
+}
+
+// UploadDocumentStream uploads an export document from a reader, see UploadDocument
+func (f *FirebaseStorageUploader) UploadDocumentStream(ctx context.Context, reader io.Reader, objectName, fileName, contentType string, expiresAt time.Time) (string, error) {
+	objectName = fmt.Sprintf("exports/%s", objectName)
+
+	// Get bucket handle
+	bucket := f.client.Bucket(f.bucketName)
+
+	// Create writer
+	w := bucket.Object(objectName).NewWriter(ctx)
+	w.ContentType = contentType
+	w.ContentDisposition = fmt.Sprintf("attachment; filename=%q", fileName)
+	w.CacheControl = "private, no-store"
+	w.Metadata = map[string]string{
+		"uploadedAt": time.Now().Format(time.RFC3339),
+		"expiresAt":  expiresAt.Format(time.RFC3339),
+	}
+
+	// Copy from reader to writer
+	bytesWritten, err := io.Copy(w, reader)
+	if err != nil {
+		w.Close()
+		return "", fmt.Errorf("failed to write document data: %w", err)
+	}
+
+	// Close writer
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("failed to close writer: %w", err)
+	}
+
+	signedURL, err := bucket.SignedURL(objectName, &storage.SignedURLOptions{
+		Method:  "GET",
+		Expires: expiresAt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate signed URL: %w", err)
+	}
+
+	log.Printf("Document uploaded to Firebase Storage: %s (size: %d bytes)", objectName, bytesWritten)
+	return signedURL, nil
+}
+
+// OpenAudio opens an audio file uploaded by UploadAudio. filePath is either the gs:// URL
+// or a signed URL returned at upload time; signed URLs are read through the storage client
+// because they expire after an hour.
+func (f *FirebaseStorageUploader) OpenAudio(ctx context.Context, filePath string) (io.ReadCloser, error) {
+	objectName, err := f.audioObjectName(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := f.client.Bucket(f.bucketName).Object(objectName).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audio file: %w", err)
+	}
+	return reader, nil
+}
+
+// audioObjectName extracts the object name from a gs:// or signed storage URL of the bucket
+func (f *FirebaseStorageUploader) audioObjectName(filePath string) (string, error) {
+	if objectName, ok := strings.CutPrefix(filePath, fmt.Sprintf("gs://%s/", f.bucketName)); ok {
+		return objectName, nil
+	}
+
+	parsed, err := url.Parse(filePath)
+	if err == nil && parsed.Host == "storage.googleapis.com" {
+		if objectName, ok := strings.CutPrefix(parsed.Path, fmt.Sprintf("/%s/", f.bucketName)); ok {
+			return objectName, nil
+		}
+	}
+	return "", fmt.Errorf("audio file %q is not stored in bucket %s", filePath, f.bucketName)
+}
+
 // DeleteAudio deletes an audio file from Firebase Storage
 func (f *FirebaseStorageUploader) DeleteAudio(ctx context.Context, filePath string) error {
 	// Extract object name from path (remove gs://bucket/ prefix if present)
@@ -0,0 +1,83 @@
+package providers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalAudioStore keeps uploaded recordings on the local filesystem until the batch
+// pipeline has transcribed them and stored the audio with the provider's uploader
+type LocalAudioStore struct {
+	dir string
+}
+
+// NewLocalAudioStore creates an audio store writing to dir
+func NewLocalAudioStore(dir string) (*LocalAudioStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create upload directory: %w", err)
+	}
+	return &LocalAudioStore{dir: dir}, nil
+}
+
+// SaveAudio writes a recording from a reader and returns its size. The recording is written
+// under a temporary name so an interrupted upload never leaves a partial file behind.
+func (l *LocalAudioStore) SaveAudio(ctx context.Context, name string, reader io.Reader) (int64, error) {
+	path, err := l.path(name)
+	if err != nil {
+		return 0, err
+	}
+
+	file, err := os.CreateTemp(l.dir, ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create audio file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	size, err := io.Copy(file, reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to write audio file: %w", err)
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return 0, fmt.Errorf("failed to write audio file: %w", err)
+	}
+
+	log.Printf("Uploaded audio stored locally: %s (size: %d bytes)", name, size)
+	return size, nil
+}
+
+// OpenAudio opens a stored recording
+func (l *LocalAudioStore) OpenAudio(ctx context.Context, name string) (io.ReadCloser, error) {
+	path, err := l.path(name)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// DeleteAudio removes a stored recording; removing a missing recording is not an error
+func (l *LocalAudioStore) DeleteAudio(ctx context.Context, name string) error {
+	path, err := l.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// path returns the location of a recording, rejecting names that could escape the directory
+func (l *LocalAudioStore) path(name string) (string, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid audio file name: %q", name)
+	}
+	return filepath.Join(l.dir, name), nil
+}
@@ -0,0 +1,147 @@
+package providers
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"teammate/server/seedwork/domain"
+)
+
+// LocalDocumentStorage stores export documents on the local filesystem and hands out
+// HMAC-signed download links that are served by the API until they expire
+type LocalDocumentStorage struct {
+	dir     string
+	baseURL string
+	secret  []byte
+}
+
+// NewLocalDocumentStorage creates a document storage writing to dir. Links point to
+// baseURL + "/exports/files/{name}" and are signed with secret. The name a document is
+// downloaded as is passed along in the link's filename parameter.
+func NewLocalDocumentStorage(dir, baseURL string, secret []byte) (*LocalDocumentStorage, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("a signing secret is required for local document links")
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create export directory: %w", err)
+	}
+	return &LocalDocumentStorage{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  secret,
+	}, nil
+}
+
+// UploadDocument writes a document and returns a download link valid until expiresAt
+func (l *LocalDocumentStorage) UploadDocument(ctx context.Context, data []byte, objectName, fileName, contentType string, expiresAt time.Time) (string, error) {
+	return l.UploadDocumentStream(ctx, bytes.NewReader(data), objectName, fileName, contentType, expiresAt)
+}
+
+// UploadDocumentStream writes a document from a reader and returns a download link valid until
+// expiresAt. The document is written under a temporary name so partial files are never served.
+func (l *LocalDocumentStorage) UploadDocumentStream(ctx context.Context, reader io.Reader, objectName, fileName, contentType string, expiresAt time.Time) (string, error) {
+	name, err := safeDocumentName(objectName)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.CreateTemp(l.dir, ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create document: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	size, err := io.Copy(file, reader)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to write document: %w", err)
+	}
+	if err := os.Chmod(file.Name(), 0o640); err != nil {
+		return "", fmt.Errorf("failed to write document: %w", err)
+	}
+	if err := os.Rename(file.Name(), filepath.Join(l.dir, name)); err != nil {
+		return "", fmt.Errorf("failed to write document: %w", err)
+	}
+
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", l.sign(name, expires))
+	if fileName != "" && fileName != name {
+		query.Set("filename", fileName)
+	}
+
+	log.Printf("Document stored locally: %s (size: %d bytes)", name, size)
+	return fmt.Sprintf("%s/exports/files/%s?%s", l.baseURL, url.PathEscape(name), query.Encode()), nil
+}
+
+// Open verifies a download link and returns the path of the document it points to.
+// Links with a wrong signature are reported the same way as missing documents.
+func (l *LocalDocumentStorage) Open(fileName, expires, signature string) (string, error) {
+	name, err := safeDocumentName(fileName)
+	if err != nil {
+		return "", err
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !hmac.Equal([]byte(signature), []byte(l.sign(name, expires))) {
+		return "", domain.NewDomainError("DOCUMENT_NOT_FOUND", "Document not found", domain.ErrNotFound)
+	}
+	if time.Now().Unix() > expiresAt {
+		return "", domain.NewDomainError("DOCUMENT_LINK_EXPIRED", "Download link has expired", domain.ErrNotFound)
+	}
+
+	path := filepath.Join(l.dir, name)
+	if _, err := os.Stat(path); err != nil {
+		return "", domain.NewDomainError("DOCUMENT_NOT_FOUND", "Document not found", err)
+	}
+	return path, nil
+}
+
+// DeleteOlderThan removes documents written before the cutoff, whose links have expired
+func (l *LocalDocumentStorage) DeleteOlderThan(cutoff time.Time) (int, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read export directory: %w", err)
+	}
+
+	deleted := 0
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || entry.IsDir() || !info.ModTime().Before(cutoff) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(l.dir, entry.Name())); err == nil {
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// sign returns the hex HMAC-SHA256 of a document name and expiry time
+func (l *LocalDocumentStorage) sign(name, expires string) string {
+	mac := hmac.New(sha256.New, l.secret)
+	mac.Write([]byte(name + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// safeDocumentName rejects names that could escape the storage directory
+func safeDocumentName(fileName string) (string, error) {
+	if fileName == "" || fileName != filepath.Base(fileName) || strings.HasPrefix(fileName, ".") {
+		return "", domain.NewDomainError("DOCUMENT_NOT_FOUND", "Document not found", domain.ErrNotFound)
+	}
+	return fileName, nil
+}
@@ -0,0 +1,81 @@
+package providers
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDocumentStorage_SignedLinks(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := NewLocalDocumentStorage(dir, "https://api.example.com/", []byte("secret"))
+	require.NoError(t, err)
+
+	link, err := storage.UploadDocument(context.Background(), []byte("hello"), "export.txt", "export.txt", "text/plain", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(link, "https://api.example.com/exports/files/export.txt?"))
+
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	expires, signature := parsed.Query().Get("expires"), parsed.Query().Get("signature")
+
+	path, err := storage.Open("export.txt", expires, signature)
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+	assert.Empty(t, parsed.Query().Get("filename"))
+
+	// Documents stored under an ID are downloaded under their own name
+	link, err = storage.UploadDocument(context.Background(), []byte("hello"), "3f2a_export.txt", "export.txt", "text/plain", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(link, "https://api.example.com/exports/files/3f2a_export.txt?"))
+	parsed, err = url.Parse(link)
+	require.NoError(t, err)
+	assert.Equal(t, "export.txt", parsed.Query().Get("filename"))
+
+	// Tampering with the expiry or the signature invalidates the link
+	_, err = storage.Open("export.txt", expires+"0", signature)
+	assert.ErrorContains(t, err, "Document not found")
+	_, err = storage.Open("export.txt", expires, strings.Repeat("0", len(signature)))
+	assert.ErrorContains(t, err, "Document not found")
+
+	// Names cannot escape the storage directory
+	_, err = storage.UploadDocument(context.Background(), []byte("x"), "../escape.txt", "escape.txt", "text/plain", time.Now().Add(time.Hour))
+	assert.Error(t, err)
+	_, err = storage.Open("../export.txt", expires, signature)
+	assert.Error(t, err)
+}
+
+func TestLocalDocumentStorage_ExpiredLinks(t *testing.T) {
+	dir := t.TempDir()
+	storage, err := NewLocalDocumentStorage(dir, "http://localhost:8080", []byte("secret"))
+	require.NoError(t, err)
+
+	link, err := storage.UploadDocument(context.Background(), []byte("old"), "old.txt", "old.txt", "text/plain", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+
+	_, err = storage.Open("old.txt", parsed.Query().Get("expires"), parsed.Query().Get("signature"))
+	assert.ErrorContains(t, err, "expired")
+
+	// Expired documents are removed from disk
+	past := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "old.txt"), past, past))
+	_, err = storage.UploadDocument(context.Background(), []byte("new"), "new.txt", "new.txt", "text/plain", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	deleted, err := storage.DeleteOlderThan(time.Now().Add(-24 * time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	assert.NoFileExists(t, filepath.Join(dir, "old.txt"))
+	assert.FileExists(t, filepath.Join(dir, "new.txt"))
+}
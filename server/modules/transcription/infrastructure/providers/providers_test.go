@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
 )
 
 // MockFirebaseUploader for testing
@@ -296,6 +297,62 @@ func TestMockAssemblyAIProvider_ErrorCases(t *testing.T) {
 	assert.Contains(t, err.Error(), "session non-existent not found")
 }
 
+func TestAssemblyAIProvider_BuildTranscriptRequest_CustomVocabulary(t *testing.T) {
+	provider := NewAssemblyAIProvider("test-key", new(MockFirebaseUploader))
+
+	request := provider.buildTranscriptRequest("https://example.com/audio.wav", services.AudioProcessingOptions{
+		WordBoost:  []string{"GitScribe", "LeMUR"},
+		BoostParam: entities.BoostHigh,
+		CustomSpelling: []entities.SpellingRule{
+			{From: []string{"git scribe"}, To: "GitScribe"},
+		},
+	})
+
+	assert.Equal(t, []string{"GitScribe", "LeMUR"}, request.WordBoost)
+	if assert.NotNil(t, request.BoostParam) {
+		assert.Equal(t, "high", *request.BoostParam)
+	}
+	if assert.Len(t, request.CustomSpelling, 1) {
+		assert.Equal(t, []string{"git scribe"}, request.CustomSpelling[0].From)
+		assert.Equal(t, "GitScribe", request.CustomSpelling[0].To)
+	}
+	assert.True(t, provider.SupportsCustomVocabulary())
+
+	// Without vocabulary nothing is sent
+	request = provider.buildTranscriptRequest("https://example.com/audio.wav", services.AudioProcessingOptions{})
+	assert.Empty(t, request.WordBoost)
+	assert.Nil(t, request.BoostParam)
+	assert.Empty(t, request.CustomSpelling)
+}
+
+func TestAssemblyAIProvider_BuildTranscriptRequest_PIIRedaction(t *testing.T) {
+	provider := NewAssemblyAIProvider("test-key", new(MockFirebaseUploader))
+
+	request := provider.buildTranscriptRequest("https://example.com/audio.wav", services.AudioProcessingOptions{
+		RedactPII:      true,
+		RedactPIIAudio: true,
+		PIIPolicies:    []entities.PIIPolicy{entities.PIIEmailAddress, entities.PIIPersonName},
+	})
+
+	if assert.NotNil(t, request.RedactPII) {
+		assert.True(t, *request.RedactPII)
+	}
+	if assert.NotNil(t, request.RedactPIIAudio) {
+		assert.True(t, *request.RedactPIIAudio)
+	}
+	if assert.NotNil(t, request.RedactPIISub) {
+		assert.Equal(t, "entity_name", *request.RedactPIISub)
+	}
+	assert.Equal(t, []assemblyai.PIIPolicy{assemblyai.PIIPolicyEmailAddress, assemblyai.PIIPolicyPersonName}, request.RedactPIIPolicies)
+	assert.True(t, provider.SupportsPIIRedaction())
+
+	// Without redaction nothing is sent
+	request = provider.buildTranscriptRequest("https://example.com/audio.wav", services.AudioProcessingOptions{})
+	assert.Nil(t, request.RedactPII)
+	assert.Nil(t, request.RedactPIIAudio)
+	assert.Empty(t, request.RedactPIIPolicies)
+}
+
 // Benchmark test for chunk processing
 func BenchmarkMockAssemblyAIProvider_ProcessChunk(b *testing.B) {
 	mockUploader := new(MockFirebaseUploader)
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormAnalyticsSnapshotRepository implements AnalyticsSnapshotRepository using GORM
+type GormAnalyticsSnapshotRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAnalyticsSnapshotRepository creates a new GORM analytics snapshot repository
+func NewGormAnalyticsSnapshotRepository() *GormAnalyticsSnapshotRepository {
+	return &GormAnalyticsSnapshotRepository{db: database.GetDB()}
+}
+
+// ReplaceSnapshot swaps the snapshot of a meeting in a single transaction
+func (r *GormAnalyticsSnapshotRepository) ReplaceSnapshot(ctx context.Context, snapshot *entities.AnalyticsSnapshot) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("meeting_id = ?", snapshot.MeetingID).Delete(&entities.AnalyticsSnapshot{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(snapshot).Error
+	})
+}
+
+// DeleteByMeetingID removes the snapshot of a meeting
+func (r *GormAnalyticsSnapshotRepository) DeleteByMeetingID(ctx context.Context, meetingID string) error {
+	return r.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Delete(&entities.AnalyticsSnapshot{}).Error
+}
+
+// FindAccessibleSnapshots retrieves the snapshots of meetings in a date range that the user owns
+// or, unless OwnedOnly is set, that have been shared with them. With a TeamID it retrieves the
+// snapshots of meetings owned by the team's members.
+func (r *GormAnalyticsSnapshotRepository) FindAccessibleSnapshots(ctx context.Context, criteria repositories.AnalyticsSnapshotCriteria) ([]*entities.AnalyticsSnapshot, error) {
+	query := r.db.WithContext(ctx).
+		Select("analytics_snapshots.*, m.type AS meeting_type, m.start_time AS meeting_start_time").
+		Joins("JOIN meetings m ON m.id = analytics_snapshots.meeting_id AND m.deleted_at IS NULL").
+		Where("m.start_time >= ? AND m.start_time < ?", criteria.From, criteria.To)
+
+	if criteria.TeamID != "" {
+		query = query.Where("m.user_id IN (SELECT tm.user_id FROM team_members tm WHERE tm.team_id = ?)", criteria.TeamID)
+	} else if criteria.OwnedOnly {
+		query = query.Where("m.user_id = ?", criteria.UserID)
+	} else {
+		query = query.Where("(m.user_id = ? OR EXISTS (SELECT 1 FROM meeting_shares s WHERE s.meeting_id = m.id AND s.shared_with_user_id = ? AND s.deleted_at IS NULL))",
+			criteria.UserID, criteria.UserID)
+	}
+	if criteria.MeetingType != "" {
+		query = query.Where("m.type = ?", criteria.MeetingType)
+	}
+
+	var snapshots []*entities.AnalyticsSnapshot
+	err := query.Order("m.start_time ASC").Find(&snapshots).Error
+	return snapshots, err
+}
+
+// FindUnsnapshottedMeetingIDs retrieves meetings with a completed transcription but no snapshot
+func (r *GormAnalyticsSnapshotRepository) FindUnsnapshottedMeetingIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Table("meetings m").
+		Where("m.deleted_at IS NULL").
+		Where("EXISTS (SELECT 1 FROM transcriptions t WHERE t.meeting_id = m.id AND t.status = ? AND t.deleted_at IS NULL)", string(entities.Completed)).
+		Where("NOT EXISTS (SELECT 1 FROM analytics_snapshots a WHERE a.meeting_id = m.id)").
+		Order("m.start_time ASC").
+		Pluck("m.id", &ids).Error
+	return ids, err
+}
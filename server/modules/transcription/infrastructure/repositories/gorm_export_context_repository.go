@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormExportContextRepository implements ExportContextRepository by reading the meeting,
+// participant and action item tables
+type GormExportContextRepository struct {
+	db *gorm.DB
+}
+
+// NewGormExportContextRepository creates a new GORM export context repository
+func NewGormExportContextRepository() *GormExportContextRepository {
+	return &GormExportContextRepository{db: database.GetDB()}
+}
+
+// FindByMeetingID loads the meeting details, participants and non-rejected action items of a meeting
+func (r *GormExportContextRepository) FindByMeetingID(ctx context.Context, meetingID string) (*repositories.ExportContext, error) {
+	db := r.db.WithContext(ctx)
+	exportContext := &repositories.ExportContext{
+		Participants: []repositories.ExportParticipant{},
+		ActionItems:  []repositories.ExportActionItem{},
+	}
+
+	var meetings []repositories.ExportMeeting
+	err := db.Table("meetings").
+		Select("title, type, start_time, end_time").
+		Where("id = ? AND deleted_at IS NULL", meetingID).
+		Limit(1).
+		Scan(&meetings).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(meetings) > 0 {
+		exportContext.Meeting = &meetings[0]
+	}
+
+	err = db.Table("participants").
+		Select("name, COALESCE(email, '') AS email, COALESCE(role, '') AS role").
+		Where("meeting_id = ? AND deleted_at IS NULL", meetingID).
+		Order("name ASC").
+		Scan(&exportContext.Participants).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Table("action_items").
+		Select("title, description, COALESCE(assignee, '') AS assignee, priority, status, due_date").
+		Where("meeting_id = ? AND status <> ? AND deleted_at IS NULL", meetingID, "rejected").
+		Order("created_at ASC").
+		Scan(&exportContext.ActionItems).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return exportContext, nil
+}
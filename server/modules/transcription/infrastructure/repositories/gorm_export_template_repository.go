@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormExportTemplateRepository implements ExportTemplateRepository using GORM
+type GormExportTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewGormExportTemplateRepository creates a new GORM export template repository
+func NewGormExportTemplateRepository() *GormExportTemplateRepository {
+	return &GormExportTemplateRepository{db: database.GetDB()}
+}
+
+// Save creates a new export template
+func (r *GormExportTemplateRepository) Save(ctx context.Context, template *entities.ExportTemplate) error {
+	return r.db.WithContext(ctx).Create(template).Error
+}
+
+// Update saves changes to an existing export template
+func (r *GormExportTemplateRepository) Update(ctx context.Context, template *entities.ExportTemplate) error {
+	return r.db.WithContext(ctx).Save(template).Error
+}
+
+// Delete removes an export template
+func (r *GormExportTemplateRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&entities.ExportTemplate{}, "id = ?", id).Error
+}
+
+// FindByID retrieves an export template by ID
+func (r *GormExportTemplateRepository) FindByID(ctx context.Context, id string) (*entities.ExportTemplate, error) {
+	var template entities.ExportTemplate
+	err := r.db.WithContext(ctx).First(&template, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByUserID retrieves all export templates of a user
+func (r *GormExportTemplateRepository) FindByUserID(ctx context.Context, userID string) ([]*entities.ExportTemplate, error) {
+	var templates []*entities.ExportTemplate
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("name ASC").Find(&templates).Error
+	return templates, err
+}
+
+// FindByUserIDAndName retrieves a user's export template by name
+func (r *GormExportTemplateRepository) FindByUserIDAndName(ctx context.Context, userID, name string) (*entities.ExportTemplate, error) {
+	var template entities.ExportTemplate
+	err := r.db.WithContext(ctx).First(&template, "user_id = ? AND name = ?", userID, name).Error
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormMeetingQuestionRepository implements MeetingQuestionRepository using GORM
+type GormMeetingQuestionRepository struct {
+	db *gorm.DB
+}
+
+// NewGormMeetingQuestionRepository creates a new GORM meeting question repository
+func NewGormMeetingQuestionRepository() *GormMeetingQuestionRepository {
+	return &GormMeetingQuestionRepository{db: database.GetDB()}
+}
+
+// Save stores an answered question
+func (r *GormMeetingQuestionRepository) Save(ctx context.Context, question *entities.MeetingQuestion) error {
+	return r.db.WithContext(ctx).Create(question).Error
+}
+
+// FindByID retrieves a question, or nil if it does not exist
+func (r *GormMeetingQuestionRepository) FindByID(ctx context.Context, id string) (*entities.MeetingQuestion, error) {
+	var question entities.MeetingQuestion
+	err := r.db.WithContext(ctx).First(&question, "id = ?", id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &question, nil
+}
+
+// FindByMeetingID retrieves a page of the questions about a meeting, newest first, and their total
+func (r *GormMeetingQuestionRepository) FindByMeetingID(ctx context.Context, meetingID string, limit, offset int) ([]*entities.MeetingQuestion, int64, error) {
+	query := r.db.WithContext(ctx).Model(&entities.MeetingQuestion{}).Where("meeting_id = ?", meetingID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var questions []*entities.MeetingQuestion
+	err := query.Order("created_at DESC").Order("id").Limit(limit).Offset(offset).Find(&questions).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return questions, total, nil
+}
@@ -0,0 +1,43 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormRedactionSettingsRepository implements RedactionSettingsRepository using GORM
+type GormRedactionSettingsRepository struct {
+	db *gorm.DB
+}
+
+// NewGormRedactionSettingsRepository creates a new GORM redaction settings repository
+func NewGormRedactionSettingsRepository() *GormRedactionSettingsRepository {
+	return &GormRedactionSettingsRepository{db: database.GetDB()}
+}
+
+// Save creates or replaces the settings of the user
+func (r *GormRedactionSettingsRepository) Save(ctx context.Context, settings *entities.RedactionSettings) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"redact_pii", "redact_pii_audio", "pii_policies", "updated_at"}),
+	}).Create(settings).Error
+}
+
+// FindByUserID retrieves the settings of a user, or nil if the user has none
+func (r *GormRedactionSettingsRepository) FindByUserID(ctx context.Context, userID string) (*entities.RedactionSettings, error) {
+	var settings entities.RedactionSettings
+	err := r.db.WithContext(ctx).First(&settings, "user_id = ?", userID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
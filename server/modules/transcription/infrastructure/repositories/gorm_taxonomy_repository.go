@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormTaxonomyRepository implements TaxonomyRepository using GORM
+type GormTaxonomyRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTaxonomyRepository creates a new GORM taxonomy repository
+func NewGormTaxonomyRepository() *GormTaxonomyRepository {
+	return &GormTaxonomyRepository{db: database.GetDB()}
+}
+
+// Save creates a new taxonomy
+func (r *GormTaxonomyRepository) Save(ctx context.Context, taxonomy *entities.Taxonomy) error {
+	return r.db.WithContext(ctx).Create(taxonomy).Error
+}
+
+// Update saves changes to an existing taxonomy
+func (r *GormTaxonomyRepository) Update(ctx context.Context, taxonomy *entities.Taxonomy) error {
+	return r.db.WithContext(ctx).Save(taxonomy).Error
+}
+
+// Delete removes a taxonomy
+func (r *GormTaxonomyRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&entities.Taxonomy{}, "id = ?", id).Error
+}
+
+// FindByID retrieves a taxonomy by ID
+func (r *GormTaxonomyRepository) FindByID(ctx context.Context, id string) (*entities.Taxonomy, error) {
+	var taxonomy entities.Taxonomy
+	err := r.db.WithContext(ctx).First(&taxonomy, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &taxonomy, nil
+}
+
+// FindByUserID retrieves the user's own taxonomies
+func (r *GormTaxonomyRepository) FindByUserID(ctx context.Context, userID string) ([]*entities.Taxonomy, error) {
+	var taxonomies []*entities.Taxonomy
+	err := r.db.WithContext(ctx).Where("user_id = ? AND team_id IS NULL", userID).Order("name ASC").Find(&taxonomies).Error
+	return taxonomies, err
+}
+
+// FindByTeamIDs retrieves the taxonomies of teams
+func (r *GormTaxonomyRepository) FindByTeamIDs(ctx context.Context, teamIDs []string) ([]*entities.Taxonomy, error) {
+	var taxonomies []*entities.Taxonomy
+	if len(teamIDs) == 0 {
+		return taxonomies, nil
+	}
+	err := r.db.WithContext(ctx).Where("team_id IN ?", teamIDs).Order("name ASC").Find(&taxonomies).Error
+	return taxonomies, err
+}
+
+// FindActiveForUser retrieves the user's active taxonomy or, without one, the active taxonomy of the
+// first team they joined that has one. It returns nil if neither exists.
+func (r *GormTaxonomyRepository) FindActiveForUser(ctx context.Context, userID string) (*entities.Taxonomy, error) {
+	var taxonomies []*entities.Taxonomy
+	err := r.db.WithContext(ctx).
+		Joins("LEFT JOIN team_members tm ON tm.team_id = analytics_taxonomies.team_id AND tm.user_id = ?", userID).
+		Where("analytics_taxonomies.active").
+		Where("(analytics_taxonomies.team_id IS NULL AND analytics_taxonomies.user_id = ?) OR tm.id IS NOT NULL", userID).
+		Order("analytics_taxonomies.team_id IS NOT NULL, tm.created_at").
+		Limit(1).
+		Find(&taxonomies).Error
+	if err != nil || len(taxonomies) == 0 {
+		return nil, err
+	}
+	return taxonomies[0], nil
+}
+
+// SetActive deactivates the user's own taxonomies and activates one of them in a single transaction.
+// Both are touched so analytics cached against either taxonomy's version are recomputed.
+func (r *GormTaxonomyRepository) SetActive(ctx context.Context, userID, id string) error {
+	return r.setActive(ctx, "user_id = ? AND team_id IS NULL", userID, id)
+}
+
+// SetTeamActive deactivates the team's taxonomies and activates one of them in a single transaction
+func (r *GormTaxonomyRepository) SetTeamActive(ctx context.Context, teamID, id string) error {
+	return r.setActive(ctx, "team_id = ?", teamID, id)
+}
+
+// setActive deactivates the taxonomies matching owner and activates the one with id among them
+func (r *GormTaxonomyRepository) setActive(ctx context.Context, owner, ownerID, id string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Model(&entities.Taxonomy{}).
+			Where(owner, ownerID).
+			Where("active").
+			Updates(map[string]interface{}{"active": false, "updated_at": now}).Error
+		if err != nil || id == "" {
+			return err
+		}
+		return tx.Model(&entities.Taxonomy{}).
+			Where(owner, ownerID).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{"active": true, "updated_at": now}).Error
+	})
+}
@@ -0,0 +1,162 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormTranscriptChunkRepository implements TranscriptChunkRepository using GORM
+type GormTranscriptChunkRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTranscriptChunkRepository creates a new GORM transcript chunk repository
+func NewGormTranscriptChunkRepository() *GormTranscriptChunkRepository {
+	return &GormTranscriptChunkRepository{db: database.GetDB()}
+}
+
+// ReplaceChunks swaps the indexed passages of a transcription for one embedder in a single transaction
+func (r *GormTranscriptChunkRepository) ReplaceChunks(ctx context.Context, transcriptionID, embedder string, chunks []entities.TranscriptChunk) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("transcription_id = ? AND embedder = ?", transcriptionID, embedder).Delete(&entities.TranscriptChunk{}).Error
+		if err != nil {
+			return err
+		}
+		if len(chunks) == 0 {
+			return nil
+		}
+		return tx.Create(&chunks).Error
+	})
+}
+
+// DeleteByTranscriptionID removes every indexed passage of a transcription
+func (r *GormTranscriptChunkRepository) DeleteByTranscriptionID(ctx context.Context, transcriptionID string) error {
+	return r.db.WithContext(ctx).Where("transcription_id = ?", transcriptionID).Delete(&entities.TranscriptChunk{}).Error
+}
+
+// FindAccessibleChunks retrieves passages from meetings the user owns or that have been shared with them.
+// With a query only passages sharing a term with it are returned, ranked by full-text relevance; the
+// terms are OR-ed so that the embedder still sees passages that match part of a question.
+func (r *GormTranscriptChunkRepository) FindAccessibleChunks(ctx context.Context, criteria repositories.ChunkSearchCriteria) ([]*entities.TranscriptChunk, error) {
+	query := r.searchableChunks(ctx, criteria)
+	if criteria.Query != "" {
+		query = query.
+			Joins("CROSS JOIN (SELECT replace(plainto_tsquery('english', ?)::text, '&', '|')::tsquery AS query) q", criteria.Query).
+			Where("transcript_chunks.search_vector @@ q.query").
+			Order("ts_rank_cd(transcript_chunks.search_vector, q.query) DESC")
+	}
+	if criteria.Limit > 0 {
+		query = query.Limit(criteria.Limit)
+	}
+
+	var chunks []*entities.TranscriptChunk
+	err := query.Order("transcript_chunks.transcription_id, transcript_chunks.chunk_index").Find(&chunks).Error
+	return chunks, err
+}
+
+// FindCorpusStats counts the passages a user may retrieve with the criteria, their average length and
+// how many of them contain each term. Query and Limit are ignored, so lexical scores do not depend on
+// which candidates were loaded.
+func (r *GormTranscriptChunkRepository) FindCorpusStats(ctx context.Context, criteria repositories.ChunkSearchCriteria, terms []string) (*entities.CorpusStats, error) {
+	var totals struct {
+		Documents     int
+		AverageLength float64
+	}
+	err := r.searchableChunks(ctx, criteria).
+		Select("COUNT(*) AS documents, COALESCE(AVG(COALESCE((transcript_chunks.embedding->>'length')::float, 0)), 0) AS average_length").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &entities.CorpusStats{
+		Documents:         totals.Documents,
+		AverageLength:     totals.AverageLength,
+		DocumentFrequency: make(map[string]int, len(terms)),
+	}
+	if len(terms) == 0 {
+		return stats, nil
+	}
+
+	var frequencies []struct {
+		Term      string
+		Documents int
+	}
+	err = r.searchableChunks(ctx, criteria).
+		Joins("CROSS JOIN unnest(ARRAY[?]::text[]) AS term", terms).
+		Where("jsonb_exists(transcript_chunks.embedding->'terms', term)").
+		Group("term").
+		Select("term, COUNT(*) AS documents").
+		Scan(&frequencies).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, frequency := range frequencies {
+		stats.DocumentFrequency[frequency.Term] = frequency.Documents
+	}
+	return stats, nil
+}
+
+// FindAccessibleChunk retrieves a passage by its ID or by a segment it contains
+func (r *GormTranscriptChunkRepository) FindAccessibleChunk(ctx context.Context, criteria repositories.ChunkLookupCriteria) (*entities.TranscriptChunk, error) {
+	query := r.accessibleChunks(ctx, criteria.UserID, criteria.Embedder)
+
+	if criteria.ChunkID != "" {
+		query = query.Where("transcript_chunks.id = ?", criteria.ChunkID)
+	}
+	if criteria.SegmentID != "" {
+		segmentIDs, err := json.Marshal([]string{criteria.SegmentID})
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where("transcript_chunks.segment_ids @> ?::jsonb", string(segmentIDs))
+	}
+
+	var chunk entities.TranscriptChunk
+	err := query.First(&chunk).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &chunk, nil
+}
+
+// searchableChunks selects the passages a user may retrieve with the criteria, leaving out Query and Limit
+func (r *GormTranscriptChunkRepository) searchableChunks(ctx context.Context, criteria repositories.ChunkSearchCriteria) *gorm.DB {
+	query := r.accessibleChunks(ctx, criteria.UserID, criteria.Embedder)
+	if criteria.MeetingID != "" {
+		query = query.Where("transcript_chunks.meeting_id = ?", criteria.MeetingID)
+	}
+	return query
+}
+
+// accessibleChunks selects the passages of one embedder from meetings the user owns or that have been shared with them
+func (r *GormTranscriptChunkRepository) accessibleChunks(ctx context.Context, userID, embedder string) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Model(&entities.TranscriptChunk{}).
+		Joins("JOIN meetings m ON m.id = transcript_chunks.meeting_id AND m.deleted_at IS NULL").
+		Where("transcript_chunks.embedder = ?", embedder).
+		Where("(m.user_id = ? OR EXISTS (SELECT 1 FROM meeting_shares s WHERE s.meeting_id = m.id AND s.shared_with_user_id = ? AND s.deleted_at IS NULL))",
+			userID, userID)
+}
+
+// FindUnindexedTranscriptionIDs retrieves completed transcriptions with no passages for an embedder
+func (r *GormTranscriptChunkRepository) FindUnindexedTranscriptionIDs(ctx context.Context, embedder string) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Table("transcriptions t").
+		Where("t.status = ? AND t.deleted_at IS NULL", string(entities.Completed)).
+		Where("NOT EXISTS (SELECT 1 FROM transcript_chunks c WHERE c.transcription_id = t.id AND c.embedder = ?)", embedder).
+		Order("t.created_at ASC").
+		Pluck("t.id", &ids).Error
+	return ids, err
+}
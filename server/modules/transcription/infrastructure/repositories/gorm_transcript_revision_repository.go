@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/domain"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormTranscriptRevisionRepository implements TranscriptRevisionRepository using GORM
+type GormTranscriptRevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTranscriptRevisionRepository creates a new GORM transcript revision repository
+func NewGormTranscriptRevisionRepository() *GormTranscriptRevisionRepository {
+	return &GormTranscriptRevisionRepository{db: database.GetDB()}
+}
+
+// Save appends a revision to the history. A revision number that is already taken violates
+// UNIQUE(transcription_id, revision_number) and is reported as domain.ErrAlreadyExists.
+func (r *GormTranscriptRevisionRepository) Save(ctx context.Context, revision *entities.TranscriptRevision) error {
+	err := database.Conn(ctx, r.db).Create(revision).Error
+	if translator, ok := r.db.Dialector.(gorm.ErrorTranslator); ok && errors.Is(translator.Translate(err), gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("revision %d of transcription %s: %w", revision.RevisionNumber, revision.TranscriptionID, domain.ErrAlreadyExists)
+	}
+	return err
+}
+
+// FindByTranscriptionID retrieves all revisions of a transcription, oldest first
+func (r *GormTranscriptRevisionRepository) FindByTranscriptionID(ctx context.Context, transcriptionID string) ([]*entities.TranscriptRevision, error) {
+	var revisions []*entities.TranscriptRevision
+	err := database.Conn(ctx, r.db).Where("transcription_id = ?", transcriptionID).Order("revision_number ASC").Find(&revisions).Error
+	return revisions, err
+}
+
+// FindByNumber retrieves a single revision of a transcription
+func (r *GormTranscriptRevisionRepository) FindByNumber(ctx context.Context, transcriptionID string, revisionNumber int) (*entities.TranscriptRevision, error) {
+	var revision entities.TranscriptRevision
+	err := database.Conn(ctx, r.db).First(&revision, "transcription_id = ? AND revision_number = ?", transcriptionID, revisionNumber).Error
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// FindLatest retrieves the most recent revision, or nil if the transcription has never been edited
+func (r *GormTranscriptRevisionRepository) FindLatest(ctx context.Context, transcriptionID string) (*entities.TranscriptRevision, error) {
+	var revision entities.TranscriptRevision
+	err := database.Conn(ctx, r.db).Where("transcription_id = ?", transcriptionID).Order("revision_number DESC").First(&revision).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
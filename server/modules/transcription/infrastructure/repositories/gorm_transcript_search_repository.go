@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"teammate/server/modules/transcription/domain/repositories"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// Highlight markers wrapped around matching terms in snippets. Segment text is HTML-escaped
+// before highlighting, so the markers are the only markup a snippet can contain.
+const (
+	snippetStartSel = "<mark>"
+	snippetStopSel  = "</mark>"
+)
+
+// GormTranscriptSearchRepository implements TranscriptSearchRepository using Postgres full-text search
+type GormTranscriptSearchRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTranscriptSearchRepository creates a new GORM transcript search repository
+func NewGormTranscriptSearchRepository() *GormTranscriptSearchRepository {
+	return &GormTranscriptSearchRepository{db: database.GetDB()}
+}
+
+type transcriptSearchRow struct {
+	SegmentID        string
+	TranscriptionID  string
+	MeetingID        string
+	MeetingTitle     string
+	MeetingType      string
+	MeetingStartTime time.Time
+	Speaker          *string
+	Text             string
+	Snippet          string
+	StartTime        float64
+	EndTime          float64
+	Rank             float64
+	TotalCount       int64
+}
+
+// SearchSegments runs a ranked full-text search over the segments the user can access
+func (r *GormTranscriptSearchRepository) SearchSegments(ctx context.Context, criteria repositories.TranscriptSearchCriteria) ([]repositories.TranscriptSearchHit, int64, error) {
+	var conditions []string
+	args := []interface{}{
+		criteria.Query,
+		"StartSel=" + snippetStartSel + ", StopSel=" + snippetStopSel + ", MaxWords=35, MinWords=15, MaxFragments=2",
+		criteria.UserID,
+		criteria.UserID,
+	}
+
+	if criteria.MeetingID != "" {
+		conditions = append(conditions, "m.id = ?")
+		args = append(args, criteria.MeetingID)
+	}
+	if criteria.Speaker != "" {
+		conditions = append(conditions, "ts.speaker = ?")
+		args = append(args, criteria.Speaker)
+	}
+	if criteria.MeetingType != "" {
+		conditions = append(conditions, "m.type = ?")
+		args = append(args, criteria.MeetingType)
+	}
+	if criteria.From != nil {
+		conditions = append(conditions, "m.start_time >= ?")
+		args = append(args, *criteria.From)
+	}
+	if criteria.To != nil {
+		conditions = append(conditions, "m.start_time < ?")
+		args = append(args, *criteria.To)
+	}
+
+	filters := ""
+	if len(conditions) > 0 {
+		filters = "AND " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, criteria.Limit, criteria.Offset)
+
+	query := `
+		SELECT
+			ts.id AS segment_id,
+			ts.transcription_id,
+			m.id AS meeting_id,
+			m.title AS meeting_title,
+			m.type AS meeting_type,
+			m.start_time AS meeting_start_time,
+			ts.speaker,
+			ts.text,
+			ts_headline('english',
+				replace(replace(replace(ts.text, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+				q.query, opts.options) AS snippet,
+			ts.start_time,
+			ts.end_time,
+			ts_rank_cd(ts.search_vector, q.query) AS rank,
+			COUNT(*) OVER() AS total_count
+		FROM transcript_segments ts
+		JOIN transcriptions t ON t.id = ts.transcription_id AND t.deleted_at IS NULL
+		JOIN meetings m ON m.id = t.meeting_id AND m.deleted_at IS NULL
+		CROSS JOIN websearch_to_tsquery('english', ?) AS q(query)
+		CROSS JOIN (SELECT ?::text AS options) AS opts
+		WHERE ts.search_vector @@ q.query
+			AND ts.deleted_at IS NULL
+			AND (m.user_id = ? OR EXISTS (
+				SELECT 1 FROM meeting_shares s
+				WHERE s.meeting_id = m.id AND s.shared_with_user_id = ? AND s.deleted_at IS NULL
+			))
+			` + filters + `
+		ORDER BY rank DESC, m.start_time DESC, ts.start_time ASC
+		LIMIT ? OFFSET ?
+	`
+
+	var rows []transcriptSearchRow
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	hits := make([]repositories.TranscriptSearchHit, len(rows))
+	var total int64
+	for i, row := range rows {
+		total = row.TotalCount
+		speaker := ""
+		if row.Speaker != nil {
+			speaker = *row.Speaker
+		}
+		hits[i] = repositories.TranscriptSearchHit{
+			SegmentID:        row.SegmentID,
+			TranscriptionID:  row.TranscriptionID,
+			MeetingID:        row.MeetingID,
+			MeetingTitle:     row.MeetingTitle,
+			MeetingType:      row.MeetingType,
+			MeetingStartTime: row.MeetingStartTime,
+			Speaker:          speaker,
+			Text:             row.Text,
+			Snippet:          row.Snippet,
+			StartTime:        row.StartTime,
+			EndTime:          row.EndTime,
+			Rank:             row.Rank,
+		}
+	}
+
+	return hits, total, nil
+}
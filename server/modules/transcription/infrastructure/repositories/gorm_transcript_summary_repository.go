@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormTranscriptSummaryRepository implements TranscriptSummaryRepository using GORM
+type GormTranscriptSummaryRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTranscriptSummaryRepository creates a new GORM transcript summary repository
+func NewGormTranscriptSummaryRepository() *GormTranscriptSummaryRepository {
+	return &GormTranscriptSummaryRepository{db: database.GetDB()}
+}
+
+// ReplaceSummary swaps the summary of a transcription in a single transaction
+func (r *GormTranscriptSummaryRepository) ReplaceSummary(ctx context.Context, summary *entities.TranscriptSummary) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("transcription_id = ?", summary.TranscriptionID).Delete(&entities.TranscriptSummary{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(summary).Error
+	})
+}
+
+// FindByTranscriptionID retrieves the summary of a transcription, or nil if it has not been summarized
+func (r *GormTranscriptSummaryRepository) FindByTranscriptionID(ctx context.Context, transcriptionID string) (*entities.TranscriptSummary, error) {
+	var summary entities.TranscriptSummary
+	err := r.db.WithContext(ctx).Where("transcription_id = ?", transcriptionID).First(&summary).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
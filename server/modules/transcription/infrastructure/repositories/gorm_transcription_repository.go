@@ -23,13 +23,13 @@ func NewGormTranscriptionRepository() *GormTranscriptionRepository {
 
 // Save stores a transcription in the database
 func (r *GormTranscriptionRepository) Save(ctx context.Context, transcription *entities.Transcription) error {
-	return r.db.WithContext(ctx).Save(transcription).Error
+	return database.Conn(ctx, r.db).Save(transcription).Error
 }
 
 // FindByID retrieves a transcription by its ID
 func (r *GormTranscriptionRepository) FindByID(ctx context.Context, id string) (*entities.Transcription, error) {
 	var transcription entities.Transcription
-	err := r.db.WithContext(ctx).First(&transcription, "id = ?", id).Error
+	err := database.Conn(ctx, r.db).First(&transcription, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -39,13 +39,13 @@ func (r *GormTranscriptionRepository) FindByID(ctx context.Context, id string) (
 // FindByMeetingID retrieves all transcriptions for a meeting
 func (r *GormTranscriptionRepository) FindByMeetingID(ctx context.Context, meetingID string) ([]*entities.Transcription, error) {
 	var transcriptions []*entities.Transcription
-	err := r.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Order("created_at DESC").Find(&transcriptions).Error
+	err := database.Conn(ctx, r.db).Where("meeting_id = ?", meetingID).Order("created_at DESC").Find(&transcriptions).Error
 	return transcriptions, err
 }
 
 // Update updates an existing transcription
 func (r *GormTranscriptionRepository) Update(ctx context.Context, transcription *entities.Transcription) error {
-	result := r.db.WithContext(ctx).Save(transcription)
+	result := database.Conn(ctx, r.db).Save(transcription)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -57,7 +57,7 @@ func (r *GormTranscriptionRepository) Update(ctx context.Context, transcription
 
 // Delete removes a transcription from the database
 func (r *GormTranscriptionRepository) Delete(ctx context.Context, id string) error {
-	result := r.db.WithContext(ctx).Delete(&entities.Transcription{}, "id = ?", id)
+	result := database.Conn(ctx, r.db).Delete(&entities.Transcription{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -70,7 +70,7 @@ func (r *GormTranscriptionRepository) Delete(ctx context.Context, id string) err
 // SaveSegments stores transcript segments
 func (r *GormTranscriptionRepository) SaveSegments(ctx context.Context, transcriptionID string, segments []entities.TranscriptSegment) error {
 	// First, delete existing segments for this transcription
-	err := r.db.WithContext(ctx).Where("transcription_id = ?", transcriptionID).Delete(&entities.TranscriptSegment{}).Error
+	err := database.Conn(ctx, r.db).Where("transcription_id = ?", transcriptionID).Delete(&entities.TranscriptSegment{}).Error
 	if err != nil {
 		return err
 	}
@@ -80,13 +80,13 @@ func (r *GormTranscriptionRepository) SaveSegments(ctx context.Context, transcri
 		segments[i].TranscriptionID = transcriptionID
 	}
 
-	return r.db.WithContext(ctx).Create(&segments).Error
+	return database.Conn(ctx, r.db).Create(&segments).Error
 }
 
 // FindSegmentsByTranscriptionID retrieves segments for a transcription
 func (r *GormTranscriptionRepository) FindSegmentsByTranscriptionID(ctx context.Context, transcriptionID string) ([]entities.TranscriptSegment, error) {
 	var segments []entities.TranscriptSegment
-	err := r.db.WithContext(ctx).Where("transcription_id = ?", transcriptionID).Order("start_time ASC").Find(&segments).Error
+	err := database.Conn(ctx, r.db).Where("transcription_id = ?", transcriptionID).Order("start_time ASC").Find(&segments).Error
 	return segments, err
 }
 
@@ -101,7 +101,7 @@ func (r *GormTranscriptionRepository) UpdateSegment(ctx context.Context, segment
 		return fmt.Errorf("segment ID is required for update")
 	}
 
-	result := r.db.WithContext(ctx).Save(segment)
+	result := database.Conn(ctx, r.db).Save(segment)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -114,14 +114,14 @@ func (r *GormTranscriptionRepository) UpdateSegment(ctx context.Context, segment
 // FindByStatus retrieves transcriptions by status
 func (r *GormTranscriptionRepository) FindByStatus(ctx context.Context, status entities.TranscriptionStatus) ([]*entities.Transcription, error) {
 	var transcriptions []*entities.Transcription
-	err := r.db.WithContext(ctx).Where("status = ?", string(status)).Find(&transcriptions).Error
+	err := database.Conn(ctx, r.db).Where("status = ?", string(status)).Find(&transcriptions).Error
 	return transcriptions, err
 }
 
 // FindByProvider retrieves transcriptions by provider
 func (r *GormTranscriptionRepository) FindByProvider(ctx context.Context, provider string) ([]*entities.Transcription, error) {
 	var transcriptions []*entities.Transcription
-	err := r.db.WithContext(ctx).Where("provider = ?", provider).Find(&transcriptions).Error
+	err := database.Conn(ctx, r.db).Where("provider = ?", provider).Find(&transcriptions).Error
 	return transcriptions, err
 }
 
@@ -149,7 +149,7 @@ func (r *GormTranscriptionRepository) GetTranscriptionStats(ctx context.Context,
 		GROUP BY t.meeting_id
 	`
 
-	err := r.db.WithContext(ctx).Raw(query, meetingID).Scan(stats).Error
+	err := database.Conn(ctx, r.db).Raw(query, meetingID).Scan(stats).Error
 	if err != nil {
 		// Return empty stats if no data found
 		return &repositories.TranscriptionStats{}, nil
@@ -161,6 +161,6 @@ func (r *GormTranscriptionRepository) GetTranscriptionStats(ctx context.Context,
 // GetSegmentCount returns the number of segments for a transcription
 func (r *GormTranscriptionRepository) GetSegmentCount(ctx context.Context, transcriptionID string) (int, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entities.TranscriptSegment{}).Where("transcription_id = ?", transcriptionID).Count(&count).Error
+	err := database.Conn(ctx, r.db).Model(&entities.TranscriptSegment{}).Where("transcription_id = ?", transcriptionID).Count(&count).Error
 	return int(count), err
 }
@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormVocabularyRepository implements VocabularyRepository using GORM
+type GormVocabularyRepository struct {
+	db *gorm.DB
+}
+
+// NewGormVocabularyRepository creates a new GORM vocabulary repository
+func NewGormVocabularyRepository() *GormVocabularyRepository {
+	return &GormVocabularyRepository{db: database.GetDB()}
+}
+
+// Save creates a new vocabulary
+func (r *GormVocabularyRepository) Save(ctx context.Context, vocabulary *entities.Vocabulary) error {
+	return r.db.WithContext(ctx).Create(vocabulary).Error
+}
+
+// Update saves changes to an existing vocabulary
+func (r *GormVocabularyRepository) Update(ctx context.Context, vocabulary *entities.Vocabulary) error {
+	return r.db.WithContext(ctx).Save(vocabulary).Error
+}
+
+// Delete removes a vocabulary
+func (r *GormVocabularyRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&entities.Vocabulary{}, "id = ?", id).Error
+}
+
+// FindByID retrieves a vocabulary by ID
+func (r *GormVocabularyRepository) FindByID(ctx context.Context, id string) (*entities.Vocabulary, error) {
+	var vocabulary entities.Vocabulary
+	err := r.db.WithContext(ctx).First(&vocabulary, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &vocabulary, nil
+}
+
+// FindByUserID retrieves all vocabularies of a user
+func (r *GormVocabularyRepository) FindByUserID(ctx context.Context, userID string) ([]*entities.Vocabulary, error) {
+	var vocabularies []*entities.Vocabulary
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("name ASC").Find(&vocabularies).Error
+	return vocabularies, err
+}
+
+// FindForMeetingType retrieves the user's vocabularies that apply to a meeting type
+func (r *GormVocabularyRepository) FindForMeetingType(ctx context.Context, userID, meetingType string) ([]*entities.Vocabulary, error) {
+	var vocabularies []*entities.Vocabulary
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND (meeting_type = '' OR meeting_type = ?)", userID, meetingType).
+		Order("meeting_type ASC, created_at ASC").
+		Find(&vocabularies).Error
+	return vocabularies, err
+}
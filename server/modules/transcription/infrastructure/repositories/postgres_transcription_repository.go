@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -230,8 +231,8 @@ func (r *PostgresTranscriptionRepository) SaveSegments(ctx context.Context, tran
 
 	// Insert new segments
 	query := `
-		INSERT INTO transcript_segments (id, transcription_id, speaker, text, start_time, end_time, confidence, sequence_number, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO transcript_segments (id, transcription_id, speaker, text, start_time, end_time, confidence, sequence_number, redactions, words, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 	`
 
 	for _, segment := range segments {
@@ -246,6 +247,15 @@ func (r *PostgresTranscriptionRepository) SaveSegments(ctx context.Context, tran
 			segment.UpdatedAt = now
 		}
 
+		redactions, err := json.Marshal(segment.Redactions)
+		if err != nil {
+			return err
+		}
+		words, err := json.Marshal(segment.Words)
+		if err != nil {
+			return err
+		}
+
 		_, err = tx.ExecContext(ctx, query,
 			segment.ID,
 			transcriptionID,
@@ -255,6 +265,8 @@ func (r *PostgresTranscriptionRepository) SaveSegments(ctx context.Context, tran
 			segment.EndTime,
 			segment.Confidence,
 			segment.SequenceNumber,
+			redactions,
+			words,
 			segment.CreatedAt,
 			segment.UpdatedAt,
 		)
@@ -269,7 +281,7 @@ func (r *PostgresTranscriptionRepository) SaveSegments(ctx context.Context, tran
 // FindSegmentsByTranscriptionID retrieves all segments for a transcription
 func (r *PostgresTranscriptionRepository) FindSegmentsByTranscriptionID(ctx context.Context, transcriptionID string) ([]entities.TranscriptSegment, error) {
 	query := `
-		SELECT id, transcription_id, speaker, text, start_time, end_time, confidence, sequence_number, created_at, updated_at
+		SELECT id, transcription_id, speaker, text, start_time, end_time, confidence, sequence_number, redactions, words, created_at, updated_at
 		FROM transcript_segments
 		WHERE transcription_id = $1
 		ORDER BY sequence_number ASC
@@ -287,6 +299,7 @@ func (r *PostgresTranscriptionRepository) FindSegmentsByTranscriptionID(ctx cont
 		var segment entities.TranscriptSegment
 		var speaker sql.NullString
 		var confidence sql.NullFloat64
+		var redactions, words []byte
 
 		err := rows.Scan(
 			&segment.ID,
@@ -297,6 +310,8 @@ func (r *PostgresTranscriptionRepository) FindSegmentsByTranscriptionID(ctx cont
 			&segment.EndTime,
 			&confidence,
 			&segment.SequenceNumber,
+			&redactions,
+			&words,
 			&segment.CreatedAt,
 			&segment.UpdatedAt,
 		)
@@ -310,6 +325,16 @@ func (r *PostgresTranscriptionRepository) FindSegmentsByTranscriptionID(ctx cont
 		if confidence.Valid {
 			segment.Confidence = confidence.Float64
 		}
+		if len(redactions) > 0 {
+			if err := json.Unmarshal(redactions, &segment.Redactions); err != nil {
+				return nil, err
+			}
+		}
+		if len(words) > 0 {
+			if err := json.Unmarshal(words, &segment.Words); err != nil {
+				return nil, err
+			}
+		}
 
 		segments = append(segments, segment)
 	}
@@ -0,0 +1,177 @@
+package summarizers
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+	"teammate/server/modules/transcription/infrastructure/embedders"
+)
+
+// Ensure ExtractiveSummarizer implements Summarizer
+var _ services.Summarizer = (*ExtractiveSummarizer)(nil)
+
+const (
+	// overviewSentences is the number of sentences in an extractive overview
+	overviewSentences = 3
+	// maxKeyPoints is the largest number of key points in a summary
+	maxKeyPoints = 5
+	// maxDecisions is the largest number of decisions in a summary
+	maxDecisions = 5
+	// minSentenceTokens is the fewest meaningful words a sentence needs to be selected
+	minSentenceTokens = 4
+)
+
+// sentenceBoundary splits text after sentence-ending punctuation
+var sentenceBoundary = regexp.MustCompile(`([.!?])\s+`)
+
+// decisionCues are phrases that mark a sentence as a decision
+var decisionCues = regexp.MustCompile(`\b(we decided|decided to|decision is|final decision|we agreed|agreed to|agreed that|let's go with|we'll go with|we will go with|going with|approved|we're going to go|sign(ed)? off on)\b`)
+
+// ExtractiveSummarizer summarizes any transcript locally by selecting its most representative
+// sentences, ranked by the frequency of their words across the transcript. It needs no external
+// service and ignores the answer format.
+type ExtractiveSummarizer struct{}
+
+// NewExtractiveSummarizer creates an extractive summarizer
+func NewExtractiveSummarizer() *ExtractiveSummarizer {
+	return &ExtractiveSummarizer{}
+}
+
+// Supports returns true for every transcription
+func (s *ExtractiveSummarizer) Supports(transcription *entities.Transcription) bool {
+	return true
+}
+
+// sentence is a transcript sentence and its position
+type sentence struct {
+	index  int
+	text   string
+	tokens []string
+	score  float64
+}
+
+// Summarize selects the highest scoring sentences as the overview and key points, and the
+// sentences stating decisions as decisions
+func (s *ExtractiveSummarizer) Summarize(ctx context.Context, request services.SummaryRequest) (*services.SummaryContent, error) {
+	sentences := splitSentences(request.Segments)
+	scoreSentences(sentences)
+
+	ranked := make([]*sentence, 0, len(sentences))
+	for _, candidate := range sentences {
+		if len(candidate.tokens) >= minSentenceTokens && !strings.HasSuffix(candidate.text, "?") {
+			ranked = append(ranked, candidate)
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+
+	seen := make(map[string]bool)
+	var overview, keyPoints []*sentence
+	for _, candidate := range ranked {
+		key := strings.Join(candidate.tokens, " ")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if len(overview) < overviewSentences {
+			overview = append(overview, candidate)
+		} else if len(keyPoints) < maxKeyPoints {
+			keyPoints = append(keyPoints, candidate)
+		} else {
+			break
+		}
+	}
+
+	content := &services.SummaryContent{
+		Overview:  strings.Join(inTranscriptOrder(overview), " "),
+		KeyPoints: inTranscriptOrder(keyPoints),
+		Decisions: []string{},
+		Method:    entities.ExtractiveSummaryMethod,
+	}
+
+	seenDecisions := make(map[string]bool)
+	for _, candidate := range sentences {
+		if len(content.Decisions) == maxDecisions {
+			break
+		}
+		lower := strings.ToLower(candidate.text)
+		if strings.HasSuffix(lower, "?") || !decisionCues.MatchString(lower) || seenDecisions[lower] {
+			continue
+		}
+		seenDecisions[lower] = true
+		content.Decisions = append(content.Decisions, candidate.text)
+	}
+
+	return content, nil
+}
+
+// splitSentences splits the text of the segments into sentences
+func splitSentences(segments []entities.TranscriptSegment) []*sentence {
+	var sentences []*sentence
+	for _, segment := range segments {
+		text := sentenceBoundary.ReplaceAllString(strings.TrimSpace(segment.Text), "$1\n")
+		for _, part := range strings.Split(text, "\n") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			sentences = append(sentences, &sentence{
+				index:  len(sentences),
+				text:   part,
+				tokens: embedders.Tokenize(part),
+			})
+		}
+	}
+	return sentences
+}
+
+// scoreSentences scores each sentence by the normalized frequency of its distinct words across
+// the transcript, dampened by its length so long rambling sentences do not always win
+func scoreSentences(sentences []*sentence) {
+	frequency := make(map[string]int)
+	maxFrequency := 0
+	for _, candidate := range sentences {
+		for _, token := range candidate.tokens {
+			frequency[token]++
+			if frequency[token] > maxFrequency {
+				maxFrequency = frequency[token]
+			}
+		}
+	}
+	if maxFrequency == 0 {
+		return
+	}
+
+	for _, candidate := range sentences {
+		distinct := make(map[string]bool, len(candidate.tokens))
+		total := 0.0
+		for _, token := range candidate.tokens {
+			if !distinct[token] {
+				distinct[token] = true
+				total += float64(frequency[token]) / float64(maxFrequency)
+			}
+		}
+		if len(candidate.tokens) > 0 {
+			candidate.score = total / math.Sqrt(float64(len(candidate.tokens)))
+		}
+	}
+}
+
+// inTranscriptOrder returns the text of sentences in the order they were said
+func inTranscriptOrder(sentences []*sentence) []string {
+	sorted := append([]*sentence(nil), sentences...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].index < sorted[j].index
+	})
+	texts := make([]string, len(sorted))
+	for i, candidate := range sorted {
+		texts[i] = candidate.text
+	}
+	return texts
+}
@@ -0,0 +1,122 @@
+package summarizers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
+)
+
+// Ensure LemurSummarizer implements Summarizer
+var _ services.Summarizer = (*LemurSummarizer)(nil)
+
+const (
+	// DefaultSummaryAnswerFormat is the overview format used when none is requested
+	DefaultSummaryAnswerFormat = "A short paragraph of three to five sentences"
+
+	lemurSummaryContext = "This is a transcript of a work meeting."
+	keyPointsQuestion   = "What were the key points discussed in the meeting?"
+	decisionsQuestion   = "What decisions were made in the meeting?"
+	listAnswerFormat    = "One item per line without numbering or bullet characters. Answer None if there are none."
+)
+
+// listMarker matches the bullets and numbering LeMUR sometimes adds to list items
+var listMarker = regexp.MustCompile(`^\s*(?:[-*•]+|\d+[.)])\s*`)
+
+// LemurClient is the part of the AssemblyAI client used for LeMUR
+type LemurClient interface {
+	LemurSummary(ctx context.Context, request *assemblyai.LemurSummaryRequest) (*assemblyai.LemurResponse, error)
+	LemurQuestionAnswer(ctx context.Context, request *assemblyai.LemurQuestionAnswerRequest) (*assemblyai.LemurQuestionAnswerResponse, error)
+}
+
+// LemurSummarizer summarizes AssemblyAI transcripts with LeMUR. The overview follows the requested
+// answer format; key points and decisions are asked for as lists. LeMUR reads AssemblyAI's copy of
+// the transcript, so edits made to the transcript afterwards are not reflected.
+type LemurSummarizer struct {
+	client LemurClient
+	model  string
+}
+
+// NewLemurSummarizer creates a LeMUR summarizer. An empty model uses AssemblyAI's default.
+func NewLemurSummarizer(client LemurClient, model string) *LemurSummarizer {
+	return &LemurSummarizer{client: client, model: model}
+}
+
+// Supports returns true for transcriptions made by AssemblyAI
+func (s *LemurSummarizer) Supports(transcription *entities.Transcription) bool {
+	return transcription.Provider == "assemblyai" && transcription.ProviderTranscriptID != ""
+}
+
+// Summarize asks LeMUR for an overview, then for the key points and decisions
+func (s *LemurSummarizer) Summarize(ctx context.Context, request services.SummaryRequest) (*services.SummaryContent, error) {
+	transcriptIDs := []string{request.Transcription.ProviderTranscriptID}
+	answerFormat := request.AnswerFormat
+	if answerFormat == "" {
+		answerFormat = DefaultSummaryAnswerFormat
+	}
+
+	summary, err := s.client.LemurSummary(ctx, &assemblyai.LemurSummaryRequest{
+		TranscriptIDs: transcriptIDs,
+		Context:       assemblyai.String(lemurSummaryContext),
+		AnswerFormat:  assemblyai.String(answerFormat),
+		FinalModel:    s.finalModel(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("LeMUR summary failed: %w", err)
+	}
+
+	answers, err := s.client.LemurQuestionAnswer(ctx, &assemblyai.LemurQuestionAnswerRequest{
+		TranscriptIDs: transcriptIDs,
+		Context:       assemblyai.String(lemurSummaryContext),
+		Questions: []assemblyai.LemurQuestion{
+			{Question: keyPointsQuestion, AnswerFormat: assemblyai.String(listAnswerFormat)},
+			{Question: decisionsQuestion, AnswerFormat: assemblyai.String(listAnswerFormat)},
+		},
+		FinalModel: s.finalModel(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("LeMUR questions failed: %w", err)
+	}
+
+	content := &services.SummaryContent{
+		Overview:  strings.TrimSpace(summary.Response),
+		KeyPoints: []string{},
+		Decisions: []string{},
+		Method:    entities.LemurSummaryMethod,
+		Model:     s.model,
+	}
+	for _, answer := range answers.Response {
+		switch answer.Question {
+		case keyPointsQuestion:
+			content.KeyPoints = ParseListAnswer(answer.Answer)
+		case decisionsQuestion:
+			content.Decisions = ParseListAnswer(answer.Answer)
+		}
+	}
+	return content, nil
+}
+
+func (s *LemurSummarizer) finalModel() *string {
+	if s.model == "" {
+		return nil
+	}
+	return assemblyai.String(s.model)
+}
+
+// ParseListAnswer splits a LeMUR list answer into items, dropping bullets, numbering and "None"
+func ParseListAnswer(answer string) []string {
+	items := []string{}
+	for _, line := range strings.Split(answer, "\n") {
+		item := strings.TrimSpace(listMarker.ReplaceAllString(line, ""))
+		if item == "" || strings.EqualFold(strings.TrimRight(item, "."), "none") {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items
+}
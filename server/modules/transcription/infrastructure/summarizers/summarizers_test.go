@@ -0,0 +1,102 @@
+package summarizers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	assemblyai "github.com/therealchrisrock/assemblyai-go"
+)
+
+// stubLemurClient answers LeMUR requests with fixed responses and records the requests
+type stubLemurClient struct {
+	summaryRequest  *assemblyai.LemurSummaryRequest
+	questionRequest *assemblyai.LemurQuestionAnswerRequest
+	err             error
+}
+
+func (c *stubLemurClient) LemurSummary(ctx context.Context, request *assemblyai.LemurSummaryRequest) (*assemblyai.LemurResponse, error) {
+	c.summaryRequest = request
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &assemblyai.LemurResponse{Response: "  The team planned the release.  "}, nil
+}
+
+func (c *stubLemurClient) LemurQuestionAnswer(ctx context.Context, request *assemblyai.LemurQuestionAnswerRequest) (*assemblyai.LemurQuestionAnswerResponse, error) {
+	c.questionRequest = request
+	return &assemblyai.LemurQuestionAnswerResponse{Response: []assemblyai.LemurAnswer{
+		{Question: keyPointsQuestion, Answer: "- Release is on Friday\n- QA needs two more days"},
+		{Question: decisionsQuestion, Answer: "None."},
+	}}, nil
+}
+
+func TestLemurSummarizer_Summarize(t *testing.T) {
+	client := &stubLemurClient{}
+	summarizer := NewLemurSummarizer(client, "anthropic/claude-3-5-sonnet")
+
+	transcription := entities.NewTranscription("meeting-1", "", "assemblyai")
+	assert.False(t, summarizer.Supports(&transcription))
+	transcription.ProviderTranscriptID = "aai-1"
+	assert.True(t, summarizer.Supports(&transcription))
+
+	content, err := summarizer.Summarize(context.Background(), services.SummaryRequest{Transcription: &transcription})
+	require.NoError(t, err)
+	assert.Equal(t, "The team planned the release.", content.Overview)
+	assert.Equal(t, []string{"Release is on Friday", "QA needs two more days"}, content.KeyPoints)
+	assert.Empty(t, content.Decisions)
+	assert.Equal(t, entities.LemurSummaryMethod, content.Method)
+	assert.Equal(t, "anthropic/claude-3-5-sonnet", content.Model)
+
+	assert.Equal(t, []string{"aai-1"}, client.summaryRequest.TranscriptIDs)
+	assert.Equal(t, DefaultSummaryAnswerFormat, *client.summaryRequest.AnswerFormat)
+	assert.Equal(t, "anthropic/claude-3-5-sonnet", *client.summaryRequest.FinalModel)
+	assert.Len(t, client.questionRequest.Questions, 2)
+}
+
+func TestLemurSummarizer_SummarizeError(t *testing.T) {
+	summarizer := NewLemurSummarizer(&stubLemurClient{err: errors.New("quota exceeded")}, "")
+	transcription := entities.NewTranscription("meeting-1", "", "assemblyai")
+	transcription.ProviderTranscriptID = "aai-1"
+
+	_, err := summarizer.Summarize(context.Background(), services.SummaryRequest{Transcription: &transcription, AnswerFormat: "bullet points"})
+	assert.Error(t, err)
+}
+
+func TestParseListAnswer(t *testing.T) {
+	assert.Equal(t, []string{"First", "Second", "Third"}, ParseListAnswer("1. First\n2) Second\n\n• Third\n"))
+	assert.Empty(t, ParseListAnswer("None"))
+	assert.Empty(t, ParseListAnswer(""))
+}
+
+func TestExtractiveSummarizer_Summarize(t *testing.T) {
+	segments := []entities.TranscriptSegment{
+		{Speaker: "Anna", Text: "The release of the mobile app is planned for Friday. Testing the release build takes two days."},
+		{Speaker: "Ben", Text: "Can we move it?"},
+		{Speaker: "Anna", Text: "The mobile release needs the new login screen first. We decided to ship the login screen on Wednesday."},
+		{Speaker: "Ben", Text: "Okay. Marketing will announce the mobile app release next week."},
+		{Speaker: "Anna", Text: "Lunch was nice today."},
+	}
+
+	content, err := NewExtractiveSummarizer().Summarize(context.Background(), services.SummaryRequest{Segments: segments})
+	require.NoError(t, err)
+	assert.Equal(t, entities.ExtractiveSummaryMethod, content.Method)
+	assert.Contains(t, content.Overview, "release")
+	assert.NotContains(t, content.Overview, "Can we move it?")
+	assert.NotContains(t, content.Overview, "Okay.")
+	assert.Equal(t, []string{"We decided to ship the login screen on Wednesday."}, content.Decisions)
+	assert.LessOrEqual(t, len(content.KeyPoints), maxKeyPoints)
+}
+
+func TestExtractiveSummarizer_EmptyTranscript(t *testing.T) {
+	content, err := NewExtractiveSummarizer().Summarize(context.Background(), services.SummaryRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, content.Overview)
+	assert.Empty(t, content.KeyPoints)
+	assert.Empty(t, content.Decisions)
+}
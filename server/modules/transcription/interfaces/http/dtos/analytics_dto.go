@@ -0,0 +1,11 @@
+package dtos
+
+// AnalyticsTrendsRequest represents the query string of an analytics trends request
+type AnalyticsTrendsRequest struct {
+	From        string `form:"from"`
+	To          string `form:"to"`
+	Interval    string `form:"interval" binding:"omitempty,oneof=day week month"`
+	Scope       string `form:"scope" binding:"omitempty,oneof=all owned team"`
+	TeamID      string `form:"team_id"`
+	MeetingType string `form:"meeting_type" binding:"omitempty,oneof=zoom google_meet microsoft_teams generic"`
+}
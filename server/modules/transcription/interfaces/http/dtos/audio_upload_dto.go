@@ -0,0 +1,123 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/services"
+	jobEntities "teammate/server/seedwork/domain/entities"
+)
+
+// UploadAudioRequest represents the form fields sent with an uploaded recording
+type UploadAudioRequest struct {
+	MeetingID          string     `form:"meeting_id"`
+	Title              string     `form:"title"`
+	StartTime          *time.Time `form:"start_time" time_format:"2006-01-02T15:04:05Z07:00"`
+	Format             string     `form:"format"`
+	Provider           string     `form:"provider"`
+	Language           string     `form:"language"`
+	SpeakerDiarization *bool      `form:"speaker_diarization"`
+}
+
+// UploadAudioURLRequest represents the request to transcribe a recording fetched from a URL
+type UploadAudioURLRequest struct {
+	URL                string     `json:"url" binding:"required,url"`
+	MeetingID          string     `json:"meeting_id"`
+	Title              string     `json:"title"`
+	StartTime          *time.Time `json:"start_time"`
+	Format             string     `json:"format"`
+	Provider           string     `json:"provider"`
+	Language           string     `json:"language"`
+	SpeakerDiarization *bool      `json:"speaker_diarization"`
+}
+
+// TranscribeJobResultResponse represents the transcription produced by a transcribe job
+type TranscribeJobResultResponse struct {
+	TranscriptionID string `json:"transcription_id"`
+	MeetingID       string `json:"meeting_id"`
+	SegmentCount    int    `json:"segment_count"`
+}
+
+// TranscribeJobResponse represents a recording being transcribed in the background
+type TranscribeJobResponse struct {
+	ID              string                          `json:"id"`
+	TranscriptionID string                          `json:"transcription_id"`
+	MeetingID       string                          `json:"meeting_id"`
+	MeetingCreated  bool                            `json:"meeting_created"`
+	Source          string                          `json:"source"`
+	FileName        string                          `json:"file_name,omitempty"`
+	Format          string                          `json:"format"`
+	Status          jobEntities.ProcessingJobStatus `json:"status"`
+	Error           string                          `json:"error,omitempty"`
+	Result          *TranscribeJobResultResponse    `json:"result,omitempty"`
+	ScheduledAt     *time.Time                      `json:"scheduled_at"`
+	StartedAt       *time.Time                      `json:"started_at,omitempty"`
+	CompletedAt     *time.Time                      `json:"completed_at,omitempty"`
+}
+
+// ToUploadAudioCommand converts an UploadAudioRequest DTO and the uploaded file's details to a command.
+// Speakers are identified unless diarization is turned off.
+func ToUploadAudioCommand(req UploadAudioRequest, userID, fileName, contentType string) commands.UploadAudioCommand {
+	return commands.UploadAudioCommand{
+		UserID:             userID,
+		MeetingID:          req.MeetingID,
+		Title:              req.Title,
+		StartTime:          req.StartTime,
+		FileName:           fileName,
+		ContentType:        contentType,
+		Format:             req.Format,
+		Provider:           req.Provider,
+		Language:           req.Language,
+		SpeakerDiarization: req.SpeakerDiarization == nil || *req.SpeakerDiarization,
+	}
+}
+
+// ToUploadAudioURLCommand converts an UploadAudioURLRequest DTO to a command
+func ToUploadAudioURLCommand(req UploadAudioURLRequest, userID string) commands.UploadAudioCommand {
+	return commands.UploadAudioCommand{
+		UserID:             userID,
+		MeetingID:          req.MeetingID,
+		Title:              req.Title,
+		StartTime:          req.StartTime,
+		Format:             req.Format,
+		Provider:           req.Provider,
+		Language:           req.Language,
+		SpeakerDiarization: req.SpeakerDiarization == nil || *req.SpeakerDiarization,
+	}
+}
+
+// ToTranscribeJobResponse converts a transcribe ProcessingJob to TranscribeJobResponse DTO
+func ToTranscribeJobResponse(job *jobEntities.ProcessingJob) TranscribeJobResponse {
+	response := TranscribeJobResponse{
+		ID:              job.GetID(),
+		TranscriptionID: job.EntityID,
+		MeetingID:       payloadString(job, "meeting_id"),
+		Source:          payloadString(job, "source"),
+		FileName:        payloadString(job, "file_name"),
+		Format:          payloadString(job, "format"),
+		Status:          job.Status,
+		Error:           job.ErrorMessage,
+		ScheduledAt:     job.ScheduledAt,
+		StartedAt:       job.StartedAt,
+		CompletedAt:     job.CompletedAt,
+	}
+	if created, ok := job.GetPayloadValue("meeting_created"); ok {
+		response.MeetingCreated = created == true
+	}
+
+	if result := services.AudioUploadJobResult(job); result != nil {
+		response.Result = &TranscribeJobResultResponse{
+			TranscriptionID: result.TranscriptionID,
+			MeetingID:       result.MeetingID,
+			SegmentCount:    result.SegmentCount,
+		}
+	}
+	return response
+}
+
+// payloadString returns a string value from a job's payload, or "" when it is missing
+func payloadString(job *jobEntities.ProcessingJob, key string) string {
+	value, _ := job.GetPayloadValue(key)
+	text, _ := value.(string)
+	return text
+}
@@ -0,0 +1,132 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/application/services"
+	jobEntities "teammate/server/seedwork/domain/entities"
+)
+
+// ExportRequest represents the request to export a transcription.
+// Long transcriptions, or any export with async set, are exported by a background job.
+type ExportRequest struct {
+	Format            string                 `json:"format" binding:"required"`
+	Title             string                 `json:"title"`
+	IncludeMetadata   bool                   `json:"include_metadata"`
+	IncludeSpeakers   bool                   `json:"include_speakers"`
+	IncludeTimestamps bool                   `json:"include_timestamps"`
+	IncludeStats      bool                   `json:"include_stats"`
+	Template          string                 `json:"template"`
+	CustomFields      map[string]interface{} `json:"custom_fields"`
+	MaxLineLength     int                    `json:"max_line_length" binding:"omitempty,min=10,max=200"`
+	MaxLines          int                    `json:"max_lines" binding:"omitempty,min=1,max=5"`
+	Async             bool                   `json:"async"`
+}
+
+// ArchiveExportRequest represents the request to export all of the user's meetings as a zip archive
+type ArchiveExportRequest struct {
+	Format       string `json:"format"` // Human-readable transcript format, defaults to md
+	Template     string `json:"template"`
+	IncludeAudio bool   `json:"include_audio"`
+}
+
+// ExportResultResponse represents a finished export
+type ExportResultResponse struct {
+	DownloadURL string                 `json:"download_url"`
+	FileName    string                 `json:"file_name"`
+	FileSize    int64                  `json:"file_size"`
+	Format      string                 `json:"format"`
+	ExpiresAt   time.Time              `json:"expires_at"`
+	GeneratedAt time.Time              `json:"generated_at"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ExportJobResponse represents a background export or archive
+type ExportJobResponse struct {
+	ID              string                          `json:"id"`
+	Type            string                          `json:"type"`
+	TranscriptionID string                          `json:"transcription_id,omitempty"`
+	Format          string                          `json:"format"`
+	Status          jobEntities.ProcessingJobStatus `json:"status"`
+	Error           string                          `json:"error,omitempty"`
+	Progress        *services.ArchiveProgress       `json:"progress,omitempty"`
+	Result          *ExportResultResponse           `json:"result,omitempty"`
+	Expired         bool                            `json:"expired"`
+	ScheduledAt     *time.Time                      `json:"scheduled_at"`
+	StartedAt       *time.Time                      `json:"started_at,omitempty"`
+	CompletedAt     *time.Time                      `json:"completed_at,omitempty"`
+}
+
+// ExportFormatsResponse lists the supported export formats
+type ExportFormatsResponse struct {
+	Formats []string `json:"formats"`
+}
+
+// ToExportOptions converts an ExportRequest DTO to export options
+func ToExportOptions(req ExportRequest) services.ExportOptions {
+	return services.ExportOptions{
+		Format:            req.Format,
+		Title:             req.Title,
+		IncludeMetadata:   req.IncludeMetadata,
+		IncludeSpeakers:   req.IncludeSpeakers,
+		IncludeTimestamps: req.IncludeTimestamps,
+		IncludeStats:      req.IncludeStats,
+		Template:          req.Template,
+		CustomFields:      req.CustomFields,
+		MaxLineLength:     req.MaxLineLength,
+		MaxLines:          req.MaxLines,
+	}
+}
+
+// ToArchiveOptions converts an ArchiveExportRequest DTO to archive options
+func ToArchiveOptions(req ArchiveExportRequest) services.ArchiveOptions {
+	return services.ArchiveOptions{
+		Format:       req.Format,
+		Template:     req.Template,
+		IncludeAudio: req.IncludeAudio,
+	}
+}
+
+// ToExportResultResponse converts an ExportResult to ExportResultResponse DTO
+func ToExportResultResponse(result *services.ExportResult) ExportResultResponse {
+	return ExportResultResponse{
+		DownloadURL: result.DownloadURL,
+		FileName:    result.FileName,
+		FileSize:    result.FileSize,
+		Format:      result.Format,
+		ExpiresAt:   result.ExpiresAt,
+		GeneratedAt: result.GeneratedAt,
+		Metadata:    result.Metadata,
+	}
+}
+
+// ToExportJobResponse converts an export or archive ProcessingJob to ExportJobResponse DTO.
+// The download link of a finished job is left out once it has expired.
+func ToExportJobResponse(job *jobEntities.ProcessingJob) ExportJobResponse {
+	format, _ := job.GetPayloadValue("format")
+	formatName, _ := format.(string)
+	response := ExportJobResponse{
+		ID:          job.GetID(),
+		Type:        job.JobType,
+		Format:      formatName,
+		Status:      job.Status,
+		Error:       job.ErrorMessage,
+		Progress:    services.ArchiveJobProgress(job),
+		ScheduledAt: job.ScheduledAt,
+		StartedAt:   job.StartedAt,
+		CompletedAt: job.CompletedAt,
+	}
+	if job.JobType == jobEntities.ExportJobType {
+		response.TranscriptionID = job.EntityID
+	}
+
+	if result := services.ExportJobResult(job); result != nil {
+		if time.Now().After(result.ExpiresAt) {
+			response.Expired = true
+		} else {
+			resultResponse := ToExportResultResponse(result)
+			response.Result = &resultResponse
+		}
+	}
+	return response
+}
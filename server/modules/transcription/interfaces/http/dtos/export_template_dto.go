@@ -0,0 +1,78 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+	"teammate/server/modules/transcription/domain/services"
+)
+
+// ExportTemplateRequest represents the request to upload or replace an export template.
+// The body is a Go text/template; HTML templates are escaped like html/template.
+type ExportTemplateRequest struct {
+	Name        string                        `json:"name" binding:"required"`
+	Description string                        `json:"description"`
+	Format      entities.ExportTemplateFormat `json:"format" binding:"required,oneof=markdown html"`
+	Body        string                        `json:"body" binding:"required"`
+}
+
+// ExportTemplateResponse represents a user-uploaded export template
+type ExportTemplateResponse struct {
+	ID          string                        `json:"id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	Format      entities.ExportTemplateFormat `json:"format"`
+	Body        string                        `json:"body"`
+	CreatedAt   time.Time                     `json:"created_at"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
+}
+
+// BuiltinExportTemplateResponse represents a template shipped with the server
+type BuiltinExportTemplateResponse struct {
+	Name        string                          `json:"name"`
+	Description string                          `json:"description"`
+	Formats     []entities.ExportTemplateFormat `json:"formats"`
+}
+
+// ExportTemplatesListResponse represents the templates available to a user
+type ExportTemplatesListResponse struct {
+	Builtin   []BuiltinExportTemplateResponse `json:"builtin"`
+	Templates []ExportTemplateResponse        `json:"templates"`
+	Total     int                             `json:"total"`
+}
+
+// ToExportTemplateResponse converts an ExportTemplate entity to ExportTemplateResponse DTO
+func ToExportTemplateResponse(template *entities.ExportTemplate) ExportTemplateResponse {
+	return ExportTemplateResponse{
+		ID:          template.GetID(),
+		Name:        template.Name,
+		Description: template.Description,
+		Format:      template.Format,
+		Body:        template.Body,
+		CreatedAt:   template.GetCreatedAt(),
+		UpdatedAt:   template.GetUpdatedAt(),
+	}
+}
+
+// ToExportTemplatesListResponse converts built-in and user templates to ExportTemplatesListResponse DTO
+func ToExportTemplatesListResponse(builtin []services.BuiltinExportTemplate, templates []*entities.ExportTemplate) ExportTemplatesListResponse {
+	builtinResponses := make([]BuiltinExportTemplateResponse, len(builtin))
+	for i, template := range builtin {
+		builtinResponses[i] = BuiltinExportTemplateResponse{
+			Name:        template.Name,
+			Description: template.Description,
+			Formats:     []entities.ExportTemplateFormat{entities.MarkdownTemplate, entities.HTMLTemplate},
+		}
+	}
+
+	responses := make([]ExportTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = ToExportTemplateResponse(template)
+	}
+
+	return ExportTemplatesListResponse{
+		Builtin:   builtinResponses,
+		Templates: responses,
+		Total:     len(builtin) + len(templates),
+	}
+}
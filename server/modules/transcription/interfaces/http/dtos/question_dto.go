@@ -0,0 +1,95 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// AskQuestionRequest represents a question about a meeting
+type AskQuestionRequest struct {
+	Question string `json:"question" binding:"required"`
+}
+
+// QuestionHistoryRequest represents the query string of a question history page
+type QuestionHistoryRequest struct {
+	Limit  int `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int `form:"offset" binding:"omitempty,min=0"`
+}
+
+// CitationResponse represents a transcript segment an answer is based on
+type CitationResponse struct {
+	SegmentID       string  `json:"segment_id"`
+	TranscriptionID string  `json:"transcription_id"`
+	Speaker         string  `json:"speaker,omitempty"`
+	Text            string  `json:"text"`
+	StartTime       float64 `json:"start_time"`
+	EndTime         float64 `json:"end_time"`
+	DeepLink        string  `json:"deep_link"`
+}
+
+// QuestionResponse represents an answered question about a meeting
+type QuestionResponse struct {
+	ID        string                `json:"id"`
+	MeetingID string                `json:"meeting_id"`
+	UserID    string                `json:"user_id"`
+	Question  string                `json:"question"`
+	Answer    string                `json:"answer"`
+	Citations []CitationResponse    `json:"citations"`
+	Method    entities.AnswerMethod `json:"method"`
+	Model     string                `json:"model,omitempty"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+// QuestionHistoryResponse represents a page of the questions asked about a meeting, newest first
+type QuestionHistoryResponse struct {
+	Questions []QuestionResponse `json:"questions"`
+	Total     int64              `json:"total"`
+	Limit     int                `json:"limit"`
+	Offset    int                `json:"offset"`
+}
+
+// ToQuestionResponse converts a MeetingQuestion to QuestionResponse DTO
+func ToQuestionResponse(question *entities.MeetingQuestion) QuestionResponse {
+	citations := make([]CitationResponse, len(question.Citations))
+	for i, citation := range question.Citations {
+		citations[i] = CitationResponse{
+			SegmentID:       citation.SegmentID,
+			TranscriptionID: citation.TranscriptionID,
+			Speaker:         citation.Speaker,
+			Text:            citation.Text,
+			StartTime:       citation.StartTime,
+			EndTime:         citation.EndTime,
+			DeepLink:        queries.SegmentDeepLink(question.MeetingID, citation.TranscriptionID, citation.SegmentID, citation.StartTime),
+		}
+	}
+
+	return QuestionResponse{
+		ID:        question.GetID(),
+		MeetingID: question.MeetingID,
+		UserID:    question.UserID,
+		Question:  question.Question,
+		Answer:    question.Answer,
+		Citations: citations,
+		Method:    question.Method,
+		Model:     question.Model,
+		CreatedAt: question.GetCreatedAt(),
+	}
+}
+
+// ToQuestionHistoryResponse converts a QuestionHistory to QuestionHistoryResponse DTO
+func ToQuestionHistoryResponse(history *services.QuestionHistory) QuestionHistoryResponse {
+	questions := make([]QuestionResponse, len(history.Questions))
+	for i, question := range history.Questions {
+		questions[i] = ToQuestionResponse(question)
+	}
+
+	return QuestionHistoryResponse{
+		Questions: questions,
+		Total:     history.Total,
+		Limit:     history.Limit,
+		Offset:    history.Offset,
+	}
+}
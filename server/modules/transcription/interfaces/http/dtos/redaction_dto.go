@@ -0,0 +1,39 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// RedactionSettingsRequest represents the request to change the default PII redaction.
+// Enabling redaction without policies redacts emails, phone numbers, card numbers and SSNs.
+type RedactionSettingsRequest struct {
+	RedactPII      bool                 `json:"redact_pii"`
+	RedactPIIAudio bool                 `json:"redact_pii_audio"`
+	PIIPolicies    []entities.PIIPolicy `json:"pii_policies"`
+}
+
+// RedactionSettingsResponse represents the default PII redaction of a user
+type RedactionSettingsResponse struct {
+	RedactPII      bool                 `json:"redact_pii"`
+	RedactPIIAudio bool                 `json:"redact_pii_audio"`
+	PIIPolicies    []entities.PIIPolicy `json:"pii_policies"`
+	// LocalPolicies are the policies redacted for providers without native redaction
+	LocalPolicies []entities.PIIPolicy `json:"local_policies"`
+	UpdatedAt     *time.Time           `json:"updated_at,omitempty"`
+}
+
+// ToRedactionSettingsResponse converts RedactionSettings to RedactionSettingsResponse DTO
+func ToRedactionSettingsResponse(settings *entities.RedactionSettings, localPolicies []entities.PIIPolicy) RedactionSettingsResponse {
+	response := RedactionSettingsResponse{
+		RedactPII:      settings.RedactPII,
+		RedactPIIAudio: settings.RedactPIIAudio,
+		PIIPolicies:    settings.PIIPolicies,
+		LocalPolicies:  localPolicies,
+	}
+	if updatedAt := settings.GetUpdatedAt(); !updatedAt.IsZero() {
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
@@ -0,0 +1,99 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/domain/entities"
+	jobEntities "teammate/server/seedwork/domain/entities"
+)
+
+// RegenerateSummaryRequest represents the request to summarize a transcription again.
+// The answer format describes how the overview should be written and is only followed by LeMUR.
+type RegenerateSummaryRequest struct {
+	AnswerFormat string `json:"answer_format"`
+}
+
+// SummaryResponse represents the structured summary of a transcription
+type SummaryResponse struct {
+	ID              string                 `json:"id"`
+	TranscriptionID string                 `json:"transcription_id"`
+	MeetingID       string                 `json:"meeting_id"`
+	Overview        string                 `json:"overview"`
+	KeyPoints       []string               `json:"key_points"`
+	Decisions       []string               `json:"decisions"`
+	AnswerFormat    string                 `json:"answer_format,omitempty"`
+	Method          entities.SummaryMethod `json:"method"`
+	Model           string                 `json:"model,omitempty"`
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
+}
+
+// SummaryJobResultResponse represents the summary produced by a summarize job
+type SummaryJobResultResponse struct {
+	SummaryID string                 `json:"summary_id"`
+	Method    entities.SummaryMethod `json:"method"`
+}
+
+// SummaryJobResponse represents a transcription being summarized in the background
+type SummaryJobResponse struct {
+	ID              string                          `json:"id"`
+	TranscriptionID string                          `json:"transcription_id"`
+	MeetingID       string                          `json:"meeting_id"`
+	AnswerFormat    string                          `json:"answer_format,omitempty"`
+	Status          jobEntities.ProcessingJobStatus `json:"status"`
+	Error           string                          `json:"error,omitempty"`
+	Result          *SummaryJobResultResponse       `json:"result,omitempty"`
+	ScheduledAt     *time.Time                      `json:"scheduled_at"`
+	StartedAt       *time.Time                      `json:"started_at,omitempty"`
+	CompletedAt     *time.Time                      `json:"completed_at,omitempty"`
+}
+
+// ToSummaryResponse converts a TranscriptSummary to SummaryResponse DTO
+func ToSummaryResponse(summary *entities.TranscriptSummary) SummaryResponse {
+	keyPoints := summary.KeyPoints
+	if keyPoints == nil {
+		keyPoints = []string{}
+	}
+	decisions := summary.Decisions
+	if decisions == nil {
+		decisions = []string{}
+	}
+
+	return SummaryResponse{
+		ID:              summary.GetID(),
+		TranscriptionID: summary.TranscriptionID,
+		MeetingID:       summary.MeetingID,
+		Overview:        summary.Overview,
+		KeyPoints:       keyPoints,
+		Decisions:       decisions,
+		AnswerFormat:    summary.AnswerFormat,
+		Method:          summary.Method,
+		Model:           summary.Model,
+		CreatedAt:       summary.GetCreatedAt(),
+		UpdatedAt:       summary.GetUpdatedAt(),
+	}
+}
+
+// ToSummaryJobResponse converts a summarize ProcessingJob to SummaryJobResponse DTO
+func ToSummaryJobResponse(job *jobEntities.ProcessingJob) SummaryJobResponse {
+	response := SummaryJobResponse{
+		ID:              job.GetID(),
+		TranscriptionID: job.EntityID,
+		MeetingID:       payloadString(job, "meeting_id"),
+		AnswerFormat:    payloadString(job, "answer_format"),
+		Status:          job.Status,
+		Error:           job.ErrorMessage,
+		ScheduledAt:     job.ScheduledAt,
+		StartedAt:       job.StartedAt,
+		CompletedAt:     job.CompletedAt,
+	}
+
+	if result := services.SummaryJobResult(job); result != nil {
+		response.Result = &SummaryJobResultResponse{
+			SummaryID: result.SummaryID,
+			Method:    result.Method,
+		}
+	}
+	return response
+}
@@ -0,0 +1,155 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// TaxonomyTermDTO represents a weighted keyword or phrase and its synonyms
+type TaxonomyTermDTO struct {
+	Term     string   `json:"term" binding:"required"`
+	Weight   float64  `json:"weight,omitempty" binding:"omitempty,min=0,max=10"`
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// TaxonomyTopicDTO represents a topic and the terms that detect it
+type TaxonomyTopicDTO struct {
+	Name  string            `json:"name" binding:"required"`
+	Terms []TaxonomyTermDTO `json:"terms" binding:"required,min=1,dive"`
+}
+
+// SentimentLexiconDTO represents the words that make a segment positive or negative
+type SentimentLexiconDTO struct {
+	Positive []TaxonomyTermDTO `json:"positive" binding:"dive"`
+	Negative []TaxonomyTermDTO `json:"negative" binding:"dive"`
+}
+
+// TaxonomyRequest represents the request to replace an analytics taxonomy.
+// An empty sentiment lexicon uses the built-in sentiment words; stemming defaults to on.
+type TaxonomyRequest struct {
+	Name        string              `json:"name" binding:"required"`
+	Description string              `json:"description"`
+	Topics      []TaxonomyTopicDTO  `json:"topics" binding:"dive"`
+	Sentiment   SentimentLexiconDTO `json:"sentiment"`
+	Stemming    *bool               `json:"stemming"`
+}
+
+// CreateTaxonomyRequest represents the request to create an analytics taxonomy. With from_default,
+// missing topics and sentiment words are copied from the built-in taxonomy.
+type CreateTaxonomyRequest struct {
+	TaxonomyRequest
+	// TeamID shares the taxonomy with a team the user owns instead of keeping it personal
+	TeamID      string `json:"team_id"`
+	FromDefault bool   `json:"from_default"`
+	Activate    bool   `json:"activate"`
+}
+
+// TaxonomyResponse represents an analytics taxonomy. A team taxonomy is active when its team uses it.
+type TaxonomyResponse struct {
+	ID          string              `json:"id"`
+	TeamID      string              `json:"team_id,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Topics      []TaxonomyTopicDTO  `json:"topics"`
+	Sentiment   SentimentLexiconDTO `json:"sentiment"`
+	Stemming    bool                `json:"stemming"`
+	Active      bool                `json:"active"`
+	Builtin     bool                `json:"builtin"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+}
+
+// TaxonomiesListResponse represents the taxonomies available to a user. ActiveID is the taxonomy
+// used for the meetings they own.
+type TaxonomiesListResponse struct {
+	Builtin    TaxonomyResponse   `json:"builtin"`
+	Taxonomies []TaxonomyResponse `json:"taxonomies"`
+	ActiveID   string             `json:"active_id"`
+	Total      int                `json:"total"`
+}
+
+// StemmingEnabled returns the requested stemming setting, on unless disabled
+func (r TaxonomyRequest) StemmingEnabled() bool {
+	return r.Stemming == nil || *r.Stemming
+}
+
+// ToTaxonomyTopics converts topic DTOs to domain topics
+func ToTaxonomyTopics(topics []TaxonomyTopicDTO) []entities.TaxonomyTopic {
+	result := make([]entities.TaxonomyTopic, len(topics))
+	for i, topic := range topics {
+		result[i] = entities.TaxonomyTopic{Name: topic.Name, Terms: toTaxonomyTerms(topic.Terms)}
+	}
+	return result
+}
+
+// ToSentimentLexicon converts a sentiment lexicon DTO to a domain lexicon
+func ToSentimentLexicon(lexicon SentimentLexiconDTO) entities.SentimentLexicon {
+	return entities.SentimentLexicon{
+		Positive: toTaxonomyTerms(lexicon.Positive),
+		Negative: toTaxonomyTerms(lexicon.Negative),
+	}
+}
+
+// ToTaxonomyResponse converts a Taxonomy entity to TaxonomyResponse DTO. The built-in taxonomy has no timestamps.
+func ToTaxonomyResponse(taxonomy *entities.Taxonomy, builtin bool) TaxonomyResponse {
+	topics := make([]TaxonomyTopicDTO, len(taxonomy.Topics))
+	for i, topic := range taxonomy.Topics {
+		topics[i] = TaxonomyTopicDTO{Name: topic.Name, Terms: fromTaxonomyTerms(topic.Terms)}
+	}
+
+	response := TaxonomyResponse{
+		ID:          taxonomy.GetID(),
+		Name:        taxonomy.Name,
+		Description: taxonomy.Description,
+		Topics:      topics,
+		Sentiment: SentimentLexiconDTO{
+			Positive: fromTaxonomyTerms(taxonomy.Sentiment.Positive),
+			Negative: fromTaxonomyTerms(taxonomy.Sentiment.Negative),
+		},
+		Stemming: taxonomy.Stemming,
+		Active:   taxonomy.Active,
+		Builtin:  builtin,
+	}
+	if taxonomy.IsTeamTaxonomy() {
+		response.TeamID = *taxonomy.TeamID
+	}
+	if !builtin {
+		createdAt, updatedAt := taxonomy.GetCreatedAt(), taxonomy.GetUpdatedAt()
+		response.CreatedAt = &createdAt
+		response.UpdatedAt = &updatedAt
+	}
+	return response
+}
+
+// ToTaxonomiesListResponse converts the built-in, user and team taxonomies to TaxonomiesListResponse DTO
+func ToTaxonomiesListResponse(builtin entities.Taxonomy, taxonomies []*entities.Taxonomy, activeID string) TaxonomiesListResponse {
+	response := TaxonomiesListResponse{
+		Taxonomies: make([]TaxonomyResponse, len(taxonomies)),
+		ActiveID:   activeID,
+		Total:      len(taxonomies) + 1,
+	}
+	for i, taxonomy := range taxonomies {
+		response.Taxonomies[i] = ToTaxonomyResponse(taxonomy, false)
+	}
+
+	builtin.Active = response.ActiveID == builtin.GetID()
+	response.Builtin = ToTaxonomyResponse(&builtin, true)
+	return response
+}
+
+func toTaxonomyTerms(terms []TaxonomyTermDTO) []entities.TaxonomyTerm {
+	result := make([]entities.TaxonomyTerm, len(terms))
+	for i, term := range terms {
+		result[i] = entities.TaxonomyTerm{Term: term.Term, Weight: term.Weight, Synonyms: term.Synonyms}
+	}
+	return result
+}
+
+func fromTaxonomyTerms(terms []entities.TaxonomyTerm) []TaxonomyTermDTO {
+	result := make([]TaxonomyTermDTO, len(terms))
+	for i, term := range terms {
+		result[i] = TaxonomyTermDTO{Term: term.Term, Weight: term.Weight, Synonyms: term.Synonyms}
+	}
+	return result
+}
@@ -0,0 +1,155 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// EditSegmentRequest represents the request to change a segment's text and/or speaker
+type EditSegmentRequest struct {
+	Text    *string `json:"text,omitempty"`
+	Speaker *string `json:"speaker,omitempty"`
+}
+
+// SplitSegmentRequest represents the request to split a segment in two.
+// Offset is the character position in the text where the second segment starts.
+type SplitSegmentRequest struct {
+	Offset    int      `json:"offset" binding:"required,min=1"`
+	SplitTime *float64 `json:"split_time,omitempty"`
+}
+
+// MergeSegmentsRequest represents the request to merge adjacent segments
+type MergeSegmentsRequest struct {
+	SegmentIDs []string `json:"segment_ids" binding:"required,min=2"`
+	Speaker    *string  `json:"speaker,omitempty"`
+}
+
+// SegmentResponse represents a transcript segment
+type SegmentResponse struct {
+	ID             string  `json:"id"`
+	Speaker        string  `json:"speaker"`
+	Text           string  `json:"text"`
+	StartTime      float64 `json:"start_time"`
+	EndTime        float64 `json:"end_time"`
+	Confidence     float64 `json:"confidence"`
+	SequenceNumber int     `json:"sequence_number"`
+}
+
+// SegmentsResponse represents the current segments of a transcription
+type SegmentsResponse struct {
+	TranscriptionID string            `json:"transcription_id"`
+	Segments        []SegmentResponse `json:"segments"`
+	Total           int               `json:"total"`
+}
+
+// RevisionSummaryResponse represents a revision without its full snapshot
+type RevisionSummaryResponse struct {
+	RevisionNumber int                       `json:"revision_number"`
+	EditType       entities.RevisionEditType `json:"edit_type"`
+	EditedBy       string                    `json:"edited_by,omitempty"`
+	SegmentIDs     []string                  `json:"segment_ids"`
+	RestoredFrom   *int                      `json:"restored_from,omitempty"`
+	CreatedAt      time.Time                 `json:"created_at"`
+}
+
+// RevisionResponse represents a revision including before/after segments and the full snapshot
+type RevisionResponse struct {
+	RevisionSummaryResponse
+	Before   []SegmentResponse `json:"before"`
+	After    []SegmentResponse `json:"after"`
+	Snapshot []SegmentResponse `json:"snapshot"`
+}
+
+// RevisionsListResponse represents the edit history of a transcription
+type RevisionsListResponse struct {
+	TranscriptionID string                    `json:"transcription_id"`
+	Revisions       []RevisionSummaryResponse `json:"revisions"`
+	Total           int                       `json:"total"`
+}
+
+// TranscriptEditResponse represents the result of an edit
+type TranscriptEditResponse struct {
+	TranscriptionID string                  `json:"transcription_id"`
+	Content         string                  `json:"content"`
+	Confidence      float64                 `json:"confidence"`
+	Segments        []SegmentResponse       `json:"segments"`
+	Revision        RevisionSummaryResponse `json:"revision"`
+}
+
+// ToSegmentResponse converts a TranscriptSegment entity to SegmentResponse DTO
+func ToSegmentResponse(segment entities.TranscriptSegment) SegmentResponse {
+	return SegmentResponse{
+		ID:             segment.GetID(),
+		Speaker:        segment.Speaker,
+		Text:           segment.Text,
+		StartTime:      segment.StartTime,
+		EndTime:        segment.EndTime,
+		Confidence:     segment.Confidence,
+		SequenceNumber: segment.SequenceNumber,
+	}
+}
+
+// ToSegmentResponses converts a slice of TranscriptSegment entities to SegmentResponse DTOs
+func ToSegmentResponses(segments []entities.TranscriptSegment) []SegmentResponse {
+	responses := make([]SegmentResponse, len(segments))
+	for i, segment := range segments {
+		responses[i] = ToSegmentResponse(segment)
+	}
+	return responses
+}
+
+// ToSegmentsResponse converts the segments of a transcription to SegmentsResponse DTO
+func ToSegmentsResponse(transcriptionID string, segments []entities.TranscriptSegment) SegmentsResponse {
+	return SegmentsResponse{
+		TranscriptionID: transcriptionID,
+		Segments:        ToSegmentResponses(segments),
+		Total:           len(segments),
+	}
+}
+
+// ToRevisionSummaryResponse converts a TranscriptRevision entity to RevisionSummaryResponse DTO
+func ToRevisionSummaryResponse(revision *entities.TranscriptRevision) RevisionSummaryResponse {
+	return RevisionSummaryResponse{
+		RevisionNumber: revision.RevisionNumber,
+		EditType:       revision.EditType,
+		EditedBy:       revision.EditedBy,
+		SegmentIDs:     revision.SegmentIDs,
+		RestoredFrom:   revision.RestoredFrom,
+		CreatedAt:      revision.GetCreatedAt(),
+	}
+}
+
+// ToRevisionResponse converts a TranscriptRevision entity to RevisionResponse DTO
+func ToRevisionResponse(revision *entities.TranscriptRevision) RevisionResponse {
+	return RevisionResponse{
+		RevisionSummaryResponse: ToRevisionSummaryResponse(revision),
+		Before:                  ToSegmentResponses(revision.Before),
+		After:                   ToSegmentResponses(revision.After),
+		Snapshot:                ToSegmentResponses(revision.Snapshot),
+	}
+}
+
+// ToRevisionsListResponse converts a slice of TranscriptRevision entities to RevisionsListResponse DTO
+func ToRevisionsListResponse(transcriptionID string, revisions []*entities.TranscriptRevision) RevisionsListResponse {
+	summaries := make([]RevisionSummaryResponse, len(revisions))
+	for i, revision := range revisions {
+		summaries[i] = ToRevisionSummaryResponse(revision)
+	}
+	return RevisionsListResponse{
+		TranscriptionID: transcriptionID,
+		Revisions:       summaries,
+		Total:           len(revisions),
+	}
+}
+
+// ToTranscriptEditResponse converts an edited transcription and its new revision to TranscriptEditResponse DTO
+func ToTranscriptEditResponse(transcription *entities.Transcription, revision *entities.TranscriptRevision) TranscriptEditResponse {
+	return TranscriptEditResponse{
+		TranscriptionID: transcription.GetID(),
+		Content:         transcription.Content,
+		Confidence:      transcription.Confidence,
+		Segments:        ToSegmentResponses(transcription.Segments),
+		Revision:        ToRevisionSummaryResponse(revision),
+	}
+}
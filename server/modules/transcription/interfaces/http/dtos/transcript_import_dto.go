@@ -0,0 +1,58 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/application/commands"
+)
+
+// ImportTranscriptRequest represents the form fields sent with an imported transcript file
+type ImportTranscriptRequest struct {
+	Format          string     `form:"format" binding:"omitempty,oneof=srt vtt json"`
+	MeetingID       string     `form:"meeting_id"`
+	Title           string     `form:"title"`
+	StartTime       *time.Time `form:"start_time" time_format:"2006-01-02T15:04:05Z07:00"`
+	SpeakerPrefixes bool       `form:"speaker_prefixes"`
+}
+
+// ImportTranscriptResponse represents an imported transcript
+type ImportTranscriptResponse struct {
+	TranscriptionID string   `json:"transcription_id"`
+	MeetingID       string   `json:"meeting_id"`
+	MeetingCreated  bool     `json:"meeting_created"`
+	Format          string   `json:"format"`
+	SegmentCount    int      `json:"segment_count"`
+	Speakers        []string `json:"speakers"`
+	Duration        float64  `json:"duration"`
+}
+
+// ToImportTranscriptCommand converts an ImportTranscriptRequest DTO and the uploaded file to a command
+func ToImportTranscriptCommand(req ImportTranscriptRequest, userID, fileName string, data []byte) commands.ImportTranscriptCommand {
+	return commands.ImportTranscriptCommand{
+		UserID:          userID,
+		MeetingID:       req.MeetingID,
+		Title:           req.Title,
+		StartTime:       req.StartTime,
+		FileName:        fileName,
+		Format:          req.Format,
+		SpeakerPrefixes: req.SpeakerPrefixes,
+		Data:            data,
+	}
+}
+
+// ToImportTranscriptResponse converts an ImportTranscriptResult to ImportTranscriptResponse DTO
+func ToImportTranscriptResponse(result *commands.ImportTranscriptResult) ImportTranscriptResponse {
+	speakers := result.Speakers
+	if speakers == nil {
+		speakers = []string{}
+	}
+	return ImportTranscriptResponse{
+		TranscriptionID: result.TranscriptionID,
+		MeetingID:       result.MeetingID,
+		MeetingCreated:  result.MeetingCreated,
+		Format:          result.Format,
+		SegmentCount:    result.SegmentCount,
+		Speakers:        speakers,
+		Duration:        result.Duration,
+	}
+}
@@ -0,0 +1,159 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/application/queries"
+)
+
+// SearchTranscriptsRequest represents the query string of a transcript search
+type SearchTranscriptsRequest struct {
+	Query       string `form:"q" binding:"required"`
+	MeetingID   string `form:"meeting_id"`
+	Speaker     string `form:"speaker"`
+	MeetingType string `form:"meeting_type" binding:"omitempty,oneof=zoom google_meet microsoft_teams generic"`
+	From        string `form:"from"`
+	To          string `form:"to"`
+	Limit       int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset      int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// SearchHitResponse represents a single search hit
+type SearchHitResponse struct {
+	SegmentID        string    `json:"segment_id"`
+	TranscriptionID  string    `json:"transcription_id"`
+	MeetingID        string    `json:"meeting_id"`
+	MeetingTitle     string    `json:"meeting_title"`
+	MeetingType      string    `json:"meeting_type"`
+	MeetingStartTime time.Time `json:"meeting_start_time"`
+	Speaker          string    `json:"speaker"`
+	Text             string    `json:"text"`
+	Snippet          string    `json:"snippet"`
+	StartTime        float64   `json:"start_time"`
+	EndTime          float64   `json:"end_time"`
+	Rank             float64   `json:"rank"`
+	DeepLink         string    `json:"deep_link"`
+}
+
+// SearchTranscriptsResponse represents the response of a transcript search
+type SearchTranscriptsResponse struct {
+	Query   string              `json:"query"`
+	Results []SearchHitResponse `json:"results"`
+	Total   int64               `json:"total"`
+	Limit   int                 `json:"limit"`
+	Offset  int                 `json:"offset"`
+}
+
+// ToSearchTranscriptsResponse converts a search result to SearchTranscriptsResponse DTO
+func ToSearchTranscriptsResponse(query string, result *queries.SearchTranscriptsResult) SearchTranscriptsResponse {
+	hits := make([]SearchHitResponse, len(result.Results))
+	for i, hit := range result.Results {
+		hits[i] = SearchHitResponse{
+			SegmentID:        hit.SegmentID,
+			TranscriptionID:  hit.TranscriptionID,
+			MeetingID:        hit.MeetingID,
+			MeetingTitle:     hit.MeetingTitle,
+			MeetingType:      hit.MeetingType,
+			MeetingStartTime: hit.MeetingStartTime,
+			Speaker:          hit.Speaker,
+			Text:             hit.Text,
+			Snippet:          hit.Snippet,
+			StartTime:        hit.StartTime,
+			EndTime:          hit.EndTime,
+			Rank:             hit.Rank,
+			DeepLink:         hit.DeepLink,
+		}
+	}
+
+	return SearchTranscriptsResponse{
+		Query:   query,
+		Results: hits,
+		Total:   result.Total,
+		Limit:   result.Limit,
+		Offset:  result.Offset,
+	}
+}
+
+// SearchPassagesRequest represents the query string of a passage search
+type SearchPassagesRequest struct {
+	Query     string `form:"q" binding:"required"`
+	MeetingID string `form:"meeting_id"`
+	TopK      int    `form:"k" binding:"omitempty,min=1,max=50"`
+}
+
+// SimilarPassagesRequest represents the query string of a similar passages search
+type SimilarPassagesRequest struct {
+	ChunkID   string `form:"chunk_id"`
+	SegmentID string `form:"segment_id"`
+	MeetingID string `form:"meeting_id"`
+	TopK      int    `form:"k" binding:"omitempty,min=1,max=50"`
+}
+
+// PassageResponse represents a single ranked passage
+type PassageResponse struct {
+	ChunkID         string   `json:"chunk_id"`
+	TranscriptionID string   `json:"transcription_id"`
+	MeetingID       string   `json:"meeting_id"`
+	Text            string   `json:"text"`
+	Speakers        []string `json:"speakers"`
+	SegmentIDs      []string `json:"segment_ids"`
+	StartTime       float64  `json:"start_time"`
+	EndTime         float64  `json:"end_time"`
+	Score           float64  `json:"score"`
+	DeepLink        string   `json:"deep_link"`
+}
+
+// SearchPassagesResponse represents the response of a passage search
+type SearchPassagesResponse struct {
+	Query    string            `json:"query"`
+	Embedder string            `json:"embedder"`
+	Passages []PassageResponse `json:"passages"`
+}
+
+// SimilarPassagesResponse represents the response of a similar passages search
+type SimilarPassagesResponse struct {
+	Source   PassageResponse   `json:"source"`
+	Embedder string            `json:"embedder"`
+	Passages []PassageResponse `json:"passages"`
+}
+
+// ToSearchPassagesResponse converts a passage search result to SearchPassagesResponse DTO
+func ToSearchPassagesResponse(query string, result *queries.SearchPassagesResult) SearchPassagesResponse {
+	return SearchPassagesResponse{
+		Query:    query,
+		Embedder: result.Embedder,
+		Passages: toPassageResponses(result.Passages),
+	}
+}
+
+// ToSimilarPassagesResponse converts a similar passages result to SimilarPassagesResponse DTO
+func ToSimilarPassagesResponse(result *queries.SimilarPassagesResult) SimilarPassagesResponse {
+	return SimilarPassagesResponse{
+		Source:   toPassageResponse(*result.Source),
+		Embedder: result.Embedder,
+		Passages: toPassageResponses(result.Passages),
+	}
+}
+
+func toPassageResponses(passages []queries.PassageResult) []PassageResponse {
+	responses := make([]PassageResponse, len(passages))
+	for i, passage := range passages {
+		responses[i] = toPassageResponse(passage)
+	}
+	return responses
+}
+
+func toPassageResponse(passage queries.PassageResult) PassageResponse {
+	return PassageResponse{
+		ChunkID:         passage.ChunkID,
+		TranscriptionID: passage.TranscriptionID,
+		MeetingID:       passage.MeetingID,
+		Text:            passage.Text,
+		Speakers:        passage.Speakers,
+		SegmentIDs:      passage.SegmentIDs,
+		StartTime:       passage.StartTime,
+		EndTime:         passage.EndTime,
+		Score:           passage.Score,
+		DeepLink:        passage.DeepLink,
+	}
+}
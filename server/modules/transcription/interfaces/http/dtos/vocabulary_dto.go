@@ -0,0 +1,82 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/transcription/domain/entities"
+)
+
+// SpellingRuleDTO represents a spelling replacement
+type SpellingRuleDTO struct {
+	From []string `json:"from" binding:"required,min=1"`
+	To   string   `json:"to" binding:"required"`
+}
+
+// VocabularyRequest represents the request to create or replace a custom vocabulary.
+// An empty meeting type applies the vocabulary to all of the user's meetings.
+type VocabularyRequest struct {
+	Name        string              `json:"name" binding:"required"`
+	MeetingType string              `json:"meeting_type" binding:"omitempty,oneof=zoom google_meet microsoft_teams generic"`
+	Terms       []string            `json:"terms"`
+	BoostParam  entities.BoostLevel `json:"boost_param" binding:"omitempty,oneof=low default high"`
+	Spellings   []SpellingRuleDTO   `json:"spellings" binding:"dive"`
+}
+
+// VocabularyResponse represents a custom vocabulary
+type VocabularyResponse struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	MeetingType string              `json:"meeting_type,omitempty"`
+	Terms       []string            `json:"terms"`
+	BoostParam  entities.BoostLevel `json:"boost_param"`
+	Spellings   []SpellingRuleDTO   `json:"spellings"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// VocabulariesListResponse represents the response containing a user's vocabularies
+type VocabulariesListResponse struct {
+	Vocabularies []VocabularyResponse `json:"vocabularies"`
+	Total        int                  `json:"total"`
+}
+
+// ToSpellingRules converts spelling rule DTOs to domain spelling rules
+func ToSpellingRules(spellings []SpellingRuleDTO) []entities.SpellingRule {
+	rules := make([]entities.SpellingRule, len(spellings))
+	for i, spelling := range spellings {
+		rules[i] = entities.SpellingRule{From: spelling.From, To: spelling.To}
+	}
+	return rules
+}
+
+// ToVocabularyResponse converts a Vocabulary entity to VocabularyResponse DTO
+func ToVocabularyResponse(vocabulary *entities.Vocabulary) VocabularyResponse {
+	spellings := make([]SpellingRuleDTO, len(vocabulary.Spellings))
+	for i, spelling := range vocabulary.Spellings {
+		spellings[i] = SpellingRuleDTO{From: spelling.From, To: spelling.To}
+	}
+
+	return VocabularyResponse{
+		ID:          vocabulary.GetID(),
+		Name:        vocabulary.Name,
+		MeetingType: vocabulary.MeetingType,
+		Terms:       vocabulary.Terms,
+		BoostParam:  vocabulary.BoostParam,
+		Spellings:   spellings,
+		CreatedAt:   vocabulary.GetCreatedAt(),
+		UpdatedAt:   vocabulary.GetUpdatedAt(),
+	}
+}
+
+// ToVocabulariesListResponse converts a slice of Vocabulary entities to VocabulariesListResponse DTO
+func ToVocabulariesListResponse(vocabularies []*entities.Vocabulary) VocabulariesListResponse {
+	responses := make([]VocabularyResponse, len(vocabularies))
+	for i, vocabulary := range vocabularies {
+		responses[i] = ToVocabularyResponse(vocabulary)
+	}
+
+	return VocabulariesListResponse{
+		Vocabularies: responses,
+		Total:        len(vocabularies),
+	}
+}
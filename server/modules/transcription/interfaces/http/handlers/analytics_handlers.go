@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	analyticsNotFoundCodes   = []string{"UNAUTHORIZED", "MEETING_NOT_FOUND", "TRANSCRIPTION_NOT_FOUND", "NO_TRANSCRIPT_SEGMENTS", "TEAM_NOT_FOUND"}
+	analyticsBadRequestCodes = []string{"INVALID_TRENDS_QUERY"}
+)
+
+// AnalyticsHandlers contains HTTP handlers for transcript analytics
+type AnalyticsHandlers struct {
+	analyticsService *services.TranscriptAnalyticsService
+}
+
+// NewAnalyticsHandlers creates a new analytics handlers instance
+func NewAnalyticsHandlers(analyticsService *services.TranscriptAnalyticsService) *AnalyticsHandlers {
+	return &AnalyticsHandlers{
+		analyticsService: analyticsService,
+	}
+}
+
+// GetTranscriptionAnalytics returns the analytics of a transcription
+// @Summary Get transcription analytics
+// @Description Get speaker, topic, sentiment, keyword, timing and quality analytics of a transcription in a meeting the authenticated user can view. Results are cached until the transcript changes.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Success 200 {object} services.AnalyticsData
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/analytics [get]
+func (h *AnalyticsHandlers) GetTranscriptionAnalytics(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	analytics, err := h.analyticsService.GetTranscriptionAnalytics(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get transcription analytics", analyticsNotFoundCodes, analyticsBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, analytics)
+}
+
+// GetMeetingAnalytics returns an analytics summary of a meeting
+// @Summary Get meeting analytics summary
+// @Description Get a summary of the analytics of the latest completed transcription of a meeting the authenticated user can view: duration, participation per speaker, top topics and keywords, sentiment, quality and insights.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Success 200 {object} services.MeetingAnalyticsSummary
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/analytics [get]
+func (h *AnalyticsHandlers) GetMeetingAnalytics(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	summary, err := h.analyticsService.GetMeetingSummary(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get meeting analytics", analyticsNotFoundCodes, analyticsBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// GetAnalyticsTrends returns analytics aggregated across the user's meetings
+// @Summary Get analytics trends
+// @Description Get time series of analytics across meetings in a date range, bucketed by day, week or month: meeting hours, meetings per type, per-speaker talk share, recurring topics and sentiment. Built from per-meeting analytics snapshots taken when transcripts complete or change. Covers meetings owned by or shared with the authenticated user, or only owned ones with scope=owned. With scope=team and a team_id it covers the meetings owned by the members of one of the user's teams.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "Meetings starting on or after this date (RFC3339 or YYYY-MM-DD, default 90 days before to)"
+// @Param to query string false "Meetings starting on or before this date (RFC3339 or YYYY-MM-DD, default now)"
+// @Param interval query string false "Period length (default week)" Enums(day, week, month)
+// @Param scope query string false "Meetings to include (default all)" Enums(all, owned, team)
+// @Param team_id query string false "Team whose members' meetings are included; required with scope=team"
+// @Param meeting_type query string false "Restrict to a meeting type" Enums(zoom, google_meet, microsoft_teams, generic)
+// @Success 200 {object} queries.AnalyticsTrendsResult
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/trends [get]
+func (h *AnalyticsHandlers) GetAnalyticsTrends(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.AnalyticsTrendsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, err := parseDateParam(req.From, false)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from date"})
+		return
+	}
+	to, err := parseDateParam(req.To, true)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to date"})
+		return
+	}
+
+	trends, err := h.analyticsService.GetTrends(c.Request.Context(), queries.GetAnalyticsTrendsQuery{
+		UserID:      userID,
+		From:        from,
+		To:          to,
+		Interval:    req.Interval,
+		Scope:       req.Scope,
+		TeamID:      req.TeamID,
+		MeetingType: req.MeetingType,
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get analytics trends", analyticsNotFoundCodes, analyticsBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, trends)
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	audioUploadNotFoundCodes   = []string{"UNAUTHORIZED", "MEETING_NOT_FOUND", "TRANSCRIBE_JOB_NOT_FOUND"}
+	audioUploadBadRequestCodes = []string{"UNSUPPORTED_AUDIO_FORMAT", "UNSUPPORTED_PROVIDER", "INVALID_AUDIO_URL", "AUDIO_URL_UNAVAILABLE", "EMPTY_AUDIO_FILE", "AUDIO_FILE_TOO_LARGE"}
+)
+
+// AudioUploadHandlers contains HTTP handlers for transcribing pre-recorded audio files
+type AudioUploadHandlers struct {
+	uploadService *services.AudioUploadService
+}
+
+// NewAudioUploadHandlers creates a new audio upload handlers instance
+func NewAudioUploadHandlers(uploadService *services.AudioUploadService) *AudioUploadHandlers {
+	return &AudioUploadHandlers{
+		uploadService: uploadService,
+	}
+}
+
+// UploadAudio uploads a recording for transcription
+// @Summary Upload a recording
+// @Description Upload a pre-recorded audio file to be transcribed in the background, in a new meeting or in an existing meeting the authenticated user can edit. The format must be supported by the provider. Poll the returned job for progress.
+// @Tags transcriptions
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Audio file (max 500MB)"
+// @Param format formData string false "Audio format; detected from the file name or content type when omitted"
+// @Param provider formData string false "Transcription provider (default assemblyai)"
+// @Param language formData string false "Language code"
+// @Param speaker_diarization formData bool false "Identify speakers (default true)"
+// @Param meeting_id formData string false "Meeting to add the recording to"
+// @Param title formData string false "Title of the created meeting"
+// @Param start_time formData string false "Start of the created meeting (RFC 3339)"
+// @Success 202 {object} dtos.TranscribeJobResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 413 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/upload [post]
+func (h *AudioUploadHandlers) UploadAudio(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, services.MaxAudioUploadSize+1<<20)
+
+	var req dtos.UploadAudioRequest
+	if err := c.ShouldBind(&req); err != nil {
+		respondWithUploadError(c, err, "Audio file is too large")
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		respondWithUploadError(c, err, "Audio file is too large")
+		return
+	}
+	if header.Size > services.MaxAudioUploadSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Audio file is too large"})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	cmd := dtos.ToUploadAudioCommand(req, userID, header.Filename, header.Header.Get("Content-Type"))
+	upload, err := h.uploadService.Upload(c.Request.Context(), cmd, file)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to upload audio", audioUploadNotFoundCodes, audioUploadBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dtos.ToTranscribeJobResponse(upload.Job))
+}
+
+// UploadAudioFromURL transcribes a recording fetched from a URL
+// @Summary Transcribe a recording from a URL
+// @Description Download a pre-recorded audio file from a public http or https URL and transcribe it in the background, in a new meeting or in an existing meeting the authenticated user can edit. URLs pointing to private, loopback or link-local addresses are rejected.
+// @Tags transcriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dtos.UploadAudioURLRequest true "Recording URL and options"
+// @Success 202 {object} dtos.TranscribeJobResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/upload/url [post]
+func (h *AudioUploadHandlers) UploadAudioFromURL(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.UploadAudioURLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	upload, err := h.uploadService.UploadFromURL(c.Request.Context(), dtos.ToUploadAudioURLCommand(req, userID), req.URL)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to queue audio download", audioUploadNotFoundCodes, audioUploadBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dtos.ToTranscribeJobResponse(upload.Job))
+}
+
+// GetTranscribeJob returns the status of a recording being transcribed
+// @Summary Get a transcribe job
+// @Description Get the status of an uploaded or fetched recording being transcribed for the authenticated user
+// @Tags transcriptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcribe job ID"
+// @Success 200 {object} dtos.TranscribeJobResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/upload/jobs/{id} [get]
+func (h *AudioUploadHandlers) GetTranscribeJob(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.uploadService.GetJob(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get transcribe job", audioUploadNotFoundCodes, audioUploadBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTranscribeJobResponse(job))
+}
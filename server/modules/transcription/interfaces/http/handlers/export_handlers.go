@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	exportNotFoundCodes   = []string{"UNAUTHORIZED", "MEETING_NOT_FOUND", "TRANSCRIPTION_NOT_FOUND", "EXPORT_JOB_NOT_FOUND", "EXPORT_TEMPLATE_NOT_FOUND", "DOCUMENT_NOT_FOUND", "DOCUMENT_LINK_EXPIRED"}
+	exportBadRequestCodes = []string{"INVALID_EXPORT_OPTIONS", "INVALID_ARCHIVE_OPTIONS", "AUDIO_EXPORT_UNAVAILABLE", "INVALID_EXPORT_TEMPLATE", "EXPORT_TOO_LARGE"}
+)
+
+// DocumentFiles resolves signed download links of locally stored documents to file paths
+type DocumentFiles interface {
+	Open(fileName, expires, signature string) (string, error)
+}
+
+// ExportHandlers contains HTTP handlers for exporting transcriptions
+type ExportHandlers struct {
+	exportService *services.TranscriptExportService
+	documentFiles DocumentFiles
+}
+
+// NewExportHandlers creates a new export handlers instance.
+// documentFiles may be nil when documents are not stored locally.
+func NewExportHandlers(exportService *services.TranscriptExportService, documentFiles DocumentFiles) *ExportHandlers {
+	return &ExportHandlers{
+		exportService: exportService,
+		documentFiles: documentFiles,
+	}
+}
+
+// ExportTranscription exports a transcription
+// @Summary Export a transcription
+// @Description Export a transcription as json, txt, pdf, docx, srt, vtt, md or html. Small exports complete immediately and return a download link; long transcriptions, or requests with async set, return 202 with a job to poll.
+// @Tags exports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param export body dtos.ExportRequest true "Export options"
+// @Success 200 {object} dtos.ExportResultResponse
+// @Success 202 {object} dtos.ExportJobResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/export [post]
+func (h *ExportHandlers) ExportTranscription(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.ExportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	export, err := h.exportService.Export(c.Request.Context(), c.Param("id"), userID, dtos.ToExportOptions(req), req.Async)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to export transcription", exportNotFoundCodes, exportBadRequestCodes)
+		return
+	}
+
+	if export.Job != nil {
+		c.JSON(http.StatusAccepted, dtos.ToExportJobResponse(export.Job))
+		return
+	}
+	c.JSON(http.StatusOK, dtos.ToExportResultResponse(export.Result))
+}
+
+// ExportArchive starts an archive of all of the user's meetings
+// @Summary Export all meetings as an archive
+// @Description Start a background job building a zip archive of all of the authenticated user's meetings: transcripts as JSON and the chosen format, participants, action items, analytics and optionally the recorded audio, described by a manifest.json. Poll the returned job for progress and the download link.
+// @Tags exports
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param archive body dtos.ArchiveExportRequest true "Archive options"
+// @Success 202 {object} dtos.ExportJobResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /exports/archive [post]
+func (h *ExportHandlers) ExportArchive(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	// The body is optional, every option has a default
+	var req dtos.ArchiveExportRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	job, err := h.exportService.ExportArchive(c.Request.Context(), userID, dtos.ToArchiveOptions(req))
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to start archive export", exportNotFoundCodes, exportBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dtos.ToExportJobResponse(job))
+}
+
+// GetExportJob returns the status of a background export
+// @Summary Get an export job
+// @Description Get the status of a background export or archive started by the authenticated user, with archive progress and the download link once completed
+// @Tags exports
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Export job ID"
+// @Success 200 {object} dtos.ExportJobResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /exports/jobs/{id} [get]
+func (h *ExportHandlers) GetExportJob(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.exportService.GetJob(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get export job", exportNotFoundCodes, exportBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToExportJobResponse(job))
+}
+
+// GetExportFormats lists the supported export formats
+// @Summary List export formats
+// @Description List the formats transcriptions can be exported to
+// @Tags exports
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dtos.ExportFormatsResponse
+// @Router /exports/formats [get]
+func (h *ExportHandlers) GetExportFormats(c *gin.Context) {
+	c.JSON(http.StatusOK, dtos.ExportFormatsResponse{Formats: h.exportService.GetSupportedFormats()})
+}
+
+// DownloadExportFile serves a locally stored export through its signed download link
+// @Summary Download an export
+// @Description Download an export file. The link returned by an export is signed and stops working when it expires.
+// @Tags exports
+// @Produce octet-stream
+// @Param name path string true "File name"
+// @Param expires query string true "Expiry time (Unix seconds)"
+// @Param signature query string true "Link signature"
+// @Param filename query string false "Name to download the file as"
+// @Success 200 {file} file
+// @Failure 404 {object} map[string]string
+// @Router /exports/files/{name} [get]
+func (h *ExportHandlers) DownloadExportFile(c *gin.Context) {
+	if h.documentFiles == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Document not found"})
+		return
+	}
+
+	name := c.Param("name")
+	path, err := h.documentFiles.Open(name, c.Query("expires"), c.Query("signature"))
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to download export", exportNotFoundCodes, exportBadRequestCodes)
+		return
+	}
+
+	// Stored names are unique IDs; the link carries the name to download the file as
+	fileName := filepath.Base(c.Query("filename"))
+	if fileName == "." || fileName == "/" {
+		fileName = name
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.FileAttachment(path, fileName)
+}
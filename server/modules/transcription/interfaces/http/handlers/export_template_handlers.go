@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	exportTemplateNotFoundCodes   = []string{"EXPORT_TEMPLATE_NOT_FOUND"}
+	exportTemplateBadRequestCodes = []string{"INVALID_EXPORT_TEMPLATE", "DUPLICATE_EXPORT_TEMPLATE"}
+)
+
+// ExportTemplateHandlers contains HTTP handlers for managing export templates
+type ExportTemplateHandlers struct {
+	templateService *services.ExportTemplateService
+}
+
+// NewExportTemplateHandlers creates a new export template handlers instance
+func NewExportTemplateHandlers(templateService *services.ExportTemplateService) *ExportTemplateHandlers {
+	return &ExportTemplateHandlers{
+		templateService: templateService,
+	}
+}
+
+// CreateExportTemplate uploads an export template
+// @Summary Upload an export template
+// @Description Upload a named Markdown or HTML template used by exports with a matching "template" option. Names of built-in templates are reserved.
+// @Tags export-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param template body dtos.ExportTemplateRequest true "Export template"
+// @Success 201 {object} dtos.ExportTemplateResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /export-templates [post]
+func (h *ExportTemplateHandlers) CreateExportTemplate(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.ExportTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	template, err := h.templateService.CreateExportTemplate(c.Request.Context(), commands.CreateExportTemplateCommand{
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Format:      req.Format,
+		Body:        req.Body,
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to create export template", exportTemplateNotFoundCodes, exportTemplateBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToExportTemplateResponse(template))
+}
+
+// GetExportTemplates lists the export templates available to the user
+// @Summary List export templates
+// @Description List the built-in export templates and the templates uploaded by the authenticated user
+// @Tags export-templates
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dtos.ExportTemplatesListResponse
+// @Failure 500 {object} map[string]string
+// @Router /export-templates [get]
+func (h *ExportTemplateHandlers) GetExportTemplates(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	templates, err := h.templateService.GetExportTemplates(c.Request.Context(), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get export templates", exportTemplateNotFoundCodes, exportTemplateBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToExportTemplatesListResponse(h.templateService.GetBuiltinExportTemplates(), templates))
+}
+
+// GetExportTemplate returns an export template
+// @Summary Get an export template
+// @Description Get an export template uploaded by the authenticated user
+// @Tags export-templates
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Export template ID"
+// @Success 200 {object} dtos.ExportTemplateResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /export-templates/{id} [get]
+func (h *ExportTemplateHandlers) GetExportTemplate(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	template, err := h.templateService.GetExportTemplate(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get export template", exportTemplateNotFoundCodes, exportTemplateBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToExportTemplateResponse(template))
+}
+
+// UpdateExportTemplate replaces an export template
+// @Summary Update an export template
+// @Description Replace the name, description, format and body of an export template
+// @Tags export-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Export template ID"
+// @Param template body dtos.ExportTemplateRequest true "Export template"
+// @Success 200 {object} dtos.ExportTemplateResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /export-templates/{id} [put]
+func (h *ExportTemplateHandlers) UpdateExportTemplate(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.ExportTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	template, err := h.templateService.UpdateExportTemplate(c.Request.Context(), commands.UpdateExportTemplateCommand{
+		ID:          c.Param("id"),
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Format:      req.Format,
+		Body:        req.Body,
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to update export template", exportTemplateNotFoundCodes, exportTemplateBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToExportTemplateResponse(template))
+}
+
+// DeleteExportTemplate deletes an export template
+// @Summary Delete an export template
+// @Description Delete an export template uploaded by the authenticated user
+// @Tags export-templates
+// @Security BearerAuth
+// @Param id path string true "Export template ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /export-templates/{id} [delete]
+func (h *ExportTemplateHandlers) DeleteExportTemplate(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.templateService.DeleteExportTemplate(c.Request.Context(), c.Param("id"), userID); err != nil {
+		respondWithDomainError(c, err, "Failed to delete export template", exportTemplateNotFoundCodes, exportTemplateBadRequestCodes)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/seedwork/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getAuthenticatedUserID returns the ID of the user set by the auth middleware,
+// writing a 401 response and returning false when it is not available
+func getAuthenticatedUserID(c *gin.Context) (string, bool) {
+	if userInterface, exists := c.Get("user"); exists {
+		if user, ok := userInterface.(*entities.User); ok {
+			return user.GetID(), true
+		}
+	}
+
+	// Try to get user ID directly if user object is not available
+	userIDInterface, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return "", false
+	}
+	userID, ok := userIDInterface.(string)
+	if !ok || userID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID"})
+		return "", false
+	}
+	return userID, true
+}
+
+// respondWithDomainError maps domain error codes to HTTP status codes.
+// Codes listed in notFound produce 404, codes in badRequest produce 400 and anything else falls back to 500.
+func respondWithDomainError(c *gin.Context, err error, fallback string, notFound, badRequest []string) {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) {
+		for _, code := range notFound {
+			if domainErr.Code == code {
+				c.JSON(http.StatusNotFound, gin.H{"error": domainErr.Message})
+				return
+			}
+		}
+		for _, code := range badRequest {
+			if domainErr.Code == code {
+				c.JSON(http.StatusBadRequest, gin.H{"error": domainErr.Message})
+				return
+			}
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+}
+
+// respondWithUploadError reports a request body over the size limit as 413 and other form errors as 400
+func respondWithUploadError(c *gin.Context, err error, tooLarge string) {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": tooLarge})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
@@ -536,6 +536,14 @@ func (h *PersistentAudioHandler) parseOptions(options interface{}, params Persis
 		result.SpeakerDiarization = msgOptions.SpeakerDiarization
 		result.RealTimeTranscription = msgOptions.RealTimeTranscription
 		result.CostOptimized = msgOptions.CostOptimized
+
+		// Session vocabulary and redaction add to the meeting owner's defaults
+		result.WordBoost = msgOptions.WordBoost
+		result.BoostParam = msgOptions.BoostParam
+		result.CustomSpelling = msgOptions.CustomSpelling
+		result.RedactPII = msgOptions.RedactPII
+		result.RedactPIIAudio = msgOptions.RedactPIIAudio
+		result.PIIPolicies = msgOptions.PIIPolicies
 	}
 
 	return result, nil
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	questionNotFoundCodes   = []string{"UNAUTHORIZED", "MEETING_NOT_FOUND", "QUESTION_NOT_FOUND"}
+	questionBadRequestCodes = []string{"INVALID_QUESTION", "INVALID_SEARCH_QUERY", "NO_COMPLETED_TRANSCRIPTION"}
+)
+
+// QuestionHandlers contains HTTP handlers for asking questions about meetings
+type QuestionHandlers struct {
+	questionService *services.QuestionService
+}
+
+// NewQuestionHandlers creates a new question handlers instance
+func NewQuestionHandlers(questionService *services.QuestionService) *QuestionHandlers {
+	return &QuestionHandlers{
+		questionService: questionService,
+	}
+}
+
+// AskQuestion answers a question about a meeting
+// @Summary Ask a question about a meeting
+// @Description Answer a question about a meeting the authenticated user can view from the most relevant parts of its transcripts, with LeMUR for AssemblyAI transcripts, the configured language model, or a quote of the best matching segment. The answer cites the segments it is based on and is added to the meeting's question history.
+// @Tags meetings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Param request body dtos.AskQuestionRequest true "Question (max 1000 characters)"
+// @Success 201 {object} dtos.QuestionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/questions [post]
+func (h *QuestionHandlers) AskQuestion(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.AskQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := h.questionService.Ask(c.Request.Context(), c.Param("id"), userID, req.Question)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to answer question", questionNotFoundCodes, questionBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToQuestionResponse(question))
+}
+
+// GetQuestions returns the question history of a meeting
+// @Summary List the questions asked about a meeting
+// @Description Get the questions asked about a meeting the authenticated user can view, newest first, with their answers and citations
+// @Tags meetings
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Param limit query int false "Maximum number of questions (default 20, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} dtos.QuestionHistoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/questions [get]
+func (h *QuestionHandlers) GetQuestions(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.QuestionHistoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	history, err := h.questionService.GetHistory(c.Request.Context(), c.Param("id"), userID, req.Limit, req.Offset)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get question history", questionNotFoundCodes, questionBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToQuestionHistoryResponse(history))
+}
+
+// GetQuestion returns a question asked about a meeting
+// @Summary Get a meeting question
+// @Description Get a question asked about a meeting the authenticated user can view, with its answer and citations
+// @Tags meetings
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Meeting ID"
+// @Param questionId path string true "Question ID"
+// @Success 200 {object} dtos.QuestionResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /meetings/{id}/questions/{questionId} [get]
+func (h *QuestionHandlers) GetQuestion(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	question, err := h.questionService.GetQuestion(c.Request.Context(), c.Param("id"), c.Param("questionId"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get question", questionNotFoundCodes, questionBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToQuestionResponse(question))
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RedactionSettingsHandlers contains HTTP handlers for the default PII redaction of a user
+type RedactionSettingsHandlers struct {
+	redactionService *services.RedactionSettingsService
+}
+
+// NewRedactionSettingsHandlers creates a new redaction settings handlers instance
+func NewRedactionSettingsHandlers(redactionService *services.RedactionSettingsService) *RedactionSettingsHandlers {
+	return &RedactionSettingsHandlers{
+		redactionService: redactionService,
+	}
+}
+
+// GetRedactionSettings returns the user's default PII redaction
+// @Summary Get PII redaction settings
+// @Description Get the PII redaction applied by default to transcriptions of the authenticated user's meetings
+// @Tags settings
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dtos.RedactionSettingsResponse
+// @Failure 500 {object} map[string]string
+// @Router /settings/redaction [get]
+func (h *RedactionSettingsHandlers) GetRedactionSettings(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	settings, err := h.redactionService.GetSettings(c.Request.Context(), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get redaction settings", nil, nil)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToRedactionSettingsResponse(settings, h.redactionService.LocalPolicies()))
+}
+
+// UpdateRedactionSettings replaces the user's default PII redaction
+// @Summary Update PII redaction settings
+// @Description Set the PII redaction applied by default to transcriptions of the authenticated user's meetings. Sessions can add redaction but cannot turn it off.
+// @Tags settings
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param settings body dtos.RedactionSettingsRequest true "Redaction settings"
+// @Success 200 {object} dtos.RedactionSettingsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /settings/redaction [put]
+func (h *RedactionSettingsHandlers) UpdateRedactionSettings(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.RedactionSettingsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	settings, err := h.redactionService.UpdateSettings(c.Request.Context(), commands.UpdateRedactionSettingsCommand{
+		UserID:         userID,
+		RedactPII:      req.RedactPII,
+		RedactPIIAudio: req.RedactPIIAudio,
+		PIIPolicies:    req.PIIPolicies,
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to update redaction settings", nil, []string{"INVALID_PII_POLICY"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToRedactionSettingsResponse(settings, h.redactionService.LocalPolicies()))
+}
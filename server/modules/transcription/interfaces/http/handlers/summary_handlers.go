@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	summaryNotFoundCodes   = []string{"UNAUTHORIZED", "MEETING_NOT_FOUND", "TRANSCRIPTION_NOT_FOUND", "SUMMARY_NOT_FOUND", "SUMMARY_JOB_NOT_FOUND"}
+	summaryBadRequestCodes = []string{"INVALID_SUMMARY_REQUEST", "TRANSCRIPTION_NOT_COMPLETED"}
+)
+
+// SummaryHandlers contains HTTP handlers for transcription summaries
+type SummaryHandlers struct {
+	summaryService *services.SummaryService
+}
+
+// NewSummaryHandlers creates a new summary handlers instance
+func NewSummaryHandlers(summaryService *services.SummaryService) *SummaryHandlers {
+	return &SummaryHandlers{
+		summaryService: summaryService,
+	}
+}
+
+// GetSummary returns the summary of a transcription
+// @Summary Get a transcription summary
+// @Description Get the overview, key points and decisions of a transcription in a meeting the authenticated user can view. Transcriptions are summarized automatically when they complete.
+// @Tags transcriptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Success 200 {object} dtos.SummaryResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/summary [get]
+func (h *SummaryHandlers) GetSummary(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	summary, err := h.summaryService.GetSummary(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get summary", summaryNotFoundCodes, summaryBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToSummaryResponse(summary))
+}
+
+// RegenerateSummary summarizes a transcription again
+// @Summary Regenerate a transcription summary
+// @Description Summarize a completed transcription again in the background, replacing its current summary. Requires edit access to the meeting. The answer format is only followed by LeMUR; other transcriptions are summarized extractively. Poll the returned job for progress.
+// @Tags transcriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param request body dtos.RegenerateSummaryRequest false "Summary options"
+// @Success 202 {object} dtos.SummaryJobResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/summary/regenerate [post]
+func (h *SummaryHandlers) RegenerateSummary(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.RegenerateSummaryRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	job, err := h.summaryService.Regenerate(c.Request.Context(), c.Param("id"), userID, req.AnswerFormat)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to queue summary", summaryNotFoundCodes, summaryBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, dtos.ToSummaryJobResponse(job))
+}
+
+// GetSummaryJob returns the status of a transcription being summarized
+// @Summary Get a summarize job
+// @Description Get the status of a transcription being summarized in a meeting the authenticated user can view
+// @Tags transcriptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param jobId path string true "Summarize job ID"
+// @Success 200 {object} dtos.SummaryJobResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/summary/jobs/{jobId} [get]
+func (h *SummaryHandlers) GetSummaryJob(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	job, err := h.summaryService.GetJob(c.Request.Context(), c.Param("id"), c.Param("jobId"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get summarize job", summaryNotFoundCodes, summaryBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToSummaryJobResponse(job))
+}
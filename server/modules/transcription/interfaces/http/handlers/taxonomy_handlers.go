@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/services"
+	domainServices "teammate/server/modules/transcription/domain/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+	"teammate/server/seedwork/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	taxonomyNotFoundCodes   = []string{"TAXONOMY_NOT_FOUND", "TEAM_NOT_FOUND"}
+	taxonomyBadRequestCodes = []string{"INVALID_TAXONOMY"}
+)
+
+// TaxonomyHandlers contains HTTP handlers for managing analytics topic taxonomies and sentiment lexicons
+type TaxonomyHandlers struct {
+	taxonomyService *services.TaxonomyService
+}
+
+// NewTaxonomyHandlers creates a new taxonomy handlers instance
+func NewTaxonomyHandlers(taxonomyService *services.TaxonomyService) *TaxonomyHandlers {
+	return &TaxonomyHandlers{
+		taxonomyService: taxonomyService,
+	}
+}
+
+// CreateTaxonomy creates an analytics taxonomy
+// @Summary Create an analytics taxonomy
+// @Description Create topics with weighted terms and synonyms, and a sentiment lexicon, used for the analytics of the user's meetings once activated. With team_id the taxonomy is shared with a team the user owns and, once activated, used for the meetings of members who have not activated their own. With from_default, missing topics and sentiment words are copied from the built-in taxonomy.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param taxonomy body dtos.CreateTaxonomyRequest true "Taxonomy"
+// @Success 201 {object} dtos.TaxonomyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/taxonomies [post]
+func (h *TaxonomyHandlers) CreateTaxonomy(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.CreateTaxonomyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	taxonomy, err := h.taxonomyService.CreateTaxonomy(c.Request.Context(), commands.CreateTaxonomyCommand{
+		UserID:      userID,
+		TeamID:      req.TeamID,
+		Name:        req.Name,
+		Description: req.Description,
+		Topics:      dtos.ToTaxonomyTopics(req.Topics),
+		Sentiment:   dtos.ToSentimentLexicon(req.Sentiment),
+		Stemming:    req.StemmingEnabled(),
+		FromDefault: req.FromDefault,
+		Activate:    req.Activate,
+	})
+	if err != nil {
+		respondWithTaxonomyError(c, err, "Failed to create taxonomy")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToTaxonomyResponse(taxonomy, false))
+}
+
+// GetTaxonomies lists the taxonomies available to the user
+// @Summary List analytics taxonomies
+// @Description List the built-in taxonomy and the taxonomies of the authenticated user and their teams, and which one is used for the user's meetings
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dtos.TaxonomiesListResponse
+// @Failure 500 {object} map[string]string
+// @Router /analytics/taxonomies [get]
+func (h *TaxonomyHandlers) GetTaxonomies(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	result, err := h.taxonomyService.GetTaxonomies(c.Request.Context(), userID)
+	if err != nil {
+		respondWithTaxonomyError(c, err, "Failed to get taxonomies")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTaxonomiesListResponse(h.taxonomyService.GetDefaultTaxonomy(), result.Taxonomies, result.ActiveID))
+}
+
+// GetTaxonomy returns an analytics taxonomy
+// @Summary Get an analytics taxonomy
+// @Description Get a taxonomy of the authenticated user or of one of their teams, or the built-in taxonomy with the ID "default"
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Taxonomy ID"
+// @Success 200 {object} dtos.TaxonomyResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/taxonomies/{id} [get]
+func (h *TaxonomyHandlers) GetTaxonomy(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	id := c.Param("id")
+	taxonomy, err := h.taxonomyService.GetTaxonomy(c.Request.Context(), id, userID)
+	if err != nil {
+		respondWithTaxonomyError(c, err, "Failed to get taxonomy")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTaxonomyResponse(taxonomy, id == domainServices.DefaultTaxonomyID))
+}
+
+// UpdateTaxonomy replaces an analytics taxonomy
+// @Summary Update an analytics taxonomy
+// @Description Replace the topics, sentiment lexicon and stemming setting of a taxonomy of the user or of a team they own. Analytics of the meetings it applies to are recomputed if it is active.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Taxonomy ID"
+// @Param taxonomy body dtos.TaxonomyRequest true "Taxonomy"
+// @Success 200 {object} dtos.TaxonomyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/taxonomies/{id} [put]
+func (h *TaxonomyHandlers) UpdateTaxonomy(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.TaxonomyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	taxonomy, err := h.taxonomyService.UpdateTaxonomy(c.Request.Context(), commands.UpdateTaxonomyCommand{
+		ID:          c.Param("id"),
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		Topics:      dtos.ToTaxonomyTopics(req.Topics),
+		Sentiment:   dtos.ToSentimentLexicon(req.Sentiment),
+		Stemming:    req.StemmingEnabled(),
+	})
+	if err != nil {
+		respondWithTaxonomyError(c, err, "Failed to update taxonomy")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTaxonomyResponse(taxonomy, false))
+}
+
+// DeleteTaxonomy deletes an analytics taxonomy
+// @Summary Delete an analytics taxonomy
+// @Description Delete a taxonomy of the authenticated user or of a team they own. Deleting the active taxonomy switches the user or team back to the built-in one.
+// @Tags analytics
+// @Security BearerAuth
+// @Param id path string true "Taxonomy ID"
+// @Success 204
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/taxonomies/{id} [delete]
+func (h *TaxonomyHandlers) DeleteTaxonomy(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taxonomyService.DeleteTaxonomy(c.Request.Context(), c.Param("id"), userID); err != nil {
+		respondWithTaxonomyError(c, err, "Failed to delete taxonomy")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ActivateTaxonomy makes a taxonomy the one used for the analytics of the user or of their team
+// @Summary Activate an analytics taxonomy
+// @Description Use a taxonomy of the authenticated user, or the built-in taxonomy with the ID "default", for the analytics of the meetings they own. Activating a team taxonomy uses it for the team; "default" with team_id switches the team back to the built-in taxonomy. Only team owners manage team taxonomies.
+// @Tags analytics
+// @Security BearerAuth
+// @Param id path string true "Taxonomy ID"
+// @Param team_id query string false "Team whose taxonomy is switched"
+// @Success 204
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/taxonomies/{id}/activate [post]
+func (h *TaxonomyHandlers) ActivateTaxonomy(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	err := h.taxonomyService.ActivateTaxonomy(c.Request.Context(), commands.ActivateTaxonomyCommand{
+		ID:     c.Param("id"),
+		UserID: userID,
+		TeamID: c.Query("team_id"),
+	})
+	if err != nil {
+		respondWithTaxonomyError(c, err, "Failed to activate taxonomy")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondWithTaxonomyError reports changes to team taxonomies by members who do not own the team as
+// forbidden and maps other domain errors like respondWithDomainError
+func respondWithTaxonomyError(c *gin.Context, err error, fallback string) {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) && domainErr.Code == "NOT_TEAM_OWNER" {
+		c.JSON(http.StatusForbidden, gin.H{"error": domainErr.Message})
+		return
+	}
+	respondWithDomainError(c, err, fallback, taxonomyNotFoundCodes, taxonomyBadRequestCodes)
+}
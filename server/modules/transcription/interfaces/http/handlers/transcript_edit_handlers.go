@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+	"teammate/server/seedwork/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	transcriptEditNotFoundCodes   = []string{"UNAUTHORIZED", "MEETING_NOT_FOUND", "TRANSCRIPTION_NOT_FOUND", "SEGMENT_NOT_FOUND", "REVISION_NOT_FOUND"}
+	transcriptEditBadRequestCodes = []string{"TRANSCRIPTION_NOT_EDITABLE", "INVALID_SEGMENT_EDIT", "INVALID_SPLIT_POSITION", "INVALID_SPLIT_TIME", "INVALID_MERGE"}
+)
+
+// TranscriptEditHandlers contains HTTP handlers for editing transcripts and browsing their revisions
+type TranscriptEditHandlers struct {
+	editService *services.TranscriptEditService
+}
+
+// NewTranscriptEditHandlers creates a new transcript edit handlers instance
+func NewTranscriptEditHandlers(editService *services.TranscriptEditService) *TranscriptEditHandlers {
+	return &TranscriptEditHandlers{
+		editService: editService,
+	}
+}
+
+// GetSegments returns the current segments of a transcription
+// @Summary Get transcript segments
+// @Description Get the current segments of a transcription owned by the authenticated user
+// @Tags transcriptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Success 200 {object} dtos.SegmentsResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/segments [get]
+func (h *TranscriptEditHandlers) GetSegments(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	transcriptionID := c.Param("id")
+	segments, err := h.editService.GetSegments(c.Request.Context(), transcriptionID, userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get transcript segments", transcriptEditNotFoundCodes, transcriptEditBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToSegmentsResponse(transcriptionID, segments))
+}
+
+// EditSegment changes the text and/or speaker of a segment
+// @Summary Edit a transcript segment
+// @Description Change the text and/or speaker of a segment; the edit is recorded as a new revision
+// @Tags transcriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param segmentId path string true "Segment ID"
+// @Param edit body dtos.EditSegmentRequest true "Segment changes"
+// @Success 200 {object} dtos.TranscriptEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/segments/{segmentId} [patch]
+func (h *TranscriptEditHandlers) EditSegment(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.EditSegmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.editService.EditSegment(c.Request.Context(), commands.EditSegmentCommand{
+		TranscriptionID: c.Param("id"),
+		SegmentID:       c.Param("segmentId"),
+		Text:            req.Text,
+		Speaker:         req.Speaker,
+		EditedBy:        userID,
+	})
+	if err != nil {
+		respondWithEditError(c, err, "Failed to edit transcript segment")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTranscriptEditResponse(result.Transcription, result.Revision))
+}
+
+// SplitSegment splits a segment in two
+// @Summary Split a transcript segment
+// @Description Split a segment at a character offset; the split is recorded as a new revision
+// @Tags transcriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param segmentId path string true "Segment ID"
+// @Param split body dtos.SplitSegmentRequest true "Split position"
+// @Success 200 {object} dtos.TranscriptEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/segments/{segmentId}/split [post]
+func (h *TranscriptEditHandlers) SplitSegment(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.SplitSegmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.editService.SplitSegment(c.Request.Context(), commands.SplitSegmentCommand{
+		TranscriptionID: c.Param("id"),
+		SegmentID:       c.Param("segmentId"),
+		Offset:          req.Offset,
+		SplitTime:       req.SplitTime,
+		EditedBy:        userID,
+	})
+	if err != nil {
+		respondWithEditError(c, err, "Failed to split transcript segment")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTranscriptEditResponse(result.Transcription, result.Revision))
+}
+
+// MergeSegments merges adjacent segments into one
+// @Summary Merge transcript segments
+// @Description Merge adjacent segments into one; the merge is recorded as a new revision
+// @Tags transcriptions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param merge body dtos.MergeSegmentsRequest true "Segments to merge"
+// @Success 200 {object} dtos.TranscriptEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/segments/merge [post]
+func (h *TranscriptEditHandlers) MergeSegments(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.MergeSegmentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.editService.MergeSegments(c.Request.Context(), commands.MergeSegmentsCommand{
+		TranscriptionID: c.Param("id"),
+		SegmentIDs:      req.SegmentIDs,
+		Speaker:         req.Speaker,
+		EditedBy:        userID,
+	})
+	if err != nil {
+		respondWithEditError(c, err, "Failed to merge transcript segments")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTranscriptEditResponse(result.Transcription, result.Revision))
+}
+
+// GetRevisions lists the edit history of a transcription
+// @Summary List transcript revisions
+// @Description List every revision of a transcription, oldest first
+// @Tags transcriptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Success 200 {object} dtos.RevisionsListResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/revisions [get]
+func (h *TranscriptEditHandlers) GetRevisions(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	transcriptionID := c.Param("id")
+	result, err := h.editService.GetRevisions(c.Request.Context(), transcriptionID, userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get transcript revisions", transcriptEditNotFoundCodes, transcriptEditBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToRevisionsListResponse(transcriptionID, result.Revisions))
+}
+
+// GetRevision returns a single revision including its full snapshot
+// @Summary Get a transcript revision
+// @Description Get a single revision with before/after segments and the full segment snapshot
+// @Tags transcriptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param revision path int true "Revision number"
+// @Success 200 {object} dtos.RevisionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/revisions/{revision} [get]
+func (h *TranscriptEditHandlers) GetRevision(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	revisionNumber, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || revisionNumber < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision number"})
+		return
+	}
+
+	revision, err := h.editService.GetRevision(c.Request.Context(), c.Param("id"), userID, revisionNumber)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get transcript revision", transcriptEditNotFoundCodes, transcriptEditBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToRevisionResponse(revision))
+}
+
+// RestoreRevision restores the segments of an earlier revision
+// @Summary Restore a transcript revision
+// @Description Restore the segments of an earlier revision; the restore is recorded as a new revision
+// @Tags transcriptions
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transcription ID"
+// @Param revision path int true "Revision number"
+// @Success 200 {object} dtos.TranscriptEditResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/{id}/revisions/{revision}/restore [post]
+func (h *TranscriptEditHandlers) RestoreRevision(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	revisionNumber, err := strconv.Atoi(c.Param("revision"))
+	if err != nil || revisionNumber < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid revision number"})
+		return
+	}
+
+	result, err := h.editService.RestoreRevision(c.Request.Context(), commands.RestoreRevisionCommand{
+		TranscriptionID: c.Param("id"),
+		RevisionNumber:  revisionNumber,
+		EditedBy:        userID,
+	})
+	if err != nil {
+		respondWithEditError(c, err, "Failed to restore transcript revision")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTranscriptEditResponse(result.Transcription, result.Revision))
+}
+
+// respondWithEditError reports an edit that kept losing the race for its revision number as 409
+func respondWithEditError(c *gin.Context, err error, fallback string) {
+	var domainErr *domain.DomainError
+	if errors.As(err, &domainErr) && domainErr.Code == "REVISION_CONFLICT" {
+		c.JSON(http.StatusConflict, gin.H{"error": domainErr.Message})
+		return
+	}
+	respondWithDomainError(c, err, fallback, transcriptEditNotFoundCodes, transcriptEditBadRequestCodes)
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MaxTranscriptImportSize is the largest transcript file that can be imported
+const MaxTranscriptImportSize = 20 << 20
+
+var (
+	transcriptImportNotFoundCodes   = []string{"UNAUTHORIZED", "MEETING_NOT_FOUND"}
+	transcriptImportBadRequestCodes = []string{"INVALID_TRANSCRIPT", "UNSUPPORTED_IMPORT_FORMAT"}
+)
+
+// TranscriptImportHandlers contains HTTP handlers for importing transcripts from other tools
+type TranscriptImportHandlers struct {
+	importService *services.TranscriptImportService
+}
+
+// NewTranscriptImportHandlers creates a new transcript import handlers instance
+func NewTranscriptImportHandlers(importService *services.TranscriptImportService) *TranscriptImportHandlers {
+	return &TranscriptImportHandlers{
+		importService: importService,
+	}
+}
+
+// ImportTranscript imports a transcript file
+// @Summary Import a transcript
+// @Description Import an SRT, WebVTT or JSON interchange transcript as a completed transcription, in a new meeting or in an existing meeting the authenticated user can edit. Speakers are added as meeting participants.
+// @Tags transcriptions
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Transcript file (max 20MB)"
+// @Param format formData string false "srt, vtt or json; detected from the file when omitted"
+// @Param meeting_id formData string false "Meeting to attach the transcript to"
+// @Param title formData string false "Title of the created meeting"
+// @Param start_time formData string false "Start of the created meeting (RFC 3339)"
+// @Param speaker_prefixes formData bool false "Read \"Speaker: text\" prefixes of subtitle cues as speakers"
+// @Success 201 {object} dtos.ImportTranscriptResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 413 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /transcriptions/import [post]
+func (h *TranscriptImportHandlers) ImportTranscript(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, MaxTranscriptImportSize+1<<20)
+
+	var req dtos.ImportTranscriptRequest
+	if err := c.ShouldBind(&req); err != nil {
+		respondWithUploadError(c, err, "Transcript file is too large")
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		respondWithUploadError(c, err, "Transcript file is too large")
+		return
+	}
+	if header.Size > MaxTranscriptImportSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "Transcript file is too large"})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.importService.Import(c.Request.Context(), dtos.ToImportTranscriptCommand(req, userID, header.Filename, data))
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to import transcript", transcriptImportNotFoundCodes, transcriptImportBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToImportTranscriptResponse(result))
+}
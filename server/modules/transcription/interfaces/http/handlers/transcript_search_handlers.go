@@ -0,0 +1,193 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"teammate/server/modules/transcription/application/queries"
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TranscriptSearchHandlers contains HTTP handlers for searching transcripts
+type TranscriptSearchHandlers struct {
+	searchService       *services.TranscriptSearchService
+	passageIndexService *services.PassageIndexService
+}
+
+// NewTranscriptSearchHandlers creates a new transcript search handlers instance
+func NewTranscriptSearchHandlers(
+	searchService *services.TranscriptSearchService,
+	passageIndexService *services.PassageIndexService,
+) *TranscriptSearchHandlers {
+	return &TranscriptSearchHandlers{
+		searchService:       searchService,
+		passageIndexService: passageIndexService,
+	}
+}
+
+// SearchTranscripts runs a full-text search over the transcripts the user can access
+// @Summary Search transcripts
+// @Description Full-text search over transcript segments of meetings owned by or shared with the authenticated user. Snippets are HTML-escaped with matches wrapped in <mark> tags.
+// @Tags search
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search terms (supports quoted phrases, OR and -exclusions)"
+// @Param meeting_id query string false "Restrict to a meeting"
+// @Param speaker query string false "Restrict to a speaker"
+// @Param meeting_type query string false "Restrict to a meeting type" Enums(zoom, google_meet, microsoft_teams, generic)
+// @Param from query string false "Meetings starting on or after this date (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "Meetings starting on or before this date (RFC3339 or YYYY-MM-DD)"
+// @Param limit query int false "Maximum number of results (default 20, max 100)"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} dtos.SearchTranscriptsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /search/transcripts [get]
+func (h *TranscriptSearchHandlers) SearchTranscripts(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.SearchTranscriptsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	from, err := parseDateParam(req.From, false)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid from date"})
+		return
+	}
+	to, err := parseDateParam(req.To, true)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid to date"})
+		return
+	}
+
+	result, err := h.searchService.Search(c.Request.Context(), queries.SearchTranscriptsQuery{
+		UserID:      userID,
+		Query:       req.Query,
+		MeetingID:   req.MeetingID,
+		Speaker:     req.Speaker,
+		MeetingType: req.MeetingType,
+		From:        from,
+		To:          to,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to search transcripts", nil, []string{"INVALID_SEARCH_QUERY"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToSearchTranscriptsResponse(req.Query, result))
+}
+
+// SearchPassages returns the transcript passages most relevant to a question
+// @Summary Search transcript passages
+// @Description Ranks indexed transcript passages of meetings owned by or shared with the authenticated user by relevance to the query and returns the top-k with timestamps.
+// @Tags search
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Question or search text"
+// @Param meeting_id query string false "Restrict to a meeting"
+// @Param k query int false "Number of passages to return (default 5, max 50)"
+// @Success 200 {object} dtos.SearchPassagesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /search/passages [get]
+func (h *TranscriptSearchHandlers) SearchPassages(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.SearchPassagesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.passageIndexService.SearchPassages(c.Request.Context(), queries.SearchPassagesQuery{
+		UserID:    userID,
+		Query:     req.Query,
+		MeetingID: req.MeetingID,
+		TopK:      req.TopK,
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to search passages", nil, []string{"INVALID_SEARCH_QUERY"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToSearchPassagesResponse(req.Query, result))
+}
+
+// SimilarPassages returns the transcript passages that discuss the same things as a given passage
+// @Summary Find similar transcript passages
+// @Description Ranks indexed transcript passages of meetings owned by or shared with the authenticated user by similarity to a passage, given by its chunk ID or by a segment it contains, and returns the top-k with timestamps. The passage itself is not included.
+// @Tags search
+// @Produce json
+// @Security BearerAuth
+// @Param chunk_id query string false "Passage to compare with (either chunk_id or segment_id is required)"
+// @Param segment_id query string false "Segment whose passage to compare with"
+// @Param meeting_id query string false "Restrict to a meeting"
+// @Param k query int false "Number of passages to return (default 5, max 50)"
+// @Success 200 {object} dtos.SimilarPassagesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /search/passages/similar [get]
+func (h *TranscriptSearchHandlers) SimilarPassages(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.SimilarPassagesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	result, err := h.passageIndexService.SimilarPassages(c.Request.Context(), queries.SimilarPassagesQuery{
+		UserID:    userID,
+		ChunkID:   req.ChunkID,
+		SegmentID: req.SegmentID,
+		MeetingID: req.MeetingID,
+		TopK:      req.TopK,
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to find similar passages", []string{"PASSAGE_NOT_FOUND"}, []string{"INVALID_PASSAGE_REFERENCE"})
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToSimilarPassagesResponse(result))
+}
+
+// parseDateParam parses an RFC3339 timestamp or a YYYY-MM-DD date. A bare date used as the
+// end of a range is moved to the start of the following day so the whole day is included.
+func parseDateParam(value string, endOfRange bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		if endOfRange {
+			// The range end is exclusive in the query; include the exact instant given
+			parsed = parsed.Add(time.Nanosecond)
+		}
+		return &parsed, nil
+	}
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	if endOfRange {
+		parsed = parsed.AddDate(0, 0, 1)
+	}
+	return &parsed, nil
+}
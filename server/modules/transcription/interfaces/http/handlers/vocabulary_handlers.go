@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/transcription/application/commands"
+	"teammate/server/modules/transcription/application/services"
+	"teammate/server/modules/transcription/interfaces/http/dtos"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	vocabularyNotFoundCodes   = []string{"VOCABULARY_NOT_FOUND"}
+	vocabularyBadRequestCodes = []string{"INVALID_VOCABULARY"}
+)
+
+// VocabularyHandlers contains HTTP handlers for managing custom vocabularies
+type VocabularyHandlers struct {
+	vocabularyService *services.VocabularyService
+}
+
+// NewVocabularyHandlers creates a new vocabulary handlers instance
+func NewVocabularyHandlers(vocabularyService *services.VocabularyService) *VocabularyHandlers {
+	return &VocabularyHandlers{
+		vocabularyService: vocabularyService,
+	}
+}
+
+// CreateVocabulary creates a custom vocabulary
+// @Summary Create a custom vocabulary
+// @Description Create a list of terms to boost and spelling rules applied to transcriptions of the user's meetings, optionally limited to one meeting type
+// @Tags vocabularies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param vocabulary body dtos.VocabularyRequest true "Vocabulary"
+// @Success 201 {object} dtos.VocabularyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /vocabularies [post]
+func (h *VocabularyHandlers) CreateVocabulary(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.VocabularyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	vocabulary, err := h.vocabularyService.CreateVocabulary(c.Request.Context(), commands.CreateVocabularyCommand{
+		UserID:      userID,
+		Name:        req.Name,
+		MeetingType: req.MeetingType,
+		Terms:       req.Terms,
+		BoostParam:  req.BoostParam,
+		Spellings:   dtos.ToSpellingRules(req.Spellings),
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to create vocabulary", vocabularyNotFoundCodes, vocabularyBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToVocabularyResponse(vocabulary))
+}
+
+// GetVocabularies lists the user's custom vocabularies
+// @Summary List custom vocabularies
+// @Description List the custom vocabularies of the authenticated user
+// @Tags vocabularies
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dtos.VocabulariesListResponse
+// @Failure 500 {object} map[string]string
+// @Router /vocabularies [get]
+func (h *VocabularyHandlers) GetVocabularies(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	vocabularies, err := h.vocabularyService.GetVocabularies(c.Request.Context(), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get vocabularies", vocabularyNotFoundCodes, vocabularyBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToVocabulariesListResponse(vocabularies))
+}
+
+// GetVocabulary returns a custom vocabulary
+// @Summary Get a custom vocabulary
+// @Description Get a custom vocabulary of the authenticated user
+// @Tags vocabularies
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vocabulary ID"
+// @Success 200 {object} dtos.VocabularyResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /vocabularies/{id} [get]
+func (h *VocabularyHandlers) GetVocabulary(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	vocabulary, err := h.vocabularyService.GetVocabulary(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to get vocabulary", vocabularyNotFoundCodes, vocabularyBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToVocabularyResponse(vocabulary))
+}
+
+// UpdateVocabulary replaces a custom vocabulary
+// @Summary Update a custom vocabulary
+// @Description Replace the name, meeting type, terms and spelling rules of a custom vocabulary
+// @Tags vocabularies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Vocabulary ID"
+// @Param vocabulary body dtos.VocabularyRequest true "Vocabulary"
+// @Success 200 {object} dtos.VocabularyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /vocabularies/{id} [put]
+func (h *VocabularyHandlers) UpdateVocabulary(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.VocabularyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	vocabulary, err := h.vocabularyService.UpdateVocabulary(c.Request.Context(), commands.UpdateVocabularyCommand{
+		ID:          c.Param("id"),
+		UserID:      userID,
+		Name:        req.Name,
+		MeetingType: req.MeetingType,
+		Terms:       req.Terms,
+		BoostParam:  req.BoostParam,
+		Spellings:   dtos.ToSpellingRules(req.Spellings),
+	})
+	if err != nil {
+		respondWithDomainError(c, err, "Failed to update vocabulary", vocabularyNotFoundCodes, vocabularyBadRequestCodes)
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToVocabularyResponse(vocabulary))
+}
+
+// DeleteVocabulary deletes a custom vocabulary
+// @Summary Delete a custom vocabulary
+// @Description Delete a custom vocabulary of the authenticated user
+// @Tags vocabularies
+// @Security BearerAuth
+// @Param id path string true "Vocabulary ID"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /vocabularies/{id} [delete]
+func (h *VocabularyHandlers) DeleteVocabulary(c *gin.Context) {
+	userID, ok := getAuthenticatedUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.vocabularyService.DeleteVocabulary(c.Request.Context(), c.Param("id"), userID); err != nil {
+		respondWithDomainError(c, err, "Failed to delete vocabulary", vocabularyNotFoundCodes, vocabularyBadRequestCodes)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnalyticsRoutes sets up transcript analytics routes
+type AnalyticsRoutes struct {
+	analyticsHandlers *handlers.AnalyticsHandlers
+	authMiddleware    *middleware.AuthMiddleware
+}
+
+// NewAnalyticsRoutes creates a new analytics routes instance
+func NewAnalyticsRoutes(analyticsHandlers *handlers.AnalyticsHandlers, authMiddleware *middleware.AuthMiddleware) *AnalyticsRoutes {
+	return &AnalyticsRoutes{
+		analyticsHandlers: analyticsHandlers,
+		authMiddleware:    authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected analytics routes (authentication required)
+func (r *AnalyticsRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	protected.GET("/transcriptions/:id/analytics", r.analyticsHandlers.GetTranscriptionAnalytics) // Analytics of a transcription
+	protected.GET("/meetings/:id/analytics", r.analyticsHandlers.GetMeetingAnalytics)             // Analytics summary of a meeting
+	protected.GET("/analytics/trends", r.analyticsHandlers.GetAnalyticsTrends)                    // Trends across meetings
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AudioUploadRoutes sets up routes for transcribing pre-recorded audio files
+type AudioUploadRoutes struct {
+	uploadHandlers *handlers.AudioUploadHandlers
+	authMiddleware *middleware.AuthMiddleware
+}
+
+// NewAudioUploadRoutes creates a new audio upload routes instance
+func NewAudioUploadRoutes(uploadHandlers *handlers.AudioUploadHandlers, authMiddleware *middleware.AuthMiddleware) *AudioUploadRoutes {
+	return &AudioUploadRoutes{
+		uploadHandlers: uploadHandlers,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected audio upload routes (authentication required)
+func (r *AudioUploadRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	upload := protected.Group("/transcriptions/upload")
+	{
+		upload.POST("", r.uploadHandlers.UploadAudio)              // Upload a recording
+		upload.POST("/url", r.uploadHandlers.UploadAudioFromURL)   // Fetch a recording from a URL
+		upload.GET("/jobs/:id", r.uploadHandlers.GetTranscribeJob) // Transcription status of a recording
+	}
+}
@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportRoutes sets up transcription export routes
+type ExportRoutes struct {
+	exportHandlers *handlers.ExportHandlers
+	authMiddleware *middleware.AuthMiddleware
+}
+
+// NewExportRoutes creates a new export routes instance
+func NewExportRoutes(exportHandlers *handlers.ExportHandlers, authMiddleware *middleware.AuthMiddleware) *ExportRoutes {
+	return &ExportRoutes{
+		exportHandlers: exportHandlers,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// SetupPublicRoutes sets up export download routes, which are authorised by their signed links
+func (r *ExportRoutes) SetupPublicRoutes(public *gin.RouterGroup) {
+	public.GET("/exports/files/:name", r.exportHandlers.DownloadExportFile) // Download a locally stored export
+}
+
+// SetupProtectedRoutes sets up protected export routes (authentication required)
+func (r *ExportRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	protected.POST("/transcriptions/:id/export", r.exportHandlers.ExportTranscription) // Export a transcription
+
+	exports := protected.Group("/exports")
+	{
+		exports.GET("/formats", r.exportHandlers.GetExportFormats) // Supported formats
+		exports.POST("/archive", r.exportHandlers.ExportArchive)   // Archive all of the user's meetings
+		exports.GET("/jobs/:id", r.exportHandlers.GetExportJob)    // Background export status
+	}
+}
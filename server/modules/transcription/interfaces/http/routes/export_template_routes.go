@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ExportTemplateRoutes sets up export template routes
+type ExportTemplateRoutes struct {
+	templateHandlers *handlers.ExportTemplateHandlers
+	authMiddleware   *middleware.AuthMiddleware
+}
+
+// NewExportTemplateRoutes creates a new export template routes instance
+func NewExportTemplateRoutes(templateHandlers *handlers.ExportTemplateHandlers, authMiddleware *middleware.AuthMiddleware) *ExportTemplateRoutes {
+	return &ExportTemplateRoutes{
+		templateHandlers: templateHandlers,
+		authMiddleware:   authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected export template routes (authentication required)
+func (r *ExportTemplateRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	templates := protected.Group("/export-templates")
+	{
+		templates.POST("", r.templateHandlers.CreateExportTemplate)       // Upload template
+		templates.GET("", r.templateHandlers.GetExportTemplates)          // List built-in and user's templates
+		templates.GET("/:id", r.templateHandlers.GetExportTemplate)       // Get specific template
+		templates.PUT("/:id", r.templateHandlers.UpdateExportTemplate)    // Replace template
+		templates.DELETE("/:id", r.templateHandlers.DeleteExportTemplate) // Delete template
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// QuestionRoutes sets up routes for asking questions about meetings
+type QuestionRoutes struct {
+	questionHandlers *handlers.QuestionHandlers
+	authMiddleware   *middleware.AuthMiddleware
+}
+
+// NewQuestionRoutes creates a new question routes instance
+func NewQuestionRoutes(questionHandlers *handlers.QuestionHandlers, authMiddleware *middleware.AuthMiddleware) *QuestionRoutes {
+	return &QuestionRoutes{
+		questionHandlers: questionHandlers,
+		authMiddleware:   authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected question routes (authentication required)
+func (r *QuestionRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	questions := protected.Group("/meetings/:id/questions")
+	{
+		questions.POST("", r.questionHandlers.AskQuestion)            // Ask a question about a meeting
+		questions.GET("", r.questionHandlers.GetQuestions)            // Question history of a meeting
+		questions.GET("/:questionId", r.questionHandlers.GetQuestion) // Single question with its answer
+	}
+}
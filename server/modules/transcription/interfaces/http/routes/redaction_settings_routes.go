@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RedactionSettingsRoutes sets up PII redaction settings routes
+type RedactionSettingsRoutes struct {
+	redactionHandlers *handlers.RedactionSettingsHandlers
+	authMiddleware    *middleware.AuthMiddleware
+}
+
+// NewRedactionSettingsRoutes creates a new redaction settings routes instance
+func NewRedactionSettingsRoutes(redactionHandlers *handlers.RedactionSettingsHandlers, authMiddleware *middleware.AuthMiddleware) *RedactionSettingsRoutes {
+	return &RedactionSettingsRoutes{
+		redactionHandlers: redactionHandlers,
+		authMiddleware:    authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected redaction settings routes (authentication required)
+func (r *RedactionSettingsRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	settings := protected.Group("/settings")
+	{
+		settings.GET("/redaction", r.redactionHandlers.GetRedactionSettings)    // Default PII redaction
+		settings.PUT("/redaction", r.redactionHandlers.UpdateRedactionSettings) // Change default PII redaction
+	}
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SummaryRoutes sets up routes for transcription summaries
+type SummaryRoutes struct {
+	summaryHandlers *handlers.SummaryHandlers
+	authMiddleware  *middleware.AuthMiddleware
+}
+
+// NewSummaryRoutes creates a new summary routes instance
+func NewSummaryRoutes(summaryHandlers *handlers.SummaryHandlers, authMiddleware *middleware.AuthMiddleware) *SummaryRoutes {
+	return &SummaryRoutes{
+		summaryHandlers: summaryHandlers,
+		authMiddleware:  authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected summary routes (authentication required)
+func (r *SummaryRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	summary := protected.Group("/transcriptions/:id/summary")
+	{
+		summary.GET("", r.summaryHandlers.GetSummary)                    // Summary of a transcription
+		summary.POST("/regenerate", r.summaryHandlers.RegenerateSummary) // Summarize a transcription again
+		summary.GET("/jobs/:jobId", r.summaryHandlers.GetSummaryJob)     // Status of a summary
+	}
+}
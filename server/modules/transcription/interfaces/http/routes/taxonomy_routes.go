@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TaxonomyRoutes sets up analytics taxonomy routes
+type TaxonomyRoutes struct {
+	taxonomyHandlers *handlers.TaxonomyHandlers
+	authMiddleware   *middleware.AuthMiddleware
+}
+
+// NewTaxonomyRoutes creates a new taxonomy routes instance
+func NewTaxonomyRoutes(taxonomyHandlers *handlers.TaxonomyHandlers, authMiddleware *middleware.AuthMiddleware) *TaxonomyRoutes {
+	return &TaxonomyRoutes{
+		taxonomyHandlers: taxonomyHandlers,
+		authMiddleware:   authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected taxonomy routes (authentication required)
+func (r *TaxonomyRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	taxonomies := protected.Group("/analytics/taxonomies")
+	{
+		taxonomies.POST("", r.taxonomyHandlers.CreateTaxonomy)                // Create taxonomy
+		taxonomies.GET("", r.taxonomyHandlers.GetTaxonomies)                  // List built-in and user's taxonomies
+		taxonomies.GET("/:id", r.taxonomyHandlers.GetTaxonomy)                // Get specific taxonomy
+		taxonomies.PUT("/:id", r.taxonomyHandlers.UpdateTaxonomy)             // Replace taxonomy
+		taxonomies.DELETE("/:id", r.taxonomyHandlers.DeleteTaxonomy)          // Delete taxonomy
+		taxonomies.POST("/:id/activate", r.taxonomyHandlers.ActivateTaxonomy) // Use taxonomy for analytics
+	}
+}
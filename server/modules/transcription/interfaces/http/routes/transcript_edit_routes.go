@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TranscriptEditRoutes sets up transcript editing and revision routes
+type TranscriptEditRoutes struct {
+	editHandlers   *handlers.TranscriptEditHandlers
+	authMiddleware *middleware.AuthMiddleware
+}
+
+// NewTranscriptEditRoutes creates a new transcript edit routes instance
+func NewTranscriptEditRoutes(editHandlers *handlers.TranscriptEditHandlers, authMiddleware *middleware.AuthMiddleware) *TranscriptEditRoutes {
+	return &TranscriptEditRoutes{
+		editHandlers:   editHandlers,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected transcript edit routes (authentication required)
+func (r *TranscriptEditRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	transcriptions := protected.Group("/transcriptions/:id")
+	{
+		transcriptions.GET("/segments", r.editHandlers.GetSegments)                         // Current segments
+		transcriptions.PATCH("/segments/:segmentId", r.editHandlers.EditSegment)            // Edit text/speaker
+		transcriptions.POST("/segments/:segmentId/split", r.editHandlers.SplitSegment)      // Split a segment
+		transcriptions.POST("/segments/merge", r.editHandlers.MergeSegments)                // Merge adjacent segments
+		transcriptions.GET("/revisions", r.editHandlers.GetRevisions)                       // Revision history
+		transcriptions.GET("/revisions/:revision", r.editHandlers.GetRevision)              // Single revision
+		transcriptions.POST("/revisions/:revision/restore", r.editHandlers.RestoreRevision) // Restore a revision
+	}
+}
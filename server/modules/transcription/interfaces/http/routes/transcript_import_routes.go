@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TranscriptImportRoutes sets up transcript import routes
+type TranscriptImportRoutes struct {
+	importHandlers *handlers.TranscriptImportHandlers
+	authMiddleware *middleware.AuthMiddleware
+}
+
+// NewTranscriptImportRoutes creates a new transcript import routes instance
+func NewTranscriptImportRoutes(importHandlers *handlers.TranscriptImportHandlers, authMiddleware *middleware.AuthMiddleware) *TranscriptImportRoutes {
+	return &TranscriptImportRoutes{
+		importHandlers: importHandlers,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected transcript import routes (authentication required)
+func (r *TranscriptImportRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	protected.POST("/transcriptions/import", r.importHandlers.ImportTranscript) // Import an SRT, WebVTT or JSON transcript
+}
@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TranscriptSearchRoutes sets up transcript search routes
+type TranscriptSearchRoutes struct {
+	searchHandlers *handlers.TranscriptSearchHandlers
+	authMiddleware *middleware.AuthMiddleware
+}
+
+// NewTranscriptSearchRoutes creates a new transcript search routes instance
+func NewTranscriptSearchRoutes(searchHandlers *handlers.TranscriptSearchHandlers, authMiddleware *middleware.AuthMiddleware) *TranscriptSearchRoutes {
+	return &TranscriptSearchRoutes{
+		searchHandlers: searchHandlers,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected search routes (authentication required)
+func (r *TranscriptSearchRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	search := protected.Group("/search")
+	{
+		search.GET("/transcripts", r.searchHandlers.SearchTranscripts)    // Full-text transcript search
+		search.GET("/passages", r.searchHandlers.SearchPassages)          // Top-k relevant passages
+		search.GET("/passages/similar", r.searchHandlers.SimilarPassages) // Passages similar to a passage or segment
+	}
+}
@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"teammate/server/modules/transcription/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VocabularyRoutes sets up custom vocabulary routes
+type VocabularyRoutes struct {
+	vocabularyHandlers *handlers.VocabularyHandlers
+	authMiddleware     *middleware.AuthMiddleware
+}
+
+// NewVocabularyRoutes creates a new vocabulary routes instance
+func NewVocabularyRoutes(vocabularyHandlers *handlers.VocabularyHandlers, authMiddleware *middleware.AuthMiddleware) *VocabularyRoutes {
+	return &VocabularyRoutes{
+		vocabularyHandlers: vocabularyHandlers,
+		authMiddleware:     authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected vocabulary routes (authentication required)
+func (r *VocabularyRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	vocabularies := protected.Group("/vocabularies")
+	{
+		vocabularies.POST("", r.vocabularyHandlers.CreateVocabulary)       // Create vocabulary
+		vocabularies.GET("", r.vocabularyHandlers.GetVocabularies)         // List user's vocabularies
+		vocabularies.GET("/:id", r.vocabularyHandlers.GetVocabulary)       // Get specific vocabulary
+		vocabularies.PUT("/:id", r.vocabularyHandlers.UpdateVocabulary)    // Replace vocabulary
+		vocabularies.DELETE("/:id", r.vocabularyHandlers.DeleteVocabulary) // Delete vocabulary
+	}
+}
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// AddTeamMemberCommand represents a command by a team owner to add a user to the team
+type AddTeamMemberCommand struct {
+	TeamID       string            `json:"team_id"`
+	UserID       string            `json:"user_id"`
+	MemberUserID string            `json:"member_user_id"`
+	Role         entities.TeamRole `json:"role"`
+}
+
+// AddTeamMemberHandler handles the add team member command
+type AddTeamMemberHandler struct {
+	teamRepo repositories.TeamRepository
+	userRepo repositories.UserRepository
+}
+
+// NewAddTeamMemberHandler creates a new add team member handler
+func NewAddTeamMemberHandler(teamRepo repositories.TeamRepository, userRepo repositories.UserRepository) *AddTeamMemberHandler {
+	return &AddTeamMemberHandler{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+	}
+}
+
+// Handle executes the add team member command
+func (h *AddTeamMemberHandler) Handle(ctx context.Context, cmd AddTeamMemberCommand) (*entities.TeamMember, error) {
+	team, err := loadMemberTeam(ctx, h.teamRepo, cmd.TeamID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !team.Member(cmd.UserID).IsOwner() {
+		return nil, domain.NewDomainError("NOT_TEAM_OWNER", "Only team owners can manage members", domain.ErrForbidden)
+	}
+	if team.Member(cmd.MemberUserID) != nil {
+		return nil, domain.NewDomainError("TEAM_MEMBER_EXISTS", "User is already a member of the team", domain.ErrAlreadyExists)
+	}
+	if _, err := h.userRepo.FindByID(cmd.MemberUserID); err != nil {
+		return nil, domain.NewDomainError("USER_NOT_FOUND", "User not found", err)
+	}
+
+	if cmd.Role == "" {
+		cmd.Role = entities.TeamMemberRole
+	}
+	member, err := team.NewMember(cmd.MemberUserID, cmd.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.teamRepo.SaveMember(ctx, member); err != nil {
+		return nil, domain.NewDomainError("SAVE_TEAM_MEMBER_FAILED", "Failed to save team member", err)
+	}
+
+	return member, nil
+}
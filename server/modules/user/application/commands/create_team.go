@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// CreateTeamCommand represents a command to create a team owned by the user
+type CreateTeamCommand struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+}
+
+// CreateTeamHandler handles the create team command
+type CreateTeamHandler struct {
+	teamRepo repositories.TeamRepository
+}
+
+// NewCreateTeamHandler creates a new create team handler
+func NewCreateTeamHandler(teamRepo repositories.TeamRepository) *CreateTeamHandler {
+	return &CreateTeamHandler{
+		teamRepo: teamRepo,
+	}
+}
+
+// Handle executes the create team command
+func (h *CreateTeamHandler) Handle(ctx context.Context, cmd CreateTeamCommand) (*entities.Team, error) {
+	team, err := entities.NewTeam(cmd.Name, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.teamRepo.Save(ctx, &team); err != nil {
+		return nil, domain.NewDomainError("SAVE_TEAM_FAILED", "Failed to save team", err)
+	}
+
+	return &team, nil
+}
+
+// loadMemberTeam loads a team, reporting teams the user is not a member of as not found
+func loadMemberTeam(ctx context.Context, teamRepo repositories.TeamRepository, id, userID string) (*entities.Team, error) {
+	team, err := teamRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_TEAM_FAILED", "Failed to get team", err)
+	}
+	if team == nil || team.Member(userID) == nil {
+		return nil, domain.NewDomainError("TEAM_NOT_FOUND", "Team not found", domain.ErrNotFound)
+	}
+	return team, nil
+}
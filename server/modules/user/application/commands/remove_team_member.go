@@ -0,0 +1,53 @@
+package commands
+
+import (
+	"context"
+
+	"teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// RemoveTeamMemberCommand represents a command to remove a user from a team. Owners may remove
+// anyone; other members may only leave.
+type RemoveTeamMemberCommand struct {
+	TeamID       string `json:"team_id"`
+	UserID       string `json:"user_id"`
+	MemberUserID string `json:"member_user_id"`
+}
+
+// RemoveTeamMemberHandler handles the remove team member command
+type RemoveTeamMemberHandler struct {
+	teamRepo repositories.TeamRepository
+}
+
+// NewRemoveTeamMemberHandler creates a new remove team member handler
+func NewRemoveTeamMemberHandler(teamRepo repositories.TeamRepository) *RemoveTeamMemberHandler {
+	return &RemoveTeamMemberHandler{
+		teamRepo: teamRepo,
+	}
+}
+
+// Handle executes the remove team member command
+func (h *RemoveTeamMemberHandler) Handle(ctx context.Context, cmd RemoveTeamMemberCommand) error {
+	team, err := loadMemberTeam(ctx, h.teamRepo, cmd.TeamID, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	if cmd.MemberUserID != cmd.UserID && !team.Member(cmd.UserID).IsOwner() {
+		return domain.NewDomainError("NOT_TEAM_OWNER", "Only team owners can manage members", domain.ErrForbidden)
+	}
+
+	member := team.Member(cmd.MemberUserID)
+	if member == nil {
+		return domain.NewDomainError("TEAM_MEMBER_NOT_FOUND", "Team member not found", domain.ErrNotFound)
+	}
+	if member.IsOwner() && team.OwnerCount() == 1 {
+		return domain.NewDomainError("LAST_TEAM_OWNER", "The last owner of a team cannot be removed", domain.ErrInvalidInput)
+	}
+
+	if err := h.teamRepo.DeleteMember(ctx, member.ID); err != nil {
+		return domain.NewDomainError("DELETE_TEAM_MEMBER_FAILED", "Failed to remove team member", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package queries
+
+import (
+	"context"
+
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+)
+
+// GetTeamsQuery represents a query for the teams a user is a member of
+type GetTeamsQuery struct {
+	UserID string `json:"user_id"`
+}
+
+// GetTeamsHandler handles the get teams query
+type GetTeamsHandler struct {
+	teamRepo repositories.TeamRepository
+}
+
+// NewGetTeamsHandler creates a new get teams handler
+func NewGetTeamsHandler(teamRepo repositories.TeamRepository) *GetTeamsHandler {
+	return &GetTeamsHandler{
+		teamRepo: teamRepo,
+	}
+}
+
+// Handle executes the get teams query
+func (h *GetTeamsHandler) Handle(ctx context.Context, query GetTeamsQuery) ([]*entities.Team, error) {
+	teams, err := h.teamRepo.FindByUserID(ctx, query.UserID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_TEAMS_FAILED", "Failed to get teams", err)
+	}
+	return teams, nil
+}
+
+// GetTeamQuery represents a query for a team the user is a member of
+type GetTeamQuery struct {
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+}
+
+// GetTeamHandler handles the get team query
+type GetTeamHandler struct {
+	teamRepo repositories.TeamRepository
+}
+
+// NewGetTeamHandler creates a new get team handler
+func NewGetTeamHandler(teamRepo repositories.TeamRepository) *GetTeamHandler {
+	return &GetTeamHandler{
+		teamRepo: teamRepo,
+	}
+}
+
+// Handle executes the get team query. Teams the user is not a member of are reported as not found.
+func (h *GetTeamHandler) Handle(ctx context.Context, query GetTeamQuery) (*entities.Team, error) {
+	team, err := h.teamRepo.FindByID(ctx, query.ID)
+	if err != nil {
+		return nil, domain.NewDomainError("GET_TEAM_FAILED", "Failed to get team", err)
+	}
+	if team == nil || team.Member(query.UserID) == nil {
+		return nil, domain.NewDomainError("TEAM_NOT_FOUND", "Team not found", domain.ErrNotFound)
+	}
+	return team, nil
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+
+	"teammate/server/modules/user/application/commands"
+	"teammate/server/modules/user/application/queries"
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/domain/repositories"
+)
+
+// TeamService manages teams and their members. Team members see trends across each other's meetings.
+type TeamService struct {
+	createHandler       *commands.CreateTeamHandler
+	addMemberHandler    *commands.AddTeamMemberHandler
+	removeMemberHandler *commands.RemoveTeamMemberHandler
+	listHandler         *queries.GetTeamsHandler
+	getHandler          *queries.GetTeamHandler
+}
+
+// NewTeamService creates a new team service
+func NewTeamService(teamRepo repositories.TeamRepository, userRepo repositories.UserRepository) *TeamService {
+	return &TeamService{
+		createHandler:       commands.NewCreateTeamHandler(teamRepo),
+		addMemberHandler:    commands.NewAddTeamMemberHandler(teamRepo, userRepo),
+		removeMemberHandler: commands.NewRemoveTeamMemberHandler(teamRepo),
+		listHandler:         queries.NewGetTeamsHandler(teamRepo),
+		getHandler:          queries.NewGetTeamHandler(teamRepo),
+	}
+}
+
+// CreateTeam creates a team owned by the user
+func (s *TeamService) CreateTeam(ctx context.Context, cmd commands.CreateTeamCommand) (*entities.Team, error) {
+	return s.createHandler.Handle(ctx, cmd)
+}
+
+// AddMember adds a user to a team the user owns
+func (s *TeamService) AddMember(ctx context.Context, cmd commands.AddTeamMemberCommand) (*entities.TeamMember, error) {
+	return s.addMemberHandler.Handle(ctx, cmd)
+}
+
+// RemoveMember removes a member from a team the user owns, or the user from a team they leave
+func (s *TeamService) RemoveMember(ctx context.Context, cmd commands.RemoveTeamMemberCommand) error {
+	return s.removeMemberHandler.Handle(ctx, cmd)
+}
+
+// GetTeams lists the teams the user is a member of
+func (s *TeamService) GetTeams(ctx context.Context, userID string) ([]*entities.Team, error) {
+	return s.listHandler.Handle(ctx, queries.GetTeamsQuery{UserID: userID})
+}
+
+// GetTeam returns a team the user is a member of
+func (s *TeamService) GetTeam(ctx context.Context, id, userID string) (*entities.Team, error) {
+	return s.getHandler.Handle(ctx, queries.GetTeamQuery{ID: id, UserID: userID})
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"teammate/server/modules/user/application/commands"
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryTeamRepository keeps teams and their members in memory
+type memoryTeamRepository struct {
+	teams   map[string]entities.Team
+	members []entities.TeamMember
+}
+
+func (r *memoryTeamRepository) Save(ctx context.Context, team *entities.Team) error {
+	r.teams[team.ID] = entities.Team{BaseEntity: team.BaseEntity, Name: team.Name, CreatedBy: team.CreatedBy}
+	r.members = append(r.members, team.Members...)
+	return nil
+}
+
+func (r *memoryTeamRepository) FindByID(ctx context.Context, id string) (*entities.Team, error) {
+	team, ok := r.teams[id]
+	if !ok {
+		return nil, nil
+	}
+	for _, member := range r.members {
+		if member.TeamID == id {
+			team.Members = append(team.Members, member)
+		}
+	}
+	return &team, nil
+}
+
+func (r *memoryTeamRepository) FindByUserID(ctx context.Context, userID string) ([]*entities.Team, error) {
+	var teams []*entities.Team
+	for _, member := range r.members {
+		if member.UserID == userID {
+			team, _ := r.FindByID(ctx, member.TeamID)
+			teams = append(teams, team)
+		}
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+	return teams, nil
+}
+
+func (r *memoryTeamRepository) FindMember(ctx context.Context, teamID, userID string) (*entities.TeamMember, error) {
+	for i := range r.members {
+		if r.members[i].TeamID == teamID && r.members[i].UserID == userID {
+			return &r.members[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryTeamRepository) SaveMember(ctx context.Context, member *entities.TeamMember) error {
+	r.members = append(r.members, *member)
+	return nil
+}
+
+func (r *memoryTeamRepository) DeleteMember(ctx context.Context, id string) error {
+	for i, member := range r.members {
+		if member.ID == id {
+			r.members = append(r.members[:i], r.members[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+// knownUserRepository finds the users whose IDs it holds
+type knownUserRepository struct {
+	repositories.UserRepository
+	ids []string
+}
+
+func (r *knownUserRepository) FindByID(id string) (*entities.User, error) {
+	for _, known := range r.ids {
+		if known == id {
+			user := entities.NewUser(id, id, entities.Email{})
+			return &user, nil
+		}
+	}
+	return nil, errors.New("record not found")
+}
+
+func assertTeamErrorCode(t *testing.T, err error, code string) {
+	t.Helper()
+	var domainErr *domain.DomainError
+	require.ErrorAs(t, err, &domainErr)
+	assert.Equal(t, code, domainErr.Code)
+}
+
+func TestTeamService_Members(t *testing.T) {
+	service := NewTeamService(&memoryTeamRepository{teams: map[string]entities.Team{}}, &knownUserRepository{ids: []string{"owner", "ana", "ben"}})
+	ctx := context.Background()
+
+	_, err := service.CreateTeam(ctx, commands.CreateTeamCommand{UserID: "owner", Name: " "})
+	assertTeamErrorCode(t, err, "INVALID_TEAM")
+
+	team, err := service.CreateTeam(ctx, commands.CreateTeamCommand{UserID: "owner", Name: " Platform "})
+	require.NoError(t, err)
+	assert.Equal(t, "Platform", team.Name)
+	require.Len(t, team.Members, 1)
+	assert.True(t, team.Members[0].IsOwner())
+
+	member, err := service.AddMember(ctx, commands.AddTeamMemberCommand{TeamID: team.ID, UserID: "owner", MemberUserID: "ana"})
+	require.NoError(t, err)
+	assert.Equal(t, entities.TeamMemberRole, member.Role)
+
+	_, err = service.AddMember(ctx, commands.AddTeamMemberCommand{TeamID: team.ID, UserID: "owner", MemberUserID: "ana"})
+	assertTeamErrorCode(t, err, "TEAM_MEMBER_EXISTS")
+	_, err = service.AddMember(ctx, commands.AddTeamMemberCommand{TeamID: team.ID, UserID: "owner", MemberUserID: "nobody"})
+	assertTeamErrorCode(t, err, "USER_NOT_FOUND")
+	_, err = service.AddMember(ctx, commands.AddTeamMemberCommand{TeamID: team.ID, UserID: "ana", MemberUserID: "ben"})
+	assertTeamErrorCode(t, err, "NOT_TEAM_OWNER")
+	_, err = service.AddMember(ctx, commands.AddTeamMemberCommand{TeamID: team.ID, UserID: "ben", MemberUserID: "ben"})
+	assertTeamErrorCode(t, err, "TEAM_NOT_FOUND")
+
+	teams, err := service.GetTeams(ctx, "ana")
+	require.NoError(t, err)
+	require.Len(t, teams, 1)
+	assert.Len(t, teams[0].Members, 2)
+	_, err = service.GetTeam(ctx, team.ID, "ben")
+	assertTeamErrorCode(t, err, "TEAM_NOT_FOUND")
+
+	// Members may leave but only owners remove others, and a team keeps an owner
+	err = service.RemoveMember(ctx, commands.RemoveTeamMemberCommand{TeamID: team.ID, UserID: "ana", MemberUserID: "owner"})
+	assertTeamErrorCode(t, err, "NOT_TEAM_OWNER")
+	err = service.RemoveMember(ctx, commands.RemoveTeamMemberCommand{TeamID: team.ID, UserID: "owner", MemberUserID: "owner"})
+	assertTeamErrorCode(t, err, "LAST_TEAM_OWNER")
+	require.NoError(t, service.RemoveMember(ctx, commands.RemoveTeamMemberCommand{TeamID: team.ID, UserID: "ana", MemberUserID: "ana"}))
+
+	teams, err = service.GetTeams(ctx, "ana")
+	require.NoError(t, err)
+	assert.Empty(t, teams)
+}
@@ -19,7 +19,7 @@ type IntegrationConfig struct {
 	UserID       string                 `json:"user_id" gorm:"column:user_id;not null"`
 	ProviderType ProviderType           `json:"provider_type" gorm:"column:provider_type;not null"`
 	ProviderName string                 `json:"provider_name" gorm:"column:provider_name;not null"`
-	Config       map[string]interface{} `json:"config" gorm:"column:config;type:jsonb;not null"`
+	Config       map[string]interface{} `json:"config" gorm:"column:config;type:jsonb;not null;serializer:json"`
 	IsActive     bool                   `json:"is_active" gorm:"column:is_active;default:true"`
 }
 
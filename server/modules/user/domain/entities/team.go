@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"strings"
+
+	"teammate/server/seedwork/domain"
+)
+
+type TeamRole string
+
+const (
+	TeamOwnerRole  TeamRole = "owner"
+	TeamMemberRole TeamRole = "member"
+)
+
+// Team is a group of users who see analytics across each other's meetings
+type Team struct {
+	domain.BaseEntity
+	Name      string       `json:"name" gorm:"column:name;not null"`
+	CreatedBy string       `json:"created_by" gorm:"column:created_by;not null"`
+	Members   []TeamMember `json:"members" gorm:"foreignKey:TeamID"`
+}
+
+// TeamMember is a user's membership of a team. Owners manage the members.
+type TeamMember struct {
+	domain.BaseEntity
+	TeamID string   `json:"team_id" gorm:"column:team_id;not null"`
+	UserID string   `json:"user_id" gorm:"column:user_id;not null"`
+	Role   TeamRole `json:"role" gorm:"column:role;not null"`
+}
+
+// NewTeam creates a new Team entity with its creator as the owner
+func NewTeam(name, createdBy string) (Team, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Team{}, domain.NewDomainError("INVALID_TEAM", "Team name is required", domain.ErrInvalidInput)
+	}
+
+	team := Team{Name: name, CreatedBy: createdBy}
+	team.SetID(domain.GenerateID())
+	owner, err := team.NewMember(createdBy, TeamOwnerRole)
+	if err != nil {
+		return Team{}, err
+	}
+	team.Members = []TeamMember{*owner}
+	return team, nil
+}
+
+// NewMember creates a membership of the team for a user
+func (t *Team) NewMember(userID string, role TeamRole) (*TeamMember, error) {
+	if userID == "" {
+		return nil, domain.NewDomainError("INVALID_TEAM_MEMBER", "User ID is required", domain.ErrInvalidInput)
+	}
+	if !role.IsValid() {
+		return nil, domain.NewDomainError("INVALID_TEAM_MEMBER", "Role must be owner or member", domain.ErrInvalidInput)
+	}
+
+	member := &TeamMember{TeamID: t.GetID(), UserID: userID, Role: role}
+	member.SetID(domain.GenerateID())
+	return member, nil
+}
+
+// Member returns the membership of a user, or nil if they are not in the team
+func (t *Team) Member(userID string) *TeamMember {
+	for i := range t.Members {
+		if t.Members[i].UserID == userID {
+			return &t.Members[i]
+		}
+	}
+	return nil
+}
+
+// OwnerCount returns the number of members who own the team
+func (t *Team) OwnerCount() int {
+	count := 0
+	for _, member := range t.Members {
+		if member.IsOwner() {
+			count++
+		}
+	}
+	return count
+}
+
+// IsValid returns true if the role is a known team role
+func (r TeamRole) IsValid() bool {
+	return r == TeamOwnerRole || r == TeamMemberRole
+}
+
+// IsOwner returns true if the member manages the team
+func (m *TeamMember) IsOwner() bool {
+	return m.Role == TeamOwnerRole
+}
+
+// TableName sets the table name for GORM
+func (Team) TableName() string {
+	return "teams"
+}
+
+// TableName sets the table name for GORM
+func (TeamMember) TableName() string {
+	return "team_members"
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/user/domain/entities"
+)
+
+// IntegrationConfigRepository defines the interface for persisting users' integration configurations
+type IntegrationConfigRepository interface {
+	// FindByID returns nil if the configuration does not exist
+	FindByID(ctx context.Context, id string) (*entities.IntegrationConfig, error)
+	// FindByProvider returns nil if the user has not configured the provider
+	FindByProvider(ctx context.Context, userID string, providerType entities.ProviderType, providerName string) (*entities.IntegrationConfig, error)
+	// FindByUserID returns the user's configurations of a provider type
+	FindByUserID(ctx context.Context, userID string, providerType entities.ProviderType) ([]*entities.IntegrationConfig, error)
+	// Save creates or updates a configuration
+	Save(ctx context.Context, config *entities.IntegrationConfig) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/modules/user/domain/entities"
+)
+
+// TeamRepository defines the interface for persisting teams and their members
+type TeamRepository interface {
+	// Save creates a team together with its members
+	Save(ctx context.Context, team *entities.Team) error
+	// FindByID returns the team with its members, or nil if it does not exist
+	FindByID(ctx context.Context, id string) (*entities.Team, error)
+	// FindByUserID returns the teams the user is a member of, with their members
+	FindByUserID(ctx context.Context, userID string) ([]*entities.Team, error)
+	// FindMember returns the user's membership of a team, or nil if they are not a member
+	FindMember(ctx context.Context, teamID, userID string) (*entities.TeamMember, error)
+	SaveMember(ctx context.Context, member *entities.TeamMember) error
+	DeleteMember(ctx context.Context, id string) error
+}
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormIntegrationConfigRepository handles database operations for integration configurations using GORM
+type GormIntegrationConfigRepository struct {
+	db *gorm.DB
+}
+
+// Ensure GormIntegrationConfigRepository implements IntegrationConfigRepository
+var _ repositories.IntegrationConfigRepository = (*GormIntegrationConfigRepository)(nil)
+
+// NewGormIntegrationConfigRepository creates a new GORM-based integration configuration repository
+func NewGormIntegrationConfigRepository() *GormIntegrationConfigRepository {
+	return &GormIntegrationConfigRepository{db: database.GetDB()}
+}
+
+// FindByID retrieves a configuration, or nil if it does not exist
+func (r *GormIntegrationConfigRepository) FindByID(ctx context.Context, id string) (*entities.IntegrationConfig, error) {
+	var config entities.IntegrationConfig
+	err := r.db.WithContext(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&config).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// FindByProvider retrieves the user's configuration of a provider, or nil if there is none
+func (r *GormIntegrationConfigRepository) FindByProvider(ctx context.Context, userID string, providerType entities.ProviderType, providerName string) (*entities.IntegrationConfig, error) {
+	var config entities.IntegrationConfig
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND provider_type = ? AND provider_name = ? AND deleted_at IS NULL", userID, string(providerType), providerName).
+		First(&config).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// FindByUserID retrieves the user's configurations of a provider type
+func (r *GormIntegrationConfigRepository) FindByUserID(ctx context.Context, userID string, providerType entities.ProviderType) ([]*entities.IntegrationConfig, error) {
+	var configs []*entities.IntegrationConfig
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND provider_type = ? AND deleted_at IS NULL", userID, string(providerType)).
+		Order("provider_name").
+		Find(&configs).Error
+	return configs, err
+}
+
+// Save creates or updates a configuration
+func (r *GormIntegrationConfigRepository) Save(ctx context.Context, config *entities.IntegrationConfig) error {
+	return r.db.WithContext(ctx).Save(config).Error
+}
+
+// Delete removes a configuration. It is deleted for good so the provider can be configured again.
+func (r *GormIntegrationConfigRepository) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&entities.IntegrationConfig{}, "id = ?", id).Error
+}
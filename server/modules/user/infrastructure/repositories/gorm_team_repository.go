@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/domain/repositories"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormTeamRepository handles database operations for teams using GORM
+type GormTeamRepository struct {
+	db *gorm.DB
+}
+
+// Ensure GormTeamRepository implements TeamRepository
+var _ repositories.TeamRepository = (*GormTeamRepository)(nil)
+
+// NewGormTeamRepository creates a new GORM-based team repository
+func NewGormTeamRepository() *GormTeamRepository {
+	return &GormTeamRepository{db: database.GetDB()}
+}
+
+// Save creates a team and its members in a single transaction
+func (r *GormTeamRepository) Save(ctx context.Context, team *entities.Team) error {
+	return r.db.WithContext(ctx).Create(team).Error
+}
+
+// FindByID retrieves a team with its members, or nil if it does not exist
+func (r *GormTeamRepository) FindByID(ctx context.Context, id string) (*entities.Team, error) {
+	var team entities.Team
+	err := r.db.WithContext(ctx).Preload("Members", orderMembers).Where("id = ?", id).First(&team).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &team, nil
+}
+
+// FindByUserID retrieves the teams the user is a member of, with their members
+func (r *GormTeamRepository) FindByUserID(ctx context.Context, userID string) ([]*entities.Team, error) {
+	var teams []*entities.Team
+	err := r.db.WithContext(ctx).
+		Preload("Members", orderMembers).
+		Where("EXISTS (SELECT 1 FROM team_members tm WHERE tm.team_id = teams.id AND tm.user_id = ?)", userID).
+		Order("teams.name, teams.id").
+		Find(&teams).Error
+	return teams, err
+}
+
+// FindMember retrieves the user's membership of a team, or nil if they are not a member
+func (r *GormTeamRepository) FindMember(ctx context.Context, teamID, userID string) (*entities.TeamMember, error) {
+	var member entities.TeamMember
+	err := r.db.WithContext(ctx).Where("team_id = ? AND user_id = ?", teamID, userID).First(&member).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// SaveMember creates or updates a membership
+func (r *GormTeamRepository) SaveMember(ctx context.Context, member *entities.TeamMember) error {
+	return r.db.WithContext(ctx).Save(member).Error
+}
+
+// DeleteMember removes a membership
+func (r *GormTeamRepository) DeleteMember(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Delete(&entities.TeamMember{}, "id = ?", id).Error
+}
+
+// orderMembers lists the members of a team in the order they joined
+func orderMembers(db *gorm.DB) *gorm.DB {
+	return db.Order("team_members.created_at, team_members.id")
+}
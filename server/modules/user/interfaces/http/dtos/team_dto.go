@@ -0,0 +1,74 @@
+package dtos
+
+import (
+	"time"
+
+	"teammate/server/modules/user/domain/entities"
+)
+
+// CreateTeamRequest represents the request to create a team
+type CreateTeamRequest struct {
+	Name string `json:"name" binding:"required,max=255"`
+}
+
+// AddTeamMemberRequest represents the request to add a user to a team
+type AddTeamMemberRequest struct {
+	UserID string `json:"user_id" binding:"required"`
+	// Role is owner or member (default)
+	Role string `json:"role" binding:"omitempty,oneof=owner member"`
+}
+
+// TeamMemberResponse represents a member of a team
+type TeamMemberResponse struct {
+	UserID   string    `json:"user_id"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// TeamResponse represents a team and its members
+type TeamResponse struct {
+	ID        string               `json:"id"`
+	Name      string               `json:"name"`
+	CreatedBy string               `json:"created_by"`
+	Members   []TeamMemberResponse `json:"members"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// TeamsListResponse represents the teams of a user
+type TeamsListResponse struct {
+	Teams []TeamResponse `json:"teams"`
+}
+
+// ToTeamMemberResponse converts a TeamMember entity to TeamMemberResponse DTO
+func ToTeamMemberResponse(member *entities.TeamMember) TeamMemberResponse {
+	return TeamMemberResponse{
+		UserID:   member.UserID,
+		Role:     string(member.Role),
+		JoinedAt: member.GetCreatedAt(),
+	}
+}
+
+// ToTeamResponse converts a Team entity to TeamResponse DTO
+func ToTeamResponse(team *entities.Team) TeamResponse {
+	members := make([]TeamMemberResponse, len(team.Members))
+	for i := range team.Members {
+		members[i] = ToTeamMemberResponse(&team.Members[i])
+	}
+
+	return TeamResponse{
+		ID:        team.GetID(),
+		Name:      team.Name,
+		CreatedBy: team.CreatedBy,
+		Members:   members,
+		CreatedAt: team.GetCreatedAt(),
+	}
+}
+
+// ToTeamsListResponse converts a slice of Team entities to TeamsListResponse DTO
+func ToTeamsListResponse(teams []*entities.Team) TeamsListResponse {
+	responses := make([]TeamResponse, len(teams))
+	for i, team := range teams {
+		responses[i] = ToTeamResponse(team)
+	}
+	return TeamsListResponse{Teams: responses}
+}
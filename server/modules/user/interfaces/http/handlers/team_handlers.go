@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"net/http"
+
+	"teammate/server/modules/user/application/commands"
+	"teammate/server/modules/user/application/services"
+	"teammate/server/modules/user/domain/entities"
+	"teammate/server/modules/user/interfaces/http/dtos"
+	"teammate/server/seedwork/domain"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TeamHandlers contains HTTP handlers for managing teams and their members
+type TeamHandlers struct {
+	teamService *services.TeamService
+}
+
+// NewTeamHandlers creates a new team handlers instance
+func NewTeamHandlers(teamService *services.TeamService) *TeamHandlers {
+	return &TeamHandlers{
+		teamService: teamService,
+	}
+}
+
+// CreateTeam creates a team owned by the authenticated user
+// @Summary Create a team
+// @Description Create a team with the authenticated user as its owner. Team members see trends across each other's meetings.
+// @Tags teams
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param team body dtos.CreateTeamRequest true "Team"
+// @Success 201 {object} dtos.TeamResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /teams [post]
+func (h *TeamHandlers) CreateTeam(c *gin.Context) {
+	userID, ok := getTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.CreateTeamRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	team, err := h.teamService.CreateTeam(c.Request.Context(), commands.CreateTeamCommand{UserID: userID, Name: req.Name})
+	if err != nil {
+		respondWithTeamError(c, err, "Failed to create team")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToTeamResponse(team))
+}
+
+// GetTeams lists the teams of the authenticated user
+// @Summary List teams
+// @Description List the teams the authenticated user is a member of, with their members
+// @Tags teams
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dtos.TeamsListResponse
+// @Failure 500 {object} map[string]string
+// @Router /teams [get]
+func (h *TeamHandlers) GetTeams(c *gin.Context) {
+	userID, ok := getTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	teams, err := h.teamService.GetTeams(c.Request.Context(), userID)
+	if err != nil {
+		respondWithTeamError(c, err, "Failed to retrieve teams")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTeamsListResponse(teams))
+}
+
+// GetTeam returns a team of the authenticated user
+// @Summary Get a team
+// @Description Get a team the authenticated user is a member of, with its members
+// @Tags teams
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Team ID"
+// @Success 200 {object} dtos.TeamResponse
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /teams/{id} [get]
+func (h *TeamHandlers) GetTeam(c *gin.Context) {
+	userID, ok := getTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	team, err := h.teamService.GetTeam(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		respondWithTeamError(c, err, "Failed to retrieve team")
+		return
+	}
+
+	c.JSON(http.StatusOK, dtos.ToTeamResponse(team))
+}
+
+// AddTeamMember adds a user to a team
+// @Summary Add a team member
+// @Description Add a user to a team the authenticated user owns
+// @Tags teams
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Team ID"
+// @Param member body dtos.AddTeamMemberRequest true "Member"
+// @Success 201 {object} dtos.TeamMemberResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /teams/{id}/members [post]
+func (h *TeamHandlers) AddTeamMember(c *gin.Context) {
+	userID, ok := getTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dtos.AddTeamMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	member, err := h.teamService.AddMember(c.Request.Context(), commands.AddTeamMemberCommand{
+		TeamID:       c.Param("id"),
+		UserID:       userID,
+		MemberUserID: req.UserID,
+		Role:         entities.TeamRole(req.Role),
+	})
+	if err != nil {
+		respondWithTeamError(c, err, "Failed to add team member")
+		return
+	}
+
+	c.JSON(http.StatusCreated, dtos.ToTeamMemberResponse(member))
+}
+
+// RemoveTeamMember removes a user from a team
+// @Summary Remove a team member
+// @Description Remove a member from a team the authenticated user owns, or leave a team by removing yourself. The last owner cannot be removed.
+// @Tags teams
+// @Security BearerAuth
+// @Param id path string true "Team ID"
+// @Param userId path string true "User ID of the member"
+// @Success 204
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /teams/{id}/members/{userId} [delete]
+func (h *TeamHandlers) RemoveTeamMember(c *gin.Context) {
+	userID, ok := getTeamUserID(c)
+	if !ok {
+		return
+	}
+
+	err := h.teamService.RemoveMember(c.Request.Context(), commands.RemoveTeamMemberCommand{
+		TeamID:       c.Param("id"),
+		UserID:       userID,
+		MemberUserID: c.Param("userId"),
+	})
+	if err != nil {
+		respondWithTeamError(c, err, "Failed to remove team member")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// respondWithTeamError maps team domain errors to HTTP responses. Teams the user is not a member
+// of are reported as not found so their existence is not revealed.
+func respondWithTeamError(c *gin.Context, err error, fallback string) {
+	if domainErr, ok := err.(*domain.DomainError); ok {
+		switch domainErr.Code {
+		case "TEAM_NOT_FOUND", "TEAM_MEMBER_NOT_FOUND", "USER_NOT_FOUND":
+			c.JSON(http.StatusNotFound, gin.H{"error": domainErr.Message})
+			return
+		case "INVALID_TEAM", "INVALID_TEAM_MEMBER", "LAST_TEAM_OWNER":
+			c.JSON(http.StatusBadRequest, gin.H{"error": domainErr.Message})
+			return
+		case "NOT_TEAM_OWNER":
+			c.JSON(http.StatusForbidden, gin.H{"error": domainErr.Message})
+			return
+		case "TEAM_MEMBER_EXISTS":
+			c.JSON(http.StatusConflict, gin.H{"error": domainErr.Message})
+			return
+		}
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": fallback})
+}
+
+// getTeamUserID returns the ID of the authenticated user set by the auth middleware
+func getTeamUserID(c *gin.Context) (string, bool) {
+	user, ok := c.Get("user")
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Not authenticated"})
+		return "", false
+	}
+	authenticated, ok := user.(*entities.User)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Invalid user data"})
+		return "", false
+	}
+	return authenticated.GetID(), true
+}
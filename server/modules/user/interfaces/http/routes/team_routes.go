@@ -0,0 +1,37 @@
+package routes
+
+import (
+	"teammate/server/modules/user/interfaces/http/handlers"
+	"teammate/server/modules/user/interfaces/http/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TeamRoutes sets up team routes
+type TeamRoutes struct {
+	teamHandlers   *handlers.TeamHandlers
+	authMiddleware *middleware.AuthMiddleware
+}
+
+// NewTeamRoutes creates a new team routes instance
+func NewTeamRoutes(teamHandlers *handlers.TeamHandlers, authMiddleware *middleware.AuthMiddleware) *TeamRoutes {
+	return &TeamRoutes{
+		teamHandlers:   teamHandlers,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// SetupProtectedRoutes sets up protected team routes (authentication required)
+func (r *TeamRoutes) SetupProtectedRoutes(protected *gin.RouterGroup) {
+	// Apply auth middleware to protected routes
+	protected.Use(r.authMiddleware.FirebaseAuth())
+
+	teams := protected.Group("/teams")
+	{
+		teams.POST("", r.teamHandlers.CreateTeam)                             // Create team
+		teams.GET("", r.teamHandlers.GetTeams)                                // List user's teams
+		teams.GET("/:id", r.teamHandlers.GetTeam)                             // Get specific team
+		teams.POST("/:id/members", r.teamHandlers.AddTeamMember)              // Add member
+		teams.DELETE("/:id/members/:userId", r.teamHandlers.RemoveTeamMember) // Remove member or leave
+	}
+}
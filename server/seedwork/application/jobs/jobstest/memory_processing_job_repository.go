@@ -0,0 +1,103 @@
+// Package jobstest provides an in-memory processing job repository for tests of services that
+// run background jobs.
+package jobstest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"teammate/server/seedwork/domain/entities"
+	"teammate/server/seedwork/domain/repositories"
+)
+
+// Ensure MemoryProcessingJobRepository implements ProcessingJobRepository
+var _ repositories.ProcessingJobRepository = (*MemoryProcessingJobRepository)(nil)
+
+// ErrJobNotFound is returned by MemoryProcessingJobRepository for unknown jobs
+var ErrJobNotFound = errors.New("record not found")
+
+// MemoryProcessingJobRepository keeps copies of processing jobs in memory. Like the database it
+// refuses to work under a context that is done.
+type MemoryProcessingJobRepository struct {
+	mu   sync.Mutex
+	jobs map[string]entities.ProcessingJob
+}
+
+// NewMemoryProcessingJobRepository creates an empty in-memory processing job repository
+func NewMemoryProcessingJobRepository() *MemoryProcessingJobRepository {
+	return &MemoryProcessingJobRepository{jobs: make(map[string]entities.ProcessingJob)}
+}
+
+// Save stores a new processing job
+func (r *MemoryProcessingJobRepository) Save(ctx context.Context, job *entities.ProcessingJob) error {
+	return r.Update(ctx, job)
+}
+
+// Update stores a copy of a processing job
+func (r *MemoryProcessingJobRepository) Update(ctx context.Context, job *entities.ProcessingJob) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[job.GetID()] = copyJob(job)
+	return nil
+}
+
+// FindByID returns a copy of a processing job
+func (r *MemoryProcessingJobRepository) FindByID(ctx context.Context, id string) (*entities.ProcessingJob, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, ErrJobNotFound
+	}
+	stored := copyJob(&job)
+	return &stored, nil
+}
+
+// FindByEntity returns the jobs of one type for an entity, newest first
+func (r *MemoryProcessingJobRepository) FindByEntity(ctx context.Context, entityType, entityID, jobType string) ([]*entities.ProcessingJob, error) {
+	jobs := r.find(func(job *entities.ProcessingJob) bool {
+		return job.EntityType == entityType && job.EntityID == entityID && job.JobType == jobType
+	})
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].ScheduledAt.After(*jobs[j].ScheduledAt) })
+	return jobs, ctx.Err()
+}
+
+// FindByJobTypeAndStatus returns the jobs of one type in a status, oldest first
+func (r *MemoryProcessingJobRepository) FindByJobTypeAndStatus(ctx context.Context, jobType string, status entities.ProcessingJobStatus) ([]*entities.ProcessingJob, error) {
+	jobs := r.find(func(job *entities.ProcessingJob) bool {
+		return job.JobType == jobType && job.Status == status
+	})
+	sort.SliceStable(jobs, func(i, j int) bool { return jobs[i].ScheduledAt.Before(*jobs[j].ScheduledAt) })
+	return jobs, ctx.Err()
+}
+
+func (r *MemoryProcessingJobRepository) find(match func(*entities.ProcessingJob) bool) []*entities.ProcessingJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var jobs []*entities.ProcessingJob
+	for _, job := range r.jobs {
+		if match(&job) {
+			stored := copyJob(&job)
+			jobs = append(jobs, &stored)
+		}
+	}
+	return jobs
+}
+
+// copyJob copies a job with its payload so that stored jobs are never shared with callers
+func copyJob(job *entities.ProcessingJob) entities.ProcessingJob {
+	stored := *job
+	stored.Payload = make(map[string]interface{}, len(job.Payload))
+	for key, value := range job.Payload {
+		stored.Payload[key] = value
+	}
+	return stored
+}
@@ -0,0 +1,131 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"teammate/server/seedwork/domain/entities"
+	"teammate/server/seedwork/domain/repositories"
+)
+
+// finishTimeout bounds recording the outcome of a job. The outcome is saved under its own context so
+// that a job that ran out of time is still marked as failed instead of staying "processing".
+const finishTimeout = 30 * time.Second
+
+// Handler does the work of a job. It may store its result in the job's payload; an error fails the job.
+type Handler func(ctx context.Context, job *entities.ProcessingJob) error
+
+// FinishHook cleans up after a job has completed or failed, before its outcome is saved. It runs
+// under a fresh context, also when the job ran out of time.
+type FinishHook func(ctx context.Context, job *entities.ProcessingJob, err error)
+
+// Runner runs the background processing jobs of a service on a bounded number of workers
+type Runner struct {
+	jobRepo  repositories.ProcessingJobRepository
+	name     string
+	timeout  time.Duration
+	handler  Handler
+	onFinish FinishHook
+	workers  chan struct{}
+}
+
+// NewRunner creates a runner for jobs described by name in logs and errors, e.g. "export", that run
+// at most workers at a time and for at most timeout each
+func NewRunner(jobRepo repositories.ProcessingJobRepository, name string, workers int, timeout time.Duration, handler Handler) *Runner {
+	return &Runner{
+		jobRepo: jobRepo,
+		name:    name,
+		timeout: timeout,
+		handler: handler,
+		workers: make(chan struct{}, workers),
+	}
+}
+
+// OnFinish sets a hook that runs after every job
+func (r *Runner) OnFinish(hook FinishHook) *Runner {
+	r.onFinish = hook
+	return r
+}
+
+// Enqueue runs a saved job in the background
+func (r *Runner) Enqueue(jobID string) {
+	go r.Run(jobID)
+}
+
+// Run runs a job once a worker is free and records its outcome on the job.
+// The job is reloaded so the caller's copy is never shared with the worker.
+func (r *Runner) Run(jobID string) {
+	r.workers <- struct{}{}
+	defer func() { <-r.workers }()
+
+	job, err := r.jobRepo.FindByID(context.Background(), jobID)
+	if err != nil {
+		log.Printf("Failed to load %s job %s: %v", r.name, jobID, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	job.Start()
+	if err := r.jobRepo.Update(ctx, job); err != nil {
+		log.Printf("Failed to update %s job %s: %v", r.name, job.GetID(), err)
+	}
+
+	err = r.handler(ctx, job)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("%s job timed out after %s: %w", r.name, r.timeout, err)
+	}
+	r.finish(job, err)
+}
+
+// Resume restarts the jobs of the given types that were queued or running when the server stopped.
+// Running jobs are retried a few times before they fail.
+func (r *Runner) Resume(ctx context.Context, jobTypes ...string) error {
+	for _, jobType := range jobTypes {
+		for _, status := range []entities.ProcessingJobStatus{entities.JobProcessing, entities.JobPending} {
+			jobs, err := r.jobRepo.FindByJobTypeAndStatus(ctx, jobType, status)
+			if err != nil {
+				return err
+			}
+			for _, job := range jobs {
+				if job.IsProcessing() {
+					if !job.CanRetry() {
+						r.finish(job, fmt.Errorf("%s job interrupted too many times", r.name))
+						continue
+					}
+					job.Retry()
+					if err := r.jobRepo.Update(ctx, job); err != nil {
+						log.Printf("Failed to update %s job %s: %v", r.name, job.GetID(), err)
+						continue
+					}
+				}
+				r.Enqueue(job.GetID())
+			}
+		}
+	}
+	return nil
+}
+
+// finish marks a job as completed or failed, runs the finish hook and saves the job
+func (r *Runner) finish(job *entities.ProcessingJob, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), finishTimeout)
+	defer cancel()
+
+	if err != nil {
+		log.Printf("%s job %s failed: %v", r.name, job.GetID(), err)
+		job.Fail(err.Error())
+	} else {
+		job.Complete()
+	}
+	if r.onFinish != nil {
+		r.onFinish(ctx, job, err)
+	}
+
+	if err := r.jobRepo.Update(ctx, job); err != nil {
+		log.Printf("Failed to update %s job %s: %v", r.name, job.GetID(), err)
+	}
+}
@@ -0,0 +1,135 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"teammate/server/seedwork/application/jobs/jobstest"
+	"teammate/server/seedwork/domain/entities"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func saveJob(t *testing.T, jobRepo *jobstest.MemoryProcessingJobRepository, jobType string) *entities.ProcessingJob {
+	t.Helper()
+	job := entities.NewProcessingJob("transcription", "tr-1", jobType, nil)
+	require.NoError(t, jobRepo.Save(context.Background(), &job))
+	return &job
+}
+
+func findJob(t *testing.T, jobRepo *jobstest.MemoryProcessingJobRepository, id string) *entities.ProcessingJob {
+	t.Helper()
+	job, err := jobRepo.FindByID(context.Background(), id)
+	require.NoError(t, err)
+	return job
+}
+
+func TestRunner_Run(t *testing.T) {
+	jobRepo := jobstest.NewMemoryProcessingJobRepository()
+	runner := NewRunner(jobRepo, "export", 1, time.Minute, func(ctx context.Context, job *entities.ProcessingJob) error {
+		assert.True(t, job.IsProcessing())
+		if job.JobType == "fail" {
+			return errors.New("renderer crashed")
+		}
+		job.SetPayloadValue("result", "done")
+		return nil
+	})
+
+	completed := saveJob(t, jobRepo, "succeed")
+	runner.Run(completed.GetID())
+	job := findJob(t, jobRepo, completed.GetID())
+	assert.True(t, job.IsCompleted())
+	assert.NotNil(t, job.StartedAt)
+	assert.Equal(t, "done", job.Payload["result"])
+
+	failed := saveJob(t, jobRepo, "fail")
+	runner.Run(failed.GetID())
+	job = findJob(t, jobRepo, failed.GetID())
+	assert.True(t, job.IsFailed())
+	assert.Equal(t, "renderer crashed", job.ErrorMessage)
+}
+
+func TestRunner_TimedOutJobsFail(t *testing.T) {
+	jobRepo := jobstest.NewMemoryProcessingJobRepository()
+	var hookErr error
+	runner := NewRunner(jobRepo, "transcribe", 1, 20*time.Millisecond, func(ctx context.Context, job *entities.ProcessingJob) error {
+		<-ctx.Done()
+		job.SetPayloadValue("partial", true)
+		return ctx.Err()
+	}).OnFinish(func(ctx context.Context, job *entities.ProcessingJob, err error) {
+		// The hook can still reach the database after the job ran out of time
+		hookErr = ctx.Err()
+		assert.Error(t, err)
+	})
+
+	saved := saveJob(t, jobRepo, "transcribe")
+	runner.Run(saved.GetID())
+
+	job := findJob(t, jobRepo, saved.GetID())
+	assert.True(t, job.IsFailed())
+	assert.Contains(t, job.ErrorMessage, "transcribe job timed out")
+	assert.Equal(t, true, job.Payload["partial"])
+	assert.NoError(t, hookErr)
+}
+
+func TestRunner_LimitsWorkers(t *testing.T) {
+	jobRepo := jobstest.NewMemoryProcessingJobRepository()
+	var mu sync.Mutex
+	running, peak := 0, 0
+	runner := NewRunner(jobRepo, "summarize", 2, time.Minute, func(ctx context.Context, job *entities.ProcessingJob) error {
+		mu.Lock()
+		running++
+		peak = max(peak, running)
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		job := saveJob(t, jobRepo, "summarize")
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runner.Run(job.GetID())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2, peak)
+}
+
+func TestRunner_Resume(t *testing.T) {
+	jobRepo := jobstest.NewMemoryProcessingJobRepository()
+	runner := NewRunner(jobRepo, "export", 1, time.Minute, func(ctx context.Context, job *entities.ProcessingJob) error {
+		return nil
+	})
+
+	pending := saveJob(t, jobRepo, "export")
+	interrupted := saveJob(t, jobRepo, "archive_export")
+	interrupted.Start()
+	require.NoError(t, jobRepo.Update(context.Background(), interrupted))
+	exhausted := saveJob(t, jobRepo, "export")
+	exhausted.RetryCount = 3
+	exhausted.Start()
+	require.NoError(t, jobRepo.Update(context.Background(), exhausted))
+	other := saveJob(t, jobRepo, "summarize")
+
+	require.NoError(t, runner.Resume(context.Background(), "export", "archive_export"))
+
+	require.Eventually(t, func() bool {
+		return findJob(t, jobRepo, pending.GetID()).IsCompleted() && findJob(t, jobRepo, interrupted.GetID()).IsCompleted()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, findJob(t, jobRepo, interrupted.GetID()).RetryCount)
+
+	job := findJob(t, jobRepo, exhausted.GetID())
+	assert.True(t, job.IsFailed())
+	assert.Equal(t, "export job interrupted too many times", job.ErrorMessage)
+	assert.True(t, findJob(t, jobRepo, other.GetID()).IsPending())
+}
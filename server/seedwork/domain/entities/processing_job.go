@@ -13,7 +13,7 @@ type ProcessingJob struct {
 	EntityID     string                 `json:"entity_id" gorm:"column:entity_id;not null"`
 	JobType      string                 `json:"job_type" gorm:"column:job_type;not null"`
 	Status       ProcessingJobStatus    `json:"status" gorm:"column:status;not null"`
-	Payload      map[string]interface{} `json:"payload" gorm:"column:payload;type:jsonb;not null"`
+	Payload      map[string]interface{} `json:"payload" gorm:"column:payload;type:jsonb;serializer:json;not null"`
 	ErrorMessage string                 `json:"error_message,omitempty" gorm:"column:error_message;type:text"`
 	RetryCount   int                    `json:"retry_count" gorm:"column:retry_count;default:0"`
 	ScheduledAt  *time.Time             `json:"scheduled_at" gorm:"column:scheduled_at;not null"`
@@ -36,6 +36,9 @@ const (
 	ExtractActionsJobType = "extract_actions"
 	CreateTicketsJobType  = "create_tickets"
 	ProcessMeetingJobType = "process_meeting"
+	ExportJobType         = "export"
+	ArchiveExportJobType  = "archive_export"
+	SummarizeJobType      = "summarize"
 )
 
 // NewProcessingJob creates a new ProcessingJob entity
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/seedwork/domain/entities"
+)
+
+// ProcessingJobRepository defines the interface for background job persistence
+type ProcessingJobRepository interface {
+	Save(ctx context.Context, job *entities.ProcessingJob) error
+	Update(ctx context.Context, job *entities.ProcessingJob) error
+	FindByID(ctx context.Context, id string) (*entities.ProcessingJob, error)
+	FindByEntity(ctx context.Context, entityType, entityID, jobType string) ([]*entities.ProcessingJob, error)
+	FindByJobTypeAndStatus(ctx context.Context, jobType string, status entities.ProcessingJobStatus) ([]*entities.ProcessingJob, error)
+}
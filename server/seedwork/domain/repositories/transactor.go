@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// Transactor runs work in a single database transaction. Repositories called with the context
+// passed to fn take part in the transaction; if fn returns an error nothing it wrote is kept.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
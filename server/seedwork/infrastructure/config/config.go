@@ -3,16 +3,22 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	Database DatabaseConfig
-	Firebase FirebaseConfig
-	Server   ServerConfig
-	User     UserConfig
+	Database   DatabaseConfig
+	Firebase   FirebaseConfig
+	Server     ServerConfig
+	User       UserConfig
+	Export     ExportConfig
+	Upload     UploadConfig
+	AssemblyAI AssemblyAIConfig
+	LLM        LLMConfig
+	Ticketing  TicketingConfig
 }
 
 // DatabaseConfig holds database configuration
@@ -45,6 +51,42 @@ type UserConfig struct {
 	RepositoryType string // "gorm" or "firebase"
 }
 
+// ExportConfig holds transcription export configuration
+type ExportConfig struct {
+	Storage         string        // "local" or "firebase"
+	LocalDir        string        // Directory for locally stored exports
+	BaseURL         string        // Public URL of this server, used in local download links
+	SigningSecret   string        // Secret signing local download links; random per process when empty
+	StorageBucket   string        // Bucket for exports stored in Firebase Storage
+	LinkTTL         time.Duration // How long download links stay valid
+	PDFFontPath     string        // TrueType font for PDF exports, e.g. one covering CJK; the bundled Go font when empty
+	PDFBoldFontPath string        // Bold TrueType font for PDF exports; PDFFontPath when empty
+}
+
+// UploadConfig holds configuration for transcribing uploaded recordings
+type UploadConfig struct {
+	LocalDir  string // Directory recordings are kept in until they are transcribed
+	FetchURLs bool   // Whether recordings can be fetched from public URLs
+}
+
+// AssemblyAIConfig holds configuration for AssemblyAI LeMUR, used for summaries and meeting questions
+type AssemblyAIConfig struct {
+	APIKey     string // LeMUR is unavailable when empty
+	LemurModel string // LeMUR final model; AssemblyAI's default when empty
+}
+
+// LLMConfig holds configuration for the language model answering meeting questions
+type LLMConfig struct {
+	BaseURL string // OpenAI-compatible API; the OpenAI API when empty
+	APIKey  string
+	Model   string // No language model is used when empty
+}
+
+// TicketingConfig holds configuration for keeping action items in step with their tickets
+type TicketingConfig struct {
+	SyncInterval time.Duration // How often tickets are reconciled; never when zero
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file if it exists
@@ -73,6 +115,32 @@ func Load() (*Config, error) {
 		User: UserConfig{
 			RepositoryType: getEnv("USER_REPOSITORY_TYPE", "gorm"),
 		},
+		Export: ExportConfig{
+			Storage:         getEnv("EXPORT_STORAGE", "local"),
+			LocalDir:        getEnv("EXPORT_LOCAL_DIR", "./exports"),
+			BaseURL:         getEnv("PUBLIC_BASE_URL", "http://localhost:"+getEnv("PORT", "8080")),
+			SigningSecret:   getEnv("EXPORT_SIGNING_SECRET", ""),
+			StorageBucket:   getEnv("FIREBASE_STORAGE_BUCKET", ""),
+			LinkTTL:         getEnvDuration("EXPORT_LINK_TTL", 24*time.Hour),
+			PDFFontPath:     getEnv("PDF_FONT_PATH", ""),
+			PDFBoldFontPath: getEnv("PDF_BOLD_FONT_PATH", ""),
+		},
+		Upload: UploadConfig{
+			LocalDir:  getEnv("UPLOAD_LOCAL_DIR", "./uploads"),
+			FetchURLs: getEnvBool("UPLOAD_FETCH_URLS", true),
+		},
+		AssemblyAI: AssemblyAIConfig{
+			APIKey:     getEnv("ASSEMBLYAI_API_KEY", ""),
+			LemurModel: getEnv("LEMUR_FINAL_MODEL", ""),
+		},
+		LLM: LLMConfig{
+			BaseURL: getEnv("LLM_BASE_URL", ""),
+			APIKey:  getEnv("LLM_API_KEY", ""),
+			Model:   getEnv("LLM_MODEL", ""),
+		},
+		Ticketing: TicketingConfig{
+			SyncInterval: getEnvDuration("TICKET_SYNC_INTERVAL", 15*time.Minute),
+		},
 	}, nil
 }
 
@@ -93,3 +161,13 @@ func getEnvBool(key string, defaultValue bool) bool {
 	}
 	return defaultValue
 }
+
+// getEnvDuration gets an environment variable as a duration (e.g. "12h") or returns a default value
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value, exists := os.LookupEnv(key); exists && value != "" {
+		if parsed, err := time.ParseDuration(value); err == nil && parsed > 0 {
+			return parsed
+		}
+	}
+	return defaultValue
+}
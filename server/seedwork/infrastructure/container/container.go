@@ -77,6 +77,11 @@ func (c *Container) GetUserService() *services.UserService {
 	return c.UserService
 }
 
+// GetUserRepository returns the user repository
+func (c *Container) GetUserRepository() repositories.UserRepository {
+	return c.UserRepository
+}
+
 // GetAuthMiddleware returns the auth middleware
 func (c *Container) GetAuthMiddleware() *userMiddleware.AuthMiddleware {
 	return c.AuthMiddleware
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	"teammate/server/seedwork/domain/repositories"
+
+	"gorm.io/gorm"
+)
+
+// Ensure GormTransactor implements Transactor
+var _ repositories.Transactor = (*GormTransactor)(nil)
+
+// transactionKey is the context key of the transaction started by a GormTransactor
+type transactionKey struct{}
+
+// GormTransactor implements Transactor with GORM transactions
+type GormTransactor struct {
+	db *gorm.DB
+}
+
+// NewGormTransactor creates a new GORM transactor
+func NewGormTransactor() *GormTransactor {
+	return &GormTransactor{db: GetDB()}
+}
+
+// WithinTransaction runs fn in a transaction that is committed when fn succeeds and rolled back
+// otherwise. Inside a transaction fn joins it instead of starting another one.
+func (t *GormTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, transactionKey{}, tx))
+	})
+}
+
+// Conn returns the transaction running in ctx, or db when there is none, bound to ctx.
+// Repositories use it so their writes take part in a transaction started by a GormTransactor.
+func Conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
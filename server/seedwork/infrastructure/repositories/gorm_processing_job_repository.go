@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"context"
+
+	"teammate/server/seedwork/domain/entities"
+	"teammate/server/seedwork/infrastructure/database"
+
+	"gorm.io/gorm"
+)
+
+// GormProcessingJobRepository implements ProcessingJobRepository using GORM
+type GormProcessingJobRepository struct {
+	db *gorm.DB
+}
+
+// NewGormProcessingJobRepository creates a new GORM processing job repository
+func NewGormProcessingJobRepository() *GormProcessingJobRepository {
+	return &GormProcessingJobRepository{db: database.GetDB()}
+}
+
+// Save creates a new processing job
+func (r *GormProcessingJobRepository) Save(ctx context.Context, job *entities.ProcessingJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
+
+// Update saves changes to an existing processing job
+func (r *GormProcessingJobRepository) Update(ctx context.Context, job *entities.ProcessingJob) error {
+	return r.db.WithContext(ctx).Save(job).Error
+}
+
+// FindByID retrieves a processing job by ID
+func (r *GormProcessingJobRepository) FindByID(ctx context.Context, id string) (*entities.ProcessingJob, error) {
+	var job entities.ProcessingJob
+	err := r.db.WithContext(ctx).Where("deleted_at IS NULL").First(&job, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// FindByEntity retrieves the jobs of one type for an entity, newest first
+func (r *GormProcessingJobRepository) FindByEntity(ctx context.Context, entityType, entityID, jobType string) ([]*entities.ProcessingJob, error) {
+	var jobs []*entities.ProcessingJob
+	err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id = ? AND job_type = ? AND deleted_at IS NULL", entityType, entityID, jobType).
+		Order("scheduled_at DESC").
+		Find(&jobs).Error
+	return jobs, err
+}
+
+// FindByJobTypeAndStatus retrieves the jobs of one type in a status, oldest first
+func (r *GormProcessingJobRepository) FindByJobTypeAndStatus(ctx context.Context, jobType string, status entities.ProcessingJobStatus) ([]*entities.ProcessingJob, error) {
+	var jobs []*entities.ProcessingJob
+	err := r.db.WithContext(ctx).
+		Where("job_type = ? AND status = ? AND deleted_at IS NULL", jobType, status).
+		Order("scheduled_at ASC").
+		Find(&jobs).Error
+	return jobs, err
+}